@@ -16,6 +16,7 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/app"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
@@ -35,11 +36,17 @@ var appInstallProdAppFunc = apps.Add
 var appInstallDevAppFunc = apps.InstallLocalApp
 var appSelectPromptFunc = prompts.AppSelectPrompt
 
+// installApprovalPollInterval is the time between checks on a pending install request
+var installApprovalPollInterval = 30 * time.Second
+
 // Flags
 
 type addCmdFlags struct {
 	orgGrantWorkspaceID string
 	environmentFlag     string
+	requestReason       string
+	wait                bool
+	waitTimeout         time.Duration
 }
 
 var addFlags addCmdFlags
@@ -55,6 +62,8 @@ func NewAddCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "app install", Meaning: "Install a production app to a team"},
 			{Command: "app install --team T0123456 --environment deployed", Meaning: "Install a production app to a specific team"},
 			{Command: "app install --team T0123456 --environment local", Meaning: "Install a local dev app to a specific team"},
+			{Command: "app install --request-reason \"Posts release notes\"", Meaning: "Request approval to install with a reason"},
+			{Command: "app install --wait --wait-timeout 2h", Meaning: "Wait up to two hours for an admin to approve the install"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -62,6 +71,13 @@ func NewAddCommand(clients *shared.ClientFactory) *cobra.Command {
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if addFlags.waitTimeout <= 0 {
+				return slackerror.New(slackerror.ErrInvalidFlag).
+					WithMessage("The --wait-timeout flag must be a positive duration")
+			}
+			if addFlags.requestReason != "" {
+				ctx = config.SetContextRequestReason(ctx, addFlags.requestReason)
+			}
 			_, _, appInstance, err := runAddCommandFunc(ctx, clients, nil, addFlags.orgGrantWorkspaceID)
 			if err != nil {
 				return err
@@ -72,6 +88,9 @@ func NewAddCommand(clients *shared.ClientFactory) *cobra.Command {
 
 	cmd.Flags().StringVar(&addFlags.orgGrantWorkspaceID, cmdutil.OrgGrantWorkspaceFlag, "", cmdutil.OrgGrantWorkspaceDescription())
 	cmd.Flags().StringVarP(&addFlags.environmentFlag, "environment", "E", "", "environment of app (local, deployed)")
+	cmd.Flags().StringVar(&addFlags.requestReason, "request-reason", "", "request approval to install with this reason\n  if administrator approval is required")
+	cmd.Flags().BoolVar(&addFlags.wait, "wait", false, "wait for an admin to approve a pending install request\n  and then complete the install")
+	cmd.Flags().DurationVar(&addFlags.waitTimeout, "wait-timeout", 30*time.Minute, "stop waiting for approval after a duration like 2h")
 
 	return cmd
 }
@@ -185,6 +204,12 @@ func RunAddCommand(ctx context.Context, clients *shared.ClientFactory, selection
 	if err != nil {
 		return ctx, installState, types.App{}, err // pass the installState because some callers may use it to handle the error
 	}
+	if installState == types.InstallRequestPending && addFlags.wait {
+		installedApp, installState, err = waitForInstallApproval(ctx, clients, selection, orgGrantWorkspaceID)
+		if err != nil {
+			return ctx, installState, types.App{}, err
+		}
+	}
 
 	// Update the context with the token
 	ctx = config.SetContextToken(ctx, selection.Auth.Token)
@@ -192,6 +217,34 @@ func RunAddCommand(ctx context.Context, clients *shared.ClientFactory, selection
 	return ctx, installState, installedApp, nil
 }
 
+// waitForInstallApproval repeats the install of an app with a pending approval
+// request until an admin reviews the request or the --wait-timeout passes
+func waitForInstallApproval(ctx context.Context, clients *shared.ClientFactory, selection *prompts.SelectedApp, orgGrantWorkspaceID string) (types.App, types.InstallState, error) {
+	ctx = config.SetContextInstallApprovalWait(ctx, true)
+	timeout := time.After(addFlags.waitTimeout)
+	for {
+		clients.IO.PrintInfo(ctx, false, "%s", style.SectionSecondaryf(
+			"Waiting for an admin to approve the install request. Checking again in %s",
+			installApprovalPollInterval,
+		))
+		select {
+		case <-ctx.Done():
+			return types.App{}, types.InstallRequestPending, ctx.Err()
+		case <-timeout:
+			return types.App{}, types.InstallRequestPending, slackerror.New(slackerror.ErrAppApprovalRequestPending).
+				WithRemediation("No review of the install request happened within %s. Increase the time with the --wait-timeout flag", addFlags.waitTimeout)
+		case <-time.After(installApprovalPollInterval):
+		}
+		installedApp, installState, err := appInstall(ctx, clients, selection, orgGrantWorkspaceID)
+		if err != nil {
+			return types.App{}, installState, err
+		}
+		if installState != types.InstallRequestPending {
+			return installedApp, installState, nil
+		}
+	}
+}
+
 // printAddSuccess will print a list of the environments
 func printAddSuccess(clients *shared.ClientFactory, cmd *cobra.Command, appInstance types.App) error {
 	return runListCommand(cmd, clients)
@@ -17,6 +17,7 @@ package app
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/api"
 	"github.com/slackapi/slack-cli/internal/app"
@@ -27,10 +28,13 @@ import (
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Mock teams
@@ -927,3 +931,134 @@ func prepareAddMocks(t *testing.T, clients *shared.ClientFactory, clientsMock *s
 		}, nil)
 	}
 }
+
+func TestAppAddCommand_RequestReason(t *testing.T) {
+	var requestReason string
+	originalRunAddCommandFunc := runAddCommandFunc
+	t.Cleanup(func() {
+		runAddCommandFunc = originalRunAddCommandFunc
+	})
+	mockRunAddCommand := func(ctx context.Context, clients *shared.ClientFactory, selection *prompts.SelectedApp, orgGrantWorkspaceID string) (context.Context, types.InstallState, types.App, error) {
+		requestReason = config.GetContextRequestReason(ctx)
+		return ctx, types.InstallRequestPending, mockAppTeam1, nil
+	}
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"sets the request reason for approval requests": {
+			CmdArgs: []string{"--request-reason", "Posts release notes"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				prepareAddMocks(t, cf, cm, "")
+				requestReason = ""
+				runAddCommandFunc = mockRunAddCommand
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, "Posts release notes", requestReason)
+			},
+		},
+		"leaves the request reason unset without the flag": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				prepareAddMocks(t, cf, cm, "")
+				requestReason = "unexpected"
+				runAddCommandFunc = mockRunAddCommand
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, requestReason)
+			},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewAddCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		cf.Config.SetFlags(cmd)
+		return cmd
+	})
+}
+
+func Test_waitForInstallApproval(t *testing.T) {
+	tests := map[string]struct {
+		installStates     []types.InstallState
+		pollInterval      time.Duration
+		waitTimeout       time.Duration
+		cancelContext     bool
+		expectedState     types.InstallState
+		expectedApp       types.App
+		expectedErrorCode string
+		expectedErrorIs   error
+		expectedInstalls  int
+	}{
+		"completes the install once the request is approved": {
+			installStates:    []types.InstallState{types.InstallRequestPending, types.InstallSuccess},
+			pollInterval:     0,
+			waitTimeout:      time.Hour,
+			expectedState:    types.InstallSuccess,
+			expectedApp:      mockAppTeam1,
+			expectedInstalls: 2,
+		},
+		"stops waiting when the request is no longer pending": {
+			installStates:    []types.InstallState{types.InstallRequestCancelled},
+			pollInterval:     0,
+			waitTimeout:      time.Hour,
+			expectedState:    types.InstallRequestCancelled,
+			expectedApp:      mockAppTeam1,
+			expectedInstalls: 1,
+		},
+		"errors when the request is pending after the timeout": {
+			pollInterval:      time.Hour,
+			waitTimeout:       time.Millisecond,
+			expectedState:     types.InstallRequestPending,
+			expectedErrorCode: slackerror.ErrAppApprovalRequestPending,
+		},
+		"stops waiting when the context is cancelled": {
+			pollInterval:    time.Hour,
+			waitTimeout:     time.Hour,
+			cancelContext:   true,
+			expectedState:   types.InstallRequestPending,
+			expectedErrorIs: context.Canceled,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(slackcontext.MockContext(t.Context()))
+			defer cancel()
+			if tc.cancelContext {
+				cancel()
+			}
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			originalPollInterval := installApprovalPollInterval
+			originalInstallProdAppFunc := appInstallProdAppFunc
+			originalAddFlags := addFlags
+			defer func() {
+				installApprovalPollInterval = originalPollInterval
+				appInstallProdAppFunc = originalInstallProdAppFunc
+				addFlags = originalAddFlags
+			}()
+			installApprovalPollInterval = tc.pollInterval
+			addFlags.waitTimeout = tc.waitTimeout
+			installs := 0
+			appInstallProdAppFunc = func(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, app types.App, orgGrantWorkspaceID string) (types.InstallState, types.App, error) {
+				assert.True(t, config.GetContextInstallApprovalWait(ctx))
+				installState := tc.installStates[installs]
+				installs++
+				return installState, app, nil
+			}
+
+			selection := &prompts.SelectedApp{Auth: mockAuthTeam1, App: mockAppTeam1}
+			app, installState, err := waitForInstallApproval(ctx, clients, selection, "")
+			switch {
+			case tc.expectedErrorCode != "":
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorCode, slackerror.ToSlackError(err).Code)
+			case tc.expectedErrorIs != nil:
+				assert.ErrorIs(t, err, tc.expectedErrorIs)
+			default:
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedState, installState)
+			assert.Equal(t, tc.expectedApp, app)
+			assert.Equal(t, tc.expectedInstalls, installs)
+		})
+	}
+}
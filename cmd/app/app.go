@@ -29,10 +29,12 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 		Short:   "Install, uninstall, and list teams with the app installed",
 		Long:    "Install, uninstall, and list teams with the app installed",
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "app info", Meaning: "Show details about an app"},
 			{Command: "app install", Meaning: "Install a production app to a team"},
 			{Command: "app link", Meaning: "Link an existing app to the project"},
 			{Command: "app list", Meaning: "List all teams with the app installed"},
 			{Command: "app settings", Meaning: "Open app settings in a web browser"},
+			{Command: "app status", Meaning: "Check if an app is installed and hosted"},
 			{Command: "app uninstall", Meaning: "Uninstall an app from a team"},
 			{Command: "app unlink", Meaning: "Remove a linked app from the project"},
 			{Command: "app delete", Meaning: "Delete an app and app info from a team"},
@@ -50,9 +52,11 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	// Add child commands
 	cmd.AddCommand(NewAddCommand(clients))
 	cmd.AddCommand(NewDeleteCommand(clients))
+	cmd.AddCommand(NewInfoCommand(clients))
 	cmd.AddCommand(NewLinkCommand(clients))
 	cmd.AddCommand(NewListCommand(clients))
 	cmd.AddCommand(NewSettingsCommand(clients))
+	cmd.AddCommand(NewStatusCommand(clients))
 	cmd.AddCommand(NewUninstallCommand(clients))
 	cmd.AddCommand(NewUnlinkCommand(clients))
 
@@ -17,6 +17,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/iostreams"
@@ -29,6 +30,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+type deleteCmdFlags struct {
+	keepLocal bool
+	localOnly bool
+}
+
+var deleteFlags deleteCmdFlags
+
 // Handle to client's function used for testing
 var runDeleteCommandFunc = RunDeleteCommand
 
@@ -40,10 +48,19 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 		Use:     "delete [flags]",
 		Aliases: []string{"del"},
 		Short:   "Delete the app",
-		Long:    "Uninstall the app from the team and permanently delete the app and all of its data",
+		Long: strings.Join([]string{
+			"Uninstall the app from the team and permanently delete the app and all of its data",
+			"",
+			"The app is also removed from project files unless the --keep-local flag is used.",
+			"Kept apps are marked as deleted and are no longer selected by other commands.",
+			"Use the --local-only flag to remove the app from project files without deleting",
+			"the app from Slack.",
+		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "app delete", Meaning: "Delete an app and app info from a team"},
 			{Command: "app delete --team T0123456 --app local", Meaning: "Delete a specific app from a team"},
+			{Command: "app delete --keep-local", Meaning: "Delete an app but keep it saved in project files"},
+			{Command: "app delete --local-only", Meaning: "Remove an app from project files but not from Slack"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			// Verify command is run in a project directory
@@ -59,6 +76,8 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 			return printDeleteSuccess(ctx, clients, cmd, env)
 		},
 	}
+	cmd.Flags().BoolVar(&deleteFlags.keepLocal, "keep-local", false, "delete the app but keep it saved in project files")
+	cmd.Flags().BoolVar(&deleteFlags.localOnly, "local-only", false, "remove the app from project files without\n  deleting the app")
 
 	return cmd
 }
@@ -68,6 +87,10 @@ func RunDeleteCommand(ctx context.Context, clients *shared.ClientFactory, cmd *c
 	if cmd == nil {
 		return types.App{}, slackerror.New("command is nil")
 	}
+	if deleteFlags.keepLocal && deleteFlags.localOnly {
+		return types.App{}, slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --keep-local and --local-only flags cannot be used together")
+	}
 
 	// Get the app auth selection from the flag or prompt
 	selection, err := deleteAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
@@ -78,7 +101,7 @@ func RunDeleteCommand(ctx context.Context, clients *shared.ClientFactory, cmd *c
 			return types.App{}, err
 		}
 	}
-	if selection.Auth.TeamDomain == "" {
+	if selection.Auth.TeamDomain == "" && !deleteFlags.localOnly {
 		return types.App{}, slackerror.New(slackerror.ErrCredentialsNotFound)
 	}
 
@@ -99,25 +122,57 @@ func RunDeleteCommand(ctx context.Context, clients *shared.ClientFactory, cmd *c
 	}
 
 	// Execute the command
-	env, teamName, err := apps.Delete(ctx, clients, team, selection.App, selection.Auth)
+	if deleteFlags.localOnly {
+		if err := apps.RemoveSaved(ctx, clients, selection.App); err != nil {
+			return types.App{}, err
+		}
+		printDeleteLocal(clients, selection.App.AppID, true)
+		return selection.App, nil
+	}
+	env, teamName, err := apps.DeleteRemote(ctx, clients, team, selection.App, selection.Auth)
 	if err != nil {
 		return env, err
 	}
 	printDeleteApp(ctx, clients, selection.App.AppID, teamName)
+	if deleteFlags.keepLocal {
+		if err := apps.MarkSavedDeleted(ctx, clients, selection.App); err != nil {
+			return types.App{}, err
+		}
+	} else {
+		if err := apps.RemoveSaved(ctx, clients, selection.App); err != nil {
+			return types.App{}, err
+		}
+	}
+	printDeleteLocal(clients, selection.App.AppID, !deleteFlags.keepLocal)
 
 	return env, nil
 }
 
 func confirmDeletion(ctx context.Context, IO iostreams.IOStreamer, app prompts.SelectedApp) (bool, error) {
+	if deleteFlags.localOnly {
+		IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "warning",
+			Text:  style.Bold("Project files"),
+			Secondary: []string{
+				fmt.Sprintf("App (%s) will be removed from project files", app.App.AppID),
+				"The app and its data will not be deleted from Slack",
+			},
+		}))
+		return IO.ConfirmPrompt(ctx, "Are you sure you want to remove the app from project files?", false)
+	}
+	secondary := []string{
+		fmt.Sprintf("App (%s) will be permanently deleted", app.App.AppID),
+		"All triggers, workflows, and functions will be deleted",
+		"All datastores for this app will be deleted",
+		"Once you delete this app, there is no going back",
+	}
+	if deleteFlags.keepLocal {
+		secondary = append(secondary, "The app will stay saved in project files and marked as deleted")
+	}
 	IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
-		Emoji: "warning",
-		Text:  style.Bold("Danger zone"),
-		Secondary: []string{
-			fmt.Sprintf("App (%s) will be permanently deleted", app.App.AppID),
-			"All triggers, workflows, and functions will be deleted",
-			"All datastores for this app will be deleted",
-			"Once you delete this app, there is no going back",
-		},
+		Emoji:     "warning",
+		Text:      style.Bold("Danger zone"),
+		Secondary: secondary,
 	}))
 
 	proceed, err := IO.ConfirmPrompt(ctx, "Are you sure you want to delete the app?", false)
@@ -142,6 +197,21 @@ func printDeleteApp(ctx context.Context, clients *shared.ClientFactory, appID st
 	}))))
 }
 
+// printDeleteLocal displays info about what happened to the app saved in project files
+func printDeleteLocal(clients *shared.ClientFactory, appID string, removed bool) {
+	secondary := []string{fmt.Sprintf(`Removed the app "%s" from project files`, appID)}
+	if !removed {
+		secondary = []string{fmt.Sprintf(`Kept the app "%s" saved in project files and marked it as deleted`, appID)}
+	} else if deleteFlags.localOnly {
+		secondary = append(secondary, fmt.Sprintf(`The app "%s" was not deleted from Slack`, appID))
+	}
+	_, _ = clients.IO.WriteOut().Write([]byte(fmt.Sprintf("\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "open_file_folder",
+		Text:      "Project Files",
+		Secondary: secondary,
+	}))))
+}
+
 // printDeleteSuccess will print a list of the apps
 func printDeleteSuccess(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, app types.App) error {
 	// Print all apps
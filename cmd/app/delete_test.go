@@ -141,6 +141,75 @@ func TestAppsDeleteCommand(t *testing.T) {
 			},
 			ExpectedError: fmt.Errorf("something went terribly wrong"),
 		},
+		"deletes the app but keeps it in project files with keep-local": {
+			CmdArgs: []string{"--keep-local"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				prepareCommonDeleteMocks(t, cf, cm)
+				appSelectMock := prompts.NewAppSelectMock()
+				deleteAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{
+					Auth: types.SlackAuth{TeamDomain: fakeDeployedApp.TeamDomain},
+					App:  fakeDeployedApp,
+				}, nil)
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Are you sure you want to delete the app?", mock.Anything).Return(true, nil)
+				cm.API.On("ValidateSession", mock.Anything, mock.Anything).Return(api.AuthSession{
+					TeamName: &fakeDeployedApp.TeamDomain,
+					TeamID:   &fakeDeployedApp.TeamID,
+				}, nil)
+				cm.API.On("DeleteApp", mock.Anything, mock.Anything, fakeDeployedApp.AppID).Return(nil)
+				appClientMock := &app.AppClientMock{}
+				appClientMock.On("Remove", mock.Anything, mock.Anything).Return(fakeDeployedApp, nil)
+				appClientMock.On("SaveDeployed", mock.Anything, mock.Anything).Return(nil)
+				cf.AppClient().AppClientInterface = appClientMock
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "DeleteApp", mock.Anything, mock.Anything, fakeDeployedApp.AppID)
+				appClientMock := cm.AppClient.AppClientInterface.(*app.AppClientMock)
+				appClientMock.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+				appClientMock.AssertCalled(t, "SaveDeployed", mock.Anything, mock.MatchedBy(func(saved types.App) bool {
+					return saved.AppID == fakeDeployedApp.AppID && saved.IsDeleted()
+				}))
+			},
+			ExpectedStdoutOutputs: []string{
+				fmt.Sprintf(`Uninstalled the app "%s" from "%s"`, fakeDeployedApp.AppID, fakeDeployedApp.TeamDomain),
+				fmt.Sprintf(`Kept the app "%s" saved in project files and marked it as deleted`, fakeDeployedApp.AppID),
+			},
+		},
+		"removes the app from project files without deleting it with local-only": {
+			CmdArgs: []string{"--local-only"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				prepareCommonDeleteMocks(t, cf, cm)
+				appSelectMock := prompts.NewAppSelectMock()
+				deleteAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{
+					Auth: types.SlackAuth{TeamDomain: fakeLocalApp.TeamDomain},
+					App:  fakeLocalApp,
+				}, nil)
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Are you sure you want to remove the app from project files?", mock.Anything).Return(true, nil)
+				appClientMock := &app.AppClientMock{}
+				appClientMock.On("Remove", mock.Anything, mock.Anything).Return(fakeLocalApp, nil)
+				cf.AppClient().AppClientInterface = appClientMock
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "DeleteApp", mock.Anything, mock.Anything, mock.Anything)
+				appClientMock := cm.AppClient.AppClientInterface.(*app.AppClientMock)
+				appClientMock.AssertCalled(t, "Remove", mock.Anything, fakeLocalApp)
+			},
+			ExpectedStdoutOutputs: []string{
+				fmt.Sprintf(`Removed the app "%s" from project files`, fakeLocalApp.AppID),
+				fmt.Sprintf(`The app "%s" was not deleted from Slack`, fakeLocalApp.AppID),
+			},
+		},
+		"errors if both keep-local and local-only are used": {
+			CmdArgs:              []string{"--keep-local", "--local-only"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				prepareCommonDeleteMocks(t, cf, cm)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "DeleteApp", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 		"errors if authentication for the team is missing": {
 			CmdArgs:       []string{},
 			ExpectedError: slackerror.New(slackerror.ErrCredentialsNotFound),
@@ -0,0 +1,222 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type infoCmdFlags struct {
+	output            string
+	withCollaborators bool
+	withTriggers      bool
+}
+
+var infoFlags infoCmdFlags
+
+var infoAppSelectPromptFunc = prompts.AppSelectPrompt
+
+// appInfo is the inventory record of an app
+type appInfo struct {
+	AppID          string   `json:"app_id"`
+	TeamID         string   `json:"team_id"`
+	TeamDomain     string   `json:"team_domain"`
+	EnterpriseID   string   `json:"enterprise_id,omitempty"`
+	Environment    string   `json:"environment"`
+	InstallStatus  string   `json:"install_status"`
+	ManifestSource string   `json:"manifest_source"`
+	Datastores     []string `json:"datastores"`
+	Collaborators  *int     `json:"collaborators,omitempty"`
+	Triggers       *int     `json:"triggers,omitempty"`
+}
+
+// NewInfoCommand returns a new Cobra command for app info
+func NewInfoCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info [flags]",
+		Short: "Show details about the app",
+		Long: strings.Join([]string{
+			"Show the team, installation status, manifest source, and datastores of an app.",
+			"",
+			"Counts of collaborators and triggers require more requests and are included",
+			"with the --with-collaborators and --with-triggers flags.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "app info", Meaning: "Show details about a selected app"},
+			{Command: "app info --app A0123456789 --output json", Meaning: "Print details about an app as JSON"},
+			{Command: "app info --with-collaborators --with-triggers", Meaning: "Include counts of collaborators and triggers"},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInfoCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&infoFlags.output, "output", "text", "output format: text, json")
+	cmd.Flags().BoolVar(&infoFlags.withCollaborators, "with-collaborators", false, "include the count of app collaborators")
+	cmd.Flags().BoolVar(&infoFlags.withTriggers, "with-triggers", false, "include the count of app triggers")
+	return cmd
+}
+
+// runInfoCommand gathers and prints details about the selected app
+func runInfoCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch infoFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", infoFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	selection, err := infoAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
+	if err != nil {
+		return err
+	}
+	info, err := getAppInfo(ctx, clients, selection)
+	if err != nil {
+		return err
+	}
+	if infoFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(info); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
+	printAppInfo(ctx, clients, info)
+	return nil
+}
+
+// getAppInfo collects details about an app from the project and API
+func getAppInfo(ctx context.Context, clients *shared.ClientFactory, selection prompts.SelectedApp) (appInfo, error) {
+	app := selection.App
+	token := selection.Auth.Token
+	info := appInfo{
+		AppID:        app.AppID,
+		TeamID:       app.TeamID,
+		TeamDomain:   selection.Auth.TeamDomain,
+		EnterpriseID: app.EnterpriseID,
+		Environment:  "deployed",
+		Datastores:   []string{},
+	}
+	if app.IsDev {
+		info.Environment = "local"
+	}
+	if info.TeamDomain == "" {
+		info.TeamDomain = app.TeamDomain
+	}
+
+	status, err := clients.API().GetAppStatus(ctx, token, []string{app.AppID}, selection.Auth.TeamID)
+	if err != nil {
+		return appInfo{}, err
+	}
+	installStatus := types.AppInstallationStatusUnknown
+	for _, a := range status.Apps {
+		if a.AppID != app.AppID {
+			continue
+		}
+		if a.Installed {
+			installStatus = types.AppStatusInstalled
+		} else {
+			installStatus = types.AppStatusUninstalled
+		}
+	}
+	info.InstallStatus = strings.ToLower(installStatus.String())
+
+	source, err := clients.Config.ProjectConfig.GetManifestSource(ctx)
+	if err != nil {
+		return appInfo{}, err
+	}
+	info.ManifestSource = source.String()
+
+	manifest, err := clients.AppClient().Manifest.GetManifestRemote(ctx, token, app.AppID)
+	if err != nil {
+		return appInfo{}, err
+	}
+	for name := range manifest.Datastores {
+		info.Datastores = append(info.Datastores, name)
+	}
+	sort.Strings(info.Datastores)
+
+	if infoFlags.withCollaborators {
+		collaborators, err := clients.API().ListCollaborators(ctx, token, app.AppID)
+		if err != nil {
+			return appInfo{}, err
+		}
+		count := len(collaborators)
+		info.Collaborators = &count
+	}
+	if infoFlags.withTriggers {
+		triggers, _, err := clients.API().WorkflowsTriggersList(ctx, token, api.TriggerListRequest{
+			AppID: app.AppID,
+			Limit: 0, // 0 means no pagination
+			Type:  "all",
+		})
+		if err != nil {
+			return appInfo{}, err
+		}
+		count := len(triggers)
+		info.Triggers = &count
+	}
+	return info, nil
+}
+
+// printAppInfo displays the details about an app
+func printAppInfo(ctx context.Context, clients *shared.ClientFactory, info appInfo) {
+	details := []string{
+		fmt.Sprintf("App ID: %s", info.AppID),
+		fmt.Sprintf("Team: %s (%s)", info.TeamDomain, info.TeamID),
+	}
+	if info.EnterpriseID != "" {
+		details = append(details, fmt.Sprintf("Enterprise ID: %s", info.EnterpriseID))
+	}
+	details = append(details,
+		fmt.Sprintf("Environment: %s", info.Environment),
+		fmt.Sprintf("Status: %s", info.InstallStatus),
+		fmt.Sprintf("Manifest source: %s", info.ManifestSource),
+	)
+	if len(info.Datastores) > 0 {
+		details = append(details, fmt.Sprintf("Datastores: %s", strings.Join(info.Datastores, ", ")))
+	} else {
+		details = append(details, "Datastores: None")
+	}
+	if info.Collaborators != nil {
+		details = append(details, fmt.Sprintf("Collaborators: %d", *info.Collaborators))
+	}
+	if info.Triggers != nil {
+		details = append(details, fmt.Sprintf("Triggers: %d", *info.Triggers))
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "house",
+		Text:      "App info",
+		Secondary: details,
+	}))
+}
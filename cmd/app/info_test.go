@@ -0,0 +1,128 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_App_InfoCommand(t *testing.T) {
+	selection := prompts.SelectedApp{
+		App:  types.App{AppID: "A0123456789", TeamID: "T0123456789", TeamDomain: "team", IsDev: true},
+		Auth: types.SlackAuth{TeamID: "T0123456789", TeamDomain: "team", Token: "xoxp-example"},
+	}
+	setupInfoMocks := func(t *testing.T, cm *shared.ClientsMock) {
+		appSelectMock := prompts.NewAppSelectMock()
+		appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).
+			Return(selection, nil)
+		infoAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+		cm.API.On("GetAppStatus", mock.Anything, "xoxp-example", []string{"A0123456789"}, "T0123456789").
+			Return(api.GetAppStatusResult{Apps: []api.AppStatusResultAppInfo{{AppID: "A0123456789", Installed: true}}}, nil)
+		projectConfigMock := config.NewProjectConfigMock()
+		projectConfigMock.On("GetManifestSource", mock.Anything).Return(config.ManifestSourceLocal, nil)
+		cm.Config.ProjectConfig = projectConfigMock
+		manifestMock := &app.ManifestMockObject{}
+		manifestMock.On("GetManifestRemote", mock.Anything, "xoxp-example", "A0123456789").Return(
+			types.SlackYaml{
+				AppManifest: types.AppManifest{
+					Datastores: map[string]types.ManifestDatastore{"tasks": {}, "drafts": {}},
+				},
+			},
+			nil,
+		)
+		cm.AppClient.Manifest = manifestMock
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints details about the app": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupInfoMocks(t, cm)
+			},
+			ExpectedOutputs: []string{
+				"App ID: A0123456789",
+				"Team: team (T0123456789)",
+				"Environment: local",
+				"Status: installed",
+				"Manifest source: local",
+				"Datastores: drafts, tasks",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "Collaborators")
+				assert.NotContains(t, cm.GetStdoutOutput(), "Triggers")
+				cm.API.AssertNotCalled(t, "ListCollaborators", mock.Anything, mock.Anything, mock.Anything)
+				cm.API.AssertNotCalled(t, "WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"prints details with counts as json": {
+			CmdArgs: []string{"--output", "json", "--with-collaborators", "--with-triggers"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupInfoMocks(t, cm)
+				cm.API.On("ListCollaborators", mock.Anything, "xoxp-example", "A0123456789").
+					Return([]types.SlackUser{{ID: "U0001"}, {ID: "U0002"}}, nil)
+				cm.API.On("WorkflowsTriggersList", mock.Anything, "xoxp-example", api.TriggerListRequest{AppID: "A0123456789", Type: "all"}).
+					Return([]types.DeployedTrigger{{ID: "Ft0001"}}, "", nil)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var info appInfo
+				require.NoError(t, json.Unmarshal([]byte(cm.GetStdoutOutput()), &info))
+				collaborators, triggers := 2, 1
+				assert.Equal(t, appInfo{
+					AppID:          "A0123456789",
+					TeamID:         "T0123456789",
+					TeamDomain:     "team",
+					Environment:    "local",
+					InstallStatus:  "installed",
+					ManifestSource: "local",
+					Datastores:     []string{"drafts", "tasks"},
+					Collaborators:  &collaborators,
+					Triggers:       &triggers,
+				}, info)
+			},
+		},
+		"errors if the app status cannot be found": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).
+					Return(selection, nil)
+				infoAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				cm.API.On("GetAppStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(api.GetAppStatusResult{}, slackerror.New(slackerror.ErrAppNotFound))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppNotFound},
+		},
+		"errors for an invalid output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewInfoCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
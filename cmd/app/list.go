@@ -15,7 +15,11 @@
 package app
 
 import (
+	"cmp"
+	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -23,17 +27,26 @@ import (
 	"github.com/slackapi/slack-cli/internal/pkg/apps"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/cobra"
 )
 
 // Handle to client's function used for testing
 var listFunc = apps.List
+var listStaleFunc = apps.ListStale
 
 // Flags
 
 type listCmdFlags struct {
 	displayAllOrgGrants bool
+	installedOn         string
+	jsonLines           bool
+	output              string
+	prune               bool
+	reverse             bool
+	sort                string
+	stale               bool
 }
 
 var listFlags listCmdFlags
@@ -47,6 +60,14 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 		Long:    "List all teams that have installed the app",
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "app list", Meaning: "List all teams with the app installed"},
+			{Command: "app list --team T0123456", Meaning: "List the apps installed to a specific team"},
+			{Command: "app list --installed-on T0123456", Meaning: "List the apps that are installed on a workspace"},
+			{Command: "app list --stale", Meaning: "List saved apps that no longer exist"},
+			{Command: "app list --stale --prune", Meaning: "Remove saved apps that no longer exist"},
+			{Command: "app list --stale --prune --force", Meaning: "Remove saved apps without a confirmation prompt"},
+			{Command: "app list --sort status --reverse", Meaning: "List apps grouped by install status"},
+			{Command: "app list --sort team --output json", Meaning: "Print the apps ordered by team ID as JSON"},
+			{Command: "app list --json-lines", Meaning: "Print each app as a line of JSON"},
 		}),
 		Args: cobra.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -54,42 +75,346 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 			return cmdutil.IsValidProjectDirectory(clients)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runListCommand(cmd, clients)
+			if listFlags.prune && !listFlags.stale {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --prune flag requires the --stale flag").
+					WithRemediation("Remove stale apps with %s", style.Commandf("app list --stale --prune", false))
+			}
+			switch listFlags.sort {
+			case "", "name", "team", "status":
+			default:
+				return slackerror.New(slackerror.ErrInvalidFlag).
+					WithMessage("Invalid sort order: %s", listFlags.sort).
+					WithRemediation("Use one of: name, team, status")
+			}
+			switch listFlags.output {
+			case "text", "json":
+			default:
+				return slackerror.New(slackerror.ErrInvalidFlag).
+					WithMessage("Invalid output format: %s", listFlags.output).
+					WithRemediation("Use one of: text, json")
+			}
+			if listFlags.output == "json" && listFlags.stale {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --output json flag cannot be used with the --stale flag")
+			}
+			if listFlags.jsonLines && cmd.Flags().Changed("output") {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --json-lines flag cannot be used with the --output flag")
+			}
+			if listFlags.installedOn != "" && listFlags.stale {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --installed-on flag cannot be used with the --stale flag")
+			}
+			if listFlags.jsonLines && listFlags.stale {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --json-lines flag cannot be used with the --stale flag")
+			}
+			return runListTeamCommand(cmd, clients, clients.Config.TeamFlag)
 		},
 	}
 
 	cmd.Flags().BoolVar(&listFlags.displayAllOrgGrants, "all-org-workspace-grants", false, "display all workspace grants for an app\ninstalled to an organization")
+	cmd.Flags().StringVar(&listFlags.installedOn, "installed-on", "", "list the apps installed on a team ID or domain")
+	cmd.Flags().BoolVar(&listFlags.jsonLines, "json-lines", false, "print each app as a line of JSON")
+	cmd.Flags().StringVar(&listFlags.output, "output", "text", "output format: text, json")
+	cmd.Flags().BoolVar(&listFlags.prune, "prune", false, "remove stale apps from the project")
+	cmd.Flags().BoolVar(&listFlags.reverse, "reverse", false, "reverse the order of listed apps")
+	cmd.Flags().StringVar(&listFlags.sort, "sort", "", "order apps by name, team, or status")
+	cmd.Flags().BoolVar(&listFlags.stale, "stale", false, "list saved apps that no longer exist")
 
 	return cmd
 }
 
 // runListCommand will execute the list command
 func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	return runListTeamCommand(cmd, clients, "")
+}
+
+// runListTeamCommand lists the apps installed to a team or every app if the
+// team is empty
+func runListTeamCommand(cmd *cobra.Command, clients *shared.ClientFactory, team string) error {
 	ctx := cmd.Context()
 	envs, _, err := listFunc(ctx, clients)
 	if err != nil {
 		return err
 	}
-	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+	var secondaryText []string
+	if team != "" {
+		auth, err := resolveListTeam(ctx, clients, team)
+		if err != nil {
+			return err
+		}
+		envs = filterAppsByTeam(envs, auth.TeamID)
+		if len(envs) == 0 {
+			secondaryText = []string{fmt.Sprintf("This project has no apps on %s", auth.TeamDomain)}
+		}
+	}
+	if listFlags.installedOn != "" {
+		auth, err := resolveListTeam(ctx, clients, listFlags.installedOn)
+		if err != nil {
+			return err
+		}
+		envs, err = filterAppsInstalledOn(ctx, clients, envs, auth)
+		if err != nil {
+			return err
+		}
+		if len(envs) == 0 && len(secondaryText) == 0 {
+			secondaryText = []string{fmt.Sprintf("No apps of this project are installed on %s", auth.TeamDomain)}
+		}
+	}
+	sortApps(envs, listFlags.sort, listFlags.reverse)
+	if listFlags.jsonLines {
+		return printListJSONLines(clients, envs)
+	}
+	if listFlags.output == "json" {
+		return printListJSON(clients, envs)
+	}
+	if listFlags.stale && len(secondaryText) == 0 {
+		return runListStaleCommand(ctx, clients, envs)
+	}
+	if len(secondaryText) == 0 {
+		secondaryText = FormatListSuccess(envs)
+	}
+	_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
 		Emoji:     "house_buildings",
 		Text:      "Apps",
-		Secondary: FormatListSuccess(envs),
+		Secondary: secondaryText,
+	}))
+	return nil
+}
+
+// runListStaleCommand lists saved apps that no longer exist and removes these
+// from the project if the prune flag is set
+func runListStaleCommand(ctx context.Context, clients *shared.ClientFactory, envs []types.App) error {
+	stale, err := listStaleFunc(ctx, clients, envs)
+	if err != nil {
+		return err
+	}
+	if len(stale) == 0 {
+		_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
+			Emoji:     "house_buildings",
+			Text:      "Stale apps",
+			Secondary: []string{"No saved apps were found that no longer exist"},
+		}))
+		return nil
+	}
+	if !listFlags.prune {
+		_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
+			Emoji:     "house_buildings",
+			Text:      "Stale apps",
+			Secondary: append(FormatListSuccess(stale), fmt.Sprintf("Remove these apps from the project with %s", style.Commandf("app list --stale --prune", false))),
+		}))
+		return nil
+	}
+	_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
+		Emoji:     "house_buildings",
+		Text:      "Stale apps",
+		Secondary: append(FormatListSuccess(stale), "These apps will be removed from the project but not deleted from Slack"),
 	}))
+	if !clients.Config.ForceFlag {
+		if !clients.IO.IsTTY() {
+			return slackerror.New(slackerror.ErrPrompt).
+				WithMessage("Removing stale apps without prompts requires the --force flag").
+				WithRemediation("Remove stale apps with %s", style.Commandf("app list --stale --prune --force", false))
+		}
+		proceed, err := clients.IO.ConfirmPrompt(ctx, "Are you sure you want to remove these apps from the project?", false)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
+				Emoji: "thumbs_up",
+				Text:  "No apps were removed from the project",
+			}))
+			return nil
+		}
+	}
+	removed := []string{}
+	for _, app := range stale {
+		if _, err := clients.AppClient().Remove(ctx, app); err != nil {
+			return err
+		}
+		removed = append(removed, fmt.Sprintf("Removed %s from %s", app.AppID, formatListTeamDomain(app)))
+	}
+	_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
+		Emoji:     "wastebasket",
+		Text:      "Stale apps",
+		Secondary: removed,
+	}))
+	return nil
+}
+
+// listedApp is an app printed with the --output json or --json-lines flag
+type listedApp struct {
+	AppID        string `json:"app_id"`
+	TeamID       string `json:"team_id"`
+	TeamDomain   string `json:"team_domain"`
+	EnterpriseID string `json:"enterprise_id,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	IsDev        bool   `json:"is_dev"`
+	Status       string `json:"status"`
+}
+
+// sortApps orders apps by the name shown in the list, the team ID, or the
+// install status with ties ordered by name and app ID
+//
+// Apps keep the listed order without a sort and are reversed if reverse is set.
+func sortApps(apps []types.App, by string, reverse bool) {
+	if by != "" {
+		slices.SortStableFunc(apps, func(a, b types.App) int {
+			var order int
+			switch by {
+			case "team":
+				order = cmp.Compare(a.TeamID, b.TeamID)
+			case "status":
+				order = cmp.Compare(formatListStatus(a), formatListStatus(b))
+			}
+			return cmp.Or(
+				order,
+				cmp.Compare(formatListTeamDomain(a), formatListTeamDomain(b)),
+				cmp.Compare(a.AppID, b.AppID),
+			)
+		})
+	}
+	if reverse {
+		slices.Reverse(apps)
+	}
+}
+
+// printListJSON prints the apps with an app ID as a JSON array in order
+func printListJSON(clients *shared.ClientFactory, apps []types.App) error {
+	listed := []listedApp{}
+	for _, app := range apps {
+		if app.AppID == "" {
+			continue
+		}
+		listed = append(listed, newListedApp(app))
+	}
+	encoder := json.NewEncoder(clients.IO.WriteOut())
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(listed); err != nil {
+		return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+	}
+	return nil
+}
+
+// printListJSONLines prints each app with an app ID as a JSON object on its
+// own line in order
+//
+// Lines are written as each app is encoded so large lists can be processed
+// before the output is complete.
+func printListJSONLines(clients *shared.ClientFactory, apps []types.App) error {
+	encoder := json.NewEncoder(clients.IO.WriteOut())
+	for _, app := range apps {
+		if app.AppID == "" {
+			continue
+		}
+		if err := encoder.Encode(newListedApp(app)); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+	}
 	return nil
 }
 
+// newListedApp returns the details of an app that are printed as JSON
+func newListedApp(app types.App) listedApp {
+	return listedApp{
+		AppID:        app.AppID,
+		TeamID:       app.TeamID,
+		TeamDomain:   app.TeamDomain,
+		EnterpriseID: app.EnterpriseID,
+		UserID:       app.UserID,
+		IsDev:        app.IsDev,
+		Status:       formatListStatus(app),
+	}
+}
+
+// resolveListTeam finds the authorization of a team ID or team domain
+func resolveListTeam(ctx context.Context, clients *shared.ClientFactory, team string) (types.SlackAuth, error) {
+	auth, err := clients.Auth().AuthWithTeamID(ctx, team)
+	if err == nil {
+		return auth, nil
+	}
+	auth, err = clients.Auth().AuthWithTeamDomain(ctx, team)
+	if err == nil {
+		return auth, nil
+	}
+	return types.SlackAuth{}, slackerror.New(slackerror.ErrTeamNotFound).
+		WithMessage("No authorization was found for the team '%s'", team).
+		WithRemediation("Log in to the team with %s or list authorized teams with %s", style.Commandf("login", false), style.Commandf("auth list", false))
+}
+
+// filterAppsByTeam keeps apps installed to a team or granted to a workspace
+func filterAppsByTeam(apps []types.App, teamID string) []types.App {
+	filtered := []types.App{}
+	for _, app := range apps {
+		if app.AppID == "" {
+			continue
+		}
+		if app.TeamID == teamID || app.EnterpriseID == teamID {
+			filtered = append(filtered, app)
+			continue
+		}
+		for _, grant := range app.EnterpriseGrants {
+			if grant.WorkspaceID == teamID {
+				filtered = append(filtered, app)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// filterAppsInstalledOn keeps the apps that are installed on the team of the
+// authorization using the app status of that team
+func filterAppsInstalledOn(ctx context.Context, clients *shared.ClientFactory, apps []types.App, auth types.SlackAuth) ([]types.App, error) {
+	appIDs := []string{}
+	for _, app := range apps {
+		if app.AppID != "" && !slices.Contains(appIDs, app.AppID) {
+			appIDs = append(appIDs, app.AppID)
+		}
+	}
+	filtered := []types.App{}
+	if len(appIDs) == 0 {
+		return filtered, nil
+	}
+	slices.Sort(appIDs)
+	apiHost := ""
+	if auth.APIHost != nil {
+		apiHost = *auth.APIHost
+	}
+	status, err := clients.APIWithHost(apiHost).GetAppStatus(ctx, auth.Token, appIDs, auth.TeamID)
+	if err != nil {
+		if slackerror.Is(err, slackerror.ErrTeamNotFound) || slackerror.Is(err, slackerror.ErrTeamAccessNotGranted) {
+			return nil, slackerror.New(slackerror.ErrTeamNotFound).
+				WithMessage("The apps of the team '%s' cannot be accessed", auth.TeamDomain).
+				WithRemediation("Log in to the team again with %s", style.Commandf("login", false)).
+				WithRootCause(err)
+		}
+		return nil, err
+	}
+	installed := map[string]bool{}
+	for _, app := range status.Apps {
+		installed[app.AppID] = app.Installed
+	}
+	for _, app := range apps {
+		if installed[app.AppID] {
+			app.InstallStatus = types.AppStatusInstalled
+			filtered = append(filtered, app)
+		}
+	}
+	return filtered, nil
+}
+
 // FormatListSuccess formats details about the list of project apps
 func FormatListSuccess(apps []types.App) (secondaryText []string) {
 	for _, app := range apps {
 		if app.AppID == "" {
 			continue
 		}
-		teamDomain := app.TeamDomain
-		if app.IsDev && !strings.HasSuffix(teamDomain, style.LocalRunNameTag) {
-			teamDomain = style.LocalRunDisplayName(teamDomain)
-		}
 		secondaryText = append(secondaryText, fmt.Sprintf(
-			style.Bold("%s:"), teamDomain))
+			style.Bold("%s:"), formatListTeamDomain(app)))
 		secondaryText = append(secondaryText, fmt.Sprintf(
 			style.Indent(style.Secondary("App  ID: %s")), app.AppID))
 		secondaryText = append(secondaryText, fmt.Sprintf(
@@ -99,7 +424,7 @@ func FormatListSuccess(apps []types.App) (secondaryText []string) {
 				style.Indent(style.Secondary("User ID: %s")), app.UserID))
 		}
 		secondaryText = append(secondaryText, fmt.Sprintf(
-			style.Indent(style.Secondary("Status:  %s")), app.InstallStatus.String()))
+			style.Indent(style.Secondary("Status:  %s")), formatListStatus(app)))
 		if app.IsEnterpriseApp() && len(app.EnterpriseGrants) > 0 {
 			secondaryText = appendEnterpriseWorkspaceGrantInfo(secondaryText, app)
 		}
@@ -110,6 +435,24 @@ func FormatListSuccess(apps []types.App) (secondaryText []string) {
 	return
 }
 
+// formatListStatus returns the install status of an app or notes that the app
+// was deleted
+func formatListStatus(app types.App) string {
+	if app.IsDeleted() {
+		return "Deleted"
+	}
+	return app.InstallStatus.String()
+}
+
+// formatListTeamDomain returns the team domain of an app with the local tag
+func formatListTeamDomain(app types.App) string {
+	teamDomain := app.TeamDomain
+	if app.IsDev && !strings.HasSuffix(teamDomain, style.LocalRunNameTag) {
+		teamDomain = style.LocalRunDisplayName(teamDomain)
+	}
+	return teamDomain
+}
+
 // Append workspace grant information for the enterprise app to the display text
 func appendEnterpriseWorkspaceGrantInfo(secondaryText []string, app types.App) []string {
 
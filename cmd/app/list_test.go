@@ -16,16 +16,22 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/slackapi/slack-cli/internal/api"
 	"github.com/slackapi/slack-cli/internal/hooks"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Setup a mock for the package
@@ -64,6 +70,423 @@ func TestAppsListCommand(t *testing.T) {
 	listPkgMock.AssertCalled(t, "List")
 }
 
+func TestAppsListCommand_Team(t *testing.T) {
+	mockApps := []types.App{
+		{AppID: "A0001", TeamID: "T0001", TeamDomain: "teamone"},
+		{AppID: "A0002", TeamID: "T0002", TeamDomain: "teamtwo"},
+		{
+			AppID:            "A0003",
+			TeamID:           "E0003",
+			TeamDomain:       "organization",
+			EnterpriseID:     "E0003",
+			EnterpriseGrants: []types.EnterpriseGrant{{WorkspaceDomain: "teamtwo", WorkspaceID: "T0002"}},
+		},
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists apps on a team found by team ID": {
+			CmdArgs: []string{"--team", "T0001"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0001").Return(types.SlackAuth{TeamID: "T0001", TeamDomain: "teamone"}, nil)
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return mockApps, "", nil
+				}
+			},
+			ExpectedOutputs: []string{"teamone:", "A0001"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "A0002")
+				assert.NotContains(t, cm.GetStdoutOutput(), "A0003")
+			},
+		},
+		"lists apps on a team found by domain including workspace grants": {
+			CmdArgs: []string{"--team", "teamtwo"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "teamtwo").Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+				cm.Auth.On("AuthWithTeamDomain", mock.Anything, "teamtwo").Return(types.SlackAuth{TeamID: "T0002", TeamDomain: "teamtwo"}, nil)
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return mockApps, "", nil
+				}
+			},
+			ExpectedOutputs: []string{"A0002", "A0003"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "A0001")
+			},
+		},
+		"notes when a team has no apps": {
+			CmdArgs: []string{"--team", "T0004"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0004").Return(types.SlackAuth{TeamID: "T0004", TeamDomain: "teamfour"}, nil)
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return mockApps, "", nil
+				}
+			},
+			ExpectedOutputs: []string{"This project has no apps on teamfour"},
+		},
+		"errors if the team is not authorized": {
+			CmdArgs: []string{"--team", "T0009"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0009").Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+				cm.Auth.On("AuthWithTeamDomain", mock.Anything, "T0009").Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return mockApps, "", nil
+				}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrTeamNotFound, "No authorization was found for the team 'T0009'"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewListCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
+
+func TestAppsListCommand_InstalledOn(t *testing.T) {
+	mockApps := []types.App{
+		{AppID: "A0001", TeamID: "T0001", TeamDomain: "teamone"},
+		{AppID: "A0002", TeamID: "E0002", TeamDomain: "organization", EnterpriseID: "E0002"},
+		{AppID: "A0003", TeamID: "E0002", TeamDomain: "organization", EnterpriseID: "E0002"},
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists the apps installed on a team": {
+			CmdArgs: []string{"--installed-on", "T0002"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0002").Return(types.SlackAuth{TeamID: "T0002", TeamDomain: "teamtwo", Token: "xoxp-example"}, nil)
+				cm.API.On("GetAppStatus", mock.Anything, "xoxp-example", []string{"A0001", "A0002", "A0003"}, "T0002").Return(api.GetAppStatusResult{
+					Apps: []api.AppStatusResultAppInfo{
+						{AppID: "A0001", Installed: false},
+						{AppID: "A0002", Installed: true},
+						{AppID: "A0003", Installed: false},
+					},
+				}, nil)
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedOutputs: []string{"A0002", "Installed"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "A0001")
+				assert.NotContains(t, cm.GetStdoutOutput(), "A0003")
+			},
+		},
+		"prints the apps installed on a team as JSON": {
+			CmdArgs: []string{"--installed-on", "teamtwo", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "teamtwo").Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+				cm.Auth.On("AuthWithTeamDomain", mock.Anything, "teamtwo").Return(types.SlackAuth{TeamID: "T0002", TeamDomain: "teamtwo", Token: "xoxp-example"}, nil)
+				cm.API.On("GetAppStatus", mock.Anything, "xoxp-example", mock.Anything, "T0002").Return(api.GetAppStatusResult{
+					Apps: []api.AppStatusResultAppInfo{
+						{AppID: "A0001", Installed: true},
+					},
+				}, nil)
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var listed []listedApp
+				require.NoError(t, json.Unmarshal([]byte(cm.GetStdoutOutput()), &listed))
+				require.Len(t, listed, 1)
+				assert.Equal(t, "A0001", listed[0].AppID)
+				assert.Equal(t, types.AppStatusInstalled.String(), listed[0].Status)
+			},
+		},
+		"notes when no apps are installed on a team": {
+			CmdArgs: []string{"--installed-on", "T0002"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0002").Return(types.SlackAuth{TeamID: "T0002", TeamDomain: "teamtwo", Token: "xoxp-example"}, nil)
+				cm.API.On("GetAppStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.GetAppStatusResult{}, nil)
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedOutputs: []string{"No apps of this project are installed on teamtwo"},
+		},
+		"errors if the team cannot be accessed": {
+			CmdArgs: []string{"--installed-on", "T0002"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0002").Return(types.SlackAuth{TeamID: "T0002", TeamDomain: "teamtwo", Token: "xoxp-example"}, nil)
+				cm.API.On("GetAppStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(api.GetAppStatusResult{}, slackerror.New(slackerror.ErrTeamAccessNotGranted))
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrTeamNotFound, "The apps of the team 'teamtwo' cannot be accessed"},
+		},
+		"errors if the team is not authorized": {
+			CmdArgs: []string{"--installed-on", "T0009"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, "T0009").Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+				cm.Auth.On("AuthWithTeamDomain", mock.Anything, "T0009").Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrTeamNotFound, "No authorization was found for the team 'T0009'"},
+		},
+		"errors with the --stale flag": {
+			CmdArgs:              []string{"--installed-on", "T0002", "--stale"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewListCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
+
+func TestAppsListCommand_Stale(t *testing.T) {
+	staleApp := types.App{AppID: "A0001", TeamID: "T0001", TeamDomain: "teamone"}
+	savedApp := types.App{AppID: "A0002", TeamID: "T0002", TeamDomain: "teamtwo", IsDev: true, UserID: "U0002"}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists saved apps that no longer exist": {
+			CmdArgs: []string{"--stale"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{staleApp, savedApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{staleApp}, nil
+				}
+			},
+			ExpectedOutputs: []string{"Stale apps", "teamone:", "A0001", "app list --stale --prune"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "A0002")
+			},
+		},
+		"notes when no saved apps are stale": {
+			CmdArgs: []string{"--stale"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{savedApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{}, nil
+				}
+			},
+			ExpectedOutputs: []string{"No saved apps were found that no longer exist"},
+		},
+		"removes stale apps from the project after confirming": {
+			CmdArgs: []string{"--stale", "--prune"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.IO.On("IsTTY").Return(true)
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Are you sure you want to remove these apps from the project?", false).Return(true, nil)
+				cm.AddDefaultMocks()
+				require.NoError(t, cm.AppClient.SaveDeployed(ctx, staleApp))
+				require.NoError(t, cm.AppClient.SaveLocal(ctx, savedApp))
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{staleApp, savedApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{staleApp}, nil
+				}
+			},
+			ExpectedOutputs: []string{"Removed A0001 from teamone"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				removed, err := cm.AppClient.GetDeployed(ctx, "T0001")
+				require.NoError(t, err)
+				assert.Empty(t, removed.AppID)
+				saved, err := cm.AppClient.GetLocal(ctx, "T0002")
+				require.NoError(t, err)
+				assert.Equal(t, "A0002", saved.AppID)
+			},
+		},
+		"removes stale apps without prompts with the force flag": {
+			CmdArgs: []string{"--stale", "--prune", "--force"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				require.NoError(t, cm.AppClient.SaveDeployed(ctx, staleApp))
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{staleApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{staleApp}, nil
+				}
+			},
+			ExpectedOutputs: []string{"Removed A0001 from teamone"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"keeps stale apps if the removal is declined": {
+			CmdArgs: []string{"--stale", "--prune"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.IO.On("IsTTY").Return(true)
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Are you sure you want to remove these apps from the project?", false).Return(false, nil)
+				cm.AddDefaultMocks()
+				require.NoError(t, cm.AppClient.SaveDeployed(ctx, staleApp))
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{staleApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{staleApp}, nil
+				}
+			},
+			ExpectedOutputs: []string{"No apps were removed from the project"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				saved, err := cm.AppClient.GetDeployed(ctx, "T0001")
+				require.NoError(t, err)
+				assert.Equal(t, "A0001", saved.AppID)
+			},
+		},
+		"errors if stale apps are pruned without prompts or the force flag": {
+			CmdArgs: []string{"--stale", "--prune"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{staleApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{staleApp}, nil
+				}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrPrompt, "Removing stale apps without prompts requires the --force flag"},
+		},
+		"errors if stale apps cannot be checked": {
+			CmdArgs: []string{"--stale"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return []types.App{staleApp}, "", nil
+				}
+				listStaleFunc = func(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+					return []types.App{}, slackerror.New(slackerror.ErrHTTPRequestFailed)
+				}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrHTTPRequestFailed},
+		},
+		"errors if prune is used without stale": {
+			CmdArgs:              []string{"--prune"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --prune flag requires the --stale flag"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewListCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
+
+func TestAppsListCommand_Sort(t *testing.T) {
+	mockApps := []types.App{
+		{AppID: "A0003", TeamID: "T0001", TeamDomain: "charlie", InstallStatus: types.AppStatusUninstalled},
+		{AppID: "A0001", TeamID: "T0003", TeamDomain: "alpha", InstallStatus: types.AppStatusInstalled},
+		{AppID: "A0002", TeamID: "T0002", TeamDomain: "bravo", InstallStatus: types.AppStatusInstalled},
+	}
+	assertOrder := func(t *testing.T, output string, appIDs ...string) {
+		index := -1
+		for _, appID := range appIDs {
+			next := strings.Index(output, appID)
+			require.Greater(t, next, index, "expected %s to be listed after the apps %v", appID, appIDs)
+			index = next
+		}
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists apps in the saved order without a sort": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assertOrder(t, cm.GetStdoutOutput(), "A0003", "A0001", "A0002")
+			},
+		},
+		"lists apps sorted by name": {
+			CmdArgs: []string{"--sort", "name"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assertOrder(t, cm.GetStdoutOutput(), "A0001", "A0002", "A0003")
+			},
+		},
+		"lists apps sorted by team in reverse": {
+			CmdArgs: []string{"--sort", "team", "--reverse"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assertOrder(t, cm.GetStdoutOutput(), "A0001", "A0002", "A0003")
+			},
+		},
+		"lists apps sorted by status with ties sorted by name": {
+			CmdArgs: []string{"--sort", "status"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assertOrder(t, cm.GetStdoutOutput(), "A0001", "A0002", "A0003")
+			},
+		},
+		"prints sorted apps as json": {
+			CmdArgs: []string{"--sort", "team", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return slices.Clone(mockApps), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var listed []listedApp
+				require.NoError(t, json.Unmarshal([]byte(cm.GetStdoutOutput()), &listed))
+				assert.Equal(t, []listedApp{
+					{AppID: "A0003", TeamID: "T0001", TeamDomain: "charlie", Status: "Uninstalled"},
+					{AppID: "A0002", TeamID: "T0002", TeamDomain: "bravo", Status: "Installed"},
+					{AppID: "A0001", TeamID: "T0003", TeamDomain: "alpha", Status: "Installed"},
+				}, listed)
+			},
+		},
+		"prints each app as a line of json": {
+			CmdArgs: []string{"--sort", "name", "--json-lines"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				listFunc = func(ctx context.Context, clients *shared.ClientFactory) ([]types.App, string, error) {
+					return append(slices.Clone(mockApps), types.App{TeamDomain: "delta"}), "", nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				lines := strings.Split(strings.TrimSpace(cm.GetStdoutOutput()), "\n")
+				listed := []listedApp{}
+				for _, line := range lines {
+					var app listedApp
+					require.NoError(t, json.Unmarshal([]byte(line), &app))
+					listed = append(listed, app)
+				}
+				assert.Equal(t, []listedApp{
+					{AppID: "A0001", TeamID: "T0003", TeamDomain: "alpha", Status: "Installed"},
+					{AppID: "A0002", TeamID: "T0002", TeamDomain: "bravo", Status: "Installed"},
+					{AppID: "A0003", TeamID: "T0001", TeamDomain: "charlie", Status: "Uninstalled"},
+				}, listed)
+			},
+		},
+		"errors for an unknown sort order": {
+			CmdArgs:              []string{"--sort", "created"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid sort order: created"},
+		},
+		"errors for an unknown output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+		"errors if json output is used with stale": {
+			CmdArgs:              []string{"--stale", "--output", "json"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --output json flag cannot be used with the --stale flag"},
+		},
+		"errors if json lines are used with an output format": {
+			CmdArgs:              []string{"--json-lines", "--output", "json"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --json-lines flag cannot be used with the --output flag"},
+		},
+		"errors if json lines are used with stale": {
+			CmdArgs:              []string{"--json-lines", "--stale"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --json-lines flag cannot be used with the --stale flag"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewListCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
+
 func TestAppsListFormat(t *testing.T) {
 	mockTeam1Deploy := types.App{
 		AppID:         "A1234",
@@ -113,6 +536,12 @@ func TestAppsListFormat(t *testing.T) {
 			{WorkspaceDomain: "Domain3", WorkspaceID: "ID3"},
 			{WorkspaceDomain: "Domain4", WorkspaceID: "ID4"}},
 	}
+	mockDeletedDeploy := types.App{
+		AppID:      "A0005",
+		DeletedAt:  1700000000,
+		TeamID:     "T0001",
+		TeamDomain: "teamone",
+	}
 	mockGhostApp := types.App{
 		AppID:         "",
 		TeamID:        "T611",
@@ -142,6 +571,15 @@ func TestAppsListFormat(t *testing.T) {
 				"Status:  Installed",
 			},
 		},
+		"a deployed app that was deleted with keep-local": {
+			Apps: []types.App{mockDeletedDeploy},
+			Expected: []string{
+				"teamone",
+				"App  ID: A0005",
+				"Team ID: T0001",
+				"Status:  Deleted",
+			},
+		},
 		"a single uninstalled local app in a single standalone workspace": {
 			Apps: []types.App{mockTeam1Local},
 			Expected: []string{
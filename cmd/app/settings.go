@@ -15,10 +15,12 @@
 package app
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"net/url"
 	"strings"
 
+	"github.com/slackapi/slack-cli/internal/api"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/prompts"
@@ -29,6 +31,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+type settingsCmdFlags struct {
+	noOpen bool
+	output string
+}
+
+var settingsFlags settingsCmdFlags
+
 var settingsAppSelectPromptFunc = prompts.AppSelectPrompt
 
 func NewSettingsCommand(clients *shared.ClientFactory) *cobra.Command {
@@ -41,6 +50,8 @@ func NewSettingsCommand(clients *shared.ClientFactory) *cobra.Command {
 			"Discovering new features and customizing an app manifest can be done from this",
 			fmt.Sprintf("web interface for apps with a \"%s\" manifest source.", config.ManifestSourceRemote.String()),
 			"",
+			"The URL is printed instead when a browser cannot be opened.",
+			"",
 			"This command does not support apps deployed to Run on Slack infrastructure.",
 		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
@@ -52,6 +63,14 @@ func NewSettingsCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Open app settings for a specific app",
 				Command: "app settings --app A0123456789",
 			},
+			{
+				Meaning: "Print the app settings URL without opening a browser",
+				Command: "app settings --app A0123456789 --no-open",
+			},
+			{
+				Meaning: "Print the app settings URL as JSON",
+				Command: "app settings --app A0123456789 --output json",
+			},
 		}),
 		Args: cobra.MaximumNArgs(0),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -61,6 +80,8 @@ func NewSettingsCommand(clients *shared.ClientFactory) *cobra.Command {
 			return appSettingsCommandRunE(clients, cmd, args)
 		},
 	}
+	cmd.Flags().BoolVar(&settingsFlags.noOpen, "no-open", false, "print the URL without opening a browser")
+	cmd.Flags().StringVar(&settingsFlags.output, "output", "text", "output format: text, json")
 	return cmd
 }
 
@@ -95,45 +116,57 @@ func appSettingsCommandPreRunE(clients *shared.ClientFactory, cmd *cobra.Command
 // appSettingsCommandRunE opens app settings in a browser for the selected app
 func appSettingsCommandRunE(clients *shared.ClientFactory, cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
+	switch settingsFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", settingsFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
 	clients.IO.PrintTrace(ctx, slacktrace.AppSettingsStart)
 
+	appID := ""
 	app, err := settingsAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
 	if err != nil {
 		// If no apps exist, open the list of all apps known to the developer
-		if slackerror.Is(err, slackerror.ErrInstallationRequired) {
-			// Clean up any empty .slack directory and files created during app selection
-			clients.AppClient().CleanUp()
-
-			host := clients.API().Host()
-			parsed, err := url.Parse(host)
-			if err != nil {
-				return err
-			}
-			parsed.Host = "api." + parsed.Host
-			settingsURL := fmt.Sprintf("%s/apps", parsed.String())
-
-			clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
-				Emoji: "house",
-				Text:  "App Settings",
-				Secondary: []string{
-					settingsURL,
-				},
-			}))
-			clients.Browser().OpenURL(settingsURL)
-
-			clients.IO.PrintTrace(ctx, slacktrace.AppSettingsSuccess, settingsURL)
-			return nil
+		if !slackerror.Is(err, slackerror.ErrInstallationRequired) {
+			return err
 		}
-		return err
+		// Clean up any empty .slack directory and files created during app selection
+		clients.AppClient().CleanUp()
+	} else {
+		appID = app.App.AppID
 	}
-	host := clients.API().Host()
-	parsed, err := url.Parse(host)
+	settingsURL, err := api.AppSettingsURL(clients.API().Host(), appID)
 	if err != nil {
 		return err
 	}
-	parsed.Host = "api." + parsed.Host
-	settingsURL := fmt.Sprintf("%s/apps/%s", parsed.String(), app.App.AppID)
+	if err := openAppSettings(ctx, clients, appID, settingsURL); err != nil {
+		return err
+	}
+	clients.IO.PrintTrace(ctx, slacktrace.AppSettingsSuccess, settingsURL)
+	return nil
+}
 
+// openAppSettings outputs the app settings URL and opens it in a browser
+//
+// The browser is not opened with the --no-open flag or for JSON outputs.
+func openAppSettings(ctx context.Context, clients *shared.ClientFactory, appID string, settingsURL string) error {
+	if settingsFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(struct {
+			AppID string `json:"app_id,omitempty"`
+			URL   string `json:"url"`
+		}{
+			AppID: appID,
+			URL:   settingsURL,
+		})
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
 	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
 		Emoji: "house",
 		Text:  "App Settings",
@@ -141,8 +174,8 @@ func appSettingsCommandRunE(clients *shared.ClientFactory, cmd *cobra.Command, a
 			settingsURL,
 		},
 	}))
-	clients.Browser().OpenURL(settingsURL)
-
-	clients.IO.PrintTrace(ctx, slacktrace.AppSettingsSuccess, settingsURL)
+	if !settingsFlags.noOpen {
+		clients.Browser().OpenURL(settingsURL)
+	}
 	return nil
 }
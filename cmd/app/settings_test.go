@@ -275,6 +275,59 @@ func Test_App_SettingsCommand(t *testing.T) {
 				cm.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.AppSettingsSuccess, []string{expectedURL})
 			},
 		},
+		"prints the url without opening a browser with the no-open flag": {
+			CmdArgs: []string{"--no-open"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectMock.On(
+					"AppSelectPrompt",
+					mock.Anything,
+					mock.Anything,
+					prompts.ShowAllEnvironments,
+					prompts.ShowInstalledAndUninstalledApps,
+				).Return(
+					prompts.SelectedApp{App: types.App{AppID: "A0123456789"}},
+					nil,
+				)
+				settingsAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				cm.API.On("Host").Return("https://slack.com")
+			},
+			ExpectedOutputs: []string{"https://api.slack.com/apps/A0123456789"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				expectedURL := "https://api.slack.com/apps/A0123456789"
+				cm.Browser.AssertNotCalled(t, "OpenURL", mock.Anything)
+				cm.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.AppSettingsSuccess, []string{expectedURL})
+			},
+		},
+		"prints the url as json without opening a browser": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectMock.On(
+					"AppSelectPrompt",
+					mock.Anything,
+					mock.Anything,
+					prompts.ShowAllEnvironments,
+					prompts.ShowInstalledAndUninstalledApps,
+				).Return(
+					prompts.SelectedApp{App: types.App{AppID: "A0123456789"}},
+					nil,
+				)
+				settingsAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				cm.API.On("Host").Return("https://slack.com")
+			},
+			ExpectedStdoutOutputs: []string{
+				`"app_id": "A0123456789"`,
+				`"url": "https://api.slack.com/apps/A0123456789"`,
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Browser.AssertNotCalled(t, "OpenURL", mock.Anything)
+			},
+		},
+		"errors with an unknown output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
 	}, func(cf *shared.ClientFactory) *cobra.Command {
 		return NewSettingsCommand(cf)
 	})
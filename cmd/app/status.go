@@ -0,0 +1,148 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type statusCmdFlags struct {
+	output string
+}
+
+var statusFlags statusCmdFlags
+
+var statusAppSelectPromptFunc = prompts.AppSelectPrompt
+
+// appStatus is the installation and hosting state of an app on a team
+type appStatus struct {
+	AppID        string `json:"app_id"`
+	Installed    bool   `json:"installed"`
+	Hosted       bool   `json:"hosted"`
+	TeamID       string `json:"team_id"`
+	TeamDomain   string `json:"team_domain"`
+	IsEnterprise bool   `json:"is_enterprise"`
+}
+
+// NewStatusCommand returns a new Cobra command for app status
+func NewStatusCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [flags]",
+		Short: "Check if the app is installed and hosted",
+		Long: strings.Join([]string{
+			"Check if the app is installed on a team and hosted by Slack.",
+			"",
+			"The command errors if the app cannot be found on the team, which makes it",
+			"useful as a health check in monitoring scripts.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "app status", Meaning: "Check the status of a selected app"},
+			{Command: "app status --app A0123456789 --output json", Meaning: "Print the status of an app as JSON"},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatusCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&statusFlags.output, "output", "text", "output format: text, json")
+	return cmd
+}
+
+// runStatusCommand gathers and prints the status of the selected app
+func runStatusCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch statusFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", statusFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	selection, err := statusAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
+	if err != nil {
+		return err
+	}
+	status, err := getAppStatus(ctx, clients, selection)
+	if err != nil {
+		return err
+	}
+	if statusFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(status); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
+	clients.IO.PrintInfo(ctx, false, "%s", formatAppStatus(status))
+	return nil
+}
+
+// getAppStatus requests the status of an app and errors if the app is missing
+func getAppStatus(ctx context.Context, clients *shared.ClientFactory, selection prompts.SelectedApp) (appStatus, error) {
+	app := selection.App
+	result, err := clients.API().GetAppStatus(ctx, selection.Auth.Token, []string{app.AppID}, selection.Auth.TeamID)
+	if err != nil {
+		return appStatus{}, err
+	}
+	for _, a := range result.Apps {
+		if a.AppID != app.AppID {
+			continue
+		}
+		status := appStatus{
+			AppID:        a.AppID,
+			Installed:    a.Installed,
+			Hosted:       a.Hosted,
+			TeamID:       result.Team.TeamID,
+			TeamDomain:   result.Team.TeamDomain,
+			IsEnterprise: result.Team.IsEnterprise,
+		}
+		if status.TeamID == "" {
+			status.TeamID = app.TeamID
+		}
+		if status.TeamDomain == "" {
+			status.TeamDomain = selection.Auth.TeamDomain
+		}
+		return status, nil
+	}
+	return appStatus{}, slackerror.New(slackerror.ErrAppNotFound).
+		WithMessage("The app %s was not found on the team %s", app.AppID, selection.Auth.TeamID)
+}
+
+// formatAppStatus returns a single line summary of the app status
+func formatAppStatus(status appStatus) string {
+	installed := "installed"
+	if !status.Installed {
+		installed = "not installed"
+	}
+	hosted := "hosted by Slack"
+	if !status.Hosted {
+		hosted = "not hosted by Slack"
+	}
+	return fmt.Sprintf("%s is %s on %s (%s) and %s", status.AppID, installed, status.TeamDomain, status.TeamID, hosted)
+}
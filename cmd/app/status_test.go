@@ -0,0 +1,114 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_App_StatusCommand(t *testing.T) {
+	selection := prompts.SelectedApp{
+		App:  types.App{AppID: "A0123456789", TeamID: "T0123456789", TeamDomain: "team"},
+		Auth: types.SlackAuth{TeamID: "T0123456789", TeamDomain: "team", Token: "xoxp-example"},
+	}
+	setupStatusMocks := func(t *testing.T, cm *shared.ClientsMock, result api.GetAppStatusResult) {
+		appSelectMock := prompts.NewAppSelectMock()
+		appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).
+			Return(selection, nil)
+		statusAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+		cm.API.On("GetAppStatus", mock.Anything, "xoxp-example", []string{"A0123456789"}, "T0123456789").
+			Return(result, nil)
+	}
+	installedResult := api.GetAppStatusResult{
+		Apps: []api.AppStatusResultAppInfo{{AppID: "A0123456789", Installed: true, Hosted: true}},
+	}
+	installedResult.Team.TeamID = "T0123456789"
+	installedResult.Team.TeamDomain = "team"
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the status of the app": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupStatusMocks(t, cm, installedResult)
+			},
+			ExpectedOutputs: []string{
+				"A0123456789 is installed on team (T0123456789) and hosted by Slack",
+			},
+		},
+		"prints the status of an uninstalled app with the selected team": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupStatusMocks(t, cm, api.GetAppStatusResult{
+					Apps: []api.AppStatusResultAppInfo{{AppID: "A0123456789"}},
+				})
+			},
+			ExpectedOutputs: []string{
+				"A0123456789 is not installed on team (T0123456789) and not hosted by Slack",
+			},
+		},
+		"prints the status of the app as json": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupStatusMocks(t, cm, installedResult)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var status appStatus
+				require.NoError(t, json.Unmarshal([]byte(cm.GetStdoutOutput()), &status))
+				assert.Equal(t, appStatus{
+					AppID:      "A0123456789",
+					Installed:  true,
+					Hosted:     true,
+					TeamID:     "T0123456789",
+					TeamDomain: "team",
+				}, status)
+			},
+		},
+		"errors if the app is missing from the status": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupStatusMocks(t, cm, api.GetAppStatusResult{})
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppNotFound, "The app A0123456789 was not found on the team T0123456789"},
+		},
+		"errors if the app status cannot be found": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).
+					Return(selection, nil)
+				statusAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				cm.API.On("GetAppStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(api.GetAppStatusResult{}, slackerror.New(slackerror.ErrAppNotFound))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppNotFound},
+		},
+		"errors for an invalid output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewStatusCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
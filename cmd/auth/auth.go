@@ -37,11 +37,14 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 
 	// Add child commands
+	cmd.AddCommand(NewExportCommand(clients))
+	cmd.AddCommand(NewImportCommand(clients))
 	cmd.AddCommand(NewListCommand(clients))
 	cmd.AddCommand(NewLoginCommand(clients))
 	cmd.AddCommand(NewLogoutCommand(clients))
 	cmd.AddCommand(NewRevokeCommand(clients))
 	cmd.AddCommand(NewTokenCommand(clients))
+	cmd.AddCommand(NewWhoamiCommand(clients))
 
 	return cmd
 }
@@ -0,0 +1,146 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/iostreams"
+	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type exportCmdFlags struct {
+	passphrase string
+	toFile     string
+}
+
+var exportFlags exportCmdFlags
+
+// NewExportCommand creates the Cobra command for exporting authorizations
+func NewExportCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: "Export team authorizations to an encrypted file",
+		Long: strings.Join([]string{
+			"Export the saved team authorizations to a file encrypted with a passphrase.",
+			"",
+			"The file can be imported on another machine with the \"auth import\" command.",
+			"Tokens are never printed and remain encrypted in the file.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "auth export --to-file creds.json", Meaning: "Export all team authorizations"},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportCommand(cmd.Context(), clients, cmd)
+		},
+	}
+	cmd.Flags().StringVar(&exportFlags.passphrase, "passphrase", "", "passphrase used to encrypt the file")
+	cmd.Flags().StringVar(&exportFlags.toFile, "to-file", "", "path of the encrypted file to write")
+	_ = cmd.MarkFlagFilename("to-file")
+	return cmd
+}
+
+// runExportCommand encrypts the saved authorizations to the export file
+func runExportCommand(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
+	if exportFlags.toFile == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The path of the export file is missing from the --to-file flag")
+	}
+	if _, err := clients.Fs.Stat(exportFlags.toFile); err == nil && !clients.Config.ForceFlag {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The file %s already exists", exportFlags.toFile).
+			WithRemediation("Choose another path or overwrite the file with the --force flag")
+	}
+
+	auths, err := clients.Auth().Auths(ctx)
+	if err != nil {
+		return err
+	}
+	if len(auths) == 0 {
+		return slackerror.New(slackerror.ErrCredentialsNotFound).
+			WithMessage("No team authorizations are saved to export")
+	}
+	sort.SliceStable(auths, func(i, j int) bool {
+		return auths[i].TeamDomain < auths[j].TeamDomain
+	})
+
+	passphrase, err := promptExportPassphrase(ctx, clients, cmd.Flag("passphrase"), "Enter a passphrase to encrypt the export file", true)
+	if err != nil {
+		return err
+	}
+	data, err := authpkg.EncryptAuths(auths, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := afero.WriteFile(clients.Fs, exportFlags.toFile, data, 0600); err != nil {
+		return slackerror.New(slackerror.ErrUnableToOpenFile).
+			WithMessage("Failed to write the export file %s", exportFlags.toFile).
+			WithRootCause(err)
+	}
+
+	teams := []string{}
+	for _, auth := range auths {
+		teams = append(teams, fmt.Sprintf("%s (%s)", auth.TeamDomain, auth.TeamID))
+	}
+	teams = append(teams, fmt.Sprintf("Import these on another machine with %s", style.Commandf("auth import "+exportFlags.toFile, false)))
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "lock",
+		Text:      fmt.Sprintf("Exported %d team authorizations to %s", len(auths), style.HomePath(exportFlags.toFile)),
+		Secondary: teams,
+	}))
+	return nil
+}
+
+// promptExportPassphrase collects the passphrase of an export file from the
+// flag or a prompt, and optionally prompts again to confirm a new passphrase
+func promptExportPassphrase(ctx context.Context, clients *shared.ClientFactory, flag *pflag.Flag, message string, confirm bool) (string, error) {
+	response, err := clients.IO.PasswordPrompt(ctx, message, iostreams.PasswordPromptConfig{
+		Flag:     flag,
+		Required: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.Value == "" {
+		return "", slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("A passphrase is required for the export file").
+			WithRemediation("Provide a passphrase with the --passphrase flag")
+	}
+	if !confirm || !response.Prompt {
+		return response.Value, nil
+	}
+	confirmation, err := clients.IO.PasswordPrompt(ctx, "Confirm the passphrase", iostreams.PasswordPromptConfig{
+		Required: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	if confirmation.Value != response.Value {
+		return "", slackerror.New(slackerror.ErrInvalidPassphrase).
+			WithMessage("The passphrases do not match").
+			WithRemediation("Enter the same passphrase twice")
+	}
+	return response.Value, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/iostreams"
+	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCommand(t *testing.T) {
+	mockAuths := []types.SlackAuth{
+		{Token: "xoxp-example-2", TeamDomain: "beta", TeamID: "T0002"},
+		{Token: "xoxp-example-1", TeamDomain: "alpha", TeamID: "T0001"},
+	}
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"encrypts the saved authorizations to the export file": {
+			CmdArgs: []string{"--to-file", "creds.json", "--passphrase", "secret"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("Auths", mock.Anything).Return(mockAuths, nil)
+				cm.IO.On("PasswordPrompt", mock.Anything, "Enter a passphrase to encrypt the export file", mock.Anything).
+					Return(iostreams.PasswordPromptResponse{Flag: true, Value: "secret"}, nil)
+			},
+			ExpectedOutputs: []string{"Exported 2 team authorizations to creds.json", "alpha (T0001)", "beta (T0002)"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				data, err := afero.ReadFile(cm.Fs, "creds.json")
+				require.NoError(t, err)
+				assert.NotContains(t, string(data), "xoxp-example")
+				auths, err := authpkg.DecryptAuths(data, "secret")
+				require.NoError(t, err)
+				assert.Equal(t, []types.SlackAuth{
+					{Token: "xoxp-example-1", TeamDomain: "alpha", TeamID: "T0001"},
+					{Token: "xoxp-example-2", TeamDomain: "beta", TeamID: "T0002"},
+				}, auths)
+				assert.NotContains(t, cm.GetCombinedOutput(), "xoxp-example")
+			},
+		},
+		"errors when a prompted passphrase is not confirmed": {
+			CmdArgs: []string{"--to-file", "creds.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Auth.On("Auths", mock.Anything).Return(mockAuths, nil)
+				cm.IO.On("PasswordPrompt", mock.Anything, "Enter a passphrase to encrypt the export file", mock.Anything).
+					Return(iostreams.PasswordPromptResponse{Prompt: true, Value: "secret"}, nil)
+				cm.IO.On("PasswordPrompt", mock.Anything, "Confirm the passphrase", mock.Anything).
+					Return(iostreams.PasswordPromptResponse{Prompt: true, Value: "secrets"}, nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidPassphrase, "The passphrases do not match"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				_, err := cm.Fs.Stat("creds.json")
+				assert.Error(t, err)
+			},
+		},
+		"errors without an export file path": {
+			CmdArgs:              []string{},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag, "--to-file"},
+		},
+		"errors if the export file exists": {
+			CmdArgs: []string{"--to-file", "creds.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				require.NoError(t, afero.WriteFile(cm.Fs, "creds.json", []byte("{}"), 0600))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "The file creds.json already exists"},
+		},
+		"errors if no authorizations are saved": {
+			CmdArgs:              []string{"--to-file", "creds.json"},
+			ExpectedErrorStrings: []string{slackerror.ErrCredentialsNotFound},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewExportCommand(clients)
+	})
+}
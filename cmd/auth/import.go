@@ -0,0 +1,120 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type importCmdFlags struct {
+	passphrase string
+}
+
+var importFlags importCmdFlags
+
+// NewImportCommand creates the Cobra command for importing authorizations
+func NewImportCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <path> [flags]",
+		Short: "Import team authorizations from an encrypted file",
+		Long: strings.Join([]string{
+			"Import team authorizations from a file made with the \"auth export\" command.",
+			"",
+			"Each authorization is checked with the Slack API before it is saved and invalid",
+			"authorizations are skipped.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "auth import creds.json", Meaning: "Import team authorizations from a file"},
+		}),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImportCommand(cmd.Context(), clients, cmd, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&importFlags.passphrase, "passphrase", "", "passphrase used to decrypt the file")
+	return cmd
+}
+
+// runImportCommand decrypts the authorizations of an export file and saves the
+// valid authorizations
+func runImportCommand(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, path string) error {
+	data, err := afero.ReadFile(clients.Fs, path)
+	if err != nil {
+		return slackerror.New(slackerror.ErrUnableToOpenFile).
+			WithMessage("Failed to read the export file %s", path).
+			WithRootCause(err)
+	}
+	passphrase, err := promptExportPassphrase(ctx, clients, cmd.Flag("passphrase"), "Enter the passphrase of the export file", false)
+	if err != nil {
+		return err
+	}
+	auths, err := authpkg.DecryptAuths(data, passphrase)
+	if err != nil {
+		return err
+	}
+
+	imported := []string{}
+	skipped := []string{}
+	for _, auth := range auths {
+		if err := validateImportedAuth(ctx, clients, auth); err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s (%s): %s", auth.TeamDomain, auth.TeamID, slackerror.ToSlackError(err).Code))
+			continue
+		}
+		if _, _, err := clients.Auth().SetAuth(ctx, auth); err != nil {
+			return err
+		}
+		imported = append(imported, fmt.Sprintf("%s (%s)", auth.TeamDomain, auth.TeamID))
+	}
+
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "key",
+		Text:      fmt.Sprintf("Imported %d of %d team authorizations", len(imported), len(auths)),
+		Secondary: imported,
+	}))
+	if len(skipped) > 0 {
+		clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+			Emoji:     "warning",
+			Text:      "Skipped team authorizations that are no longer valid",
+			Secondary: skipped,
+		}))
+	}
+	if len(imported) == 0 && len(skipped) > 0 {
+		return slackerror.New(slackerror.ErrInvalidAuth).
+			WithMessage("None of the exported team authorizations are valid").
+			WithRemediation("Log in to these teams with %s", style.Commandf("login", false))
+	}
+	return nil
+}
+
+// validateImportedAuth checks that the token of an authorization is valid with
+// the API host of that authorization
+func validateImportedAuth(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth) error {
+	apiClient := clients.API()
+	previousHost := apiClient.Host()
+	defer apiClient.SetHost(previousHost)
+	apiClient.SetHost(clients.Auth().ResolveAPIHost(ctx, clients.Config.APIHostFlag, &auth))
+	_, err := apiClient.ValidateSession(ctx, auth.Token)
+	return err
+}
@@ -0,0 +1,97 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/iostreams"
+	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportCommand(t *testing.T) {
+	validAuth := types.SlackAuth{Token: "xoxp-valid", TeamDomain: "alpha", TeamID: "T0001"}
+	revokedAuth := types.SlackAuth{Token: "xoxp-revoked", TeamDomain: "beta", TeamID: "T0002"}
+	exported, err := authpkg.EncryptAuths([]types.SlackAuth{validAuth, revokedAuth}, "secret")
+	require.NoError(t, err)
+	exportedRevoked, err := authpkg.EncryptAuths([]types.SlackAuth{revokedAuth}, "secret")
+	require.NoError(t, err)
+
+	setupImport := func(t *testing.T, cm *shared.ClientsMock, data []byte) {
+		require.NoError(t, afero.WriteFile(cm.Fs, "creds.json", data, 0600))
+		cm.IO.On("PasswordPrompt", mock.Anything, "Enter the passphrase of the export file", mock.Anything).
+			Return(iostreams.PasswordPromptResponse{Prompt: true, Value: "secret"}, nil)
+		cm.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("https://slack.com")
+		cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{}, "", nil)
+		cm.API.On("SetHost", mock.Anything)
+		cm.API.On("ValidateSession", mock.Anything, "xoxp-valid").Return(api.AuthSession{}, nil)
+		cm.API.On("ValidateSession", mock.Anything, "xoxp-revoked").Return(api.AuthSession{}, slackerror.New(slackerror.ErrTokenRevoked))
+	}
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"saves valid authorizations and skips invalid ones": {
+			CmdArgs: []string{"creds.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupImport(t, cm, exported)
+			},
+			ExpectedOutputs: []string{
+				"Imported 1 of 2 team authorizations",
+				"alpha (T0001)",
+				"beta (T0002): token_revoked",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertCalled(t, "SetAuth", mock.Anything, validAuth)
+				cm.Auth.AssertNotCalled(t, "SetAuth", mock.Anything, revokedAuth)
+				assert.NotContains(t, cm.GetCombinedOutput(), "xoxp-")
+			},
+		},
+		"errors if no authorizations are valid": {
+			CmdArgs: []string{"creds.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupImport(t, cm, exportedRevoked)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAuth, "None of the exported team authorizations are valid"},
+		},
+		"errors with the wrong passphrase": {
+			CmdArgs: []string{"creds.json", "--passphrase", "wrong"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				require.NoError(t, afero.WriteFile(cm.Fs, "creds.json", exported, 0600))
+				cm.IO.On("PasswordPrompt", mock.Anything, mock.Anything, mock.Anything).
+					Return(iostreams.PasswordPromptResponse{Flag: true, Value: "wrong"}, nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidPassphrase},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertNotCalled(t, "SetAuth", mock.Anything, mock.Anything)
+			},
+		},
+		"errors if the file cannot be read": {
+			CmdArgs:              []string{"missing.json"},
+			ExpectedErrorStrings: []string{slackerror.ErrUnableToOpenFile, "missing.json"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewImportCommand(clients)
+	})
+}
@@ -63,6 +63,7 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 // User ID: U01PHTW5JFR
 // API Host: https://dev.slack.com (optional, only shown for custom API Hosts)
 // Last Updated: 2021-03-12 11:18:00 -0700
+// Token Type: Service token that does not rotate (optional, only shown for service tokens)
 func printAuthList(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []types.SlackAuth) {
 	ctx := cmd.Context()
 
@@ -96,6 +97,12 @@ func printAuthList(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []t
 			style.Secondary("Authorization Level: %s\n"),
 			caser.String(authInfo.AuthLevel()),
 		)
+		if authInfo.IsServiceToken {
+			cmd.Printf(
+				style.Secondary("Token Type: %s\n"),
+				"Service token that does not rotate",
+			)
+		}
 
 		cmd.Println()
 
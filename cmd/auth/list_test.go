@@ -53,6 +53,21 @@ func TestListCommand(t *testing.T) {
 				"U67890",
 			},
 		},
+		"a service token authorization": {
+			auths: []types.SlackAuth{
+				{
+					TeamDomain:     "ci-workspace",
+					TeamID:         "T33333",
+					UserID:         "U33333",
+					LastUpdated:    time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC),
+					IsServiceToken: true,
+				},
+			},
+			expected: []string{
+				"ci-workspace",
+				"Token Type: Service token that does not rotate",
+			},
+		},
 		"multiple authorized accounts": {
 			auths: []types.SlackAuth{
 				{
@@ -17,7 +17,9 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
 	"github.com/slackapi/slack-cli/internal/shared"
@@ -34,7 +36,10 @@ var tokenFlag string
 var ticketArg string
 var challengeCodeArg string
 var noPromptFlag bool
+var noBrowserFlag bool
+var expiryWarningDaysFlag int
 var serviceTokenFlag bool
+var serviceTokenArg string
 
 const invalidFlagComboMessage = "The --auth and --token flags cannot be used together. Please use"
 const deprecatedUserTokenMessage = "The --auth flag has been removed"
@@ -47,8 +52,12 @@ func NewLoginCommand(clients *shared.ClientFactory) *cobra.Command {
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "auth login", Meaning: "Login to a Slack account with prompts"},
 			{Command: "auth login --no-prompt", Meaning: "Login to a Slack account without prompts, this returns a ticket"},
+			{Command: "auth login --no-browser", Meaning: "Login on a remote machine by entering the challenge code as input"},
 			{Command: "auth login --challenge 6d0a31c9 --ticket ISQWLiZT0OtMLO3YWNTJO0...", Meaning: "Complete login using ticket and challenge code"},
 			{Command: "auth login --token xoxp-...", Meaning: "Login with a user token"},
+			{Command: "auth login --service-token xoxp-...", Meaning: "Save a service token for logins without prompts in CI"},
+			{Command: "auth login --expiry-warning-days 14", Meaning: "Warn if the authorization expires within two weeks"},
+			{Command: "auth login --team acme", Meaning: "Login to a specific team and check the authorized team"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			_, err := RunLoginCommand(clients, cmd)
@@ -63,11 +72,20 @@ func NewLoginCommand(clients *shared.ClientFactory) *cobra.Command {
 
 	cmd.Flags().StringVarP(&tokenFlag, "token", "", "", "provide a token for a pre-authenticated login")
 
+	// Support login in automation with a service token that does not rotate
+	cmd.Flags().StringVarP(&serviceTokenArg, "service-token", "", "", "save a service token from \"auth token\" that\n  does not rotate for logins without prompts")
+
 	// Support login in promptless fashion
 	cmd.Flags().BoolVarP(&noPromptFlag, "no-prompt", "", false, "login without prompts using ticket and challenge code")
 	cmd.Flags().StringVarP(&ticketArg, "ticket", "", "", "provide an auth ticket value")
 	cmd.Flags().StringVarP(&challengeCodeArg, "challenge", "", "", "provide a challenge code for pre-authenticated login")
 
+	// Support login on remote machines that read the challenge code from input
+	cmd.Flags().BoolVarP(&noBrowserFlag, "no-browser", "", false, "login with a challenge code read from input")
+
+	// Warn about an authorization that expires soon for just this login
+	cmd.Flags().IntVarP(&expiryWarningDaysFlag, "expiry-warning-days", "", config.DefaultAuthExpiryWarningDays, "warn if the authorization expires within this\n  many days or 0 to disable the warning")
+
 	return cmd
 }
 
@@ -101,6 +119,50 @@ func RunLoginCommand(clients *shared.ClientFactory, cmd *cobra.Command) (types.S
 		cmd.Print(style.SectionSecondaryf("%s", proceedMessage))
 	}
 
+	if cmd.Flags().Changed("expiry-warning-days") && expiryWarningDaysFlag < 0 {
+		return types.SlackAuth{}, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --expiry-warning-days flag must not be negative")
+	}
+
+	// When --service-token flag supplied save the service token without prompts
+	if serviceTokenArg != "" {
+		for _, flag := range []string{"auth", "challenge", "no-browser", "no-prompt", "ticket", "token"} {
+			if cmd.Flags().Changed(flag) {
+				return types.SlackAuth{}, slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --service-token flag cannot be used with the --%s flag", flag)
+			}
+		}
+		selectedAuth, credentialsPath, err := authpkg.LoginWithServiceToken(ctx, clients, serviceTokenArg)
+		if err != nil {
+			return types.SlackAuth{}, err
+		}
+		printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth.Token)
+		printAuthNextSteps(ctx, clients)
+		return selectedAuth, nil
+	}
+
+	// When --no-browser flag supplied read the challenge code from input instead
+	// of prompts, which requires an interactive session
+	if noBrowserFlag {
+		if noPromptFlag {
+			return types.SlackAuth{}, slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("%s", authpkg.InvalidNoBrowserFlags).
+				WithRemediation("Login without prompts using %s then complete login with the --ticket and --challenge flags", style.Commandf("login --no-prompt", false))
+		}
+		if ticketArg != "" || challengeCodeArg != "" || tokenFlag != "" {
+			return types.SlackAuth{}, slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --no-browser flag cannot be used with the --ticket, --challenge, or --token flags")
+		}
+		selectedAuth, credentialsPath, err := authpkg.LoginNoBrowser(ctx, clients, serviceTokenFlag)
+		if err != nil {
+			return types.SlackAuth{}, err
+		}
+		printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth.Token)
+		printAuthExpiry(ctx, clients, cmd, selectedAuth)
+		printAuthNextSteps(ctx, clients)
+		return selectedAuth, nil
+	}
+
 	// When --no-prompt flag supplied OR --ticket and --challenge code flags provided
 	// attempt to login in a promptless fashion
 	if (noPromptFlag) || (ticketArg != "" || challengeCodeArg != "") {
@@ -110,6 +172,7 @@ func RunLoginCommand(clients *shared.ClientFactory, cmd *cobra.Command) (types.S
 		}
 		if selectedAuth.Token != "" {
 			printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth.Token)
+			printAuthExpiry(ctx, clients, cmd, selectedAuth)
 			printAuthNextSteps(ctx, clients)
 		}
 		return selectedAuth, err
@@ -120,12 +183,33 @@ func RunLoginCommand(clients *shared.ClientFactory, cmd *cobra.Command) (types.S
 		return types.SlackAuth{}, err
 	} else {
 		printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth.Token)
+		printAuthExpiry(ctx, clients, cmd, selectedAuth)
 		printAuthNextSteps(ctx, clients)
 	}
 
 	return selectedAuth, nil
 }
 
+// printAuthExpiry warns if the authorization expires within the days of the
+// --expiry-warning-days flag for this login or the auth_expiry_warning_days
+// system config otherwise
+func printAuthExpiry(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, auth types.SlackAuth) {
+	days := expiryWarningDaysFlag
+	if !cmd.Flags().Changed("expiry-warning-days") {
+		configured, err := clients.Config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+		if err != nil {
+			clients.IO.PrintDebug(ctx, "failed to read the auth expiry warning days: %s", err)
+			return
+		}
+		days = configured
+	}
+	if days <= 0 || !auth.ExpiresWithin(time.Duration(days)*24*time.Hour) {
+		return
+	}
+	expiresAt := time.Unix(int64(auth.ExpiresAt), 0)
+	clients.IO.PrintWarning(ctx, "The authorization for %s expires on %s", auth.TeamDomain, expiresAt.Format(time.DateOnly))
+}
+
 func printAuthSuccess(cmd *cobra.Command, IO iostreams.IOStreamer, credentialsPath string, token string) {
 	ctx := cmd.Context()
 
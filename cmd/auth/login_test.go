@@ -16,9 +16,12 @@ package auth
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
 	"github.com/slackapi/slack-cli/internal/shared"
@@ -26,7 +29,9 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var mockChallengeCode = "1234"
@@ -172,6 +177,226 @@ func TestLoginCommand(t *testing.T) {
 			},
 			ExpectedError: slackerror.New(slackerror.ErrHTTPResponseInvalid),
 		},
+		"no browser flag reads the challenge code from input": {
+			CmdArgs:               []string{"--no-browser"},
+			ExpectedStdoutOutputs: []string{"Enter the challenge code from Slack", "You've successfully authenticated!"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.IO.Stdin = strings.NewReader(" " + mockChallengeCode + "\n")
+				cm.API.On("GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything).Return(api.GenerateAuthTicketResult{Ticket: "example"}, nil)
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{Token: "xoxp-example"}, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On(
+					"SetAuth",
+					mock.Anything,
+					mock.Anything,
+				).Return(
+					types.SlackAuth{Token: "xoxp-example"},
+					"",
+					nil,
+				)
+				cm.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "ExchangeAuthTicket", mock.Anything, "example", mockChallengeCode, mock.Anything)
+				cm.IO.AssertNotCalled(t, "InputPrompt", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"no browser flag errors without a challenge code from input": {
+			CmdArgs:              []string{"--no-browser"},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingChallenge},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.IO.Stdin = strings.NewReader("")
+				cm.API.On("GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything).Return(api.GenerateAuthTicketResult{Ticket: "example"}, nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"no browser flag errors with the no prompt flag": {
+			CmdArgs:              []string{"--no-browser", "--no-prompt"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, authpkg.InvalidNoBrowserFlags},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"no browser flag errors with the ticket flag": {
+			CmdArgs:              []string{"--no-browser", "--ticket", "example"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+		},
+		"warns if the authorization expires within the days of the flag without saving the days": {
+			CmdArgs: []string{"--expiry-warning-days", "14", "--ticket", "example", "--challenge", "tictactoe"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					IsReady:    true,
+					Token:      "xoxp-example",
+					TeamDomain: "example",
+					ExpiresAt:  int(time.Now().Add(10 * 24 * time.Hour).Unix()),
+				}, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{Token: "xoxp-example"}, "", nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.IO.AssertCalled(t, "PrintWarning", mock.Anything, "The authorization for %s expires on %s", mock.Anything)
+				days, err := cm.Config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, config.DefaultAuthExpiryWarningDays, days)
+			},
+		},
+		"does not warn if the authorization expires after the days of the config": {
+			CmdArgs: []string{"--ticket", "example", "--challenge", "tictactoe"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					IsReady:   true,
+					Token:     "xoxp-example",
+					ExpiresAt: int(time.Now().Add(10 * 24 * time.Hour).Unix()),
+				}, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{Token: "xoxp-example"}, "", nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.IO.AssertNotCalled(t, "PrintWarning", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors if the number of days to warn before an authorization expires is negative": {
+			CmdArgs:              []string{"--expiry-warning-days", "-1"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"team flag logs in with a ticket for the matching team": {
+			CmdArgs: []string{"--ticket", "example", "--challenge", "tictactoe", "--team", "acme"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					IsReady:    true,
+					Token:      "xoxp-example",
+					TeamDomain: "acme",
+					TeamID:     "T0001",
+				}, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{Token: "xoxp-example"}, "", nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertCalled(t, "SetAuth", mock.Anything, mock.Anything)
+			},
+		},
+		"team flag errors with a ticket for another team without saving the auth": {
+			CmdArgs: []string{"--ticket", "example", "--challenge", "tictactoe", "--team", "acme"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					IsReady:    true,
+					Token:      "xoxp-example",
+					TeamDomain: "other",
+					TeamID:     "T0002",
+				}, nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAuthTeamMismatch, "The authorization is for other (T0002) instead of the team acme"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertNotCalled(t, "SetAuth", mock.Anything, mock.Anything)
+			},
+		},
+		"team flag notes the team in the slash command instructions": {
+			CmdArgs:               []string{"--no-prompt", "--team", "acme"},
+			ExpectedStdoutOutputs: []string{"Run the following slash command in any channel or DM of the acme team"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything).Return(api.GenerateAuthTicketResult{Ticket: "example"}, nil)
+				cm.AddDefaultMocks()
+			},
+		},
+		"team flag offers another login after authorizing another team": {
+			CmdArgs: []string{"--team", "T0001"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.IO.On("IsTTY").Return(true)
+				cm.API.On("GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything).Return(api.GenerateAuthTicketResult{Ticket: "example"}, nil)
+				cm.IO.On("InputPrompt", mock.Anything, "Enter challenge code", iostreams.InputPromptConfig{
+					Required: true,
+				}).Return(mockChallengeCode, nil)
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					Token:      "xoxp-other",
+					TeamDomain: "other",
+					TeamID:     "T0002",
+				}, nil).Once()
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					Token:      "xoxp-example",
+					TeamDomain: "acme",
+					TeamID:     "T0001",
+				}, nil).Once()
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Log in to T0001 again?", true).Return(true, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{Token: "xoxp-example"}, "", nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "GenerateAuthTicket", 2)
+				cm.IO.AssertCalled(t, "PrintWarning", mock.Anything, "%s", []any{"The authorization is for other (T0002) instead of the team T0001"})
+				cm.Auth.AssertCalled(t, "SetAuth", mock.Anything, mock.MatchedBy(func(auth types.SlackAuth) bool {
+					return auth.TeamID == "T0001"
+				}))
+			},
+		},
+		"team flag errors if another login is declined after authorizing another team": {
+			CmdArgs: []string{"--team", "acme"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.IO.On("IsTTY").Return(true)
+				cm.API.On("GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything).Return(api.GenerateAuthTicketResult{Ticket: "example"}, nil)
+				cm.IO.On("InputPrompt", mock.Anything, "Enter challenge code", iostreams.InputPromptConfig{
+					Required: true,
+				}).Return(mockChallengeCode, nil)
+				cm.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+					Token:      "xoxp-other",
+					TeamDomain: "other",
+					TeamID:     "T0002",
+				}, nil)
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Log in to acme again?", true).Return(false, nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAuthTeamMismatch},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertNotCalled(t, "SetAuth", mock.Anything, mock.Anything)
+			},
+		},
+		"service token flag saves a service token that does not rotate": {
+			CmdArgs: []string{"--service-token", "xoxp-service"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ValidateSession", mock.Anything, "xoxp-service").Return(api.AuthSession{
+					UserID:   &mockOrgAuth.UserID,
+					TeamID:   &mockOrgAuth.TeamID,
+					TeamName: &mockOrgAuth.TeamDomain,
+					URL:      &mockOrgAuthURL,
+				}, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{Token: "xoxp-service", IsServiceToken: true}, "", nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedOutputs: []string{"You've successfully authenticated!"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything)
+				cm.Auth.AssertNotCalled(t, "AuthWithTeamDomain", mock.Anything, mock.Anything)
+				cm.Auth.AssertCalled(t, "SetAuth", mock.Anything, mock.MatchedBy(func(auth types.SlackAuth) bool {
+					return auth.Token == "xoxp-service" && auth.IsServiceToken && auth.RefreshToken == "" && auth.ExpiresAt == 0
+				}))
+			},
+		},
+		"service token flag errors if the token is not valid": {
+			CmdArgs: []string{"--service-token", "xoxp-expired"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ValidateSession", mock.Anything, "xoxp-expired").Return(api.AuthSession{}, slackerror.New(slackerror.ErrTokenRevoked))
+				cm.AddDefaultMocks()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAuth},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertNotCalled(t, "SetAuth", mock.Anything, mock.Anything)
+			},
+		},
+		"service token flag errors with the token flag": {
+			CmdArgs:              []string{"--service-token", "xoxp-service", "--token", "xoxp-example"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --service-token flag cannot be used with the --token flag"},
+		},
 	}, func(cf *shared.ClientFactory) *cobra.Command {
 		return NewLoginCommand(cf)
 	})
@@ -17,27 +17,46 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/cobra"
 )
 
+type revokeCmdFlags struct {
+	yes bool
+}
+
+var revokeFlags revokeCmdFlags
+
 func NewRevokeCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revoke [flags]",
 		Short: "Revoke an authentication token",
-		Long:  "Revoke an authentication token",
+		Long: strings.Join([]string{
+			"Revoke an authentication token",
+			"",
+			"With the --team flag the token of a saved team authorization is revoked with",
+			"the Slack API and then removed from local credentials.",
+		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "auth revoke --token xoxp-1-4921830...", Meaning: "Revoke a service token"},
+			{Command: "auth revoke --team T0123456", Meaning: "Revoke and remove the authorization of a team"},
+			{Command: "auth revoke --team acme --yes", Meaning: "Revoke the authorization of a team without confirming"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			clients.IO.PrintTrace(ctx, slacktrace.AuthRevokeStart)
 
+			if teamFlag := cmd.Flag("team"); teamFlag != nil && teamFlag.Changed {
+				return runRevokeTeamCommand(ctx, clients)
+			}
+
 			token, err := promptAuthToken(ctx, clients)
 			if err != nil {
 				return slackerror.New("Failed to collect a token to revoke").WithCode(slackerror.ErrNoTokenFound).WithRootCause(err)
@@ -50,9 +69,108 @@ func NewRevokeCommand(clients *shared.ClientFactory) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&revokeFlags.yes, "yes", false, "skip confirmation prompt when revoking a team")
+
 	return cmd
 }
 
+// runRevokeTeamCommand revokes the token of the team authorization selected
+// with the --team flag then removes that authorization from credentials
+func runRevokeTeamCommand(ctx context.Context, clients *shared.ClientFactory) error {
+	if clients.Config.TokenFlag != "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --token flag cannot be used with --team")
+	}
+	if clients.Config.TeamFlag == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The argument is missing from the --team flag")
+	}
+
+	auth, err := findTeamAuth(ctx, clients, clients.Config.TeamFlag)
+	if err != nil {
+		return err
+	}
+
+	if !revokeFlags.yes {
+		proceed, err := clients.IO.ConfirmPrompt(ctx, fmt.Sprintf("Are you sure you want to revoke the authorization for %s?", auth.TeamDomain), false)
+		if err != nil {
+			if slackerror.Is(err, slackerror.ErrProcessInterrupted) {
+				clients.IO.SetExitCode(iostreams.ExitCancel)
+			}
+			return err
+		}
+		if !proceed {
+			clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+				Emoji: "thumbs_up",
+				Text:  "Revoke cancelled",
+			}))
+			return nil
+		}
+	}
+
+	revokeErr, err := revokeTeamAuth(ctx, clients, auth)
+	if err != nil {
+		return err
+	}
+	printRevokeTeamSuccess(ctx, clients, auth, revokeErr)
+	return nil
+}
+
+// findTeamAuth returns the saved authorization matching a team ID or domain
+func findTeamAuth(ctx context.Context, clients *shared.ClientFactory, team string) (types.SlackAuth, error) {
+	auths, err := clients.Auth().Auths(ctx)
+	if err != nil {
+		return types.SlackAuth{}, err
+	}
+	for _, auth := range auths {
+		if auth.TeamID == team || auth.TeamDomain == team {
+			return auth, nil
+		}
+	}
+	return types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound).
+		WithMessage("No authorization was found for the team '%s'", team).
+		WithRemediation("List saved authorizations with %s", style.Commandf("auth list", false))
+}
+
+// revokeTeamAuth revokes the tokens of an authorization with the Slack API and
+// removes the authorization from credentials
+//
+// Tokens that cannot be revoked with the API are still removed locally and the
+// reason is returned as revokeErr. Other errors stop the removal.
+func revokeTeamAuth(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth) (revokeErr *slackerror.Error, err error) {
+	clients.Config.APIHostResolved = clients.Auth().ResolveAPIHost(ctx, clients.Config.APIHostFlag, &auth)
+	clients.Config.LogstashHostResolved = clients.Auth().ResolveLogstashHost(ctx, clients.Config.APIHostResolved)
+
+	if err := clients.API().RevokeToken(ctx, auth.Token); err != nil {
+		slackErr := slackerror.ToSlackError(err)
+		switch slackErr.Code {
+		case slackerror.ErrTokenRevoked,
+			slackerror.ErrCannotRevokeOrgBotToken,
+			slackerror.ErrTokenExpired,
+			slackerror.ErrInvalidAuth,
+			slackerror.ErrAlreadyLoggedOut:
+			clients.IO.PrintDebug(ctx, "%s.", slackErr.Message)
+			revokeErr = slackErr
+		default:
+			return nil, err
+		}
+	}
+	if auth.RefreshToken != "" {
+		if err := clients.Auth().RevokeToken(ctx, auth.RefreshToken); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := clients.Auth().DeleteAuth(ctx, auth); err != nil {
+		return nil, err
+	}
+
+	clients.Config.APIHostResolved = clients.Auth().ResolveAPIHost(ctx, clients.Config.APIHostFlag, nil)
+	clients.Config.LogstashHostResolved = clients.Auth().ResolveLogstashHost(ctx, clients.Config.APIHostResolved)
+	return revokeErr, nil
+}
+
 func promptAuthToken(ctx context.Context, clients *shared.ClientFactory) (string, error) {
 	response, err := clients.IO.PasswordPrompt(ctx, "Enter a token to revoke", iostreams.PasswordPromptConfig{
 		Required: true,
@@ -78,3 +196,26 @@ func printRevokeSuccess(ctx context.Context, clients *shared.ClientFactory) {
 		Secondary: logoutNextSteps,
 	}))
 }
+
+// printRevokeTeamSuccess outputs whether the token of a team was revoked with
+// the Slack API or only removed from local credentials
+func printRevokeTeamSuccess(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, revokeErr *slackerror.Error) {
+	clients.IO.PrintTrace(ctx, slacktrace.AuthRevokeSuccess)
+	if revokeErr != nil {
+		clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "wastebasket",
+			Text:  fmt.Sprintf("Authorization removed locally for %s", auth.TeamDomain),
+			Secondary: []string{
+				fmt.Sprintf("The token was not revoked by the Slack API: %s (%s)", revokeErr.Message, revokeErr.Code),
+			},
+		}))
+		return
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "wastebasket",
+		Text:  fmt.Sprintf("Authorization revoked and removed for %s", auth.TeamDomain),
+		Secondary: []string{
+			fmt.Sprintf("Login to this team again with %s", style.Commandf("login", false)),
+		},
+	}))
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/test/testutil"
@@ -90,6 +91,98 @@ func TestRevokeCommand(t *testing.T) {
 				clients.IO.AssertNotCalled(t, "PrintTrace", mock.Anything, slacktrace.AuthRevokeSuccess)
 			},
 		},
+		"revoke and remove the authorization of a team after confirming": {
+			CmdArgs: []string{"--team", "team1"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("api.slack.com")
+				clientsMock.Auth.On("ResolveLogstashHost", mock.Anything, mock.Anything).Return("logstash.slack.com")
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.IO.On("ConfirmPrompt", mock.Anything, "Are you sure you want to revoke the authorization for team1?", false).Return(true, nil)
+				clientsMock.API.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].Token).Return(nil)
+				clientsMock.Auth.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].RefreshToken).Return(nil)
+				clientsMock.Auth.On("DeleteAuth", mock.Anything, fakeAuthsByTeamSlice[0]).Return(types.SlackAuth{}, nil)
+			},
+			ExpectedOutputs: []string{"Authorization revoked and removed for team1"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.API.AssertCalled(t, "RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].Token)
+				clients.Auth.AssertCalled(t, "DeleteAuth", mock.Anything, fakeAuthsByTeamSlice[0])
+				clients.IO.AssertNotCalled(t, "PasswordPrompt", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"remove the authorization of a team locally if the token was already revoked": {
+			CmdArgs: []string{"--team", "T2", "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("api.slack.com")
+				clientsMock.Auth.On("ResolveLogstashHost", mock.Anything, mock.Anything).Return("logstash.slack.com")
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.API.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[1].Token).Return(slackerror.New(slackerror.ErrTokenRevoked))
+				clientsMock.Auth.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[1].RefreshToken).Return(nil)
+				clientsMock.Auth.On("DeleteAuth", mock.Anything, fakeAuthsByTeamSlice[1]).Return(types.SlackAuth{}, nil)
+			},
+			ExpectedOutputs: []string{
+				"Authorization removed locally for team2",
+				"The token was not revoked by the Slack API: Your token has already been revoked (token_revoked)",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.Auth.AssertCalled(t, "DeleteAuth", mock.Anything, fakeAuthsByTeamSlice[1])
+				clients.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"remove the authorization of a team locally if an org bot token cannot be revoked": {
+			CmdArgs: []string{"--team", "team1", "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("api.slack.com")
+				clientsMock.Auth.On("ResolveLogstashHost", mock.Anything, mock.Anything).Return("logstash.slack.com")
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.API.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].Token).Return(slackerror.New(slackerror.ErrCannotRevokeOrgBotToken))
+				clientsMock.Auth.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].RefreshToken).Return(nil)
+				clientsMock.Auth.On("DeleteAuth", mock.Anything, fakeAuthsByTeamSlice[0]).Return(types.SlackAuth{}, nil)
+			},
+			ExpectedOutputs: []string{"Authorization removed locally for team1", "cannot_revoke_org_bot_token"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.Auth.AssertCalled(t, "DeleteAuth", mock.Anything, fakeAuthsByTeamSlice[0])
+			},
+		},
+		"keep the authorization of a team if revoking fails unexpectedly": {
+			CmdArgs: []string{"--team", "team1", "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("api.slack.com")
+				clientsMock.Auth.On("ResolveLogstashHost", mock.Anything, mock.Anything).Return("logstash.slack.com")
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.API.On("RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].Token).Return(slackerror.New(slackerror.ErrHTTPRequestFailed))
+			},
+			ExpectedError: slackerror.New(slackerror.ErrHTTPRequestFailed),
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.Auth.AssertNotCalled(t, "DeleteAuth", mock.Anything, mock.Anything)
+				clients.IO.AssertNotCalled(t, "PrintTrace", mock.Anything, slacktrace.AuthRevokeSuccess)
+			},
+		},
+		"cancel revoking the authorization of a team": {
+			CmdArgs: []string{"--team", "team1"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.IO.On("ConfirmPrompt", mock.Anything, "Are you sure you want to revoke the authorization for team1?", false).Return(false, nil)
+			},
+			ExpectedOutputs: []string{"Revoke cancelled"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.API.AssertNotCalled(t, "RevokeToken", mock.Anything, mock.Anything)
+				clients.Auth.AssertNotCalled(t, "DeleteAuth", mock.Anything, mock.Anything)
+			},
+		},
+		"error if no authorization matches the team": {
+			CmdArgs: []string{"--team", "T3", "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+			},
+			ExpectedErrorStrings: []string{"No authorization was found for the team 'T3'"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.API.AssertNotCalled(t, "RevokeToken", mock.Anything, mock.Anything)
+			},
+		},
+		"require a team value with the flag": {
+			CmdArgs:              []string{"--team", ""},
+			ExpectedErrorStrings: []string{"The argument is missing from the --team flag (missing_flag)"},
+		},
 	}, func(clients *shared.ClientFactory) *cobra.Command {
 		return NewRevokeCommand(clients)
 	})
@@ -0,0 +1,205 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/slacktrace"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+// whoamiOutputFlag is the format used to print the summary
+var whoamiOutputFlag string
+
+// whoamiAppSelectPromptFunc provides a handle for stubbing app selections
+var whoamiAppSelectPromptFunc = prompts.AppSelectPrompt
+
+// whoamiTeamSelectPromptFunc provides a handle for stubbing team selections
+var whoamiTeamSelectPromptFunc = prompts.PromptTeamSlackAuth
+
+// whoamiInfo summarizes the active authorization and app
+type whoamiInfo struct {
+	TeamDomain string     `json:"team_domain"`
+	TeamID     string     `json:"team_id"`
+	UserID     string     `json:"user_id"`
+	APIHost    string     `json:"api_host"`
+	App        *whoamiApp `json:"app,omitempty"`
+	auth       types.SlackAuth
+}
+
+// whoamiApp contains details of the app used by default
+type whoamiApp struct {
+	AppID       string `json:"app_id"`
+	Environment string `json:"environment"`
+}
+
+// NewWhoamiCommand creates the Cobra command for summarizing the active auth and app
+func NewWhoamiCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the active team authorization and app",
+		Long: `Show the team, user, and API host of the active authorization with the app used
+by default in a project.
+
+The same team and app are selected as other commands that use the --team and
+--app flags, and the access token is checked to be valid.`,
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "auth whoami", Meaning: "Show the active team authorization and app"},
+			{Command: "auth whoami --team T0123456789 --output json", Meaning: "Print details of an authorization as JSON"},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWhoamiCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&whoamiOutputFlag, "output", "text", "output format: text, json")
+	return cmd
+}
+
+// runWhoamiCommand will execute the whoami command
+func runWhoamiCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch whoamiOutputFlag {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", whoamiOutputFlag).
+			WithRemediation("Use one of: text, json")
+	}
+	info, err := getWhoamiInfo(ctx, clients)
+	if err != nil {
+		return err
+	}
+	if err := validateWhoamiSession(ctx, clients, info); err != nil {
+		return err
+	}
+	if whoamiOutputFlag == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(info); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+	} else {
+		printWhoami(ctx, clients, info)
+	}
+	clients.IO.PrintTrace(ctx, slacktrace.AuthWhoamiSuccess, info.UserID, info.TeamID)
+	return nil
+}
+
+// getWhoamiInfo resolves the selected authorization and app
+//
+// Apps are only selected in a project directory. Otherwise the authorization
+// is chosen from the --token or --team flag or a team selection.
+func getWhoamiInfo(ctx context.Context, clients *shared.ClientFactory) (whoamiInfo, error) {
+	if cmdutil.IsValidProjectDirectory(clients) == nil {
+		selection, err := whoamiAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps, prompts.WithNoAppOption())
+		if err == nil {
+			info := newWhoamiInfo(ctx, clients, selection.Auth)
+			if selection.App.AppID != "" {
+				environment := "deployed"
+				if selection.App.IsDev {
+					environment = "local"
+				}
+				info.App = &whoamiApp{
+					AppID:       selection.App.AppID,
+					Environment: environment,
+				}
+			}
+			return info, nil
+		}
+		switch slackerror.ToSlackError(err).Code {
+		case slackerror.ErrInstallationRequired, slackerror.ErrNoAppSelected:
+		default:
+			return whoamiInfo{}, err
+		}
+	}
+	if clients.Config.TokenFlag != "" {
+		auth, err := clients.Auth().AuthWithToken(ctx, clients.Config.TokenFlag)
+		if err != nil {
+			return whoamiInfo{}, err
+		}
+		return newWhoamiInfo(ctx, clients, auth), nil
+	}
+	auths, err := clients.Auth().Auths(ctx)
+	if err != nil {
+		return whoamiInfo{}, err
+	}
+	if len(auths) == 0 {
+		return whoamiInfo{}, slackerror.New(slackerror.ErrNotAuthed)
+	}
+	auth, err := whoamiTeamSelectPromptFunc(ctx, clients, "Select a team", nil)
+	if err != nil {
+		return whoamiInfo{}, err
+	}
+	return newWhoamiInfo(ctx, clients, *auth), nil
+}
+
+// newWhoamiInfo returns the summary of an authorization
+func newWhoamiInfo(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth) whoamiInfo {
+	return whoamiInfo{
+		TeamDomain: auth.TeamDomain,
+		TeamID:     auth.TeamID,
+		UserID:     auth.UserID,
+		APIHost:    clients.Auth().ResolveAPIHost(ctx, clients.Config.APIHostFlag, &auth),
+		auth:       auth,
+	}
+}
+
+// validateWhoamiSession confirms the access token of the authorization is live
+func validateWhoamiSession(ctx context.Context, clients *shared.ClientFactory, info whoamiInfo) error {
+	apiClient := clients.API()
+	if info.APIHost != "" {
+		apiClient.SetHost(info.APIHost)
+	}
+	_, err := apiClient.ValidateSession(ctx, info.auth.Token)
+	if err == nil {
+		return nil
+	}
+	switch slackerror.ToSlackError(err).Code {
+	case slackerror.ErrTokenExpired:
+		return slackerror.New(slackerror.ErrTokenExpired).WithRootCause(err)
+	case slackerror.ErrInvalidAuth, slackerror.ErrNotAuthed, slackerror.ErrTokenRevoked:
+		return slackerror.New(slackerror.ErrInvalidAuth).WithRootCause(err)
+	default:
+		return err
+	}
+}
+
+// printWhoami displays the summary of the authorization and app
+func printWhoami(ctx context.Context, clients *shared.ClientFactory, info whoamiInfo) {
+	details := []string{
+		fmt.Sprintf("User ID: %s", info.UserID),
+		fmt.Sprintf("API Host: %s", info.APIHost),
+	}
+	if info.App != nil {
+		details = append(details, fmt.Sprintf("App: %s (%s)", info.App.AppID, info.App.Environment))
+	} else {
+		details = append(details, "App: None")
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "bust_in_silhouette",
+		Text:      fmt.Sprintf("%s (Team ID: %s)", info.TeamDomain, info.TeamID),
+		Secondary: details,
+	}))
+}
@@ -0,0 +1,127 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWhoamiCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"errors without any authorizations": {
+			CmdArgs:       []string{},
+			ExpectedError: slackerror.New(slackerror.ErrNotAuthed),
+		},
+		"prints the selected team outside of a project": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("https://slack.com")
+				clientsMock.API.On("SetHost", "https://slack.com")
+				clientsMock.API.On("ValidateSession", mock.Anything, fakeAuthsByTeamSlice[1].Token).Return(api.AuthSession{}, nil)
+				whoamiTeamSelectPromptFunc = func(ctx context.Context, clients *shared.ClientFactory, promptText string, promptConfig *prompts.PromptTeamSlackAuthConfig) (*types.SlackAuth, error) {
+					return &fakeAuthsByTeamSlice[1], nil
+				}
+			},
+			ExpectedOutputs: []string{
+				"team2 (Team ID: T2)",
+				"User ID: U2",
+				"API Host: https://slack.com",
+				"App: None",
+			},
+		},
+		"prints the selected app as json in a project": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clients.SDKConfig.WorkingDirectory = "."
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("https://dev.slack.com")
+				clientsMock.API.On("SetHost", "https://dev.slack.com")
+				clientsMock.API.On("ValidateSession", mock.Anything, fakeAuthsByTeamSlice[0].Token).Return(api.AuthSession{}, nil)
+				appSelectMock := prompts.NewAppSelectMock()
+				whoamiAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{
+					App:  types.App{AppID: "A1", TeamID: "T1", TeamDomain: "team1", IsDev: true},
+					Auth: fakeAuthsByTeamSlice[0],
+				}, nil)
+			},
+			ExpectedStdoutOutputs: []string{
+				`"team_domain": "team1"`,
+				`"user_id": "U1"`,
+				`"api_host": "https://dev.slack.com"`,
+				`"app_id": "A1"`,
+				`"environment": "local"`,
+			},
+		},
+		"falls back to the team without saved apps in a project": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clients.SDKConfig.WorkingDirectory = "."
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("https://slack.com")
+				clientsMock.API.On("SetHost", "https://slack.com")
+				clientsMock.API.On("ValidateSession", mock.Anything, fakeAuthsByTeamSlice[0].Token).Return(api.AuthSession{}, nil)
+				appSelectMock := prompts.NewAppSelectMock()
+				whoamiAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{}, slackerror.New(slackerror.ErrInstallationRequired))
+				whoamiTeamSelectPromptFunc = func(ctx context.Context, clients *shared.ClientFactory, promptText string, promptConfig *prompts.PromptTeamSlackAuthConfig) (*types.SlackAuth, error) {
+					return &fakeAuthsByTeamSlice[0], nil
+				}
+			},
+			ExpectedOutputs: []string{
+				"team1 (Team ID: T1)",
+				"App: None",
+			},
+		},
+		"errors if the token has expired": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("Auths", mock.Anything).Return(fakeAuthsByTeamSlice, nil)
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("https://slack.com")
+				clientsMock.API.On("SetHost", "https://slack.com")
+				clientsMock.API.On("ValidateSession", mock.Anything, mock.Anything).Return(api.AuthSession{}, slackerror.New(slackerror.ErrTokenExpired))
+				whoamiTeamSelectPromptFunc = func(ctx context.Context, clients *shared.ClientFactory, promptText string, promptConfig *prompts.PromptTeamSlackAuthConfig) (*types.SlackAuth, error) {
+					return &fakeAuthsByTeamSlice[0], nil
+				}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrTokenExpired},
+		},
+		"errors if the token is invalid": {
+			CmdArgs: []string{"--token", "xoxp-invalid"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.Auth.On("AuthWithToken", mock.Anything, "xoxp-invalid").Return(types.SlackAuth{Token: "xoxp-invalid"}, nil)
+				clientsMock.Auth.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("https://slack.com")
+				clientsMock.API.On("SetHost", "https://slack.com")
+				clientsMock.API.On("ValidateSession", mock.Anything, "xoxp-invalid").Return(api.AuthSession{}, slackerror.New(slackerror.ErrInvalidAuth))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAuth},
+		},
+		"errors with an unknown output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{"Invalid output format: yaml"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewWhoamiCommand(clients)
+	})
+}
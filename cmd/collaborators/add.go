@@ -34,6 +34,9 @@ import (
 
 // addCmdFlags contains the flag set for this command
 type addCmdFlags struct {
+	batch          cmdutil.BatchFlags
+	emails         []string
+	output         string
 	permissionType string
 }
 
@@ -42,7 +45,7 @@ var addFlags addCmdFlags
 
 func NewAddCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "add [email|user_id]",
+		Use:     "add [email|user_id...]",
 		Aliases: []string{"new", "include"},
 		Short:   "Add a new collaborator to the app",
 		Long:    "Add a collaborator to your app by Slack email address or user ID",
@@ -50,8 +53,11 @@ func NewAddCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "collaborator add", Meaning: "Add a collaborator via prompt"},
 			{Command: "collaborator add bot@slack.com", Meaning: "Add a collaborator from email"},
 			{Command: "collaborator add USLACKBOT", Meaning: "Add a collaborator by user ID"},
+			{Command: "collaborator add --email bot@slack.com --email dev@slack.com", Meaning: "Add collaborators by looking up their emails"},
+			{Command: "collaborator add U0123 U0456 --output json", Meaning: "Add collaborators and print the result of each as JSON"},
+			{Command: "collaborator add U0123 U0456 --keep-going", Meaning: "Add each collaborator even if one fails"},
 		}),
-		Args: cobra.RangeArgs(0, 1),
+		Args: cobra.ArbitraryArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			clients.Config.SetFlags(cmd)
 			return cmdutil.IsValidProjectDirectory(clients)
@@ -61,6 +67,9 @@ func NewAddCommand(clients *shared.ClientFactory) *cobra.Command {
 			return runAddCommandFunc(ctx, clients, cmd, args)
 		},
 	}
+	addFlags.batch.AddFlags(cmd)
+	cmd.Flags().StringArrayVar(&addFlags.emails, "email", []string{}, "look up the user ID of a collaborator by email")
+	cmd.Flags().StringVar(&addFlags.output, "output", "text", "output format: text, json")
 	cmd.Flags().StringVarP(&addFlags.permissionType, "permission-type", "P", string(types.OWNER), fmt.Sprintf(
 		"collaborator permission type\n(\"%s\" or \"%s\")",
 		string(types.OWNER),
@@ -75,6 +84,14 @@ func runAddCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *
 	span, ctx = opentracing.StartSpanFromContext(ctx, "cmd.Collaborators.Add")
 	defer span.Finish()
 
+	if err := validateOutputFlag(addFlags.output); err != nil {
+		return err
+	}
+	batch, err := newCollaboratorsBatch(&addFlags.batch, addFlags.output)
+	if err != nil {
+		return err
+	}
+
 	// Get the app auth selection from the flag or prompt
 	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps)
 	if err != nil {
@@ -83,11 +100,76 @@ func runAddCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *
 	if err = cmdutil.AppExists(selection.App, selection.Auth); err != nil {
 		return err
 	}
+	if len(addFlags.emails) > 0 {
+		if len(args) > 0 {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --email flag cannot be used with a collaborator argument").
+				WithRemediation("Provide collaborators with either arguments or the --email flag")
+		}
+		slackUsers, err := lookupCollaboratorsByEmail(ctx, clients, selection.Auth.Token, addFlags.emails)
+		if err != nil {
+			return err
+		}
+		return addCollaborators(ctx, clients, cmd, batch, selection, slackUsers)
+	}
+	if len(args) > 1 {
+		slackUsers := []types.SlackUser{}
+		for _, arg := range args {
+			slackUser, err := promptCollaboratorsAdd(ctx, clients, []string{arg}, selection)
+			if err != nil {
+				return err
+			}
+			slackUsers = append(slackUsers, slackUser)
+		}
+		return addCollaborators(ctx, clients, cmd, batch, selection, slackUsers)
+	}
 	slackUser, err := promptCollaboratorsAdd(ctx, clients, args, selection)
 	if err != nil {
 		return err
 	}
-	err = clients.API().AddCollaborator(ctx, selection.Auth.Token, selection.App.AppID, slackUser)
+	return addCollaborators(ctx, clients, cmd, batch, selection, []types.SlackUser{slackUser})
+}
+
+// addCollaborators adds each user as a collaborator of the selected app and
+// errors with each failure once the batch stops or every user is attempted
+func addCollaborators(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	batch *cmdutil.Batch,
+	selection prompts.SelectedApp,
+	slackUsers []types.SlackUser,
+) error {
+	if addFlags.output != "json" {
+		if len(slackUsers) == 1 {
+			return addCollaborator(ctx, clients, cmd, selection, slackUsers[0])
+		}
+		cmdutil.Run(batch, slackUsers, collaboratorName, func(slackUser types.SlackUser) error {
+			return addCollaborator(ctx, clients, cmd, selection, slackUser)
+		})
+		return batch.Err(slackerror.ErrFailedAddingCollaborator, "add", "collaborators", len(slackUsers))
+	}
+	results := []collaboratorResult{}
+	cmdutil.Run(batch, slackUsers, collaboratorName, func(slackUser types.SlackUser) error {
+		err := clients.API().AddCollaborator(ctx, selection.Auth.Token, selection.App.AppID, slackUser)
+		results = append(results, newCollaboratorResult(slackUser, "add", err))
+		return err
+	})
+	if err := printCollaboratorResults(clients, results); err != nil {
+		return err
+	}
+	return batch.Err(slackerror.ErrFailedAddingCollaborator, "add", "collaborators", len(slackUsers))
+}
+
+// addCollaborator adds the user as a collaborator of the selected app
+func addCollaborator(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	selection prompts.SelectedApp,
+	slackUser types.SlackUser,
+) error {
+	err := clients.API().AddCollaborator(ctx, selection.Auth.Token, selection.App.AppID, slackUser)
 	if err != nil {
 		if strings.Contains(err.Error(), "user_already_owner") {
 			cmd.Println()
@@ -138,6 +220,42 @@ func promptCollaboratorsAdd(
 	return slackUser, nil
 }
 
+// lookupCollaboratorsByEmail resolves the user ID of each email before any
+// collaborator is added
+func lookupCollaboratorsByEmail(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	token string,
+	emails []string,
+) (
+	slackUsers []types.SlackUser,
+	err error,
+) {
+	permission := types.OWNER
+	if clients.Config.Flags.Lookup("permission-type").Changed {
+		permission, err = promptCollaboratorsAddPermissionFlags(ctx, clients, addFlags.permissionType)
+		if err != nil {
+			return []types.SlackUser{}, err
+		}
+	}
+	for _, email := range emails {
+		userInfo, err := clients.API().UsersLookupByEmail(ctx, token, email)
+		if err != nil {
+			if slackerror.ToSlackError(err).Code == "users_not_found" {
+				return []types.SlackUser{}, slackerror.New(slackerror.ErrUserNotFound).
+					WithMessage("No user was found with the email '%s'", email).
+					WithRemediation("Check the email address or add the collaborator by user ID")
+			}
+			return []types.SlackUser{}, err
+		}
+		slackUsers = append(slackUsers, types.SlackUser{
+			ID:             userInfo.ID,
+			PermissionType: permission,
+		})
+	}
+	return slackUsers, nil
+}
+
 // promptCollaboratorsAddArguments gathers a collaborator ID or email from input
 // and sets the permission type
 func promptCollaboratorsAddSlackUserArguments(
@@ -21,9 +21,11 @@ import (
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -92,6 +94,154 @@ func TestAddCommand(t *testing.T) {
 				cm.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.CollaboratorAddCollaborator, []string{"joe.smith@company.com", "owner"})
 			},
 		},
+		"add collaborators from looked up emails": {
+			CmdArgs: []string{"--email", "bot@slack.com", "--email", "dev@slack.com", "--permission-type", "reader"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				// Mock App Selection
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{App: types.App{AppID: "A123"}, Auth: types.SlackAuth{}}, nil)
+				// Mock API calls
+				cm.API.On("UsersLookupByEmail", mock.Anything, mock.Anything, "bot@slack.com").
+					Return(&types.UserInfo{ID: "U001"}, nil)
+				cm.API.On("UsersLookupByEmail", mock.Anything, mock.Anything, "dev@slack.com").
+					Return(&types.UserInfo{ID: "U002"}, nil)
+				cm.API.On("AddCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "AddCollaborator", mock.Anything, mock.Anything,
+					"A123",
+					types.SlackUser{ID: "U001", PermissionType: types.READER})
+				cm.API.AssertCalled(t, "AddCollaborator", mock.Anything, mock.Anything,
+					"A123",
+					types.SlackUser{ID: "U002", PermissionType: types.READER})
+				cm.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.CollaboratorAddCollaborator, []string{"U001", "reader"})
+				cm.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.CollaboratorAddCollaborator, []string{"U002", "reader"})
+			},
+		},
+		"errors without adding collaborators if an email is not found": {
+			CmdArgs: []string{"--email", "bot@slack.com", "--email", "nobody@slack.com"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				// Mock App Selection
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{App: types.App{AppID: "A123"}, Auth: types.SlackAuth{}}, nil)
+				// Mock API calls
+				cm.API.On("UsersLookupByEmail", mock.Anything, mock.Anything, "bot@slack.com").
+					Return(&types.UserInfo{ID: "U001"}, nil)
+				cm.API.On("UsersLookupByEmail", mock.Anything, mock.Anything, "nobody@slack.com").
+					Return(&types.UserInfo{}, slackerror.NewAPIError("users_not_found", "", nil, "users.lookupByEmail"))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrUserNotFound, "nobody@slack.com"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "AddCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors if emails are provided with a collaborator argument": {
+			CmdArgs: []string{"U123", "--email", "bot@slack.com"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				// Mock App Selection
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{App: types.App{AppID: "A123"}, Auth: types.SlackAuth{}}, nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "UsersLookupByEmail", mock.Anything, mock.Anything, mock.Anything)
+				cm.API.AssertNotCalled(t, "AddCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"prints the result of each collaborator as json and errors on failures": {
+			CmdArgs: []string{"U001", "U002", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				// Mock App Selection
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{App: types.App{AppID: "A123"}, Auth: types.SlackAuth{}}, nil)
+				// Mock API calls
+				cm.API.On("AddCollaborator", mock.Anything, mock.Anything, "A123", types.SlackUser{ID: "U001", PermissionType: types.OWNER}).
+					Return(slackerror.NewAPIError("user_already_owner", "", nil, "developer.apps.owners.add"))
+				cm.API.On("AddCollaborator", mock.Anything, mock.Anything, "A123", types.SlackUser{ID: "U002", PermissionType: types.OWNER}).
+					Return(nil)
+			},
+			ExpectedStdoutOutputs: []string{
+				`"user_id": "U001",
+    "action": "add",
+    "success": false,
+    "error_code": "user_already_owner"`,
+				`"user_id": "U002",
+    "action": "add",
+    "success": true`,
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrFailedAddingCollaborator, "Failed to add 1 of 2 collaborators"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "AddCollaborator", 2)
+				cm.IO.AssertNotCalled(t, "PrintTrace", mock.Anything, slacktrace.CollaboratorAddSuccess, mock.Anything)
+			},
+		},
+		"stops at the first failure of json outputs with the fail-fast flag": {
+			CmdArgs: []string{"U001", "U002", "--output", "json", "--fail-fast"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{App: types.App{AppID: "A123"}, Auth: types.SlackAuth{}}, nil)
+				cm.API.On("AddCollaborator", mock.Anything, mock.Anything, "A123", types.SlackUser{ID: "U001", PermissionType: types.OWNER}).
+					Return(slackerror.NewAPIError("user_already_owner", "", nil, "developer.apps.owners.add"))
+			},
+			ExpectedStdoutOutputs: []string{
+				`"user_id": "U001",
+    "action": "add",
+    "success": false,
+    "error_code": "user_already_owner"`,
+			},
+			ExpectedErrorStrings: []string{
+				slackerror.ErrFailedAddingCollaborator,
+				"Failed to add 1 of 2 collaborators",
+				"Remaining collaborators were skipped after the first failure",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "AddCollaborator", 1)
+				assert.NotContains(t, cm.GetStdoutOutput(), "U002")
+			},
+		},
+		"continues after a failure of text outputs with the keep-going flag": {
+			CmdArgs: []string{"U001", "U002", "--keep-going"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).Return(prompts.SelectedApp{App: types.App{AppID: "A123"}, Auth: types.SlackAuth{}}, nil)
+				cm.API.On("AddCollaborator", mock.Anything, mock.Anything, "A123", types.SlackUser{ID: "U001", PermissionType: types.OWNER}).
+					Return(slackerror.New(slackerror.ErrUserNotFound))
+				cm.API.On("AddCollaborator", mock.Anything, mock.Anything, "A123", types.SlackUser{ID: "U002", PermissionType: types.OWNER}).
+					Return(nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrFailedAddingCollaborator, "Failed to add 1 of 2 collaborators"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "AddCollaborator", 2)
+				cm.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.CollaboratorAddSuccess, mock.Anything)
+			},
+		},
+		"errors if the fail-fast and keep-going flags are both set": {
+			CmdArgs:              []string{"U001", "U002", "--fail-fast", "--keep-going"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --fail-fast flag cannot be used with the --keep-going flag"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "AddCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors for an unknown output format": {
+			CmdArgs:              []string{"U001", "--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "AddCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 	}, func(clients *shared.ClientFactory) *cobra.Command {
 		cmd := NewAddCommand(clients)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
@@ -16,12 +16,15 @@ package collaborators
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/cobra"
 )
@@ -67,3 +70,64 @@ func printSuccess(ctx context.Context, io iostreams.IOStreamer, user types.Slack
 
 	io.PrintInfo(ctx, false, "%s\n", successText)
 }
+
+// collaboratorResult is the outcome of changing one collaborator for outputs
+// of the --output json flag
+type collaboratorResult struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email,omitempty"`
+	Action    string `json:"action"`
+	Success   bool   `json:"success"`
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// newCollaboratorResult records the outcome of an action on a collaborator
+func newCollaboratorResult(user types.SlackUser, action string, err error) collaboratorResult {
+	result := collaboratorResult{
+		UserID:  user.ID,
+		Email:   user.Email,
+		Action:  action,
+		Success: err == nil,
+	}
+	if err != nil {
+		result.ErrorCode = slackerror.ToSlackError(err).Code
+	}
+	return result
+}
+
+// validateOutputFlag errors if the output format is not known
+func validateOutputFlag(output string) error {
+	switch output {
+	case "text", "json":
+		return nil
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", output).
+			WithRemediation("Use one of: text, json")
+	}
+}
+
+// printCollaboratorResults writes the results as JSON
+func printCollaboratorResults(clients *shared.ClientFactory, results []collaboratorResult) error {
+	encoder := json.NewEncoder(clients.IO.WriteOut())
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(results); err != nil {
+		return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+	}
+	return nil
+}
+
+// newCollaboratorsBatch returns a batch for changes to multiple collaborators.
+// Text outputs stop at the first failure and JSON outputs continue with each
+// user unless the --fail-fast or --keep-going flag is set
+func newCollaboratorsBatch(flags *cmdutil.BatchFlags, output string) (*cmdutil.Batch, error) {
+	return flags.NewBatch(output == "json")
+}
+
+// collaboratorName returns the email or user ID of a collaborator for errors
+func collaboratorName(user types.SlackUser) string {
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
@@ -32,9 +32,18 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// removeCmdFlags contains the flag set for this command
+type removeCmdFlags struct {
+	batch  cmdutil.BatchFlags
+	output string
+}
+
+// removeFlags implements values of the command flag set
+var removeFlags removeCmdFlags
+
 func NewRemoveCommand(clients *shared.ClientFactory) *cobra.Command {
-	return &cobra.Command{
-		Use:     "remove [email|user_id]",
+	cmd := &cobra.Command{
+		Use:     "remove [email|user_id...]",
 		Aliases: []string{"delete"},
 		Short:   "Remove a collaborator from an app",
 		Long:    "Remove a collaborator from an app by Slack email address or user ID",
@@ -42,8 +51,9 @@ func NewRemoveCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "collaborator remove", Meaning: "Remove collaborator on prompt"},
 			{Command: "collaborator remove bot@slack.com", Meaning: "Remove collaborator by email"},
 			{Command: "collaborator remove USLACKBOT", Meaning: "Remove collaborator using ID"},
+			{Command: "collaborator remove U0123 U0456 --output json", Meaning: "Remove collaborators and print the result of each as JSON"},
 		}),
-		Args: cobra.RangeArgs(0, 1),
+		Args: cobra.ArbitraryArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return cmdutil.IsValidProjectDirectory(clients)
 		},
@@ -52,6 +62,9 @@ func NewRemoveCommand(clients *shared.ClientFactory) *cobra.Command {
 			return runRemoveCommandFunc(ctx, clients, cmd, args)
 		},
 	}
+	removeFlags.batch.AddFlags(cmd)
+	cmd.Flags().StringVar(&removeFlags.output, "output", "text", "output format: text, json")
+	return cmd
 }
 
 // runRemoveCommandFunc removes a user as an app collaborator from an app
@@ -59,24 +72,65 @@ func runRemoveCommandFunc(ctx context.Context, clients *shared.ClientFactory, cm
 	var span opentracing.Span
 	span, ctx = opentracing.StartSpanFromContext(ctx, "cmd.Collaborators.Remove")
 	defer span.Finish()
-	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps)
+	if err := validateOutputFlag(removeFlags.output); err != nil {
+		return err
+	}
+	batch, err := newCollaboratorsBatch(&removeFlags.batch, removeFlags.output)
 	if err != nil {
 		return err
 	}
-	slackUser, err := promptCollaboratorsRemoveSlackUser(ctx, clients, args, selection)
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps)
 	if err != nil {
 		return err
 	}
+	slackUsers := []types.SlackUser{}
+	if len(args) > 1 {
+		for _, arg := range args {
+			slackUser, err := promptCollaboratorsRemoveSlackUserArguments(arg)
+			if err != nil {
+				return err
+			}
+			slackUsers = append(slackUsers, slackUser)
+		}
+	} else {
+		slackUser, err := promptCollaboratorsRemoveSlackUser(ctx, clients, args, selection)
+		if err != nil {
+			return err
+		}
+		slackUsers = append(slackUsers, slackUser)
+	}
 	if err = cmdutil.AppExists(selection.App, selection.Auth); err != nil {
 		return err
 	}
-	warnings, err := clients.API().RemoveCollaborator(ctx, selection.Auth.Token, selection.App.AppID, slackUser)
-	if err != nil {
-		return err
+	if removeFlags.output == "json" {
+		results := []collaboratorResult{}
+		cmdutil.Run(batch, slackUsers, collaboratorName, func(slackUser types.SlackUser) error {
+			warnings, err := clients.API().RemoveCollaborator(ctx, selection.Auth.Token, selection.App.AppID, slackUser)
+			results = append(results, newCollaboratorResult(slackUser, "remove", err))
+			for _, warning := range warnings {
+				clients.IO.PrintWarning(ctx, "%s", warning.Message)
+			}
+			return err
+		})
+		if err := printCollaboratorResults(clients, results); err != nil {
+			return err
+		}
+		return batch.Err(slackerror.ErrCannotRemoveOwners, "remove", "collaborators", len(slackUsers))
+	}
+	removeCollaborator := func(slackUser types.SlackUser) error {
+		warnings, err := clients.API().RemoveCollaborator(ctx, selection.Auth.Token, selection.App.AppID, slackUser)
+		if err != nil {
+			return err
+		}
+		printCollaboratorsRemoveSuccess(ctx, clients, selection.App.AppID, slackUser)
+		printWarnings(ctx, clients, warnings)
+		return nil
+	}
+	if len(slackUsers) == 1 {
+		return removeCollaborator(slackUsers[0])
 	}
-	printCollaboratorsRemoveSuccess(ctx, clients, selection.App.AppID, slackUser)
-	printWarnings(ctx, clients, warnings)
-	return nil
+	cmdutil.Run(batch, slackUsers, collaboratorName, removeCollaborator)
+	return batch.Err(slackerror.ErrCannotRemoveOwners, "remove", "collaborators", len(slackUsers))
 }
 
 // promptCollaboratorsRemoveSlackUser determines which user to remove as an app
@@ -26,6 +26,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -111,6 +112,77 @@ func TestRemoveCommand(t *testing.T) {
 			},
 			ExpectedError: slackerror.New(slackerror.ErrProcessInterrupted),
 		},
+		"removes each collaborator provided via arguments": {
+			CmdArgs: []string{"U001", "reader@slack.com"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).
+					Return(mockSelection, nil)
+				cm.API.On("RemoveCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "RemoveCollaborator", mock.Anything, mock.Anything, "A001", types.SlackUser{ID: "U001"})
+				cm.API.AssertCalled(t, "RemoveCollaborator", mock.Anything, mock.Anything, "A001", types.SlackUser{Email: "reader@slack.com"})
+			},
+		},
+		"prints the result of each collaborator as json and errors on failures": {
+			CmdArgs: []string{"U001", "reader@slack.com", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).
+					Return(mockSelection, nil)
+				cm.API.On("RemoveCollaborator", mock.Anything, mock.Anything, "A001", types.SlackUser{ID: "U001"}).
+					Return(slackerror.NewAPIError(slackerror.ErrCannotRemoveOwners, "", nil, "developer.apps.owners.remove"))
+				cm.API.On("RemoveCollaborator", mock.Anything, mock.Anything, "A001", types.SlackUser{Email: "reader@slack.com"}).
+					Return(nil)
+			},
+			ExpectedStdoutOutputs: []string{
+				`"user_id": "U001",
+    "action": "remove",
+    "success": false,
+    "error_code": "cannot_remove_owner"`,
+				`"user_id": "",
+    "email": "reader@slack.com",
+    "action": "remove",
+    "success": true`,
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrCannotRemoveOwners, "Failed to remove 1 of 2 collaborators"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "RemoveCollaborator", 2)
+				cm.IO.AssertNotCalled(t, "PrintTrace", mock.Anything, slacktrace.CollaboratorRemoveSuccess, mock.Anything)
+			},
+		},
+		"stops at the first failure of json outputs with the fail-fast flag": {
+			CmdArgs: []string{"U001", "reader@slack.com", "--output", "json", "--fail-fast"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).
+					Return(mockSelection, nil)
+				cm.API.On("RemoveCollaborator", mock.Anything, mock.Anything, "A001", types.SlackUser{ID: "U001"}).
+					Return(slackerror.NewAPIError(slackerror.ErrCannotRemoveOwners, "", nil, "developer.apps.owners.remove"))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrCannotRemoveOwners, "Failed to remove 1 of 2 collaborators"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "RemoveCollaborator", 1)
+				assert.NotContains(t, cm.GetStdoutOutput(), "reader@slack.com")
+			},
+		},
+		"prints the results as json without errors when each succeeds": {
+			CmdArgs: []string{"U001", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowInstalledAndUninstalledApps).
+					Return(mockSelection, nil)
+				cm.API.On("RemoveCollaborator", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			ExpectedStdoutOutputs: []string{`"user_id": "U001"`, `"success": true`},
+		},
 	}, func(clients *shared.ClientFactory) *cobra.Command {
 		cmd := NewRemoveCommand(clients)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,304 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/experiment"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+// experimentsKeyPrefix starts the keys that toggle a single experiment
+const experimentsKeyPrefix = "experiments."
+
+func NewCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config <subcommand>",
+		Short: "Read and write configurations",
+		Long: strings.Join([]string{
+			"Read and write configurations of the project or the system.",
+			"",
+			`Project configurations are saved to the ".slack/config.json" file of a project`,
+			`and system configurations are saved to the "config.json" file of the system.`,
+			"",
+			"Project configuration keys:",
+			formatConfigKeys(false),
+			"",
+			"System configuration keys, used with the --system flag:",
+			formatConfigKeys(true),
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Print the manifest source of the project",
+				Command: "config get manifest.source",
+			},
+			{
+				Meaning: "Use the app settings as the manifest source",
+				Command: "config set manifest.source remote",
+			},
+			{
+				Meaning: "Trust unknown sources on this system",
+				Command: "config set trust_unknown_sources true --system",
+			},
+		}),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// Add child commands
+	cmd.AddCommand(NewContextCommand(clients))
+	cmd.AddCommand(NewGetCommand(clients))
+	cmd.AddCommand(NewProfileCommand(clients))
+	cmd.AddCommand(NewSetCommand(clients))
+
+	return cmd
+}
+
+// configKey is a known configuration with the handlers to read and write it
+type configKey struct {
+	name   string
+	values string
+	system bool
+	get    func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error)
+	set    func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error
+}
+
+// configKeys are the configurations that can be read and written
+var configKeys = []configKey{
+	{
+		name:   "deploy.git_metadata",
+		values: "true, false",
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			return clients.Config.ProjectConfig.GetDeployGitMetadata(ctx)
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			enabled, err := parseConfigBool(key, value)
+			if err != nil {
+				return err
+			}
+			return config.SetDeployGitMetadata(ctx, clients.Fs, clients.Os, enabled)
+		},
+	},
+	{
+		name:   experimentsKeyPrefix + "<name>",
+		values: "true, false",
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			projectConfig, err := config.ReadProjectConfigFile(ctx, clients.Fs, clients.Os)
+			if err != nil {
+				return nil, err
+			}
+			return projectConfig.Experiments[strings.TrimPrefix(key, experimentsKeyPrefix)], nil
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			enabled, err := parseConfigBool(key, value)
+			if err != nil {
+				return err
+			}
+			exp := experiment.Experiment(strings.TrimPrefix(key, experimentsKeyPrefix))
+			return config.SetProjectExperiment(ctx, clients.Fs, clients.Os, exp, enabled)
+		},
+	},
+	{
+		name:   "manifest.source",
+		values: fmt.Sprintf("%s, %s", config.ManifestSourceLocal, config.ManifestSourceRemote),
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			source, err := clients.Config.ProjectConfig.GetManifestSource(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return source.String(), nil
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			source := config.ManifestSource(value)
+			if !source.Equals(config.ManifestSourceLocal) && !source.Equals(config.ManifestSourceRemote) {
+				return invalidConfigValueError(key, value, fmt.Sprintf("%s, %s", config.ManifestSourceLocal, config.ManifestSourceRemote))
+			}
+			return config.SetManifestSource(ctx, clients.Fs, clients.Os, source)
+		},
+	},
+	{
+		name:   "auth_expiry_warning_days",
+		values: "0 or more",
+		system: true,
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			return clients.Config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			days, err := strconv.Atoi(value)
+			if err != nil || days < 0 {
+				return invalidConfigValueError(key, value, "0 or more")
+			}
+			return clients.Config.SystemConfig.SetAuthExpiryWarningDays(ctx, days)
+		},
+	},
+	{
+		name:   "credential_store",
+		values: "auto, file, keychain",
+		system: true,
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			store, err := clients.Config.SystemConfig.GetCredentialStore(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return store.String(), nil
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			store := config.CredentialStore(value)
+			if !store.IsValid() {
+				return invalidConfigValueError(key, value, "auto, file, keychain")
+			}
+			return clients.Config.SystemConfig.SetCredentialStore(ctx, store)
+		},
+	},
+	{
+		name:   experimentsKeyPrefix + "<name>",
+		values: "true, false",
+		system: true,
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			userConfig, err := clients.Config.SystemConfig.UserConfig(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return userConfig.Experiments[strings.TrimPrefix(key, experimentsKeyPrefix)], nil
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			enabled, err := parseConfigBool(key, value)
+			if err != nil {
+				return err
+			}
+			exp := experiment.Experiment(strings.TrimPrefix(key, experimentsKeyPrefix))
+			return clients.Config.SystemConfig.SetExperiment(ctx, exp, enabled)
+		},
+	},
+	{
+		name:   "trust_unknown_sources",
+		values: "true, false",
+		system: true,
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			return clients.Config.SystemConfig.GetTrustUnknownSources(ctx)
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			trust, err := parseConfigBool(key, value)
+			if err != nil {
+				return err
+			}
+			return clients.Config.SystemConfig.SetTrustUnknownSources(ctx, trust)
+		},
+	},
+	{
+		name:   "version_check_interval",
+		values: "a duration like 12h, 0, off",
+		system: true,
+		get: func(ctx context.Context, clients *shared.ClientFactory, key string) (any, error) {
+			interval, err := clients.Config.SystemConfig.GetVersionCheckInterval(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if interval == 0 {
+				return "off", nil
+			}
+			return interval.String(), nil
+		},
+		set: func(ctx context.Context, clients *shared.ClientFactory, key string, value string) error {
+			interval, err := config.ParseVersionCheckInterval(value)
+			if err != nil {
+				return invalidConfigValueError(key, value, "a duration like 12h, 0, off")
+			}
+			return clients.Config.SystemConfig.SetVersionCheckInterval(ctx, interval)
+		},
+	},
+}
+
+// findConfigKey returns the known configuration for the key in the scope or an
+// error that lists the valid keys
+func findConfigKey(key string, system bool) (configKey, error) {
+	name := key
+	if strings.HasPrefix(key, experimentsKeyPrefix) {
+		exp := experiment.Experiment(strings.TrimPrefix(key, experimentsKeyPrefix))
+		if !experiment.Includes(exp) {
+			names := make([]string, 0, len(experiment.AllExperiments))
+			for _, e := range experiment.AllExperiments {
+				names = append(names, string(e))
+			}
+			return configKey{}, slackerror.New(slackerror.ErrConfigKeyUnknown).
+				WithMessage("The \"%s\" experiment is not known", exp).
+				WithRemediation("Use one of the experiments: %s", strings.Join(names, ", "))
+		}
+		name = experimentsKeyPrefix + "<name>"
+	}
+	for _, k := range configKeys {
+		if k.name == name && k.system == system {
+			return k, nil
+		}
+	}
+	err := slackerror.New(slackerror.ErrConfigKeyUnknown).
+		WithMessage("The \"%s\" key is not a known %s configuration", key, configScope(system))
+	for _, k := range configKeys {
+		if k.name == name {
+			if system {
+				return configKey{}, err.WithRemediation("Remove the --system flag to use the project configuration")
+			}
+			return configKey{}, err.WithRemediation("Add the --system flag to use the system configuration")
+		}
+	}
+	return configKey{}, err.WithRemediation("Use one of the %s configuration keys:\n%s", configScope(system), formatConfigKeys(system))
+}
+
+// formatConfigKeys lists the keys and accepted values of a configuration scope
+func formatConfigKeys(system bool) string {
+	lines := []string{}
+	for _, k := range configKeys {
+		if k.system == system {
+			lines = append(lines, fmt.Sprintf("  %s (%s)", k.name, k.values))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// configScope returns the name of the configuration scope
+func configScope(system bool) string {
+	if system {
+		return "system"
+	}
+	return "project"
+}
+
+// parseConfigBool parses a configuration value of either "true" or "false"
+func parseConfigBool(key string, value string) (bool, error) {
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, invalidConfigValueError(key, value, "true, false")
+	}
+}
+
+// invalidConfigValueError returns an error with the values accepted by a key
+func invalidConfigValueError(key string, value string, values string) error {
+	return slackerror.New(slackerror.ErrConfigValueInvalid).
+		WithMessage("The \"%s\" value is not valid for the \"%s\" key", value, key).
+		WithRemediation("Use one of the values: %s", values)
+}
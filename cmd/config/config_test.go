@@ -0,0 +1,102 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Config_Command(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"shows the help page without commands or arguments or flags": {
+			ExpectedStdoutOutputs: []string{
+				"manifest.source (local, remote)",
+				"trust_unknown_sources (true, false)",
+				"Print the manifest source of the project",
+				"Trust unknown sources on this system",
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewCommand(clients)
+		return cmd
+	})
+}
+
+func Test_findConfigKey(t *testing.T) {
+	tests := map[string]struct {
+		key                 string
+		system              bool
+		expectedName        string
+		expectedErrorCode   string
+		expectedRemediation string
+	}{
+		"finds a project key": {
+			key:          "manifest.source",
+			expectedName: "manifest.source",
+		},
+		"finds a system key": {
+			key:          "trust_unknown_sources",
+			system:       true,
+			expectedName: "trust_unknown_sources",
+		},
+		"finds a known experiment": {
+			key:          "experiments.lipgloss",
+			system:       true,
+			expectedName: "experiments.<name>",
+		},
+		"errors for an unknown experiment": {
+			key:                 "experiments.unicorn",
+			expectedErrorCode:   slackerror.ErrConfigKeyUnknown,
+			expectedRemediation: "Use one of the experiments: lipgloss, placeholder, set-icon",
+		},
+		"errors for a system key without the system scope": {
+			key:                 "trust_unknown_sources",
+			expectedErrorCode:   slackerror.ErrConfigKeyUnknown,
+			expectedRemediation: "Add the --system flag to use the system configuration",
+		},
+		"errors for a project key with the system scope": {
+			key:                 "manifest.source",
+			system:              true,
+			expectedErrorCode:   slackerror.ErrConfigKeyUnknown,
+			expectedRemediation: "Remove the --system flag to use the project configuration",
+		},
+		"errors with the valid keys for an unknown key": {
+			key:                 "manifest.sauce",
+			expectedErrorCode:   slackerror.ErrConfigKeyUnknown,
+			expectedRemediation: "Use one of the project configuration keys:\n  deploy.git_metadata (true, false)\n  experiments.<name> (true, false)\n  manifest.source (local, remote)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			key, err := findConfigKey(tc.key, tc.system)
+			if tc.expectedErrorCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorCode, slackerror.ToSlackError(err).Code)
+				assert.Equal(t, tc.expectedRemediation, slackerror.ToSlackError(err).Remediation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedName, key.name)
+			assert.Equal(t, tc.system, key.system)
+		})
+	}
+}
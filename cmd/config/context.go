@@ -0,0 +1,183 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	authpkg "github.com/slackapi/slack-cli/internal/pkg/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+func NewContextCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context <subcommand>",
+		Short: "Manage saved contexts of a team, app, and token",
+		Long: strings.Join([]string{
+			"Manage saved contexts of a team, app, and token.",
+			"",
+			"A context bundles the values of the --team and --app flags. Select a context",
+			"with the --context flag and any of these flags that are also set are used",
+			"instead of the saved values. Tokens are saved with the credentials of a team.",
+			"",
+			`Contexts are saved to the "config.json" file of the system or profile.`,
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Save a context for an app on a team",
+				Command: "config context set work --team T0123456789 --app A0123456789",
+			},
+			{
+				Meaning: "List the saved contexts",
+				Command: "config context list",
+			},
+			{
+				Meaning: "Deploy the app of a saved context",
+				Command: "deploy --context work",
+			},
+		}),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// Add child commands
+	cmd.AddCommand(NewContextListCommand(clients))
+	cmd.AddCommand(NewContextSetCommand(clients))
+
+	return cmd
+}
+
+func NewContextSetCommand(clients *shared.ClientFactory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <name> [flags]",
+		Short: "Save a context of the team, app, and token flags",
+		Long: strings.Join([]string{
+			"Save a context of the team, app, and token flags.",
+			"",
+			"Values of the --team, --app, and --token flags are saved and replace the values",
+			"of an existing context with the same name.",
+			"",
+			"Tokens are saved with the credentials of the team and the context only keeps",
+			"the team of the token.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Save a context for an app on a team",
+				Command: "config context set work --team T0123456789 --app A0123456789",
+			},
+			{
+				Meaning: "Save a context of the local app on a team",
+				Command: "config context set dev --team T0123456789 --app local",
+			},
+		}),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runContextSetCommand(clients, cmd, args[0])
+		},
+	}
+}
+
+// runContextSetCommand saves the team, app, and token flags as a context
+func runContextSetCommand(clients *shared.ClientFactory, cmd *cobra.Command, name string) error {
+	ctx := cmd.Context()
+	if clients.Config.ContextFlag != "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --context flag cannot be used when saving a context")
+	}
+	preset := config.ContextPreset{
+		App:  clients.Config.AppFlag,
+		Team: clients.Config.TeamFlag,
+	}
+	if preset == (config.ContextPreset{}) && clients.Config.TokenFlag == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("A context requires at least one of the --team, --app, or --token flags").
+			WithRemediation("Save a context with %s", style.Commandf(fmt.Sprintf("config context set %s --team <id> --app <id>", name), false))
+	}
+	// Tokens are saved to the credentials of the team so the context references
+	// the team instead of writing the token to the config file
+	if clients.Config.TokenFlag != "" {
+		auth, _, err := authpkg.LoginWithServiceToken(ctx, clients, clients.Config.TokenFlag)
+		if err != nil {
+			return err
+		}
+		preset.Team = auth.TeamID
+	}
+	if err := clients.Config.SystemConfig.SetContextPreset(ctx, name, preset); err != nil {
+		return err
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "gear",
+		Text:  "Context Set",
+		Secondary: []string{
+			fmt.Sprintf("Successfully saved the \"%s\" context", name),
+			fmt.Sprintf("Use this context with %s", style.Highlight(fmt.Sprintf("--context %s", name))),
+		},
+	}))
+	return nil
+}
+
+func NewContextListCommand(clients *shared.ClientFactory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the saved contexts",
+		Long:  "List the saved contexts and highlight the context that is in use.",
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "List the saved contexts",
+				Command: "config context list",
+			},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runContextListCommand(clients, cmd)
+		},
+	}
+}
+
+// runContextListCommand prints each saved context with the saved values
+func runContextListCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	presets, err := clients.Config.SystemConfig.ListContextPresets(ctx)
+	if err != nil {
+		return err
+	}
+	if len(presets) == 0 {
+		clients.IO.PrintInfo(ctx, false, "No contexts are saved. Save a context with %s", style.Commandf("config context set <name>", false))
+		return nil
+	}
+	for _, name := range slices.Sorted(maps.Keys(presets)) {
+		preset := presets[name]
+		if name == clients.Config.ContextFlag {
+			clients.IO.PrintInfo(ctx, false, "%s %s", style.Bold(name), style.Secondary("(active)"))
+		} else {
+			clients.IO.PrintInfo(ctx, false, "%s", style.Bold(name))
+		}
+		if preset.Team != "" {
+			clients.IO.PrintInfo(ctx, false, "%s", style.Indent(style.Secondary(fmt.Sprintf("Team:  %s", preset.Team))))
+		}
+		if preset.App != "" {
+			clients.IO.PrintInfo(ctx, false, "%s", style.Indent(style.Secondary(fmt.Sprintf("App:   %s", preset.App))))
+		}
+	}
+	return nil
+}
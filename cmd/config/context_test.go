@@ -0,0 +1,126 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Config_ContextSetCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"saves the team and app flags as a context": {
+			CmdArgs:               []string{"work", "--team", "T0123456789", "--app", "A0123456789"},
+			ExpectedStdoutOutputs: []string{`Successfully saved the "work" context`, "--context work"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				preset, err := cm.Config.SystemConfig.GetContextPreset(ctx, "work")
+				require.NoError(t, err)
+				assert.Equal(t, config.ContextPreset{Team: "T0123456789", App: "A0123456789"}, preset)
+			},
+		},
+		"saves the token to the credentials of the team and the team as a context": {
+			CmdArgs: []string{"ci", "--token", "xoxp-example", "--app", "A0123456789"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teamID := "T0123456789"
+				userID := "U0123456789"
+				teamURL := "https://example.slack.com/"
+				cm.API.On("ValidateSession", mock.Anything, "xoxp-example").Return(api.AuthSession{
+					TeamID: &teamID,
+					UserID: &userID,
+					URL:    &teamURL,
+				}, nil)
+				cm.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+				cm.Auth.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{Token: "xoxp-example", TeamID: teamID}, "", nil)
+				cm.AddDefaultMocks()
+			},
+			ExpectedStdoutOutputs: []string{`Successfully saved the "ci" context`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertCalled(t, "SetAuth", mock.Anything, mock.MatchedBy(func(auth types.SlackAuth) bool {
+					return auth.Token == "xoxp-example" && auth.TeamID == "T0123456789"
+				}))
+				preset, err := cm.Config.SystemConfig.GetContextPreset(ctx, "ci")
+				require.NoError(t, err)
+				assert.Equal(t, config.ContextPreset{Team: "T0123456789", App: "A0123456789"}, preset)
+				configDir, err := cm.Config.SystemConfig.SlackConfigDir(ctx)
+				require.NoError(t, err)
+				configFile, err := afero.ReadFile(cm.Fs, filepath.Join(configDir, "config.json"))
+				require.NoError(t, err)
+				assert.NotContains(t, string(configFile), "xoxp-example")
+			},
+		},
+		"errors without saving a token that is not valid": {
+			CmdArgs: []string{"ci", "--token", "xoxp-expired"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("ValidateSession", mock.Anything, "xoxp-expired").Return(api.AuthSession{}, slackerror.New(slackerror.ErrTokenRevoked))
+				cm.AddDefaultMocks()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAuth},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Auth.AssertNotCalled(t, "SetAuth", mock.Anything, mock.Anything)
+				presets, err := cm.Config.SystemConfig.ListContextPresets(ctx)
+				require.NoError(t, err)
+				assert.Empty(t, presets)
+			},
+		},
+		"errors without the team, app, or token flags": {
+			CmdArgs:              []string{"work"},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag, "A context requires at least one of the --team, --app, or --token flags"},
+		},
+		"errors with the context flag": {
+			CmdArgs:              []string{"work", "--context", "dev", "--team", "T0123456789"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --context flag cannot be used when saving a context"},
+		},
+		"errors without a name": {
+			CmdArgs:              []string{},
+			ExpectedErrorStrings: []string{"accepts 1 arg(s), received 0"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewContextSetCommand(clients)
+	})
+}
+
+func Test_Config_ContextListCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"notes when no contexts are saved": {
+			CmdArgs:               []string{},
+			ExpectedStdoutOutputs: []string{"No contexts are saved"},
+		},
+		"lists saved contexts and marks the active context": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				require.NoError(t, cm.Config.SystemConfig.SetContextPreset(ctx, "work", config.ContextPreset{Team: "T0123456789"}))
+				require.NoError(t, cm.Config.SystemConfig.SetContextPreset(ctx, "dev", config.ContextPreset{App: "local"}))
+				cf.Config.ContextFlag = "work"
+			},
+			ExpectedStdoutOutputs: []string{"dev\n", "App:   local", "work (active)", "Team:  T0123456789"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewContextListCommand(clients)
+	})
+}
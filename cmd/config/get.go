@@ -0,0 +1,108 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type getCmdFlags struct {
+	output string
+	system bool
+}
+
+var getFlags getCmdFlags
+
+func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <key> [flags]",
+		Short: "Print the value of a configuration",
+		Long: strings.Join([]string{
+			"Print the value of a configuration key from the project or the system.",
+			"",
+			"Project configuration keys:",
+			formatConfigKeys(false),
+			"",
+			"System configuration keys, used with the --system flag:",
+			formatConfigKeys(true),
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Print the manifest source of the project",
+				Command: "config get manifest.source",
+			},
+			{
+				Meaning: "Print if an experiment is enabled for the system",
+				Command: "config get experiments.lipgloss --system",
+			},
+			{
+				Meaning: "Print a configuration value as JSON",
+				Command: "config get trust_unknown_sources --system --output json",
+			},
+		}),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGetCommand(clients, cmd, args)
+		},
+	}
+	cmd.Flags().StringVar(&getFlags.output, "output", "text", "output format: text, json")
+	cmd.Flags().BoolVar(&getFlags.system, "system", false, "use the system configuration")
+	return cmd
+}
+
+// runGetCommand prints the value saved for a configuration key
+func runGetCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	switch getFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", getFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	key, err := findConfigKey(args[0], getFlags.system)
+	if err != nil {
+		return err
+	}
+	value, err := key.get(ctx, clients, args[0])
+	if err != nil {
+		return err
+	}
+	if getFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(struct {
+			Key   string `json:"key"`
+			Scope string `json:"scope"`
+			Value any    `json:"value"`
+		}{
+			Key:   args[0],
+			Scope: configScope(getFlags.system),
+			Value: value,
+		})
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
+	clients.IO.PrintInfo(ctx, false, "%v", value)
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/slackmock"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Config_GetCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the default manifest source of a project": {
+			CmdArgs: []string{"manifest.source"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+			},
+			ExpectedStdoutOutputs: []string{"local"},
+		},
+		"prints the default git metadata preference of a project": {
+			CmdArgs: []string{"deploy.git_metadata"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+			},
+			ExpectedStdoutOutputs: []string{"false"},
+		},
+		"prints an experiment of the project": {
+			CmdArgs: []string{"experiments.lipgloss"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+				err := afero.WriteFile(cm.Fs, config.GetProjectConfigJSONFilePath(slackdeps.MockWorkingDirectory), []byte(`{"experiments":{"lipgloss":true}}`), 0644)
+				require.NoError(t, err)
+			},
+			ExpectedStdoutOutputs: []string{"true"},
+		},
+		"prints a system configuration as json": {
+			CmdArgs: []string{"auth_expiry_warning_days", "--system", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"key": "auth_expiry_warning_days"`,
+				`"scope": "system"`,
+				`"value": 7`,
+			},
+		},
+		"errors outside of a project for project keys": {
+			CmdArgs:              []string{"manifest.source"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAppDirectory},
+		},
+		"errors for an unknown key": {
+			CmdArgs:              []string{"manifest.sauce"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigKeyUnknown, "manifest.source (local, remote)"},
+		},
+		"errors for an unknown output format": {
+			CmdArgs:              []string{"manifest.source", "--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+		"errors without a key": {
+			CmdArgs:              []string{},
+			ExpectedErrorStrings: []string{"accepts 1 arg(s), received 0"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewGetCommand(clients)
+	})
+}
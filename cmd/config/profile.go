@@ -0,0 +1,94 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+func NewProfileCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile <subcommand>",
+		Short: "Manage profiles of authorizations and configurations",
+		Long: strings.Join([]string{
+			"Manage profiles of authorizations and configurations.",
+			"",
+			"Each profile keeps separate authorizations and system configurations. Select a",
+			`profile with the --profile flag or the SLACK_CLI_PROFILE environment variable.`,
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "List the saved profiles",
+				Command: "config profile list",
+			},
+			{
+				Meaning: "Login to a workspace with a separate profile",
+				Command: "login --profile work",
+			},
+		}),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// Add child commands
+	cmd.AddCommand(NewProfileListCommand(clients))
+
+	return cmd
+}
+
+func NewProfileListCommand(clients *shared.ClientFactory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the saved profiles",
+		Long:  "List the saved profiles and highlight the profile that is in use.",
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "List the saved profiles",
+				Command: "config profile list",
+			},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfileListCommand(clients, cmd)
+		},
+	}
+}
+
+// runProfileListCommand prints the default profile then each saved profile
+func runProfileListCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	profiles, err := clients.Config.SystemConfig.ListProfiles(ctx)
+	if err != nil {
+		return err
+	}
+	active := clients.Config.SystemConfig.GetProfile()
+	if active == "" {
+		active = config.DefaultProfileName
+	}
+	for _, profile := range append([]string{config.DefaultProfileName}, profiles...) {
+		if profile == active {
+			clients.IO.PrintInfo(ctx, false, "%s %s", style.Bold(profile), style.Secondary("(active)"))
+		} else {
+			clients.IO.PrintInfo(ctx, false, "%s", profile)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Config_ProfileListCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists the default profile without saved profiles": {
+			CmdArgs:               []string{},
+			ExpectedStdoutOutputs: []string{"default (active)"},
+		},
+		"lists saved profiles and marks the active profile": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				for _, profile := range []string{"work", "personal"} {
+					err := cm.Fs.MkdirAll(filepath.Join(slackdeps.MockHomeDirectory, ".slack", "profiles", profile), 0755)
+					require.NoError(t, err)
+				}
+				cm.Config.SystemConfig.SetProfile("work")
+			},
+			ExpectedStdoutOutputs: []string{"default\npersonal\nwork (active)"},
+		},
+		"errors with arguments": {
+			CmdArgs:              []string{"work"},
+			ExpectedErrorStrings: []string{`unknown command "work" for "list"`},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewProfileListCommand(clients)
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type setCmdFlags struct {
+	system bool
+}
+
+var setFlags setCmdFlags
+
+func NewSetCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <key> <value> [flags]",
+		Short: "Save the value of a configuration",
+		Long: strings.Join([]string{
+			"Save the value of a configuration key to the project or the system.",
+			"",
+			"Values are checked before saving so that configuration files remain valid.",
+			"",
+			"Project configuration keys:",
+			formatConfigKeys(false),
+			"",
+			"System configuration keys, used with the --system flag:",
+			formatConfigKeys(true),
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Use the app settings as the manifest source",
+				Command: "config set manifest.source remote",
+			},
+			{
+				Meaning: "Enable an experiment for the project",
+				Command: "config set experiments.lipgloss true",
+			},
+			{
+				Meaning: "Trust unknown sources on this system",
+				Command: "config set trust_unknown_sources true --system",
+			},
+		}),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSetCommand(clients, cmd, args)
+		},
+	}
+	cmd.Flags().BoolVar(&setFlags.system, "system", false, "use the system configuration")
+	return cmd
+}
+
+// runSetCommand validates and saves the value of a configuration key
+func runSetCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	key, err := findConfigKey(args[0], setFlags.system)
+	if err != nil {
+		return err
+	}
+	if err := key.set(ctx, clients, args[0], args[1]); err != nil {
+		return err
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "gear",
+		Text:  "Config Set",
+		Secondary: []string{
+			fmt.Sprintf("Successfully set \"%s\" to \"%s\" in the %s configuration", args[0], args[1], configScope(setFlags.system)),
+		},
+	}))
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/slackmock"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Config_SetCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"saves the manifest source of a project": {
+			CmdArgs: []string{"manifest.source", "remote"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+			},
+			ExpectedStdoutOutputs: []string{`Successfully set "manifest.source" to "remote" in the project configuration`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				source, err := cm.Config.ProjectConfig.GetManifestSource(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, config.ManifestSourceRemote, source)
+			},
+		},
+		"saves an experiment of the project": {
+			CmdArgs: []string{"experiments.placeholder", "true"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				projectConfig, err := config.ReadProjectConfigFile(ctx, cm.Fs, cm.Os)
+				require.NoError(t, err)
+				assert.Equal(t, map[string]bool{"placeholder": true}, projectConfig.Experiments)
+			},
+		},
+		"saves git metadata of deploys for the project": {
+			CmdArgs: []string{"deploy.git_metadata", "true"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+			},
+			ExpectedStdoutOutputs: []string{`Successfully set "deploy.git_metadata" to "true" in the project configuration`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				enabled, err := cm.Config.ProjectConfig.GetDeployGitMetadata(ctx)
+				require.NoError(t, err)
+				assert.True(t, enabled)
+			},
+		},
+		"saves trust of unknown sources to the system": {
+			CmdArgs:               []string{"trust_unknown_sources", "true", "--system"},
+			ExpectedStdoutOutputs: []string{`Successfully set "trust_unknown_sources" to "true" in the system configuration`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				trust, err := cm.Config.SystemConfig.GetTrustUnknownSources(ctx)
+				require.NoError(t, err)
+				assert.True(t, trust)
+			},
+		},
+		"saves the auth expiry warning days to the system": {
+			CmdArgs: []string{"auth_expiry_warning_days", "0", "--system"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				days, err := cm.Config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, 0, days)
+			},
+		},
+		"saves the credential store to the system": {
+			CmdArgs: []string{"credential_store", "keychain", "--system"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				store, err := cm.Config.SystemConfig.GetCredentialStore(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, config.CredentialStoreKeychain, store)
+			},
+		},
+		"saves the version check interval to the system": {
+			CmdArgs: []string{"version_check_interval", "12h", "--system"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				interval, err := cm.Config.SystemConfig.GetVersionCheckInterval(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, 12*time.Hour, interval)
+			},
+		},
+		"saves a disabled version check interval to the system": {
+			CmdArgs: []string{"version_check_interval", "off", "--system"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				interval, err := cm.Config.SystemConfig.GetVersionCheckInterval(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, time.Duration(0), interval)
+			},
+		},
+		"errors for a version check interval that is not a duration": {
+			CmdArgs:              []string{"version_check_interval", "daily", "--system"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigValueInvalid, "Use one of the values: a duration like 12h, 0, off"},
+		},
+		"errors for an unknown credential store": {
+			CmdArgs:              []string{"credential_store", "vault", "--system"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigValueInvalid, "Use one of the values: auto, file, keychain"},
+		},
+		"errors for an invalid manifest source": {
+			CmdArgs: []string{"manifest.source", "upstream"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				slackmock.CreateProject(t, ctx, cm.Fs, cm.Os, slackdeps.MockWorkingDirectory)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigValueInvalid, "Use one of the values: local, remote"},
+		},
+		"errors for a negative number of days": {
+			CmdArgs:              []string{"auth_expiry_warning_days", "--system", "--", "-1"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigValueInvalid, "Use one of the values: 0 or more"},
+		},
+		"errors for a non-boolean value": {
+			CmdArgs:              []string{"trust_unknown_sources", "yes", "--system"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigValueInvalid, "Use one of the values: true, false"},
+		},
+		"errors for a system key without the system flag": {
+			CmdArgs:              []string{"trust_unknown_sources", "true"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigKeyUnknown, "Add the --system flag"},
+		},
+		"errors for an unknown experiment": {
+			CmdArgs:              []string{"experiments.unicorn", "true", "--system"},
+			ExpectedErrorStrings: []string{slackerror.ErrConfigKeyUnknown, `The "unicorn" experiment is not known`},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewSetCommand(clients)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
@@ -51,6 +52,10 @@ func NewCountCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Count number of items in datastore that match a query",
 				Command: `datastore count '{"datastore": "tasks", "expression": "#status = :status", "expression_attributes": {"#status": "status"}, "expression_values": {":status": "In Progress"}}'`,
 			},
+			{
+				Meaning: "Count items that match an expression read from a file as JSON",
+				Command: `datastore count --datastore tasks --expression-file query.json --output json`,
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -62,6 +67,9 @@ func NewCountCommand(clients *shared.ClientFactory) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
+	cmd.Flags().StringVar(&expressionFileFlag, "expression-file", "", expressionFileUsage)
+	_ = cmd.MarkFlagFilename("expression-file")
+	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
@@ -95,12 +103,22 @@ func runCountCommandFunc(
 ) error {
 	var count types.AppDatastoreCount
 
-	if len(args) > 0 {
-		err := setQueryExpression(clients, &count, args[0], "count")
+	if outputFlag != "text" && outputFlag != "json" {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The output format \"%s\" is not supported", outputFlag).
+			WithRemediation("Choose an output format of text or json")
+	}
+
+	expression, hasExpression, err := getQueryExpression(clients, args)
+	if err != nil {
+		return err
+	}
+	if hasExpression {
+		err := setQueryExpression(clients, &count, expression, "count")
 		if err != nil {
 			return err
 		}
-	} else if len(args) == 0 && !unstableFlag {
+	} else if !unstableFlag {
 		err := setQueryExpression(clients, &count, "{}", "count")
 		if err != nil {
 			return err
@@ -119,7 +137,7 @@ func runCountCommandFunc(
 	}
 
 	// Build the count if it wasn't passed by argument
-	if len(args) == 0 && unstableFlag {
+	if !hasExpression && unstableFlag {
 		count, err = promptDatastoreCountRequest(ctx, clients, selection.App, selection.Auth)
 		if err != nil {
 			return err
@@ -147,7 +165,22 @@ func printCountResult(ctx context.Context, clients *shared.ClientFactory, countR
 	clients.IO.PrintTrace(ctx, slacktrace.DatastoreCountSuccess)
 	clients.IO.PrintTrace(ctx, slacktrace.DatastoreCountTotal, fmt.Sprintf("%d", countResult.Count))
 	clients.IO.PrintTrace(ctx, slacktrace.DatastoreCountDatastore, countResult.Datastore)
-	clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+	if outputFlag == "json" {
+		output := struct {
+			Datastore string `json:"datastore"`
+			Count     int    `json:"count"`
+		}{
+			Datastore: countResult.Datastore,
+			Count:     countResult.Count,
+		}
+		b, err := goutils.JSONMarshalUnescapedIndent(output)
+		if err != nil {
+			return slackerror.New("Error during output indentation").WithRootCause(err)
+		}
+		_, _ = fmt.Fprintln(clients.IO.WriteOut(), string(b))
+		return nil
+	}
+	_, _ = fmt.Fprintln(clients.IO.WriteOut(), style.Sectionf(style.TextSection{
 		Emoji: "tada",
 		Text: fmt.Sprintf(
 			"Counted %d matching items from datastore: %s",
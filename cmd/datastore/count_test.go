@@ -27,9 +27,11 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCountCommandPreRun(t *testing.T) {
@@ -340,11 +342,65 @@ func TestCountCommand(t *testing.T) {
 				unstableFlag = false
 			},
 		},
+		"output the count as json": {
+			CmdArgs: []string{"--datastore", "tasks", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreCountResult{Datastore: "tasks", Count: 0}, nil)
+			},
+			ExpectedStdoutOutputs: []string{`"datastore": "tasks"`, `"count": 0`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "Counted")
+			},
+		},
+		"output the count as json at the error log level": {
+			CmdArgs: []string{"--datastore", "tasks", "--output", "json", "--log-level", "error"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.API.On("AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreCountResult{Datastore: "tasks", Count: 3}, nil)
+			},
+			ExpectedStdoutOutputs: []string{`"datastore": "tasks"`, `"count": 3`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, config.LogLevelError, cm.Config.LogLevel)
+			},
+		},
+		"count items matching an expression read from a file": {
+			CmdArgs: []string{"--expression-file", "query.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				err := afero.WriteFile(cm.Fs, "query.json", []byte(`{"datastore":"tasks","expression":"#status = :status","expression_attributes":{"#status":"status"},"expression_values":{":status":"done"}}`), 0600)
+				require.NoError(t, err)
+				cm.API.On("AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreCountResult{Datastore: "tasks", Count: 4}, nil)
+			},
+			ExpectedStdoutOutputs: []string{"Counted 4 matching items from datastore: tasks"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(
+					t,
+					"AppsDatastoreCount",
+					mock.Anything,
+					mock.Anything,
+					types.AppDatastoreCount{
+						Datastore:            "tasks",
+						App:                  "A001",
+						Expression:           "#status = :status",
+						ExpressionAttributes: map[string]interface{}{"#status": "status"},
+						ExpressionValues:     map[string]interface{}{":status": "done"},
+					},
+				)
+			},
+		},
+		"errors on an unsupported output format": {
+			CmdArgs:              []string{"--datastore", "tasks", "--output", "csv"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, `The output format "csv" is not supported`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 	}, func(clients *shared.ClientFactory) *cobra.Command {
 		cmd := NewCountCommand(clients)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
 			clients.Config.SetFlags(cmd)
-			return nil
+			return clients.Config.SetLogLevel()
 		}
 		appSelectMock := prompts.NewAppSelectMock()
 		appSelectPromptFunc = appSelectMock.AppSelectPrompt
@@ -16,7 +16,9 @@ package datastore
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/slackapi/slack-cli/internal/goutils"
@@ -25,6 +27,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -34,6 +37,15 @@ var appSelectPromptFunc = prompts.AppSelectPrompt
 var datastoreFlag string
 var datastoreUsage = "the datastore used to store items"
 
+var expressionFileFlag string
+var expressionFileUsage = "read the JSON expression from a file or \"-\" for stdin"
+
+var failOnMissingFlag bool
+var failOnMissingUsage = "error if any key of the --keys-file is not found"
+
+var keysFileFlag string
+var keysFileUsage = "get the items with keys from a file of one key per line\n  and print each item found as a line of JSON"
+
 var outputFlag string
 var outputUsage = "output format: text, json"
 
@@ -92,6 +104,10 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Count number of items in datastore",
 				Command: `datastore count --datastore tasks`,
 			},
+			{
+				Meaning: "Print the attributes and primary key of a datastore",
+				Command: `datastore schema --datastore tasks`,
+			},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
@@ -106,6 +122,7 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.AddCommand(NewBulkDeleteCommand(clients))
 	cmd.AddCommand(NewQueryCommand(clients))
 	cmd.AddCommand(NewCountCommand(clients))
+	cmd.AddCommand(NewSchemaCommand(clients))
 
 	return cmd
 }
@@ -136,6 +153,39 @@ func setQueryExpression(clients *shared.ClientFactory, query types.Datastorer, e
 	return nil
 }
 
+// getQueryExpression returns the expression provided as an argument or read from
+// the --expression-file flag and reports if an expression was found
+func getQueryExpression(clients *shared.ClientFactory, args []string) (string, bool, error) {
+	if expressionFileFlag == "" {
+		if len(args) > 0 {
+			return args[0], true, nil
+		}
+		return "", false, nil
+	}
+	if len(args) > 0 {
+		return "", false, slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("An expression cannot be provided as an argument and with the --expression-file flag")
+	}
+	var expression []byte
+	var err error
+	if expressionFileFlag == "-" {
+		expression, err = io.ReadAll(clients.IO.ReadIn())
+	} else {
+		expression, err = afero.ReadFile(clients.Fs, expressionFileFlag)
+	}
+	if err != nil {
+		return "", false, slackerror.New(slackerror.ErrInvalidDatastoreExpression).
+			WithMessage("Failed to read the expression file %s", expressionFileFlag).
+			WithRootCause(err)
+	}
+	if !json.Valid(expression) {
+		return "", false, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse the expression in %s", expressionFileFlag).
+			WithRemediation("Check that %s contains a valid JSON expression", expressionFileFlag)
+	}
+	return string(expression), true, nil
+}
+
 // datastoreExpressionRemediation returns a command-specific message to display
 // on invalid expressions
 func datastoreExpressionRemediation(command string, isEmpty bool) string {
@@ -15,6 +15,7 @@
 package datastore
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/slackapi/slack-cli/internal/app"
@@ -23,6 +24,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -156,3 +158,75 @@ func TestSetQueryExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestGetQueryExpression(t *testing.T) {
+	tests := map[string]struct {
+		args               []string
+		expressionFile     string
+		fileContents       string
+		stdin              string
+		expectedExpression string
+		expectedFound      bool
+		expectedError      error
+	}{
+		"returns the expression from arguments": {
+			args:               []string{`{"id":"0002"}`},
+			expectedExpression: `{"id":"0002"}`,
+			expectedFound:      true,
+		},
+		"returns nothing without arguments or a file": {
+			expectedFound: false,
+		},
+		"reads the expression from a file": {
+			expressionFile:     "query.json",
+			fileContents:       `{"datastore":"Todos","id":"0002"}`,
+			expectedExpression: `{"datastore":"Todos","id":"0002"}`,
+			expectedFound:      true,
+		},
+		"reads the expression from stdin": {
+			expressionFile:     "-",
+			stdin:              `{"datastore":"Todos","id":"0003"}`,
+			expectedExpression: `{"datastore":"Todos","id":"0003"}`,
+			expectedFound:      true,
+		},
+		"errors if the file contains malformed json": {
+			expressionFile: "query.json",
+			fileContents:   `{"datastore":"Todos"`,
+			expectedError:  slackerror.New(slackerror.ErrUnableToParseJSON),
+		},
+		"errors if the file does not exist": {
+			expressionFile: "missing.json",
+			expectedError:  slackerror.New(slackerror.ErrInvalidDatastoreExpression),
+		},
+		"errors if both an argument and a file are provided": {
+			args:           []string{`{"id":"0002"}`},
+			expressionFile: "query.json",
+			fileContents:   `{"id":"0002"}`,
+			expectedError:  slackerror.New(slackerror.ErrMismatchedFlags),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clientsMock := setupDatastoreMocks()
+			if tc.fileContents != "" {
+				err := afero.WriteFile(clientsMock.Fs, tc.expressionFile, []byte(tc.fileContents), 0600)
+				require.NoError(t, err)
+			}
+			clientsMock.IO.Stdin = strings.NewReader(tc.stdin)
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			expressionFileFlag = tc.expressionFile
+			defer func() {
+				expressionFileFlag = ""
+			}()
+			expression, found, err := getQueryExpression(clients, tc.args)
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError.(*slackerror.Error).Code, err.(*slackerror.Error).Code)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedExpression, expression)
+				assert.Equal(t, tc.expectedFound, found)
+			}
+		})
+	}
+}
@@ -34,6 +34,14 @@ import (
 
 var Delete = datastore.Delete
 
+const (
+	maxDeleteWhereBulkSize = 25
+)
+
+var deleteWhereFlag string
+var deleteYesFlag bool
+var deleteDryRunFlag bool
+
 func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <expression> [flags]",
@@ -41,6 +49,9 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 		Long: strings.Join([]string{
 			"Delete an item from a datastore.",
 			"",
+			"Items that match a query expression can be removed in batches with the --where",
+			"flag. Either the --yes or --dry-run flag is required with the --where flag.",
+			"",
 			"This command is supported for apps deployed to Slack managed infrastructure but",
 			"other apps can attempt to run the command with the --force flag.",
 		}, "\n"),
@@ -53,6 +64,14 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Remove an item from the datastore with an expression",
 				Command: `datastore delete '{"datastore": "tasks", "id": "42"}'`,
 			},
+			{
+				Meaning: "Preview the items that match a query expression",
+				Command: `datastore delete --datastore tasks --dry-run --where '{"expression": "#status = :status", "expression_attributes": {"#status": "status"}, "expression_values": {":status": "Done"}}'`,
+			},
+			{
+				Meaning: "Remove all items that match a query expression",
+				Command: `datastore delete --datastore tasks --yes --where '{"expression": "#status = :status", "expression_attributes": {"#status": "status"}, "expression_values": {":status": "Done"}}'`,
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -62,6 +81,9 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 			var ctx = cmd.Context()
 			var query types.AppDatastoreDelete
 
+			if cmd.Flags().Changed("where") {
+				return runDeleteWhereCommand(ctx, clients, cmd, args)
+			}
 			if len(args) > 0 {
 				err := setQueryExpression(clients, &query, args[0], "delete")
 				if err != nil {
@@ -109,10 +131,118 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().StringVar(&deleteWhereFlag, "where", "", "delete all items that match a query expression")
+	cmd.Flags().BoolVar(&deleteYesFlag, "yes", false, "confirm deleting the items that match the --where flag")
+	cmd.Flags().BoolVar(&deleteDryRunFlag, "dry-run", false, "list the items that match the --where flag without deleting")
 
 	return cmd
 }
 
+// runDeleteWhereCommand queries for the items that match the --where expression
+// then deletes these items in batches
+func runDeleteWhereCommand(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("An expression argument cannot be used with the --where flag")
+	}
+	if deleteYesFlag && deleteDryRunFlag {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --yes and --dry-run flags cannot be used together")
+	}
+	if !deleteYesFlag && !deleteDryRunFlag {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The --where flag requires either the --yes or --dry-run flag").
+			WithRemediation("Preview the matching items with %s before deleting", style.Highlight("--dry-run"))
+	}
+
+	var query types.AppDatastoreQuery
+	err := setQueryExpression(clients, &query, deleteWhereFlag, "query")
+	if err != nil {
+		return err
+	}
+	if query.Datastore == "" {
+		return slackerror.New(slackerror.ErrInvalidDatastoreExpression).
+			WithMessage("No datastore was provided").
+			WithRemediation("Select a datastore with the %s flag", style.Highlight("--datastore"))
+	}
+
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
+	if err != nil {
+		return err
+	}
+	ctx = config.SetContextToken(ctx, selection.Auth.Token)
+	query.App = selection.App.AppID
+
+	primaryKey, err := getPrimaryKey(ctx, clients, selection.App, selection.Auth, query.Datastore)
+	if err != nil {
+		return err
+	}
+
+	// Collect all matching IDs before deleting so pagination is not affected
+	ids := []string{}
+	query.Limit = maxExportQueryLimit
+	for {
+		result, err := Query(ctx, clients, query)
+		if err != nil {
+			return err
+		}
+		for _, item := range result.Items {
+			if id, ok := item[primaryKey]; ok {
+				ids = append(ids, fmt.Sprint(id))
+			}
+		}
+		if result.NextCursor == "" {
+			break
+		}
+		query.Cursor = result.NextCursor
+	}
+
+	if deleteDryRunFlag {
+		cmd.Printf(
+			style.Bold("%s Found %d items to delete from datastore: %s\n\n"),
+			style.Emoji("mag"),
+			len(ids),
+			query.Datastore,
+		)
+		for _, id := range ids {
+			cmd.Printf("%s: %s\n", primaryKey, id)
+		}
+		return nil
+	}
+
+	deleted := 0
+	failedIDs := []string{}
+	for start := 0; start < len(ids); start += maxDeleteWhereBulkSize {
+		end := min(start+maxDeleteWhereBulkSize, len(ids))
+		result, err := BulkDelete(ctx, clients, types.AppDatastoreBulkDelete{
+			Datastore: query.Datastore,
+			App:       query.App,
+			IDs:       ids[start:end],
+		})
+		if err != nil {
+			return slackerror.New(slackerror.ErrFailedDatastoreOperation).
+				WithMessage("Failed to delete items after deleting %d of %d items", deleted, len(ids)).
+				WithRootCause(err)
+		}
+		deleted += end - start - len(result.FailedItems)
+		failedIDs = append(failedIDs, result.FailedItems...)
+	}
+
+	cmd.Printf(
+		style.Bold("%s Deleted %d items from datastore: %s\n\n"),
+		style.Emoji("tada"),
+		deleted,
+		query.Datastore,
+	)
+	if len(failedIDs) > 0 {
+		return slackerror.New(slackerror.ErrFailedDatastoreOperation).
+			WithMessage("Failed to delete %d items with the %s: %s", len(failedIDs), primaryKey, strings.Join(failedIDs, ", ")).
+			WithRemediation("Retry the command to delete the remaining items")
+	}
+	printDatastoreDeleteSuccess(cmd)
+	return nil
+}
+
 // preRunDeleteCommandFunc determines if the command is supported for a project
 // and configures flags
 func preRunDeleteCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
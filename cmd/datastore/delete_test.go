@@ -23,10 +23,13 @@ import (
 	"github.com/slackapi/slack-cli/internal/app"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/pkg/datastore"
+	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -236,3 +239,102 @@ func TestDeleteCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteCommand_Where(t *testing.T) {
+	whereExpression := `{"expression":"#status = :status","expression_attributes":{"#status":"status"},"expression_values":{":status":"Done"}}`
+	mockWhereDelete := func(cm *shared.ClientsMock, failed []string) {
+		manifestMock := &app.ManifestMockObject{}
+		manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+			AppManifest: types.AppManifest{
+				Datastores: map[string]types.ManifestDatastore{"tasks": {PrimaryKey: "id"}},
+			},
+		}, nil)
+		cm.AppClient.Manifest = manifestMock
+		cm.API.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.MatchedBy(func(query types.AppDatastoreQuery) bool {
+			return query.Cursor == ""
+		})).Return(types.AppDatastoreQueryResult{
+			Datastore:  "tasks",
+			Items:      []map[string]interface{}{{"id": "1"}, {"id": "2"}},
+			NextCursor: "next",
+		}, nil)
+		cm.API.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.MatchedBy(func(query types.AppDatastoreQuery) bool {
+			return query.Cursor == "next"
+		})).Return(types.AppDatastoreQueryResult{
+			Datastore: "tasks",
+			Items:     []map[string]interface{}{{"id": 3}},
+		}, nil)
+		cm.API.On("AppsDatastoreBulkDelete", mock.Anything, mock.Anything, mock.Anything).
+			Return(types.AppDatastoreBulkDeleteResult{Datastore: "tasks", FailedItems: failed}, nil)
+	}
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"deletes all matching items with the yes flag": {
+			CmdArgs: []string{"--datastore", "tasks", "--where", whereExpression, "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockWhereDelete(cm, nil)
+			},
+			ExpectedStdoutOutputs: []string{"Deleted 3 items from datastore: tasks"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "AppsDatastoreBulkDelete", mock.Anything, mock.Anything, types.AppDatastoreBulkDelete{
+					Datastore: "tasks",
+					App:       "A001",
+					IDs:       []string{"1", "2", "3"},
+				})
+			},
+		},
+		"lists matching items without deleting with the dry run flag": {
+			CmdArgs: []string{"--datastore", "tasks", "--where", whereExpression, "--dry-run"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockWhereDelete(cm, nil)
+			},
+			ExpectedStdoutOutputs: []string{"Found 3 items to delete from datastore: tasks", "id: 1", "id: 2", "id: 3"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "AppsDatastoreBulkDelete", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"reports the items that failed to delete": {
+			CmdArgs: []string{"--datastore", "tasks", "--where", whereExpression, "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockWhereDelete(cm, []string{"2"})
+			},
+			ExpectedStdoutOutputs: []string{"Deleted 2 items from datastore: tasks"},
+			ExpectedErrorStrings:  []string{slackerror.ErrFailedDatastoreOperation, "Failed to delete 1 items with the id: 2"},
+		},
+		"errors without the yes or dry run flag": {
+			CmdArgs:              []string{"--datastore", "tasks", "--where", whereExpression},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors with both the yes and dry run flags": {
+			CmdArgs:              []string{"--datastore", "tasks", "--where", whereExpression, "--yes", "--dry-run"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+		},
+		"errors with an expression argument": {
+			CmdArgs:              []string{"--datastore", "tasks", "--where", whereExpression, "--yes", `{"id":"42"}`},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+		},
+		"errors with an invalid expression": {
+			CmdArgs:              []string{"--datastore", "tasks", "--where", "{status", "--yes"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidDatastoreExpression},
+		},
+		"errors without a datastore": {
+			CmdArgs:              []string{"--where", whereExpression, "--yes"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidDatastoreExpression, "No datastore was provided"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		Query = datastore.Query
+		BulkDelete = datastore.BulkDelete
+		cmd := NewDeleteCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return nil
+		}
+		appSelectMock := prompts.NewAppSelectMock()
+		appSelectPromptFunc = appSelectMock.AppSelectPrompt
+		appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).
+			Return(prompts.SelectedApp{App: types.App{AppID: "A001"}}, nil)
+		return cmd
+	})
+}
@@ -15,9 +15,13 @@
 package datastore
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"slices"
 	"sort"
 	"strings"
 
@@ -30,6 +34,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -54,6 +59,14 @@ func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Get an item from the datastore with an expression",
 				Command: `datastore get '{"datastore": "tasks", "id": "42"}'`,
 			},
+			{
+				Meaning: "Get an item from the datastore with an expression in a file",
+				Command: `datastore get --datastore tasks --expression-file get.json`,
+			},
+			{
+				Meaning: "Get the items with keys listed in a file as lines of JSON",
+				Command: `datastore get --datastore tasks --keys-file keys.txt`,
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -63,13 +76,25 @@ func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
 			var ctx = cmd.Context()
 			var query types.AppDatastoreGet
 
+			if keysFileFlag != "" {
+				return runGetKeysFile(ctx, clients, args)
+			}
+			if failOnMissingFlag {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --fail-on-missing flag can only be used with the --keys-file flag")
+			}
+
 			// TODO: almost all the code below here except for the actual API call is identical across all datastore commands. can we DRY this up / is it worth it?
-			if len(args) > 0 {
-				err := setQueryExpression(clients, &query, args[0], "get")
+			expression, hasExpression, err := getQueryExpression(clients, args)
+			if err != nil {
+				return err
+			}
+			if hasExpression {
+				err = setQueryExpression(clients, &query, expression, "get")
 				if err != nil {
 					return err
 				}
-			} else if len(args) == 0 && !unstableFlag {
+			} else if !unstableFlag {
 				return slackerror.New(slackerror.ErrInvalidDatastoreExpression).
 					WithMessage("No expression was provided").
 					WithRemediation("%s", datastoreExpressionRemediation("get", true))
@@ -83,7 +108,7 @@ func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
 			ctx = config.SetContextToken(ctx, selection.Auth.Token)
 
 			// Build the query if it wasn't passed by argument
-			if len(args) == 0 && unstableFlag {
+			if !hasExpression && unstableFlag {
 				query, err = promptDatastoreGetRequest(ctx, clients, selection.App, selection.Auth)
 				if err != nil {
 					return err
@@ -111,11 +136,129 @@ func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
+	cmd.Flags().StringVar(&expressionFileFlag, "expression-file", "", expressionFileUsage)
+	_ = cmd.MarkFlagFilename("expression-file")
+	cmd.Flags().StringVar(&keysFileFlag, "keys-file", "", keysFileUsage)
+	_ = cmd.MarkFlagFilename("keys-file")
+	cmd.Flags().BoolVar(&failOnMissingFlag, "fail-on-missing", false, failOnMissingUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
 
 	return cmd
 }
 
+// runGetKeysFile gets the items with keys read from the --keys-file flag in
+// batches and prints each item found as a line of JSON
+//
+// Keys that are not found are reported without erroring unless the
+// --fail-on-missing flag is set.
+func runGetKeysFile(ctx context.Context, clients *shared.ClientFactory, args []string) error {
+	if len(args) > 0 || expressionFileFlag != "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --keys-file flag cannot be used with an expression")
+	}
+	if datastoreFlag == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The --datastore flag is required with the --keys-file flag")
+	}
+	keys, err := readDatastoreKeys(clients)
+	if err != nil {
+		return err
+	}
+
+	// Get the app auth selection from the flag or prompt
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
+	if err != nil {
+		return err
+	}
+	ctx = config.SetContextToken(ctx, selection.Auth.Token)
+	primaryKey, err := getPrimaryKey(ctx, clients, selection.App, selection.Auth, datastoreFlag)
+	if err != nil {
+		return err
+	}
+
+	// Batches match the size of bulk imports
+	missingKeys := []string{}
+	failedKeys := []string{}
+	for batch := range slices.Chunk(keys, maxImportBulkSize) {
+		result, err := BulkGet(ctx, clients, types.AppDatastoreBulkGet{
+			Datastore: datastoreFlag,
+			App:       selection.App.AppID,
+			IDs:       batch,
+		})
+		if err != nil {
+			return err
+		}
+		found := map[string]bool{}
+		for _, item := range result.Items {
+			line, err := json.Marshal(item)
+			if err != nil {
+				return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+			}
+			_, _ = fmt.Fprintf(clients.IO.WriteOut(), "%s\n", line)
+			found[fmt.Sprint(item[primaryKey])] = true
+		}
+		for _, key := range result.FailedItems {
+			found[key] = true
+			failedKeys = append(failedKeys, key)
+		}
+		for _, key := range batch {
+			if !found[key] {
+				missingKeys = append(missingKeys, key)
+			}
+		}
+	}
+
+	if len(failedKeys) > 0 {
+		clients.IO.PrintWarning(ctx, "Some keys failed to be retrieved and should be retried: %s", strings.Join(failedKeys, ", "))
+	}
+	if len(missingKeys) > 0 {
+		if failOnMissingFlag {
+			return slackerror.New(slackerror.ErrDatastore).
+				WithMessage("%d of %d keys were not found in the %s datastore: %s", len(missingKeys), len(keys), datastoreFlag, strings.Join(missingKeys, ", "))
+		}
+		clients.IO.PrintWarning(ctx, "%d of %d keys were not found in the %s datastore: %s", len(missingKeys), len(keys), datastoreFlag, strings.Join(missingKeys, ", "))
+	}
+	return nil
+}
+
+// readDatastoreKeys returns the unique keys of the --keys-file flag with one key
+// on each line or from stdin with "-"
+func readDatastoreKeys(clients *shared.ClientFactory) ([]string, error) {
+	var content []byte
+	var err error
+	if keysFileFlag == "-" {
+		content, err = io.ReadAll(clients.IO.ReadIn())
+	} else {
+		content, err = afero.ReadFile(clients.Fs, keysFileFlag)
+	}
+	if err != nil {
+		return nil, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Failed to read the keys file %s", keysFileFlag).
+			WithRootCause(err)
+	}
+	keys := []string{}
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		key := strings.TrimSpace(scanner.Text())
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Failed to read the keys file %s", keysFileFlag).
+			WithRootCause(err)
+	}
+	if len(keys) == 0 {
+		return nil, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("No keys were found in the keys file %s", keysFileFlag)
+	}
+	return keys, nil
+}
+
 // preRunGetCommandFunc determines if the command is supported for a project and
 // configures flags
 func preRunGetCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
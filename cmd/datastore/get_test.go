@@ -18,18 +18,23 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/slackapi/slack-cli/internal/app"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/pkg/datastore"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type GetPkgMock struct {
@@ -235,3 +240,106 @@ func TestGetCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCommand_KeysFile(t *testing.T) {
+	keys := []string{}
+	for i := 1; i <= 30; i++ {
+		keys = append(keys, fmt.Sprintf("%04d", i))
+	}
+	tests := map[string]struct {
+		args              []string
+		keysFile          string
+		missingKeys       []string
+		expectedBatches   [][]string
+		expectedErrorCode string
+		expectedOutputs   []string
+	}{
+		"prints found items in batches and reports missing keys": {
+			args:            []string{"--datastore", "Todos", "--keys-file", "keys.txt"},
+			keysFile:        strings.Join(keys, "\n") + "\n\n0001\n",
+			missingKeys:     []string{"0007"},
+			expectedBatches: [][]string{keys[:25], keys[25:]},
+			expectedOutputs: []string{
+				`{"task_id":"0001"}` + "\n",
+				`{"task_id":"0030"}` + "\n",
+				"1 of 30 keys were not found in the Todos datastore: 0007",
+			},
+		},
+		"errors on missing keys with the --fail-on-missing flag": {
+			args:              []string{"--datastore", "Todos", "--keys-file", "keys.txt", "--fail-on-missing"},
+			keysFile:          "0001\n0002\n",
+			missingKeys:       []string{"0002"},
+			expectedBatches:   [][]string{{"0001", "0002"}},
+			expectedErrorCode: slackerror.ErrDatastore,
+			expectedOutputs:   []string{`{"task_id":"0001"}` + "\n"},
+		},
+		"errors without the --datastore flag": {
+			args:              []string{"--keys-file", "keys.txt"},
+			keysFile:          "0001\n",
+			expectedErrorCode: slackerror.ErrMissingFlag,
+		},
+		"errors with an expression": {
+			args:              []string{`{"datastore":"Todos","id":"0001"}`, "--keys-file", "keys.txt"},
+			keysFile:          "0001\n",
+			expectedErrorCode: slackerror.ErrMismatchedFlags,
+		},
+		"errors for a keys file without keys": {
+			args:              []string{"--datastore", "Todos", "--keys-file", "keys.txt"},
+			keysFile:          "\n\n",
+			expectedErrorCode: slackerror.ErrInvalidFlag,
+		},
+		"errors with the --fail-on-missing flag without a keys file": {
+			args:              []string{`{"datastore":"Todos","id":"0001"}`, "--fail-on-missing"},
+			expectedErrorCode: slackerror.ErrMismatchedFlags,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := setupDatastoreMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			if tc.keysFile != "" {
+				require.NoError(t, afero.WriteFile(clients.Fs, "keys.txt", []byte(tc.keysFile), 0600))
+			}
+
+			batches := [][]string{}
+			BulkGet = func(ctx context.Context, clients *shared.ClientFactory, query types.AppDatastoreBulkGet) (types.AppDatastoreBulkGetResult, error) {
+				batches = append(batches, query.IDs)
+				result := types.AppDatastoreBulkGetResult{Datastore: query.Datastore}
+				for _, id := range query.IDs {
+					if !slices.Contains(tc.missingKeys, id) {
+						result.Items = append(result.Items, map[string]interface{}{"task_id": id})
+					}
+				}
+				return result, nil
+			}
+			defer func() {
+				BulkGet = datastore.BulkGet
+			}()
+
+			cmd := NewGetCommand(clients)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+				clientsMock.Config.SetFlags(cmd)
+				return nil
+			}
+			cmd.SetArgs(tc.args)
+			clients.IO.SetCmdIO(cmd)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedErrorCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorCode, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedBatches != nil {
+				assert.Equal(t, tc.expectedBatches, batches)
+			} else {
+				assert.Empty(t, batches)
+			}
+			for _, expectedOutput := range tc.expectedOutputs {
+				assert.Contains(t, clientsMock.GetCombinedOutput(), expectedOutput)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -33,6 +34,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -47,6 +49,9 @@ var attributeUsage = "attribute pairings for the expression"
 var saveToFileFlag string
 var saveToFileUsage = "save items directly to a file as JSON Lines"
 
+var cursorFlag string
+var cursorUsage = "start the query at the cursor of an earlier query"
+
 var Query = datastore.Query
 var exportProgressSpinner *style.Spinner
 
@@ -59,6 +64,9 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 			"",
 			"This command is supported for apps deployed to Slack managed infrastructure but",
 			"other apps can attempt to run the command with the --force flag.",
+			"",
+			"An export with --to-file that is interrupted keeps the items saved so far and",
+			"prints a cursor. Run the same query with --cursor to append the remaining items.",
 		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{
@@ -69,6 +77,10 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Collect items from the datastore starting at a cursor",
 				Command: `datastore query --datastore tasks '{"cursor": "eyJfX2NWaV..."}'`,
 			},
+			{
+				Meaning: "Resume an interrupted export of items to a file",
+				Command: `datastore query --datastore tasks '{}' --to-file tasks.jsonl --cursor eyJfX2NWaV...`,
+			},
 			{
 				Meaning: "Query the datastore for specific items",
 				Command: `datastore query --datastore tasks '{"expression": "#status = :status", "expression_attributes": {"#status": "status"}, "expression_values": {":status": "In Progress"}}'`,
@@ -77,6 +89,10 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Query the datastore for specific items with only an expression",
 				Command: `datastore query '{"datastore": "tasks", "expression": "#status = :status", "expression_attributes": {"#status": "status"}, "expression_values": {":status": "In Progress"}}'`,
 			},
+			{
+				Meaning: "Query the datastore with an expression read from stdin",
+				Command: `datastore query --datastore tasks --expression-file - < query.json`,
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -86,12 +102,16 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 			var ctx = cmd.Context()
 			var query types.AppDatastoreQuery
 
-			if len(args) > 0 {
-				err := setQueryExpression(clients, &query, args[0], "query")
+			expression, hasExpression, err := getQueryExpression(clients, args)
+			if err != nil {
+				return err
+			}
+			if hasExpression {
+				err = setQueryExpression(clients, &query, expression, "query")
 				if err != nil {
 					return err
 				}
-			} else if len(args) == 0 && !unstableFlag {
+			} else if !unstableFlag {
 				return slackerror.New(slackerror.ErrInvalidDatastoreExpression).
 					WithMessage("No expression was provided").
 					WithRemediation("%s", datastoreExpressionRemediation("query", true))
@@ -105,7 +125,7 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 			ctx = config.SetContextToken(ctx, selection.Auth.Token)
 
 			// Build the query if it wasn't passed by argument
-			if len(args) == 0 && unstableFlag {
+			if !hasExpression && unstableFlag {
 				query, err = promptDatastoreQueryRequest(ctx, clients, selection.App, selection.Auth)
 				if err != nil {
 					return err
@@ -114,6 +134,9 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 
 			// Set the app ID from the selected workspace
 			query.App = selection.App.AppID
+			if cursorFlag != "" {
+				query.Cursor = cursorFlag
+			}
 
 			// Optionally display the JSON expression and exit
 			if showExpressionFlag {
@@ -136,12 +159,15 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
+	cmd.Flags().StringVar(&expressionFileFlag, "expression-file", "", expressionFileUsage)
+	_ = cmd.MarkFlagFilename("expression-file")
 
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().StringVar(&attributeFlag, "attributes", "", attributeUsage)
 
 	cmd.Flags().StringVar(&saveToFileFlag, "to-file", "", saveToFileUsage)
+	cmd.Flags().StringVar(&cursorFlag, "cursor", "", cursorUsage)
 
 	cmd.Flag("attributes").Hidden = true // Hide while unstable is present
 
@@ -355,7 +381,19 @@ func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *c
 		userPreferredLimit = query.Limit
 	}
 
-	itemsFile, err := clients.Fs.Create(saveToFileFlag)
+	// Hold the process on an interrupt until the items exported so far are saved
+	// and the cursor to resume from is printed
+	clients.CleanupWaitGroup.Add(1)
+	defer clients.CleanupWaitGroup.Done()
+
+	// Items are appended to the file when resuming from a cursor
+	var itemsFile afero.File
+	var err error
+	if query.Cursor != "" {
+		itemsFile, err = clients.Fs.OpenFile(saveToFileFlag, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	} else {
+		itemsFile, err = clients.Fs.Create(saveToFileFlag)
+	}
 	if err != nil {
 		return err
 	}
@@ -388,6 +426,9 @@ func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *c
 
 		query.Limit = maxItemsToRead
 		queryResult, err := clients.API().AppsDatastoreQuery(ctx, token, query)
+		if ctx.Err() != nil {
+			return printQueryExportInterrupted(ctx, clients, totalExportedItems, itemsFilePath, query.Cursor)
+		}
 		if err != nil {
 			return err
 		}
@@ -424,3 +465,23 @@ func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *c
 
 	return nil
 }
+
+// printQueryExportInterrupted prints the count of items saved before an export
+// was interrupted with the cursor to resume the export from
+func printQueryExportInterrupted(ctx context.Context, clients *shared.ClientFactory, totalExportedItems int, itemsFilePath string, cursor string) error {
+	exportProgressSpinner.Update(fmt.Sprintf("Exported (%d) items before the interrupt", totalExportedItems), "").Stop()
+	secondaryText := []string{
+		fmt.Sprintf("Items exported so far are saved to %s", style.HomePath(itemsFilePath)),
+	}
+	if cursor != "" {
+		secondaryText = append(secondaryText,
+			fmt.Sprintf("Resume the export with the same query and %s", style.Highlight("--cursor "+cursor)),
+		)
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "warning",
+		Text:      "Export was interrupted",
+		Secondary: secondaryText,
+	}))
+	return slackerror.New(slackerror.ErrProcessInterrupted).WithRootCause(ctx.Err())
+}
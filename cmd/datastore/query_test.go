@@ -29,9 +29,11 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type QueryDatastorePkgMock struct {
@@ -433,6 +435,40 @@ func TestQueryCommandExport(t *testing.T) {
 				assert.Contains(t, status, "Successfully exported (10000) items!")
 			},
 		},
+		"resume an export from a cursor": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--to-file=my-file`,
+				`--cursor=4`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				err := afero.WriteFile(cm.Fs, "my-file", []byte(`{"status":"ongoing","task":"counting","task_id":"0004"}`+"\n"), 0644)
+				require.NoError(t, err)
+				cm.API.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.MatchedBy(func(query types.AppDatastoreQuery) bool {
+					return query.Cursor == "4"
+				})).Return(types.AppDatastoreQueryResult{
+					Items: []map[string]interface{}{
+						{"task_id": "0005", "task": "counting", "status": "ongoing"},
+						{"task_id": "0006", "task": "counting", "status": "ongoing"},
+					},
+				}, nil)
+
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNumberOfCalls(t, "AppsDatastoreQuery", 1)
+				itemsFile, err := cm.Fs.Open("my-file")
+				require.NoError(t, err)
+				items := []string{}
+				scanner := bufio.NewScanner(itemsFile)
+				for scanner.Scan() {
+					items = append(items, scanner.Text())
+				}
+				assert.NoError(t, scanner.Err())
+				assert.Equal(t, 3, len(items))
+			},
+		},
 	}, func(cf *shared.ClientFactory) *cobra.Command {
 		cmd := NewQueryCommand(cf)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
@@ -440,6 +476,49 @@ func TestQueryCommandExport(t *testing.T) {
 	})
 }
 
+func TestQueryCommandExport_Interrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(slackcontext.MockContext(t.Context()))
+	defer cancel()
+	clientsMock := setupDatastoreMocks()
+	clientsMock.API.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything).
+		Return(types.AppDatastoreQueryResult{
+			Items: []map[string]interface{}{
+				{"task_id": "0001", "task": "counting", "status": "ongoing"},
+				{"task_id": "0002", "task": "counting", "status": "ongoing"},
+			},
+			NextCursor: "2",
+		}, nil).Once()
+	clientsMock.API.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			cancel()
+		}).
+		Return(types.AppDatastoreQueryResult{}, context.Canceled).Once()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	cmd := NewQueryCommand(clients)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+	clientsMock.Config.InitializeGlobalFlags(cmd)
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	cmd.SetArgs([]string{`{"datastore":"Todos"}`, `--to-file=my-file`})
+	err := cmd.ExecuteContext(ctx)
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrProcessInterrupted, slackerror.ToSlackError(err).Code)
+
+	output := clientsMock.GetCombinedOutput()
+	assert.Contains(t, output, "Export was interrupted")
+	assert.Contains(t, output, "--cursor 2")
+
+	itemsFile, err := clientsMock.Fs.Open("my-file")
+	require.NoError(t, err)
+	items := []string{}
+	scanner := bufio.NewScanner(itemsFile)
+	for scanner.Scan() {
+		items = append(items, scanner.Text())
+	}
+	assert.NoError(t, scanner.Err())
+	assert.Equal(t, 2, len(items))
+}
+
 func prepareExportMockData(cm *shared.ClientsMock, numberOfItems int, maxItemsToReturn int) ([]map[string]interface{}, error) {
 	data := []map[string]interface{}{}
 	for i := 1; i <= numberOfItems; i++ {
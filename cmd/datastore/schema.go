@@ -0,0 +1,192 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/goutils"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+func NewSchemaCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [flags]",
+		Short: "Print the attributes and primary key of a datastore",
+		Long: strings.Join([]string{
+			"Print the attributes, types, and primary key of datastores defined in the app",
+			"manifest to help write query expressions.",
+			"",
+			"The app manifest is gathered from the project or from app settings of the",
+			"selected app depending on the manifest source of the project.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Print the schema of a datastore",
+				Command: "datastore schema --datastore tasks",
+			},
+			{
+				Meaning: "Print the schema of each datastore as JSON",
+				Command: "datastore schema --output json",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchemaCommandFunc(cmd.Context(), clients)
+		},
+	}
+	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", "the datastore to print the schema of")
+	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
+	return cmd
+}
+
+// datastoreSchema is the definition of a datastore printed with the --output
+// json flag
+type datastoreSchema struct {
+	Datastore           string                             `json:"datastore"`
+	PrimaryKey          string                             `json:"primary_key"`
+	TimeToLiveAttribute string                             `json:"time_to_live_attribute,omitempty"`
+	Attributes          map[string]types.ManifestAttribute `json:"attributes"`
+}
+
+// runSchemaCommandFunc prints the datastore definitions of the app manifest
+func runSchemaCommandFunc(ctx context.Context, clients *shared.ClientFactory) error {
+	if outputFlag != "text" && outputFlag != "json" {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The output format \"%s\" is not supported", outputFlag).
+			WithRemediation("Choose an output format of text or json")
+	}
+	slackManifest, err := getSchemaManifest(ctx, clients)
+	if err != nil {
+		return err
+	}
+	schemas, err := getDatastoreSchemas(slackManifest.AppManifest, datastoreFlag)
+	if err != nil {
+		return err
+	}
+	if outputFlag == "json" {
+		b, err := goutils.JSONMarshalUnescapedIndent(schemas)
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		_, _ = fmt.Fprintln(clients.IO.WriteOut(), string(b))
+		return nil
+	}
+	for _, schema := range schemas {
+		_, _ = fmt.Fprintf(clients.IO.WriteOut(), "\n%s\n", style.Sectionf(style.TextSection{
+			Emoji:     "file_cabinet",
+			Text:      fmt.Sprintf("Datastore: %s", schema.Datastore),
+			Secondary: formatDatastoreSchema(schema),
+		}))
+	}
+	return nil
+}
+
+// getSchemaManifest returns the app manifest from the project or from app
+// settings of the selected app when the manifest source is remote
+func getSchemaManifest(ctx context.Context, clients *shared.ClientFactory) (types.SlackYaml, error) {
+	source, err := clients.Config.ProjectConfig.GetManifestSource(ctx)
+	if err != nil {
+		return types.SlackYaml{}, err
+	}
+	if source.Equals(config.ManifestSourceLocal) {
+		return clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	}
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
+	if err != nil {
+		return types.SlackYaml{}, err
+	}
+	return clients.AppClient().Manifest.GetManifestRemote(ctx, selection.Auth.Token, selection.App.AppID)
+}
+
+// getDatastoreSchemas returns the definition of the named datastore or of each
+// datastore in order if the name is empty
+func getDatastoreSchemas(manifest types.AppManifest, name string) ([]datastoreSchema, error) {
+	if len(manifest.Datastores) == 0 {
+		return nil, slackerror.New(slackerror.ErrDatastoreNotFound).
+			WithMessage("No datastores are defined in the app manifest")
+	}
+	names := slices.Sorted(maps.Keys(manifest.Datastores))
+	if name != "" {
+		if _, ok := manifest.Datastores[name]; !ok {
+			return nil, slackerror.New(slackerror.ErrDatastoreNotFound).
+				WithMessage("The datastore \"%s\" is not defined in the app manifest", name).
+				WithRemediation("Choose one of the datastores: %s", strings.Join(names, ", "))
+		}
+		names = []string{name}
+	}
+	schemas := []datastoreSchema{}
+	for _, name := range names {
+		datastore := manifest.Datastores[name]
+		if _, ok := datastore.Attributes[datastore.PrimaryKey]; !ok {
+			return nil, slackerror.New(slackerror.ErrInvalidDatastore).
+				WithMessage("The primary key \"%s\" of the datastore \"%s\" is not an attribute", datastore.PrimaryKey, name).
+				WithRemediation("Set the primary key of the datastore to one of its attributes")
+		}
+		schemas = append(schemas, datastoreSchema{
+			Datastore:           name,
+			PrimaryKey:          datastore.PrimaryKey,
+			TimeToLiveAttribute: datastore.TimeToLiveAttribute,
+			Attributes:          datastore.Attributes,
+		})
+	}
+	return schemas, nil
+}
+
+// formatDatastoreSchema formats the attributes of a datastore as a table with
+// the primary key first
+func formatDatastoreSchema(schema datastoreSchema) []string {
+	names := slices.Sorted(maps.Keys(schema.Attributes))
+	names = slices.DeleteFunc(names, func(name string) bool { return name == schema.PrimaryKey })
+	names = append([]string{schema.PrimaryKey}, names...)
+	nameWidth := len("ATTRIBUTE")
+	typeWidth := len("TYPE")
+	for _, name := range names {
+		nameWidth = max(nameWidth, len(name))
+		typeWidth = max(typeWidth, len(schema.Attributes[name].Type))
+	}
+	lines := []string{
+		fmt.Sprintf("%-*s  %-*s  %s", nameWidth, "ATTRIBUTE", typeWidth, "TYPE", "NOTES"),
+	}
+	for _, name := range names {
+		notes := []string{}
+		switch name {
+		case schema.PrimaryKey:
+			notes = append(notes, "primary key")
+		case schema.TimeToLiveAttribute:
+			notes = append(notes, "time to live")
+		}
+		if description := schema.Attributes[name].Description; description != "" {
+			notes = append(notes, description)
+		}
+		lines = append(lines, strings.TrimRight(fmt.Sprintf("%-*s  %-*s  %s", nameWidth, name, typeWidth, schema.Attributes[name].Type, strings.Join(notes, ", ")), " "))
+	}
+	return lines
+}
@@ -0,0 +1,151 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaCommand(t *testing.T) {
+	mockManifest := types.SlackYaml{
+		AppManifest: types.AppManifest{
+			Datastores: map[string]types.ManifestDatastore{
+				"tasks": {
+					PrimaryKey:          "id",
+					TimeToLiveAttribute: "expires_at",
+					Attributes: map[string]types.ManifestAttribute{
+						"id":          {Type: "string"},
+						"description": {Type: "string", Description: "details of the task"},
+						"expires_at":  {Type: "slack#/types/timestamp"},
+					},
+				},
+				"notes": {
+					PrimaryKey: "id",
+					Attributes: map[string]types.ManifestAttribute{
+						"id": {Type: "string"},
+					},
+				},
+			},
+		},
+	}
+	mockManifestSource := func(cm *shared.ClientsMock, source config.ManifestSource) {
+		projectConfigMock := config.NewProjectConfigMock()
+		projectConfigMock.On("GetManifestSource", mock.Anything).Return(source, nil)
+		cm.Config.ProjectConfig = projectConfigMock
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the attributes of a datastore with the primary key first": {
+			CmdArgs: []string{"--datastore", "tasks"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockManifestSource(cm, config.ManifestSourceLocal)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+				cm.AppClient.Manifest = manifestMock
+			},
+			ExpectedStdoutOutputs: []string{
+				"Datastore: tasks",
+				"ATTRIBUTE    TYPE                    NOTES",
+				"id           string                  primary key",
+				"description  string                  details of the task",
+				"expires_at   slack#/types/timestamp  time to live",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "Datastore: notes")
+			},
+		},
+		"prints each datastore from app settings as json": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockManifestSource(cm, config.ManifestSourceRemote)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, "A001").Return(mockManifest, nil)
+				cm.AppClient.Manifest = manifestMock
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var schemas []datastoreSchema
+				require.NoError(t, json.Unmarshal([]byte(cm.GetStdoutOutput()), &schemas))
+				require.Len(t, schemas, 2)
+				assert.Equal(t, "notes", schemas[0].Datastore)
+				assert.Equal(t, "tasks", schemas[1].Datastore)
+				assert.Equal(t, "expires_at", schemas[1].TimeToLiveAttribute)
+				assert.Equal(t, mockManifest.Datastores["tasks"].Attributes, schemas[1].Attributes)
+			},
+		},
+		"errors if the datastore is not defined": {
+			CmdArgs: []string{"--datastore", "events"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockManifestSource(cm, config.ManifestSourceLocal)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+				cm.AppClient.Manifest = manifestMock
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrDatastoreNotFound, "Choose one of the datastores: notes, tasks"},
+		},
+		"errors if no datastores are defined": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockManifestSource(cm, config.ManifestSourceLocal)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{}, nil)
+				cm.AppClient.Manifest = manifestMock
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrDatastoreNotFound, "No datastores are defined in the app manifest"},
+		},
+		"errors if the primary key is not an attribute": {
+			CmdArgs: []string{"--datastore", "tasks"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockManifestSource(cm, config.ManifestSourceLocal)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+					AppManifest: types.AppManifest{
+						Datastores: map[string]types.ManifestDatastore{
+							"tasks": {PrimaryKey: "uuid", Attributes: map[string]types.ManifestAttribute{"id": {Type: "string"}}},
+						},
+					},
+				}, nil)
+				cm.AppClient.Manifest = manifestMock
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidDatastore, `The primary key "uuid" of the datastore "tasks" is not an attribute`},
+		},
+		"errors on an unsupported output format": {
+			CmdArgs:              []string{"--output", "csv"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, `The output format "csv" is not supported`},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewSchemaCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return nil
+		}
+		appSelectMock := prompts.NewAppSelectMock()
+		appSelectPromptFunc = appSelectMock.AppSelectPrompt
+		appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).
+			Return(prompts.SelectedApp{App: types.App{AppID: "A001"}}, nil)
+		return cmd
+	})
+}
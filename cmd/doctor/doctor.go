@@ -44,8 +44,15 @@ type DoctorHookJSON struct {
 	} `json:"versions"`
 }
 
+type doctorCmdFlags struct {
+	fix bool
+	yes bool
+}
+
+var doctorFlags doctorCmdFlags
+
 func NewDoctorCommand(clients *shared.ClientFactory) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "doctor",
 		Short: "Check and report on system and app information",
 		Long: strings.Join([]string{
@@ -59,12 +66,23 @@ func NewDoctorCommand(clients *shared.ClientFactory) *cobra.Command {
 			"* New versions will be listed if there are any updates available",
 			"",
 			"Unfortunately, the doctor command cannot heal all problems",
+			"* Deprecated hooks files and corrupt apps files can be fixed with the --fix flag",
 		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "doctor", Meaning: "Create a status report of system dependencies"},
+			{Command: "doctor --fix", Meaning: "Fix problems with project files after confirming"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if doctorFlags.yes && !doctorFlags.fix {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --yes flag can only be used with the --fix flag")
+			}
+			if doctorFlags.fix {
+				if err := runFixes(ctx, clients); err != nil {
+					return err
+				}
+			}
 			report, err := performChecks(ctx, clients)
 			if err != nil {
 				return err
@@ -80,6 +98,32 @@ func NewDoctorCommand(clients *shared.ClientFactory) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&doctorFlags.fix, "fix", false, "fix problems with project files that are known to be safe")
+	cmd.Flags().BoolVar(&doctorFlags.yes, "yes", false, "skip confirmation prompts when fixing problems")
+
+	return cmd
+}
+
+// runFixes makes the fixes found for the project in the current directory and
+// reloads the project hooks after changes
+func runFixes(ctx context.Context, clients *shared.ClientFactory) error {
+	dirPath, err := clients.Os.Getwd()
+	if err != nil {
+		return err
+	}
+	fixes := findConfigFixes(clients, dirPath)
+	applied, err := applyConfigFixes(ctx, clients, dirPath, fixes, doctorFlags.yes)
+	if err != nil {
+		return err
+	}
+	printConfigFixes(ctx, clients, fixes, applied)
+	if len(applied) > 0 {
+		if err := clients.InitSDKConfig(ctx, dirPath); err != nil {
+			clients.IO.PrintDebug(ctx, "failed to initialize hook configurations: %s", err)
+		}
+	}
+	return nil
 }
 
 // DoctorReport contains information about system statistics
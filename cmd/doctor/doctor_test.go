@@ -32,6 +32,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/version"
 	"github.com/slackapi/slack-cli/test/slackmock"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -313,6 +314,17 @@ func TestDoctorCommand(t *testing.T) {
 	})
 }
 
+func TestDoctorCommand_Flags(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"errors when the yes flag is used without the fix flag": {
+			CmdArgs:              []string{"--yes"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --yes flag can only be used with the --fix flag"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewDoctorCommand(clients)
+	})
+}
+
 func TestDoctorHook(t *testing.T) {
 	tests := map[string]struct {
 		mockHookSetup func(cm *shared.ClientsMock) *shared.ClientFactory
@@ -0,0 +1,190 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/goutils"
+	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+// hooksFilePath is the current location of the project hooks file
+var hooksFilePath = filepath.Join(".slack", "hooks.json")
+
+// deprecatedHooksFiles are outdated locations of the project hooks file with
+// the error that is shown for each
+var deprecatedHooksFiles = []struct {
+	path string
+	code string
+}{
+	{path: "slack.json", code: slackerror.ErrSlackJSONLocation},
+	{path: filepath.Join(".slack", "slack.json"), code: slackerror.ErrSlackSlackJSONLocation},
+	{path: filepath.Join(".slack", "cli.json"), code: slackerror.ErrCLIConfigLocationError},
+}
+
+// appsFilePaths are the project files that save details of apps
+var appsFilePaths = []string{
+	filepath.Join(".slack", "apps.json"),
+	filepath.Join(".slack", "apps.dev.json"),
+}
+
+// configFix moves a project file to another path and optionally resets the
+// moved file to an empty object
+type configFix struct {
+	from  string
+	to    string
+	code  string
+	reset bool
+}
+
+// String describes the change made by the fix
+func (f configFix) String() string {
+	if f.reset {
+		return fmt.Sprintf("Backed up %s to %s and reset it", f.from, f.to)
+	}
+	return fmt.Sprintf("Moved %s to %s", f.from, f.to)
+}
+
+// prompt asks to confirm the change made by the fix
+func (f configFix) prompt() string {
+	if f.reset {
+		return fmt.Sprintf("Back up %s to %s and reset it?", f.from, f.to)
+	}
+	return fmt.Sprintf("Move %s to %s?", f.from, f.to)
+}
+
+// findConfigFixes returns the fixes that can be made to files of the project
+// in the directory
+func findConfigFixes(clients *shared.ClientFactory, dirPath string) []configFix {
+	fixes := findHooksFileFixes(clients, dirPath)
+	fixes = append(fixes, findAppsFileFixes(clients, dirPath)...)
+	return fixes
+}
+
+// findHooksFileFixes returns a fix for a deprecated hooks file
+//
+// Only a single deprecated hooks file is moved when the current hooks file is
+// missing, since the file to keep is otherwise unclear.
+func findHooksFileFixes(clients *shared.ClientFactory, dirPath string) []configFix {
+	if _, err := clients.Fs.Stat(filepath.Join(dirPath, hooksFilePath)); err == nil {
+		return []configFix{}
+	}
+	fixes := []configFix{}
+	for _, deprecated := range deprecatedHooksFiles {
+		info, err := clients.Fs.Stat(filepath.Join(dirPath, deprecated.path))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		fixes = append(fixes, configFix{
+			from: deprecated.path,
+			to:   hooksFilePath,
+			code: deprecated.code,
+		})
+	}
+	if len(fixes) != 1 {
+		return []configFix{}
+	}
+	return fixes
+}
+
+// findAppsFileFixes returns fixes for apps files that are not valid JSON
+//
+// Files with content after valid JSON are repaired when read so are skipped.
+func findAppsFileFixes(clients *shared.ClientFactory, dirPath string) []configFix {
+	fixes := []configFix{}
+	for _, path := range appsFilePaths {
+		data, err := afero.ReadFile(clients.Fs, filepath.Join(dirPath, path))
+		if err != nil || goutils.IsEmptyJSON(data) || json.Valid(data) {
+			continue
+		}
+		if _, ok := goutils.TrimTrailingJSON(data); ok {
+			continue
+		}
+		backup, err := filepath.Rel(dirPath, app.BackupFilePath(clients.Fs, filepath.Join(dirPath, path)))
+		if err != nil {
+			continue
+		}
+		fixes = append(fixes, configFix{
+			from:  path,
+			to:    backup,
+			code:  slackerror.ErrUnableToParseJSON,
+			reset: true,
+		})
+	}
+	return fixes
+}
+
+// applyConfigFixes makes each fix after confirming unless skipped and returns
+// the fixes that were made
+func applyConfigFixes(ctx context.Context, clients *shared.ClientFactory, dirPath string, fixes []configFix, yes bool) ([]configFix, error) {
+	applied := []configFix{}
+	for _, fix := range fixes {
+		if !yes {
+			proceed, err := clients.IO.ConfirmPrompt(ctx, fix.prompt(), true)
+			if err != nil {
+				if slackerror.Is(err, slackerror.ErrProcessInterrupted) {
+					clients.IO.SetExitCode(iostreams.ExitCancel)
+				}
+				return applied, err
+			}
+			if !proceed {
+				continue
+			}
+		}
+		to := filepath.Join(dirPath, fix.to)
+		if err := clients.Fs.MkdirAll(filepath.Dir(to), 0755); err != nil {
+			return applied, slackerror.Wrap(err, slackerror.ErrProjectFileUpdate)
+		}
+		if err := clients.Fs.Rename(filepath.Join(dirPath, fix.from), to); err != nil {
+			return applied, slackerror.Wrap(err, slackerror.ErrProjectFileUpdate)
+		}
+		if fix.reset {
+			if err := afero.WriteFile(clients.Fs, filepath.Join(dirPath, fix.from), []byte("{}"), 0600); err != nil {
+				return applied, slackerror.Wrap(err, slackerror.ErrProjectFileUpdate)
+			}
+		}
+		applied = append(applied, fix)
+	}
+	return applied, nil
+}
+
+// printConfigFixes outputs the changes that were made to the project
+func printConfigFixes(ctx context.Context, clients *shared.ClientFactory, fixes []configFix, applied []configFix) {
+	if len(fixes) == 0 {
+		clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "thumbs_up",
+			Text:  "No problems were found that can be fixed",
+		}))
+		return
+	}
+	changes := []string{}
+	for _, fix := range applied {
+		changes = append(changes, fix.String())
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "wrench",
+		Text:      fmt.Sprintf("Fixed %d of %d problems", len(applied), len(fixes)),
+		Secondary: changes,
+	}))
+}
@@ -0,0 +1,201 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoctorFindConfigFixes(t *testing.T) {
+	tests := map[string]struct {
+		files         []string
+		contents      string
+		expectedFixes []configFix
+	}{
+		"finds no fixes without project files": {
+			files:         []string{},
+			expectedFixes: []configFix{},
+		},
+		"finds no fixes when the hooks file exists": {
+			files:         []string{hooksFilePath, "slack.json"},
+			expectedFixes: []configFix{},
+		},
+		"moves the slack.json file of the project": {
+			files: []string{"slack.json"},
+			expectedFixes: []configFix{
+				{from: "slack.json", to: hooksFilePath, code: slackerror.ErrSlackJSONLocation},
+			},
+		},
+		"moves the .slack/slack.json file of the project": {
+			files: []string{filepath.Join(".slack", "slack.json")},
+			expectedFixes: []configFix{
+				{from: filepath.Join(".slack", "slack.json"), to: hooksFilePath, code: slackerror.ErrSlackSlackJSONLocation},
+			},
+		},
+		"moves the .slack/cli.json file of the project": {
+			files: []string{filepath.Join(".slack", "cli.json")},
+			expectedFixes: []configFix{
+				{from: filepath.Join(".slack", "cli.json"), to: hooksFilePath, code: slackerror.ErrCLIConfigLocationError},
+			},
+		},
+		"finds no fixes with more than one deprecated file": {
+			files:         []string{"slack.json", filepath.Join(".slack", "cli.json")},
+			expectedFixes: []configFix{},
+		},
+		"resets apps files that are not valid json": {
+			files:    []string{hooksFilePath, filepath.Join(".slack", "apps.json"), filepath.Join(".slack", "apps.dev.json")},
+			contents: `{"apps":`,
+			expectedFixes: []configFix{
+				{from: filepath.Join(".slack", "apps.json"), to: filepath.Join(".slack", "apps.json.bak"), code: slackerror.ErrUnableToParseJSON, reset: true},
+				{from: filepath.Join(".slack", "apps.dev.json"), to: filepath.Join(".slack", "apps.dev.json.bak"), code: slackerror.ErrUnableToParseJSON, reset: true},
+			},
+		},
+		"finds no fixes for apps files that can be repaired when read": {
+			files:         []string{hooksFilePath, filepath.Join(".slack", "apps.json")},
+			contents:      "{}\n}",
+			expectedFixes: []configFix{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			contents := "{}"
+			if tc.contents != "" {
+				contents = tc.contents
+			}
+			for _, file := range tc.files {
+				err := afero.WriteFile(clientsMock.Fs, filepath.Join(slackdeps.MockWorkingDirectory, file), []byte(contents), 0644)
+				require.NoError(t, err)
+			}
+			fixes := findConfigFixes(clients, slackdeps.MockWorkingDirectory)
+			assert.Equal(t, tc.expectedFixes, fixes)
+		})
+	}
+}
+
+func TestDoctorApplyConfigFixes(t *testing.T) {
+	fix := configFix{from: "slack.json", to: hooksFilePath, code: slackerror.ErrSlackJSONLocation}
+	tests := map[string]struct {
+		yes             bool
+		confirm         bool
+		expectedApplied []configFix
+	}{
+		"moves files without prompts when skipped": {
+			yes:             true,
+			expectedApplied: []configFix{fix},
+		},
+		"moves files after confirming": {
+			confirm:         true,
+			expectedApplied: []configFix{fix},
+		},
+		"keeps files when declined": {
+			confirm:         false,
+			expectedApplied: []configFix{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.IO.On("ConfirmPrompt", mock.Anything, "Move slack.json to "+hooksFilePath+"?", true).Return(tc.confirm, nil)
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			err := afero.WriteFile(clientsMock.Fs, filepath.Join(slackdeps.MockWorkingDirectory, "slack.json"), []byte(`{"hooks":{}}`), 0644)
+			require.NoError(t, err)
+
+			applied, err := applyConfigFixes(ctx, clients, slackdeps.MockWorkingDirectory, []configFix{fix}, tc.yes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedApplied, applied)
+			moved, _ := afero.Exists(clientsMock.Fs, filepath.Join(slackdeps.MockWorkingDirectory, hooksFilePath))
+			assert.Equal(t, len(tc.expectedApplied) > 0, moved)
+			kept, _ := afero.Exists(clientsMock.Fs, filepath.Join(slackdeps.MockWorkingDirectory, "slack.json"))
+			assert.Equal(t, len(tc.expectedApplied) == 0, kept)
+			if tc.yes {
+				clientsMock.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestDoctorApplyConfigFixes_Reset(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	appsFilePath := filepath.Join(slackdeps.MockWorkingDirectory, ".slack", "apps.json")
+	err := afero.WriteFile(clientsMock.Fs, appsFilePath, []byte(`{"apps":`), 0600)
+	require.NoError(t, err)
+	fix := configFix{
+		from:  filepath.Join(".slack", "apps.json"),
+		to:    filepath.Join(".slack", "apps.json.bak"),
+		code:  slackerror.ErrUnableToParseJSON,
+		reset: true,
+	}
+
+	applied, err := applyConfigFixes(ctx, clients, slackdeps.MockWorkingDirectory, []configFix{fix}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []configFix{fix}, applied)
+	backup, err := afero.ReadFile(clientsMock.Fs, appsFilePath+".bak")
+	require.NoError(t, err)
+	assert.Equal(t, `{"apps":`, string(backup))
+	reset, err := afero.ReadFile(clientsMock.Fs, appsFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(reset))
+	assert.Equal(t, "Backed up "+fix.from+" to "+fix.to+" and reset it", fix.String())
+}
+
+func TestDoctorRunFixes(t *testing.T) {
+	t.Run("reports the files that were moved", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		clientsMock := shared.NewClientsMock()
+		clientsMock.AddDefaultMocks()
+		clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+		err := afero.WriteFile(clientsMock.Fs, filepath.Join(slackdeps.MockWorkingDirectory, ".slack", "cli.json"), []byte(`{"hooks":{"doctor":"echo checkup"}}`), 0644)
+		require.NoError(t, err)
+		doctorFlags.yes = true
+		defer func() {
+			doctorFlags = doctorCmdFlags{}
+		}()
+
+		err = runFixes(ctx, clients)
+		require.NoError(t, err)
+		assert.Contains(t, clientsMock.GetStdoutOutput(), "Fixed 1 of 1 problems")
+		assert.Contains(t, clientsMock.GetStdoutOutput(), "Moved "+filepath.Join(".slack", "cli.json")+" to "+hooksFilePath)
+		assert.Equal(t, "echo checkup", clients.SDKConfig.Hooks.Doctor.Command)
+	})
+
+	t.Run("reports when no problems can be fixed", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		clientsMock := shared.NewClientsMock()
+		clientsMock.AddDefaultMocks()
+		clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+		err := runFixes(ctx, clients)
+		require.NoError(t, err)
+		assert.Contains(t, clientsMock.GetStdoutOutput(), "No problems were found that can be fixed")
+	})
+}
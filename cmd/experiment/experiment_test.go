@@ -0,0 +1,36 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+)
+
+func Test_Experiment_Command(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"shows the help page without commands or arguments or flags": {
+			ExpectedStdoutOutputs: []string{
+				"List all experiments and if each is enabled",
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewCommand(clients)
+		return cmd
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiment
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/experiment"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type listCmdFlags struct {
+	output string
+}
+
+var listFlags listCmdFlags
+
+// experimentInfo is the status of an experiment
+type experimentInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
+func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: "List all experiments and if each is enabled",
+		Long: strings.Join([]string{
+			"List all experiments of the CLI with a description and if each is enabled.",
+			"",
+			"Experiments are enabled with the --experiment flag or the \"experiments\" field",
+			"of the project or system configuration.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "List all experiments and if each is enabled",
+				Command: "experiment list",
+			},
+			{
+				Meaning: "List experiments enabled with a flag",
+				Command: "experiment list --experiment lipgloss",
+			},
+			{
+				Meaning: "List all experiments as JSON",
+				Command: "experiment list --output json",
+			},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListCommand(clients, cmd)
+		},
+	}
+	cmd.Flags().StringVar(&listFlags.output, "output", "text", "output format: text, json")
+	return cmd
+}
+
+// runListCommand prints the known experiments and the enabled experiments
+func runListCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	switch listFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", listFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	experiments := listExperiments(clients)
+	if listFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(struct {
+			Experiments []experimentInfo `json:"experiments"`
+		}{
+			Experiments: experiments,
+		})
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
+	secondary := []string{}
+	unknown := []string{}
+	for _, exp := range experiments {
+		if !experiment.Includes(experiment.Experiment(exp.Name)) {
+			unknown = append(unknown, exp.Name)
+			continue
+		}
+		status := "disabled"
+		if exp.Enabled {
+			status = "enabled"
+		}
+		secondary = append(secondary, fmt.Sprintf("%s (%s): %s", exp.Name, status, exp.Description))
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "test_tube",
+		Text:      "Experiments",
+		Secondary: secondary,
+	}))
+	if len(unknown) > 0 {
+		clients.IO.PrintWarning(ctx, "Unknown experiments are enabled and have no effect: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// listExperiments returns each known experiment followed by any enabled
+// experiment that is not known
+func listExperiments(clients *shared.ClientFactory) []experimentInfo {
+	experiments := []experimentInfo{}
+	for _, exp := range experiment.AllExperiments {
+		experiments = append(experiments, experimentInfo{
+			Name:        string(exp),
+			Description: experiment.Descriptions[exp],
+			Enabled:     clients.Config.WithExperimentOn(exp),
+		})
+	}
+	for _, exp := range clients.Config.GetExperiments() {
+		if !experiment.Includes(exp) {
+			experiments = append(experiments, experimentInfo{
+				Name:    string(exp),
+				Enabled: true,
+			})
+		}
+	}
+	return experiments
+}
@@ -0,0 +1,85 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiment
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Experiment_ListCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists experiments that are disabled": {
+			CmdArgs: []string{},
+			ExpectedStdoutOutputs: []string{
+				"Experiments",
+				"lipgloss (disabled): Shows pretty styles",
+				"placeholder (disabled): A placeholder for testing that does nothing",
+			},
+		},
+		"lists experiments enabled with flags": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				cm.Config.ExperimentsFlag = []string{"placeholder", "unicorn"}
+				cm.Config.LoadExperiments(ctx, cm.IO.PrintDebug)
+			},
+			ExpectedStdoutOutputs: []string{
+				"lipgloss (disabled): Shows pretty styles",
+				"placeholder (enabled): A placeholder for testing that does nothing",
+				"Unknown experiments are enabled and have no effect: unicorn",
+			},
+		},
+		"lists experiments as json": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				cm.Config.ExperimentsFlag = []string{"lipgloss"}
+				cm.Config.LoadExperiments(ctx, cm.IO.PrintDebug)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var actual struct {
+					Experiments []experimentInfo `json:"experiments"`
+				}
+				err := json.Unmarshal([]byte(cm.GetStdoutOutput()), &actual)
+				require.NoError(t, err)
+				assert.Contains(t, actual.Experiments, experimentInfo{
+					Name:        "lipgloss",
+					Description: "Shows pretty styles",
+					Enabled:     true,
+				})
+				assert.Contains(t, actual.Experiments, experimentInfo{
+					Name:        "placeholder",
+					Description: "A placeholder for testing that does nothing",
+					Enabled:     false,
+				})
+			},
+		},
+		"errors for an unknown output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewListCommand(clients)
+	})
+}
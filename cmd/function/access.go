@@ -18,10 +18,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
@@ -40,6 +44,9 @@ type distributeFlagSet struct {
 	everyone  bool
 	appCollab bool
 	info      bool
+	output    string
+	setUsers  string
+	dryRun    bool
 }
 
 var distributeFlags distributeFlagSet
@@ -65,6 +72,8 @@ func NewDistributeCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "function access --name callback_id --everyone", Meaning: "Share a function with everyone in a workspace"},
 			{Command: "function access --name callback_id --revoke \\\n    --users USLACKBOT,U012345678,U0RHJTSPQ3", Meaning: "Revoke function access for multiple users"},
 			{Command: "function access --info", Meaning: "Lookup access information for a function"},
+			{Command: "function access --name callback_id --info --output json", Meaning: "Print who can access a function as JSON"},
+			{Command: "function access --name callback_id --dry-run \\\n    --set-users U012345678,U023456789", Meaning: "Preview the changes to only allow certain users"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clients.Config.SetFlags(cmd)
@@ -73,6 +82,7 @@ func NewDistributeCommand(clients *shared.ClientFactory) *cobra.Command {
 		Aliases: []string{"distribute", "distribution", "dist"},
 	}
 
+	cmd.PersistentFlags().BoolVar(&distributeFlags.dryRun, "dry-run", false, "preview changes from the --set-users flag")
 	cmd.PersistentFlags().BoolVarP(&distributeFlags.appCollab, "app-collaborators", "A", false, "grant access to only fellow app collaborators")
 	cmd.PersistentFlags().BoolVarP(&distributeFlags.everyone, "everyone", "E", false, "grant access to everyone in installed workspaces")
 	cmd.PersistentFlags().StringVarP(&distributeFlags.file, "file", "F", "", "specify access permissions using a file")
@@ -80,8 +90,11 @@ func NewDistributeCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(&distributeFlags.info, "info", "I", false, "check who has access to the function --name")
 	// TODO: The flag name, variable name, and description all use different terms: --name flag for a callback_id that maps to a functionFlag variable. Consider supporting `--callback-id in for the next semver MAJOR
 	cmd.PersistentFlags().StringVarP(&functionFlag, "name", "N", "", "the callback_id of a function in your app")
+	cmd.PersistentFlags().StringVar(&distributeFlags.output, "output", "text", "output format: text, json")
 	cmd.PersistentFlags().BoolVarP(&distributeFlags.revoke, "revoke", "R", false, "revoke access for --users to use --name")
+	cmd.PersistentFlags().StringVar(&distributeFlags.setUsers, "set-users", "", "replace the users that can access the function")
 	cmd.PersistentFlags().StringVarP(&distributeFlags.users, "users", "U", "", "a comma-separated list of Slack user IDs")
+	_ = cmd.MarkPersistentFlagFilename("file")
 
 	return cmd
 }
@@ -90,6 +103,29 @@ func NewDistributeCommand(clients *shared.ClientFactory) *cobra.Command {
 func runDistributeCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	ctx := cmd.Context()
 
+	switch distributeFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", distributeFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+
+	if cmdutil.IsFlagChanged(cmd, "set-users") {
+		if distributeFlags.grant || distributeFlags.revoke || distributeFlags.everyone || distributeFlags.appCollab ||
+			distributeFlags.info || distributeFlags.file != "" || distributeFlags.users != "" {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --set-users flag replaces the access list and cannot be used with other access flags")
+		}
+		if distributeFlags.dryRun && distributeFlags.output == "json" {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --dry-run flag cannot be used with the --output json flag")
+		}
+	} else if distributeFlags.dryRun {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --dry-run flag can only be used with the --set-users flag")
+	}
+
 	// Get the app selection and accompanying auth from the prompt
 	selectedApp, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
 	if err != nil {
@@ -117,6 +153,9 @@ func runDistributeCommand(cmd *cobra.Command, clients *shared.ClientFactory) err
 	if distributeFlags.info {
 		return printDistribution(ctx, cmd, clients, app)
 	}
+	if cmdutil.IsFlagChanged(cmd, "set-users") {
+		return setDistributionUsers(ctx, cmd, clients, app, setUsersList(distributeFlags.setUsers))
+	}
 	return handleUpdate(ctx, cmd, clients, app, token)
 }
 
@@ -160,10 +199,7 @@ func handleUpdate(
 		}
 
 		users := strings.Split(distributeFlags.users, ",")
-		_, _ = clients.IO.WriteOut().Write([]byte(style.Sectionf(style.TextSection{
-			Emoji: "party_popper",
-			Text:  fmt.Sprintf("Function access granted to the provided %s", style.Pluralize("user", "users", len(users))),
-		})))
+		printDistributionChange(clients, "party_popper", fmt.Sprintf("Function access granted to the provided %s", style.Pluralize("user", "users", len(users))))
 		return printDistribution(ctx, cmd, clients, app)
 	} else if distributeFlags.revoke {
 		if distributeFlags.users == "" {
@@ -176,10 +212,7 @@ func handleUpdate(
 		}
 
 		users := strings.Split(distributeFlags.users, ",")
-		_, _ = clients.IO.WriteOut().Write([]byte(style.Sectionf(style.TextSection{
-			Emoji: "firecracker",
-			Text:  fmt.Sprintf("Function access revoked for the provided %s", style.Pluralize("user", "users", len(users))),
-		})))
+		printDistributionChange(clients, "firecracker", fmt.Sprintf("Function access revoked for the provided %s", style.Pluralize("user", "users", len(users))))
 		return printDistribution(ctx, cmd, clients, app)
 	} else {
 		dist, err := chooseDistributionPrompt(ctx, clients, app, token)
@@ -211,19 +244,13 @@ func handleUpdate(
 				}
 
 				users := strings.Split(distributeFlags.users, ",")
-				_, _ = clients.IO.WriteOut().Write([]byte(style.Sectionf(style.TextSection{
-					Emoji: "party_popper",
-					Text:  fmt.Sprintf("Function access granted to the provided %s", style.Pluralize("user", "users", len(users))),
-				})))
+				printDistributionChange(clients, "party_popper", fmt.Sprintf("Function access granted to the provided %s", style.Pluralize("user", "users", len(users))))
 			case "remove":
 				err = clients.API().FunctionDistributionRemoveUsers(ctx, functionFlag, app.AppID, users)
 				if err != nil {
 					return err
 				}
-				_, _ = clients.IO.WriteOut().Write([]byte(style.Sectionf(style.TextSection{
-					Emoji: "firecracker",
-					Text:  fmt.Sprintf("Function access revoked for the provided %s", style.Pluralize("user", "users", len(users))),
-				})))
+				printDistributionChange(clients, "firecracker", fmt.Sprintf("Function access revoked for the provided %s", style.Pluralize("user", "users", len(users))))
 			}
 			return printDistribution(ctx, cmd, clients, app)
 		}
@@ -278,31 +305,152 @@ func distributePermissionFile(ctx context.Context, clients *shared.ClientFactory
 					},
 				})
 		}
-		switch permissions.Type {
-		case types.PermissionNamedEntities:
-			if len(permissions.UserIDs) == 0 {
-				clients.IO.PrintWarning(ctx, "No users will have access to '%s'",
-					function,
-				)
-			}
-			err := updateNamedEntitiesDistribution(ctx, clients, app, function, permissions.UserIDs)
+	}
+	results := []functionAccessInfo{}
+	var firstErr error
+	for _, function := range slices.Sorted(maps.Keys(data.FunctionMap)) {
+		permissions := data.FunctionMap[function]
+		err := distributePermissions(ctx, clients, app, function, permissions.Type, permissions.UserIDs)
+		if distributeFlags.output != "json" {
 			if err != nil {
 				return err
 			}
-		default:
-			if len(permissions.UserIDs) != 0 {
-				clients.IO.PrintWarning(ctx, "The supplied user IDs to '%s' are overridden by the '%s' permission",
-					function,
-					permissions.Type,
-				)
-			}
-			_, err := clients.API().FunctionDistributionSet(ctx, function, app.AppID, permissions.Type, "")
-			if err != nil {
-				return err
+			continue
+		}
+		result := functionAccessInfo{Function: function, Users: []types.FunctionDistributionUser{}}
+		if err == nil {
+			result, err = getFunctionAccessInfo(ctx, clients, app, function)
+		}
+		if err != nil {
+			result.ErrorCode = slackerror.ToSlackError(err).Code
+			if firstErr == nil {
+				firstErr = err
 			}
 		}
+		results = append(results, result)
 	}
-	return nil
+	if distributeFlags.output != "json" {
+		return nil
+	}
+	if err := printJSON(clients, results); err != nil {
+		return err
+	}
+	return firstErr
+}
+
+// distributePermissions sets the access permissions of a function from a file
+func distributePermissions(ctx context.Context, clients *shared.ClientFactory, app types.App, function string, permissionType types.Permission, userIDs []string) error {
+	switch permissionType {
+	case types.PermissionNamedEntities:
+		if len(userIDs) == 0 {
+			clients.IO.PrintWarning(ctx, "No users will have access to '%s'",
+				function,
+			)
+		}
+		return updateNamedEntitiesDistribution(ctx, clients, app, function, userIDs)
+	default:
+		if len(userIDs) != 0 {
+			clients.IO.PrintWarning(ctx, "The supplied user IDs to '%s' are overridden by the '%s' permission",
+				function,
+				permissionType,
+			)
+		}
+		_, err := clients.API().FunctionDistributionSet(ctx, function, app.AppID, permissionType, "")
+		return err
+	}
+}
+
+// setDistributionUsers changes the users with access to the function to match
+// the declared users and switches the distribution type to named entities if
+// needed
+func setDistributionUsers(ctx context.Context, cmd *cobra.Command, clients *shared.ClientFactory, app types.App, declaredUsers []string) error {
+	currentType, currentUsers, err := clients.API().FunctionDistributionList(ctx, functionFlag, app.AppID)
+	if err != nil {
+		return err
+	}
+	currentUserIDs := []string{}
+	if currentType == types.PermissionNamedEntities {
+		for _, user := range currentUsers {
+			currentUserIDs = append(currentUserIDs, user.ID)
+		}
+	}
+	add := usersNotIn(declaredUsers, currentUserIDs)
+	remove := usersNotIn(currentUserIDs, declaredUsers)
+
+	if distributeFlags.dryRun {
+		printDistributionUserChanges(ctx, clients, currentType, add, remove)
+		return nil
+	}
+
+	if len(declaredUsers) == 0 {
+		clients.IO.PrintWarning(ctx, "No users will have access to '%s'", functionFlag)
+	}
+	if currentType != types.PermissionNamedEntities {
+		err = handleDistributionType(ctx, clients, app)
+		if err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		err = clients.API().FunctionDistributionAddUsers(ctx, functionFlag, app.AppID, strings.Join(add, ","))
+		if err != nil {
+			return err
+		}
+		printDistributionChange(clients, "party_popper", fmt.Sprintf("Function access granted to the provided %s", style.Pluralize("user", "users", len(add))))
+	}
+	if len(remove) > 0 {
+		err = clients.API().FunctionDistributionRemoveUsers(ctx, functionFlag, app.AppID, strings.Join(remove, ","))
+		if err != nil {
+			return err
+		}
+		printDistributionChange(clients, "firecracker", fmt.Sprintf("Function access revoked for the %s not provided", style.Pluralize("user", "users", len(remove))))
+	}
+	return printDistribution(ctx, cmd, clients, app)
+}
+
+// setUsersList returns the unique user IDs of a comma-separated list
+func setUsersList(users string) []string {
+	list := []string{}
+	for _, user := range strings.Split(goutils.UpperCaseTrimAll(users), ",") {
+		if user != "" && !slices.Contains(list, user) {
+			list = append(list, user)
+		}
+	}
+	return list
+}
+
+// usersNotIn returns the users that are not in the excluded users
+func usersNotIn(users []string, excluded []string) []string {
+	remaining := []string{}
+	for _, user := range users {
+		if !slices.Contains(excluded, user) {
+			remaining = append(remaining, user)
+		}
+	}
+	return remaining
+}
+
+// printDistributionUserChanges displays the changes that would be made to the
+// users with access to the function
+func printDistributionUserChanges(ctx context.Context, clients *shared.ClientFactory, currentType types.Permission, add []string, remove []string) {
+	secondary := []string{}
+	if currentType != types.PermissionNamedEntities {
+		secondary = append(secondary, fmt.Sprintf("Change the distribution type from %s to %s", currentType, types.PermissionNamedEntities))
+	}
+	if len(add) > 0 {
+		secondary = append(secondary, fmt.Sprintf("Add users: %s", strings.Join(add, ", ")))
+	}
+	if len(remove) > 0 {
+		secondary = append(secondary, fmt.Sprintf("Remove users: %s", strings.Join(remove, ", ")))
+	}
+	if len(secondary) == 0 {
+		secondary = append(secondary, "No changes are needed to the access list")
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "clipboard",
+		Text:      fmt.Sprintf("Function '%s' access changes (dry run)", functionFlag),
+		Secondary: secondary,
+	}))
 }
 
 // updateNamedEntitiesDistribution removes unspecified entities and adds any
@@ -346,6 +494,13 @@ func updateNamedEntitiesDistribution(
 
 // printDistribution formats and displays access information
 func printDistribution(ctx context.Context, cmd *cobra.Command, clients *shared.ClientFactory, app types.App) error {
+	if distributeFlags.output == "json" {
+		info, err := getFunctionAccessInfo(ctx, clients, app, functionFlag)
+		if err != nil {
+			return err
+		}
+		return printJSON(clients, info)
+	}
 	dist, userAccessList, err := clients.API().FunctionDistributionList(ctx, functionFlag, app.AppID)
 	if err != nil {
 		return err
@@ -389,7 +544,7 @@ func printEntityAccess(ctx context.Context, cmd *cobra.Command, clients *shared.
 	if err != nil {
 		return err
 	}
-	if distType != types.PermissionNamedEntities {
+	if distType != types.PermissionNamedEntities || distributeFlags.output == "json" {
 		return nil
 	}
 	var emoji string
@@ -417,6 +572,55 @@ func printEntityAccess(ctx context.Context, cmd *cobra.Command, clients *shared.
 	return nil
 }
 
+// functionAccessInfo is the access of a function for outputs of the --output
+// json flag
+type functionAccessInfo struct {
+	Function         string                           `json:"function"`
+	DistributionType types.Permission                 `json:"distribution_type,omitempty"`
+	Users            []types.FunctionDistributionUser `json:"users"`
+	ErrorCode        string                           `json:"error_code,omitempty"`
+}
+
+// getFunctionAccessInfo returns the distribution type and users with access to
+// a function
+func getFunctionAccessInfo(ctx context.Context, clients *shared.ClientFactory, app types.App, function string) (functionAccessInfo, error) {
+	dist, users, err := clients.API().FunctionDistributionList(ctx, function, app.AppID)
+	if err != nil {
+		return functionAccessInfo{Function: function, Users: []types.FunctionDistributionUser{}}, err
+	}
+	info := functionAccessInfo{
+		Function:         function,
+		DistributionType: dist,
+		Users:            []types.FunctionDistributionUser{},
+	}
+	if dist != types.PermissionEveryone {
+		info.Users = append(info.Users, users...)
+	}
+	return info, nil
+}
+
+// printJSON writes the value as indented JSON to stdout
+func printJSON(clients *shared.ClientFactory, v any) error {
+	encoder := json.NewEncoder(clients.IO.WriteOut())
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+	}
+	return nil
+}
+
+// printDistributionChange outputs a change to the access of a function unless
+// the output is JSON
+func printDistributionChange(clients *shared.ClientFactory, emoji string, text string) {
+	if distributeFlags.output == "json" {
+		return
+	}
+	_, _ = clients.IO.WriteOut().Write([]byte(style.Sectionf(style.TextSection{
+		Emoji: emoji,
+		Text:  text,
+	})))
+}
+
 // handleDistributionType checks if the function's distribution type is named_entities and if not, updates it
 func handleDistributionType(ctx context.Context, clients *shared.ClientFactory, app types.App) error {
 	distType, _, err := clients.API().FunctionDistributionList(ctx, functionFlag, app.AppID)
@@ -427,3 +427,205 @@ func TestFunctionDistributeCommand_UpdateNamedEntitiesDistribution(t *testing.T)
 		})
 	}
 }
+
+func TestFunctionDistributionCommand_OutputJSON(t *testing.T) {
+	var appSelectTeardown func()
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the access of a function as json": {
+			CmdArgs: []string{"--info", "--name", "F1234", "--output", "json"},
+			ExpectedOutputs: []string{
+				`"function": "F1234"`,
+				`"distribution_type": "named_entities"`,
+				`"user_id": "U00"`,
+				`"username": "user 0"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", fakeApp.AppID).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{UserName: "user 0", ID: "U00"}}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				distributeFlags.output = "text"
+			},
+		},
+		"prints only the updated access as json": {
+			CmdArgs: []string{"--users", "U00", "--grant", "--name", "F1234", "--output", "json"},
+			ExpectedOutputs: []string{
+				`"distribution_type": "named_entities"`,
+				`"user_id": "U00"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{}, nil).Once()
+				clientsMock.API.On("FunctionDistributionAddUsers", mock.Anything, mock.Anything, mock.Anything, "U00").
+					Return(nil).Once()
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{ID: "U00"}}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				distributeFlags.output = "text"
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetStdoutOutput(), "Function access granted")
+			},
+		},
+		"prints an empty user list for everyone": {
+			CmdArgs:         []string{"--everyone", "--name", "F1234", "--output", "json"},
+			ExpectedOutputs: []string{`"distribution_type": "everyone"`, `"users": []`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionSet", mock.Anything, mock.Anything, mock.Anything, types.PermissionEveryone, mock.Anything).
+					Return([]types.FunctionDistributionUser{}, nil).Once()
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionEveryone, []types.FunctionDistributionUser{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				distributeFlags.output = "text"
+			},
+		},
+		"prints the results of a permissions file with error codes": {
+			CmdArgs: []string{"--file", "permissions.json", "--output", "json"},
+			ExpectedOutputs: []string{
+				`"function": "goodbye_function"`,
+				`"distribution_type": "app_collaborators"`,
+				`"function": "greeting_function"`,
+				`"error_code": "` + slackerror.ErrInvalidDistributionType + `"`,
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidDistributionType},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				data := `{"function_distribution": {"greeting_function": {"type": "everyone"}, "goodbye_function": {"type": "app_collaborators"}}}`
+				require.NoError(t, afero.WriteFile(clients.Fs, "permissions.json", []byte(data), 0o600))
+				clientsMock.API.On("FunctionDistributionSet", mock.Anything, "goodbye_function", mock.Anything, types.PermissionAppCollaborators, mock.Anything).
+					Return([]types.FunctionDistributionUser{}, nil)
+				clientsMock.API.On("FunctionDistributionSet", mock.Anything, "greeting_function", mock.Anything, types.PermissionEveryone, mock.Anything).
+					Return([]types.FunctionDistributionUser{}, slackerror.New(slackerror.ErrInvalidDistributionType))
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "goodbye_function", mock.Anything).
+					Return(types.PermissionAppCollaborators, []types.FunctionDistributionUser{{ID: "U00"}}, nil)
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				distributeFlags.output = "text"
+			},
+		},
+		"errors with an unknown output format": {
+			CmdArgs:              []string{"--info", "--name", "F1234", "--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+			Teardown: func() {
+				distributeFlags.output = "text"
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewDistributeCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
+
+func TestFunctionDistributionCommand_SetUsers(t *testing.T) {
+	var appSelectTeardown func()
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"converges the users with access to the declared users": {
+			CmdArgs: []string{"--name", "F1234", "--set-users", "U01, u02"},
+			ExpectedOutputs: []string{
+				"Function access granted to the provided user",
+				"Function access revoked for the user not provided",
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", mock.Anything).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{ID: "U00"}, {ID: "U01"}}, nil).Once()
+				clientsMock.API.On("FunctionDistributionAddUsers", mock.Anything, "F1234", mock.Anything, "U02").
+					Return(nil).Once()
+				clientsMock.API.On("FunctionDistributionRemoveUsers", mock.Anything, "F1234", mock.Anything, "U00").
+					Return(nil).Once()
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", mock.Anything).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{ID: "U01"}, {ID: "U02"}}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "FunctionDistributionSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"switches the distribution type to named entities first": {
+			CmdArgs: []string{"--name", "F1234", "--set-users", "U01"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", mock.Anything).
+					Return(types.PermissionEveryone, []types.FunctionDistributionUser{}, nil).Twice()
+				clientsMock.API.On("FunctionDistributionSet", mock.Anything, "F1234", mock.Anything, types.PermissionNamedEntities, "").
+					Return([]types.FunctionDistributionUser{}, nil).Once()
+				clientsMock.API.On("FunctionDistributionAddUsers", mock.Anything, "F1234", mock.Anything, "U01").
+					Return(nil).Once()
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", mock.Anything).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{ID: "U01"}}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "FunctionDistributionSet", mock.Anything, "F1234", mock.Anything, types.PermissionNamedEntities, "")
+				cm.API.AssertNotCalled(t, "FunctionDistributionRemoveUsers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"previews the changes with the dry run flag": {
+			CmdArgs: []string{"--name", "F1234", "--set-users", "U01,U02", "--dry-run"},
+			ExpectedOutputs: []string{
+				"Function 'F1234' access changes (dry run)",
+				"Change the distribution type from app_collaborators to named_entities",
+				"Add users: U01, U02",
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", mock.Anything).
+					Return(types.PermissionAppCollaborators, []types.FunctionDistributionUser{{ID: "U00"}}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "FunctionDistributionSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				cm.API.AssertNotCalled(t, "FunctionDistributionAddUsers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				cm.API.AssertNotCalled(t, "FunctionDistributionRemoveUsers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"previews no changes when the users match": {
+			CmdArgs:         []string{"--name", "F1234", "--set-users", "U00", "--dry-run"},
+			ExpectedOutputs: []string{"No changes are needed to the access list"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "F1234", mock.Anything).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{ID: "U00"}}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"errors when combined with other access flags": {
+			CmdArgs:              []string{"--name", "F1234", "--set-users", "U00", "--grant"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --set-users flag replaces the access list"},
+		},
+		"errors with the dry run flag and no set users flag": {
+			CmdArgs:              []string{"--name", "F1234", "--everyone", "--dry-run"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --dry-run flag can only be used with the --set-users flag"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewDistributeCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -46,12 +46,14 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "function distribute", Meaning: "Select a function and choose distribution options"},
 			{Command: "function distribute --name callback_id --everyone", Meaning: "Distribute a function to everyone in a workspace"},
 			{Command: "function distribute --info", Meaning: "Lookup the distribution information for a function"},
+			{Command: "function list --output json", Meaning: "Print the functions of an app as JSON"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
 	}
 	cmd.AddCommand(NewDistributeCommand(clients))
+	cmd.AddCommand(NewListCommand(clients))
 	return cmd
 }
 
@@ -0,0 +1,153 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type listCmdFlags struct {
+	output string
+}
+
+var listFlags listCmdFlags
+
+// NewListCommand implements the "function list" command
+func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: "List the functions of an app",
+		Long: strings.Join([]string{
+			"List the custom functions of an app with who can access each function in",
+			"Workflow Builder.",
+			"",
+			"Functions are listed from the app manifest on app settings.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "function list", Meaning: "Select an app and list the functions"},
+			{Command: "function list --app A0123456 --output json", Meaning: "Print the functions of an app as JSON"},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return runListCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&listFlags.output, "output", "text", "output format: text, json")
+	return cmd
+}
+
+// functionListItem is a function of an app for outputs of the --output json
+// flag
+type functionListItem struct {
+	CallbackID       string           `json:"callback_id"`
+	Title            string           `json:"title"`
+	Description      string           `json:"description,omitempty"`
+	DistributionType types.Permission `json:"distribution_type,omitempty"`
+	ErrorCode        string           `json:"error_code,omitempty"`
+}
+
+// runListCommand lists the functions of the selected app with the access of
+// each function. Errors from checking the access of a function are noted with
+// that function and the first is returned after all functions are listed
+func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch listFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", listFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
+	if err != nil {
+		return err
+	}
+	functions, err := GetAppFunctions(ctx, clients, selection.App, selection.Auth)
+	if err != nil {
+		return err
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].CallbackID < functions[j].CallbackID
+	})
+	items := []functionListItem{}
+	var firstErr error
+	for _, function := range functions {
+		item, err := getFunctionListItem(ctx, clients, selection.App, function)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		items = append(items, item)
+	}
+	if listFlags.output == "json" {
+		if err := printJSON(clients, items); err != nil {
+			return err
+		}
+		return firstErr
+	}
+	printFunctionList(ctx, clients, selection.App, items)
+	return firstErr
+}
+
+// getFunctionListItem returns a function with the distribution type or the
+// error code from checking the access of the function
+func getFunctionListItem(ctx context.Context, clients *shared.ClientFactory, app types.App, function types.Function) (functionListItem, error) {
+	item := functionListItem{
+		CallbackID:  function.CallbackID,
+		Title:       function.Title,
+		Description: function.Description,
+	}
+	dist, _, err := clients.API().FunctionDistributionList(ctx, function.CallbackID, app.AppID)
+	if err != nil {
+		item.ErrorCode = slackerror.ToSlackError(err).Code
+		return item, err
+	}
+	item.DistributionType = dist
+	return item, nil
+}
+
+// printFunctionList formats and displays the functions of an app
+func printFunctionList(ctx context.Context, clients *shared.ClientFactory, app types.App, items []functionListItem) {
+	if len(items) == 0 {
+		clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "ghost",
+			Text:  fmt.Sprintf("No functions were found in the app manifest of %s", app.AppID),
+		}))
+		return
+	}
+	secondary := []string{}
+	for _, item := range items {
+		access := fmt.Sprintf("accessible to %s", item.DistributionType.ToString())
+		if item.ErrorCode != "" {
+			access = fmt.Sprintf("access unavailable (%s)", item.ErrorCode)
+		}
+		secondary = append(secondary, fmt.Sprintf("%s (%s) %s", item.CallbackID, item.Title, style.Secondary(access)))
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "gear",
+		Text:      fmt.Sprintf("%d %s of %s", len(items), style.Pluralize("function", "functions", len(items)), app.AppID),
+		Secondary: secondary,
+	}))
+}
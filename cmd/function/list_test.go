@@ -0,0 +1,120 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFunctionListCommand(t *testing.T) {
+	var appSelectTeardown func()
+	mockFunctions := func(clientsMock *shared.ClientsMock) {
+		manifestMock := &app.ManifestMockObject{}
+		manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, fakeAppID).Return(types.SlackYaml{
+			AppManifest: types.AppManifest{
+				Functions: map[string]types.ManifestFunction{
+					"greeting_function": {Title: "Greeting", Description: "Say hello"},
+					"goodbye_function":  {Title: "Goodbye"},
+				},
+			},
+		}, nil)
+		clientsMock.AppClient.Manifest = manifestMock
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists the functions of an app with access": {
+			ExpectedOutputs: []string{
+				"2 functions of A1234",
+				"goodbye_function (Goodbye)",
+				"accessible to app collaborators",
+				"greeting_function (Greeting)",
+				"accessible to everyone",
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				mockFunctions(clientsMock)
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "goodbye_function", fakeAppID).
+					Return(types.PermissionAppCollaborators, []types.FunctionDistributionUser{}, nil)
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "greeting_function", fakeAppID).
+					Return(types.PermissionEveryone, []types.FunctionDistributionUser{}, nil)
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"prints the functions as json with error codes": {
+			CmdArgs: []string{"--output", "json"},
+			ExpectedOutputs: []string{
+				`"callback_id": "goodbye_function"`,
+				`"distribution_type": "named_entities"`,
+				`"callback_id": "greeting_function"`,
+				`"description": "Say hello"`,
+				`"error_code": "` + slackerror.ErrFunctionNotFound + `"`,
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrFunctionNotFound},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				mockFunctions(clientsMock)
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "goodbye_function", fakeAppID).
+					Return(types.PermissionNamedEntities, []types.FunctionDistributionUser{{ID: "U00"}}, nil)
+				clientsMock.API.On("FunctionDistributionList", mock.Anything, "greeting_function", fakeAppID).
+					Return(types.Permission(""), []types.FunctionDistributionUser{}, slackerror.New(slackerror.ErrFunctionNotFound))
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				listFlags.output = "text"
+			},
+		},
+		"notes an app without functions": {
+			ExpectedOutputs: []string{"No functions were found in the app manifest of A1234"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, fakeAppID).Return(types.SlackYaml{}, nil)
+				clientsMock.AppClient.Manifest = manifestMock
+				clientsMock.AddDefaultMocks()
+				appSelectTeardown = setupMockAppSelection(installedProdApp)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "FunctionDistributionList", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors with an unknown output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+			Teardown: func() {
+				listFlags.output = "text"
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, cm.GetStdoutOutput())
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewListCommand(clients)
+	})
+}
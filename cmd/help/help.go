@@ -32,7 +32,7 @@ func HelpFunc(
 ) func(*cobra.Command, []string) {
 	return func(cmd *cobra.Command, args []string) {
 		ctx := cmd.Context()
-		style.ToggleStyles(clients.IO.IsTTY() && !clients.Config.NoColor)
+		style.ToggleStyles(clients.IsStyleEnabled())
 		if help, _ := clients.Config.Flags.GetBool("help"); help {
 			clients.Config.LoadExperiments(ctx, clients.IO.PrintDebug)
 		}
@@ -0,0 +1,55 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks <subcommand>",
+		Short: "Inspect the SDK hooks of a project",
+		Long: strings.Join([]string{
+			"Inspect the SDK hooks of a project.",
+			"",
+			"Hooks are resolved from the \"get-hooks\" hook and the \".slack/hooks.json\"",
+			"file of the project.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "List the resolved command of each hook",
+				Command: "hooks list",
+			},
+			{
+				Meaning: "Run the get-manifest hook",
+				Command: "hooks run get-manifest",
+			},
+		}),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// Add child commands
+	cmd.AddCommand(NewListCommand(clients))
+	cmd.AddCommand(NewRunCommand(clients))
+
+	return cmd
+}
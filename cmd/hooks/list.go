@@ -0,0 +1,111 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type listCmdFlags struct {
+	output string
+}
+
+var listFlags listCmdFlags
+
+// hookInfo is the resolved command of a hook
+type hookInfo struct {
+	Name      string `json:"name"`
+	Command   string `json:"command"`
+	Available bool   `json:"available"`
+}
+
+func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: "List the resolved command of each hook",
+		Long:  "List each hook of the project with the command resolved from the hooks file and note hooks that are missing",
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "List the resolved command of each hook",
+				Command: "hooks list",
+			},
+			{
+				Meaning: "List the hooks as JSON",
+				Command: "hooks list --output json",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&listFlags.output, "output", "text", "output format: text, json")
+	return cmd
+}
+
+// runListCommand prints the resolved command of each hook in the SDK config
+func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch listFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", listFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+
+	hooks := []hookInfo{}
+	for _, entry := range clients.SDKConfig.ListHooks() {
+		hooks = append(hooks, hookInfo{
+			Name:      entry.Key,
+			Command:   entry.Script.Command,
+			Available: entry.Script.IsAvailable(),
+		})
+	}
+
+	if listFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(hooks); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
+
+	secondary := []string{}
+	for _, hook := range hooks {
+		command := hook.Command
+		if !hook.Available {
+			command = style.Secondary("(missing)")
+		}
+		secondary = append(secondary, fmt.Sprintf("%s: %s", style.Bold(hook.Name), command))
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "nut_and_bolt",
+		Text:      "Project Hooks",
+		Secondary: secondary,
+	}))
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/hooks"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupHooksListMocks resolves the get-manifest and start hooks of the project
+func setupHooksListMocks(cf *shared.ClientFactory) {
+	cf.SDKConfig.Hooks.GetManifest = hooks.HookScript{Name: "GetManifest", Command: "cat manifest.json"}
+	cf.SDKConfig.Hooks.Start = hooks.HookScript{Name: "Start", Command: "npm start"}
+}
+
+func Test_Hooks_ListCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"lists resolved and missing hooks": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupHooksListMocks(cf)
+			},
+			ExpectedStdoutOutputs: []string{
+				"Project Hooks",
+				"get-manifest: cat manifest.json",
+				"start: npm start",
+				"deploy: (missing)",
+			},
+		},
+		"lists hooks as json": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupHooksListMocks(cf)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var actual []hookInfo
+				require.NoError(t, json.Unmarshal([]byte(cm.GetStdoutOutput()), &actual))
+				require.Len(t, actual, 9)
+				assert.Contains(t, actual, hookInfo{Name: "get-manifest", Command: "cat manifest.json", Available: true})
+				assert.Contains(t, actual, hookInfo{Name: "deploy", Command: "", Available: false})
+			},
+		},
+		"errors on an invalid output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewListCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/hooks"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+func NewRunCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <hook-name> [-- <key=value>...]",
+		Short: "Run a single hook of the project",
+		Long: strings.Join([]string{
+			"Run a single hook of the project with the hook executor and environment of",
+			"the CLI to debug the hook in isolation.",
+			"",
+			"The hook response is printed to stdout and diagnostics to stderr. Arguments",
+			"after \"--\" are passed to the hook as \"--key=value\" flags.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Run the get-manifest hook",
+				Command: "hooks run get-manifest",
+			},
+			{
+				Meaning: "Run the get-trigger hook with a trigger definition",
+				Command: "hooks run get-trigger -- source=triggers/shortcut.ts",
+			},
+		}),
+		Args: cobra.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRunCommand(cmd, clients, args)
+		},
+	}
+	return cmd
+}
+
+// runRunCommand executes the named hook and prints the response and exit status
+func runRunCommand(cmd *cobra.Command, clients *shared.ClientFactory, args []string) error {
+	ctx := cmd.Context()
+	name := args[0]
+
+	var script hooks.HookScript
+	found := false
+	for _, entry := range clients.SDKConfig.ListHooks() {
+		if entry.Key == name {
+			script = entry.Script
+			found = true
+			break
+		}
+	}
+	if !found {
+		return slackerror.New(slackerror.ErrSDKHookNotFound).
+			WithMessage("The hook '%s' is not a known hook", name).
+			WithRemediation("Run %s to see the hooks of the project", style.Commandf("hooks list", false))
+	}
+	if _, err := script.Get(); err != nil {
+		return err
+	}
+
+	hookArgs, err := parseHookArgs(args[1:])
+	if err != nil {
+		return err
+	}
+	hookExecOpts := hooks.HookExecOpts{
+		Directory: clients.SDKConfig.WorkingDirectory,
+		Hook:      script,
+		Args:      hookArgs,
+		Env:       map[string]string{},
+		Stdin:     clients.IO.ReadIn(),
+		Stderr:    clients.IO.WriteErr(),
+	}
+	for key, val := range clients.Config.ManifestEnv {
+		hookExecOpts.Env[key] = val
+	}
+
+	response, err := clients.HookExecutor.Execute(ctx, hookExecOpts)
+	if err != nil {
+		return err
+	}
+	if response != "" {
+		_, _ = fmt.Fprintln(clients.IO.WriteOut(), response)
+	}
+	_, _ = fmt.Fprintln(clients.IO.WriteErr(), style.Secondary(fmt.Sprintf("Hook '%s' exited with status 0", name)))
+	return nil
+}
+
+// parseHookArgs converts "key=value" arguments into the flags passed to a hook
+func parseHookArgs(args []string) (map[string]string, error) {
+	hookArgs := map[string]string{}
+	for _, arg := range args {
+		key, value, ok := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !ok || key == "" {
+			return nil, slackerror.New(slackerror.ErrInvalidArguments).
+				WithMessage("The hook argument '%s' is not formatted as key=value", arg).
+				WithRemediation("Pass hook arguments after %s such as %s", style.Highlight("--"), style.Highlight("source=manifest.json"))
+		}
+		hookArgs[key] = value
+	}
+	return hookArgs, nil
+}
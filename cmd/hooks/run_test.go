@@ -0,0 +1,91 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/hooks"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Hooks_RunCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"runs a hook and prints the response": {
+			CmdArgs: []string{"get-manifest"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cf.SDKConfig.Hooks.GetManifest = hooks.HookScript{Name: "GetManifest", Command: "cat manifest.json"}
+				cm.Config.ManifestEnv = map[string]string{"EXAMPLE": "12"}
+				cm.HookExecutor.On("Execute", mock.Anything, mock.Anything).Return(`{"display_information":{}}`, nil)
+			},
+			ExpectedStdoutOutputs: []string{
+				`{"display_information":{}}`,
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.HookExecutor.AssertCalled(t, "Execute", mock.Anything, mock.MatchedBy(func(opts hooks.HookExecOpts) bool {
+					return opts.Hook.Name == "GetManifest" &&
+						opts.Env["EXAMPLE"] == "12" &&
+						len(opts.Args) == 0
+				}))
+			},
+		},
+		"passes arguments after the hook name to the hook": {
+			CmdArgs: []string{"get-trigger", "--", "source=triggers/shortcut.ts", "--verbose=true"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cf.SDKConfig.Hooks.GetTrigger = hooks.HookScript{Name: "GetTrigger", Command: "deno run get_trigger.ts"}
+				cm.HookExecutor.On("Execute", mock.Anything, mock.Anything).Return(`{}`, nil)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.HookExecutor.AssertCalled(t, "Execute", mock.Anything, mock.MatchedBy(func(opts hooks.HookExecOpts) bool {
+					return opts.Args["source"] == "triggers/shortcut.ts" &&
+						opts.Args["verbose"] == "true"
+				}))
+			},
+		},
+		"returns the error of a failing hook": {
+			CmdArgs: []string{"deploy"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cf.SDKConfig.Hooks.Deploy = hooks.HookScript{Name: "Deploy", Command: "./deploy.sh"}
+				cm.HookExecutor.On("Execute", mock.Anything, mock.Anything).
+					Return("", slackerror.New(slackerror.ErrSDKHookInvocationFailed).WithMessage("Error running 'Deploy' command: exit status 2"))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrSDKHookInvocationFailed, "exit status 2"},
+		},
+		"errors if the hook is not known": {
+			CmdArgs:              []string{"get-everything"},
+			ExpectedErrorStrings: []string{slackerror.ErrSDKHookNotFound, "The hook 'get-everything' is not a known hook"},
+		},
+		"errors if the hook has no command": {
+			CmdArgs:              []string{"doctor"},
+			ExpectedErrorStrings: []string{slackerror.ErrSDKHookNotFound},
+		},
+		"errors if a hook argument is not a key and value": {
+			CmdArgs: []string{"get-manifest", "--", "source"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cf.SDKConfig.Hooks.GetManifest = hooks.HookScript{Name: "GetManifest", Command: "cat manifest.json"}
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidArguments, "The hook argument 'source' is not formatted as key=value"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewRunCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
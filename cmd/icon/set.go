@@ -0,0 +1,137 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icon
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/icon"
+	"github.com/slackapi/slack-cli/internal/pkg/apps"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type setCmdFlags struct {
+	file   string
+	output string
+}
+
+var setFlags setCmdFlags
+
+var setAppSelectPromptFunc = prompts.AppSelectPrompt
+
+// iconSetResult is the outcome of setting the icon of an app
+type iconSetResult struct {
+	AppID string `json:"app_id"`
+	File  string `json:"file"`
+}
+
+func NewSetCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set --file <path> [flags]",
+		Short: "Set the icon of an app",
+		Long: strings.Join([]string{
+			"Set the icon of an app to an image file without installing the app again.",
+			"",
+			fmt.Sprintf("The image must end in one of %s and be %d MB or smaller.", strings.Join(icon.SupportedExtensions, ", "), icon.MaxFileSize/1024/1024),
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Set the icon of an app to an image file",
+				Command: "icon set --file logo.png",
+			},
+			{
+				Meaning: "Set the icon of a specific app and print the result as JSON",
+				Command: "icon set --app A0123456789 --file logo.png --output json",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSetCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&setFlags.file, "file", "", "path to the image file of the icon")
+	_ = cmd.MarkFlagFilename("file")
+	cmd.Flags().StringVar(&setFlags.output, "output", "text", "output format: text, json")
+	return cmd
+}
+
+// runSetCommand uploads the icon file to the selected app
+func runSetCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch setFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", setFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	if setFlags.file == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The --file flag is required to set the icon").
+			WithRemediation("Provide the path to an image file with %s", style.Highlight("--file <path>"))
+	}
+	if err := icon.Validate(clients.Fs, setFlags.file); err != nil {
+		return err
+	}
+
+	selection, err := setAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
+	if err != nil {
+		return err
+	}
+
+	isHosted := true
+	if err := cmdutil.IsSlackHostedProject(ctx, clients); err != nil {
+		if !slackerror.Is(err, slackerror.ErrAppNotHosted) {
+			return err
+		}
+		isHosted = false
+	}
+
+	err = apps.SetIcon(ctx, clients, selection.App.AppID, selection.Auth.Token, setFlags.file, isHosted)
+	if err != nil {
+		return err
+	}
+
+	result := iconSetResult{
+		AppID: selection.App.AppID,
+		File:  setFlags.file,
+	}
+	if setFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		return nil
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "art",
+		Text:  "App Icon",
+		Secondary: []string{
+			fmt.Sprintf("Updated the icon of %s to %s", result.AppID, result.File),
+		},
+	}))
+	return nil
+}
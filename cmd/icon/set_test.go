@@ -0,0 +1,168 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/experiment"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPNG is the start of a PNG image file
+var mockPNG = []byte("\x89PNG\r\n\x1a\n")
+
+// setupIconSetMocks selects an app and sets the runtime of the project manifest
+func setupIconSetMocks(t *testing.T, ctx context.Context, cm *shared.ClientsMock, hosted bool, iconErr error) {
+	require.NoError(t, afero.WriteFile(cm.Fs, "logo.png", mockPNG, 0o644))
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectMock.On(
+		"AppSelectPrompt",
+		mock.Anything,
+		mock.Anything,
+		prompts.ShowAllEnvironments,
+		prompts.ShowInstalledAndUninstalledApps,
+	).Return(
+		prompts.SelectedApp{
+			App:  types.App{AppID: "A001"},
+			Auth: types.SlackAuth{Token: "xoxp-example"},
+		},
+		nil,
+	)
+	setAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+	projectConfigMock := config.NewProjectConfigMock()
+	projectConfigMock.On("GetManifestSource", mock.Anything).Return(config.ManifestSourceLocal, nil)
+	cm.Config.ProjectConfig = projectConfigMock
+	runtime := types.Remote
+	if hosted {
+		runtime = types.SlackHosted
+	}
+	manifestMock := &app.ManifestMockObject{}
+	manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(
+		types.SlackYaml{
+			AppManifest: types.AppManifest{
+				Settings: &types.AppSettings{FunctionRuntime: runtime},
+			},
+		},
+		nil,
+	)
+	cm.AppClient.Manifest = manifestMock
+	cm.API.On("Icon", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.IconResult{}, iconErr)
+	cm.API.On("IconSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.IconResult{}, iconErr)
+}
+
+func Test_Icon_SetCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"sets the icon of a hosted app": {
+			CmdArgs: []string{"--file", "logo.png"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, true, nil)
+			},
+			ExpectedStdoutOutputs: []string{
+				"App Icon",
+				"Updated the icon of A001 to logo.png",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "Icon", mock.Anything, mock.Anything, "xoxp-example", "A001", "logo.png")
+				cm.API.AssertNotCalled(t, "IconSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"sets the icon of a remote app with the experiment": {
+			CmdArgs: []string{"--file", "logo.png"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, false, nil)
+				cm.AddDefaultMocks()
+				cm.Config.ExperimentsFlag = []string{string(experiment.SetIcon)}
+				cm.Config.LoadExperiments(ctx, cm.IO.PrintDebug)
+			},
+			ExpectedStdoutOutputs: []string{
+				"Updated the icon of A001 to logo.png",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertCalled(t, "IconSet", mock.Anything, mock.Anything, "xoxp-example", "A001", "logo.png")
+			},
+		},
+		"prints the result as json": {
+			CmdArgs: []string{"--file", "logo.png", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, true, nil)
+			},
+			ExpectedStdoutOutputs: []string{
+				`"app_id": "A001"`,
+				`"file": "logo.png"`,
+			},
+		},
+		"errors for a remote app without the experiment": {
+			CmdArgs: []string{"--file", "logo.png"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, false, nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingExperiment},
+		},
+		"errors when the upload fails": {
+			CmdArgs: []string{"--file", "logo.png"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, true, fmt.Errorf("invalid_image"))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppIconUpdate, "invalid_image logo.png"},
+		},
+		"errors without the file flag": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, true, nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag, "The --file flag is required"},
+		},
+		"errors for an unsupported file name before selecting an app": {
+			CmdArgs: []string{"--file", "logo.svg"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, true, nil)
+				require.NoError(t, afero.WriteFile(cm.Fs, "logo.svg", []byte("<svg></svg>"), 0o644))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrUnsupportedFileName},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.API.AssertNotCalled(t, "Icon", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors for an unknown file type": {
+			CmdArgs: []string{"--file", "notes.png"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupIconSetMocks(t, ctx, cm, true, nil)
+				require.NoError(t, afero.WriteFile(cm.Fs, "notes.png", []byte("plain text notes"), 0o644))
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrUnknownFileType},
+		},
+		"errors for an invalid output format": {
+			CmdArgs:              []string{"--file", "logo.png", "--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewSetCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
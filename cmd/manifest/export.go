@@ -0,0 +1,130 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// exportFlagSet contains flag values for the export command
+type exportFlagSet struct {
+	output string
+}
+
+// exportFlags has the set flag values
+var exportFlags exportFlagSet
+
+// NewExportCommand implements the "manifest export" command
+func NewExportCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write the app manifest of an app to a file",
+		Long: strings.Join([]string{
+			"Write the app manifest saved on app settings to a file or standard output.",
+			"",
+			"Files ending with \".yaml\" or \".yml\" are written as YAML and other files",
+			"are written as JSON.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "manifest export --app A0123456789", Meaning: "Print the app manifest of an app"},
+			{Command: "manifest export --output manifest.json", Meaning: "Write the app manifest to a JSON file"},
+			{Command: "manifest export --output manifest.yaml", Meaning: "Write the app manifest to a YAML file"},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportCommand(cmd.Context(), clients)
+		},
+	}
+	cmd.Flags().StringVar(&exportFlags.output, "output", "", "path of a file to write the app manifest to")
+	_ = cmd.MarkFlagFilename("output")
+	return cmd
+}
+
+// runExportCommand writes the remote manifest of the selected app
+func runExportCommand(ctx context.Context, clients *shared.ClientFactory) error {
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
+	if err != nil {
+		return err
+	}
+	slackManifest, err := clients.AppClient().Manifest.GetManifestRemote(ctx, selection.Auth.Token, selection.App.AppID)
+	if err != nil {
+		return slackerror.New(slackerror.ErrAppManifestAccess).
+			WithMessage("Failed to export the manifest of app %s", selection.App.AppID).
+			WithRemediation("Check that the app exists and that you are a collaborator").
+			WithRootCause(err)
+	}
+	bytes, err := encodeManifest(slackManifest.AppManifest, exportFlags.output)
+	if err != nil {
+		return slackerror.New(slackerror.ErrFailedExport).
+			WithMessage("Failed to encode the app manifest").
+			WithRootCause(err)
+	}
+	if exportFlags.output == "" {
+		_, err = clients.IO.WriteOut().Write(bytes)
+		return err
+	}
+	err = afero.WriteFile(clients.Fs, exportFlags.output, bytes, 0o644)
+	if err != nil {
+		return slackerror.New(slackerror.ErrFailedExport).
+			WithMessage("Failed to write the app manifest to %s", exportFlags.output).
+			WithRootCause(err)
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "books",
+		Text:  "App Manifest",
+		Secondary: []string{
+			fmt.Sprintf("Exported the manifest of app %s to %s", selection.App.AppID, style.Highlight(exportFlags.output)),
+		},
+	}))
+	return nil
+}
+
+// encodeManifest formats the manifest as YAML for YAML file paths and as JSON
+// otherwise
+func encodeManifest(manifest types.AppManifest, path string) ([]byte, error) {
+	bytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		// Decoding JSON into a MapSlice keeps the order of manifest fields
+		var ordered yaml.MapSlice
+		if err := yaml.Unmarshal(bytes, &ordered); err != nil {
+			return nil, err
+		}
+		return yaml.Marshal(ordered)
+	default:
+		return append(bytes, '\n'), nil
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCommand(t *testing.T) {
+	mockManifest := types.SlackYaml{
+		AppManifest: types.AppManifest{
+			DisplayInformation: types.DisplayInformation{
+				Name: "app001",
+			},
+		},
+	}
+	mockSelection := func(cf *shared.ClientFactory) {
+		appSelectMock := prompts.NewAppSelectMock()
+		appSelectPromptFunc = appSelectMock.AppSelectPrompt
+		appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).
+			Return(prompts.SelectedApp{App: types.App{AppID: "A0001"}, Auth: types.SlackAuth{Token: "xoxp-example"}}, nil)
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the remote manifest as json without an output path": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockSelection(cf)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, "xoxp-example", "A0001").Return(mockManifest, nil)
+				cf.AppClient().Manifest = manifestMock
+			},
+			ExpectedStdoutOutputs: []string{`"display_information": {`, `"name": "app001"`},
+		},
+		"writes the remote manifest to a json file": {
+			CmdArgs: []string{"--output", "manifest.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockSelection(cf)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+				cf.AppClient().Manifest = manifestMock
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				bytes, err := afero.ReadFile(cm.Fs, "manifest.json")
+				require.NoError(t, err)
+				assert.Equal(t, "{\n  \"display_information\": {\n    \"name\": \"app001\"\n  }\n}\n", string(bytes))
+			},
+		},
+		"writes the remote manifest to a yaml file": {
+			CmdArgs: []string{"--output", "manifest.yaml"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockSelection(cf)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+				cf.AppClient().Manifest = manifestMock
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				bytes, err := afero.ReadFile(cm.Fs, "manifest.yaml")
+				require.NoError(t, err)
+				assert.Equal(t, "display_information:\n  name: app001\n", string(bytes))
+			},
+		},
+		"errors when the remote manifest cannot be accessed": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockSelection(cf)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.SlackYaml{}, slackerror.New(slackerror.ErrInvalidAuth))
+				cf.AppClient().Manifest = manifestMock
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppManifestAccess, "Failed to export the manifest of app A0001"},
+		},
+		"errors when the manifest file cannot be written": {
+			CmdArgs: []string{"--output", "manifest.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				mockSelection(cf)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+				cf.AppClient().Manifest = manifestMock
+				cf.Fs = afero.NewReadOnlyFs(afero.NewMemMapFs())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrFailedExport, "Failed to write the app manifest to manifest.json"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewExportCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -59,6 +59,10 @@ func NewInfoCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Print the app manifest gathered from App Config",
 				Command: "manifest info --source remote",
 			},
+			{
+				Meaning: "Print the manifest source and app manifest as JSON",
+				Command: "manifest info --output json",
+			},
 		}),
 		Aliases: []string{"show", "list"},
 		Args:    cobra.NoArgs,
@@ -80,29 +84,50 @@ func NewInfoCommand(clients *shared.ClientFactory) *cobra.Command {
 			config.ManifestSourceRemote.String(),
 		),
 	)
+	cmd.Flags().StringVar(&manifestFlags.output, "output", "text", "output format: text, json")
 	return cmd
 }
 
+// manifestInfo is the app manifest with details about where it was gathered
+type manifestInfo struct {
+	Source   string            `json:"source"`
+	Path     string            `json:"path,omitempty"`
+	AppID    string            `json:"app_id,omitempty"`
+	Manifest types.AppManifest `json:"manifest"`
+}
+
 // runInfoCommand performs the "manifest info" command
 func runInfoCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	ctx := cmd.Context()
+	switch manifestFlags.output {
+	case "", "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", manifestFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
 	info, err := getManifestInfo(ctx, clients, cmd)
 	if err != nil {
 		return err
 	}
-	manifest, err := json.MarshalIndent(info, "", "  ")
+	var manifest []byte
+	if manifestFlags.output == "json" {
+		manifest, err = json.MarshalIndent(info, "", "  ")
+	} else {
+		manifest, err = json.MarshalIndent(info.Manifest, "", "  ")
+	}
 	if err != nil {
 		return err
 	}
-	clients.IO.PrintInfo(ctx, false, "%s", string(manifest))
+	_, _ = fmt.Fprintln(clients.IO.WriteOut(), string(manifest))
 	return nil
 }
 
 // getManifestInfo gathers app manifest information from the specified source
-func getManifestInfo(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) (types.AppManifest, error) {
+func getManifestInfo(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) (manifestInfo, error) {
 	source, err := getManifestSource(ctx, clients, cmd)
 	if err != nil {
-		return types.AppManifest{}, err
+		return manifestInfo{}, err
 	}
 	switch {
 	case source.Equals(config.ManifestSourceLocal):
@@ -110,28 +135,32 @@ func getManifestInfo(ctx context.Context, clients *shared.ClientFactory, cmd *co
 	case source.Equals(config.ManifestSourceRemote):
 		return getManifestInfoRemote(ctx, clients)
 	default:
-		return types.AppManifest{}, slackerror.New(slackerror.ErrInvalidManifestSource)
+		return manifestInfo{}, slackerror.New(slackerror.ErrInvalidManifestSource)
 	}
 }
 
 // getManifestInfoProject gathers app manifest information from "get-manifest"
-func getManifestInfoProject(ctx context.Context, clients *shared.ClientFactory) (types.AppManifest, error) {
+func getManifestInfoProject(ctx context.Context, clients *shared.ClientFactory) (manifestInfo, error) {
 	slackManifest, err := clients.AppClient().Manifest.GetManifestLocal(
 		ctx,
 		clients.SDKConfig,
 		clients.HookExecutor,
 	)
 	if err != nil {
-		return types.AppManifest{}, err
+		return manifestInfo{}, err
 	}
-	return slackManifest.AppManifest, nil
+	return manifestInfo{
+		Source:   config.ManifestSourceLocal.String(),
+		Path:     config.GetProjectHooksJSONFilePath(),
+		Manifest: slackManifest.AppManifest,
+	}, nil
 }
 
 // getManifestInfoRemote gathers app manifest information from app settings
-func getManifestInfoRemote(ctx context.Context, clients *shared.ClientFactory) (types.AppManifest, error) {
+func getManifestInfoRemote(ctx context.Context, clients *shared.ClientFactory) (manifestInfo, error) {
 	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
 	if err != nil {
-		return types.AppManifest{}, err
+		return manifestInfo{}, err
 	}
 	slackManifest, err := clients.AppClient().Manifest.GetManifestRemote(
 		ctx,
@@ -139,7 +168,11 @@ func getManifestInfoRemote(ctx context.Context, clients *shared.ClientFactory) (
 		selection.App.AppID,
 	)
 	if err != nil {
-		return types.AppManifest{}, err
+		return manifestInfo{}, err
 	}
-	return slackManifest.AppManifest, nil
+	return manifestInfo{
+		Source:   config.ManifestSourceRemote.String(),
+		AppID:    selection.App.AppID,
+		Manifest: slackManifest.AppManifest,
+	}, nil
 }
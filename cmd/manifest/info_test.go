@@ -108,6 +108,77 @@ func TestInfoCommand(t *testing.T) {
 				assert.Equal(t, string(manifest)+"\n", cm.GetStdoutOutput())
 			},
 		},
+		"outputs the --source local manifest and source details as json": {
+			CmdArgs: []string{"--source", "local", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+					AppManifest: types.AppManifest{
+						DisplayInformation: types.DisplayInformation{
+							Name: "app001",
+						},
+					},
+				}, nil)
+				cf.AppClient().Manifest = manifestMock
+				cf.SDKConfig = hooks.NewSDKConfigMock()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var actual manifestInfo
+				err := json.Unmarshal([]byte(cm.GetStdoutOutput()), &actual)
+				require.NoError(t, err)
+				assert.Equal(t, manifestInfo{
+					Source: "local",
+					Path:   config.GetProjectHooksJSONFilePath(),
+					Manifest: types.AppManifest{
+						DisplayInformation: types.DisplayInformation{
+							Name: "app001",
+						},
+					},
+				}, actual)
+			},
+		},
+		"outputs the --source remote manifest and app details as json": {
+			CmdArgs: []string{"--source", "remote", "--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appSelectMock := prompts.NewAppSelectMock()
+				appSelectPromptFunc = appSelectMock.AppSelectPrompt
+				appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps).Return(
+					prompts.SelectedApp{
+						App:  types.App{AppID: "A002"},
+						Auth: types.SlackAuth{Token: "xapp"}}, nil)
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+					AppManifest: types.AppManifest{
+						DisplayInformation: types.DisplayInformation{
+							Name: "app002",
+						},
+					},
+				}, nil)
+				cf.AppClient().Manifest = manifestMock
+				cf.SDKConfig = hooks.NewSDKConfigMock()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				var actual manifestInfo
+				err := json.Unmarshal([]byte(cm.GetStdoutOutput()), &actual)
+				require.NoError(t, err)
+				assert.Equal(t, manifestInfo{
+					Source: "remote",
+					AppID:  "A002",
+					Manifest: types.AppManifest{
+						DisplayInformation: types.DisplayInformation{
+							Name: "app002",
+						},
+					},
+				}, actual)
+			},
+		},
+		"errors when the output is an unexpected format": {
+			CmdArgs: []string{"--output", "yaml"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cf.SDKConfig = hooks.NewSDKConfigMock()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
 		"gathers manifest.source local from project configurations": {
 			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
 				appSelectMock := prompts.NewAppSelectMock()
@@ -0,0 +1,126 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/manifest"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type lintCmdFlags struct {
+	output string
+	strict bool
+}
+
+var lintFlags lintCmdFlags
+
+// NewLintCommand implements the "manifest lint" command
+func NewLintCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [flags]",
+		Short: "Check the app manifest for best practices",
+		Long: strings.Join([]string{
+			"Check the app manifest of a project for best practices without requests to Slack.",
+			"",
+			"Lint rules:",
+			formatLintRules(),
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Check the app manifest for best practices",
+				Command: "manifest lint",
+			},
+			{
+				Meaning: "Error if the app manifest has lint warnings",
+				Command: "manifest lint --strict",
+			},
+			{
+				Meaning: "Print the lint rules and warnings as JSON",
+				Command: "manifest lint --output json",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLintCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&lintFlags.output, "output", "text", "output format: text, json")
+	cmd.Flags().BoolVar(&lintFlags.strict, "strict", false, "error if any lint warnings are found")
+	return cmd
+}
+
+// runLintCommand checks the app manifest from the project against lint rules
+func runLintCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	switch lintFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", lintFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	slackManifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrAppManifestGenerate)
+	}
+	warnings := manifest.Lint(clients.Fs, slackManifest)
+	if lintFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(struct {
+			Rules    []manifest.LintRule `json:"rules"`
+			Warnings slackerror.Warnings `json:"warnings"`
+		}{
+			Rules:    manifest.LintRules,
+			Warnings: warnings,
+		})
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+	} else if len(warnings) == 0 {
+		clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "white_check_mark",
+			Text:  "No lint warnings were found in the app manifest",
+		}))
+	} else {
+		clients.IO.PrintWarning(ctx, "%s", warnings.WarningBySection(clients.Config.DebugEnabled, "The following lint warnings were found in the app manifest"))
+	}
+	if lintFlags.strict && len(warnings) > 0 {
+		return slackerror.New(slackerror.ErrManifestLintWarnings).
+			WithDetails(warningDetails(warnings))
+	}
+	return nil
+}
+
+// formatLintRules lists the codes and descriptions of lint rules
+func formatLintRules() string {
+	lines := []string{}
+	for _, rule := range manifest.LintRules {
+		lines = append(lines, fmt.Sprintf("  %s: %s", rule.Code, rule.Description))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,99 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/hooks"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintCommand(t *testing.T) {
+	lintedManifest := types.SlackYaml{
+		AppManifest: types.AppManifest{
+			Features: &types.AppFeatures{BotUser: types.BotUser{DisplayName: "tasks"}},
+			OAuthConfig: &types.OAuthConfig{
+				Scopes: &types.ManifestScopes{Bot: []string{"chat:write", "channels:history"}},
+			},
+		},
+	}
+	setupLintMocks := func(t *testing.T, cm *shared.ClientsMock, cf *shared.ClientFactory, slackManifest types.SlackYaml) {
+		manifestMock := &app.ManifestMockObject{}
+		manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(slackManifest, nil)
+		cf.AppClient().Manifest = manifestMock
+		cf.SDKConfig = hooks.NewSDKConfigMock()
+		require.NoError(t, afero.WriteFile(cm.Fs, "assets/icon.png", []byte("png"), 0o600))
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the lint warnings of the app manifest": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupLintMocks(t, cm, cf, lintedManifest)
+			},
+			ExpectedOutputs: []string{
+				"The following lint warnings were found in the app manifest",
+				"The channels:history scope grants broad access",
+			},
+		},
+		"prints that no lint warnings were found": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupLintMocks(t, cm, cf, types.SlackYaml{})
+			},
+			ExpectedStdoutOutputs: []string{"No lint warnings were found in the app manifest"},
+		},
+		"prints the lint rules and warnings as json": {
+			CmdArgs: []string{"--output", "json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupLintMocks(t, cm, cf, lintedManifest)
+			},
+			ExpectedStdoutOutputs: []string{
+				`"code": "missing_bot_display_name"`,
+				`"code": "broad_scope"`,
+				`"pointer": "/oauth_config/scopes/bot/1"`,
+			},
+		},
+		"errors with lint warnings and the strict flag": {
+			CmdArgs: []string{"--strict"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupLintMocks(t, cm, cf, lintedManifest)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrManifestLintWarnings},
+		},
+		"passes without lint warnings and the strict flag": {
+			CmdArgs: []string{"--strict"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupLintMocks(t, cm, cf, types.SlackYaml{})
+			},
+			ExpectedStdoutOutputs: []string{"No lint warnings were found in the app manifest"},
+		},
+		"errors on an unknown output format": {
+			CmdArgs:              []string{"--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewLintCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
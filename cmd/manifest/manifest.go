@@ -30,6 +30,7 @@ import (
 
 // manifestFlagSet contains persistent flag values for this command
 type manifestFlagSet struct {
+	output string
 	source string
 }
 
@@ -80,7 +81,9 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 
 	// Add child commands
+	cmd.AddCommand(NewExportCommand(clients))
 	cmd.AddCommand(NewInfoCommand(clients))
+	cmd.AddCommand(NewLintCommand(clients))
 	cmd.AddCommand(NewValidateCommand(clients))
 
 	cmd.Flags().StringVar(
@@ -16,11 +16,14 @@ package manifest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/cache"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/manifest"
@@ -36,13 +39,39 @@ import (
 // TODO - Find best practice, such as using an Interface and Struct to create a client
 var manifestValidateFunc = manifest.ManifestValidate
 
+// validateFlagSet contains flag values for the validate command
+type validateFlagSet struct {
+	against      string
+	diffBreaking bool
+	noCache      bool
+	noPrompt     bool
+	output       string
+	runtime      string
+	strict       bool
+}
+
+// validateFlags has the set flag values
+var validateFlags validateFlagSet
+
 func NewValidateCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validate",
 		Short: "Validate the app manifest generated by a project",
-		Long:  "Validate the app manifest generated from a valid project directory",
+		Long: strings.Join([]string{
+			"Validate the app manifest generated from a valid project directory",
+			"",
+			"The manifest is validated against the settings of an installed app, so warnings",
+			"can differ between the local and deployed apps of a project. Choose the app with",
+			"the --against flag to validate against the same app each time.",
+		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "manifest validate", Meaning: "Validate the app manifest generated by a project"},
+			{Command: "manifest validate --against deployed", Meaning: "Validate the app manifest against the deployed app"},
+			{Command: "manifest validate --strict", Meaning: "Fail validation if any warnings are raised"},
+			{Command: "manifest validate --no-cache", Meaning: "Validate with the API even if the manifest is unchanged"},
+			{Command: "manifest validate --runtime deno", Meaning: "Validate the manifest as it would be for a hosted app"},
+			{Command: "manifest validate --diff-breaking", Meaning: "List only the breaking changes of the app manifest"},
+			{Command: "manifest validate --diff-breaking --output json", Meaning: "List the breaking changes as JSON"},
 		}),
 		Aliases: []string{"verify", "check"},
 		Args:    cobra.NoArgs,
@@ -58,12 +87,32 @@ func NewValidateCommand(clients *shared.ClientFactory) *cobra.Command {
 			var span, _ = opentracing.StartSpanFromContext(ctx, "cmd.manifest.validate")
 			defer span.Finish()
 
+			if err := manifest.ValidateRuntime(validateFlags.runtime); err != nil {
+				return err
+			}
+			switch validateFlags.output {
+			case "text", "json":
+			default:
+				return slackerror.New(slackerror.ErrInvalidFlag).
+					WithMessage("Invalid output format: %s", validateFlags.output).
+					WithRemediation("Use one of: text, json")
+			}
+			if validateFlags.output == "json" && !validateFlags.diffBreaking {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --output flag can only be used with the --diff-breaking flag")
+			}
+
+			environment, err := validateAgainstEnvironment(clients)
+			if err != nil {
+				return err
+			}
+
 			// Get the app selection and accompanying auth of an installed app or gather
-			// some other authentication token
+			// some other authentication token unless an app was chosen to validate against
 			var token string
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
+			selection, err := appSelectPromptFunc(ctx, clients, environment, prompts.ShowInstalledAppsOnly)
 			if err != nil {
-				if slackerror.ToSlackError(err).Code != slackerror.ErrInstallationRequired {
+				if slackerror.ToSlackError(err).Code != slackerror.ErrInstallationRequired || validateFlags.against != "" {
 					return err
 				}
 				auth, err := gatherAuthenticationToken(ctx, clients)
@@ -75,14 +124,63 @@ func NewValidateCommand(clients *shared.ClientFactory) *cobra.Command {
 				token = selection.Auth.Token
 			}
 
+			if validateFlags.against != "" && validateFlags.output != "json" {
+				clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf("Validating the app manifest against app %s on %s", selection.App.AppID, selection.App.TeamDomain)))
+			}
+
 			clients.Config.ManifestEnv = app.SetManifestEnvTeamVars(clients.Config.ManifestEnv, selection.App.TeamDomain, selection.App.IsDev)
 
-			isValid, warn, err := manifestValidateFunc(ctx, clients, selection.App, token)
+			// Skip validation with the API if this manifest was already found valid
+			var slackManifest *types.SlackYaml
+			var hash cache.Hash
+			caches := selection.App.AppID != "" && validateFlags.runtime == "" && !validateFlags.noCache && !clients.Config.ForceFlag
+			if caches {
+				localManifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+				if err != nil {
+					return slackerror.Wrap(err, slackerror.ErrAppManifestGenerate)
+				}
+				slackManifest = &localManifest
+				hash, caches = getManifestHash(ctx, clients, localManifest.AppManifest)
+			}
+			if caches {
+				saved, err := clients.Config.ProjectConfig.Cache().GetValidatedManifestHash(ctx, selection.App.AppID)
+				if err != nil {
+					clients.IO.PrintDebug(ctx, "failed to read the validated manifest hash: %s", err)
+				} else if hash.Equals(saved) {
+					if validateFlags.diffBreaking {
+						return printBreakingChanges(ctx, clients, slackerror.Warnings{})
+					}
+					cmd.Printf(
+						"\n%s: %s %s\n",
+						style.Bold("App Manifest Validation Result"),
+						style.Green("Valid"),
+						style.Secondary("(cached)"),
+					)
+					clients.IO.PrintTrace(ctx, slacktrace.ManifestValidateSuccess)
+					return nil
+				}
+			}
+
+			noPrompt := validateFlags.noPrompt || validateFlags.strict || validateFlags.diffBreaking
+			isValid, warn, err := manifestValidateFunc(ctx, clients, selection.App, token, slackManifest, noPrompt, validateFlags.runtime)
 			if err != nil {
 				return err
 			}
+			// Only results without warnings are remembered as valid
+			if caches && isValid && len(warn) == 0 {
+				err := clients.Config.ProjectConfig.Cache().SetValidatedManifestHash(ctx, selection.App.AppID, hash)
+				if err != nil {
+					clients.IO.PrintDebug(ctx, "failed to save the validated manifest hash: %s", err)
+				}
+			}
+			if validateFlags.diffBreaking {
+				return printBreakingChanges(ctx, clients, filterBreakingChanges(warn))
+			}
+			if validateFlags.strict && len(warn) > 0 {
+				return newStrictValidationError(warn)
+			}
 			if warn != nil {
-				clients.IO.PrintWarning(ctx, "%s", warn.Warning(clients.Config.DebugEnabled, "The following warnings were raised during manifest validation"))
+				clients.IO.PrintWarning(ctx, "%s", warn.WarningBySection(clients.Config.DebugEnabled, "The following warnings were raised during manifest validation"))
 				return nil
 			}
 			if isValid {
@@ -103,9 +201,120 @@ func NewValidateCommand(clients *shared.ClientFactory) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&validateFlags.against, "against", "", "validate against the deployed app, the local\n  app, or an app ID")
+	cmd.Flags().BoolVar(&validateFlags.diffBreaking, "diff-breaking", false, "list only breaking changes and error if any exist\n  unless the --force flag is set")
+	cmd.Flags().BoolVar(&validateFlags.noCache, "no-cache", false, "validate with the API even if the manifest is unchanged")
+	cmd.Flags().BoolVar(&validateFlags.noPrompt, "no-prompt", false, "validate without prompts to approve connectors")
+	cmd.Flags().StringVar(&validateFlags.output, "output", "text", "output format of breaking changes: text, json")
+	cmd.Flags().StringVar(&validateFlags.runtime, "runtime", "", "validate the manifest as a runtime: bolt, deno")
+	cmd.Flags().BoolVar(&validateFlags.strict, "strict", false, "treat warnings as errors and skip prompts")
+	cmd.MarkFlagsMutuallyExclusive("diff-breaking", "strict")
+
 	return cmd
 }
 
+// validateAgainstEnvironment returns the environment of apps to select from
+// with the --against flag and uses an app ID of the flag as the app to select
+func validateAgainstEnvironment(clients *shared.ClientFactory) (prompts.AppEnvironmentType, error) {
+	against := validateFlags.against
+	if against == "" {
+		return prompts.ShowAllEnvironments, nil
+	}
+	if clients.Config.AppFlag != "" && clients.Config.AppFlag != against {
+		return prompts.ShowAllEnvironments, slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --against flag cannot be used with the --app flag")
+	}
+	switch {
+	case against == "deployed":
+		return prompts.ShowHostedOnly, nil
+	case against == "local":
+		return prompts.ShowLocalOnly, nil
+	case types.IsAppID(against):
+		clients.Config.AppFlag = against
+		return prompts.ShowAllEnvironments, nil
+	}
+	return prompts.ShowAllEnvironments, slackerror.New(slackerror.ErrInvalidFlag).
+		WithMessage("Invalid app to validate against: %s", against).
+		WithRemediation("Use one of: deployed, local, or an app ID")
+}
+
+// getManifestHash returns a hash of the manifest and if the hash can be used to
+// cache validation results. Problems with the cache are logged and skip caching
+func getManifestHash(ctx context.Context, clients *shared.ClientFactory, appManifest types.AppManifest) (cache.Hash, bool) {
+	hash, err := clients.Config.ProjectConfig.Cache().NewManifestHash(ctx, appManifest)
+	if err != nil {
+		clients.IO.PrintDebug(ctx, "failed to hash the manifest for the validation cache: %s", err)
+		return "", false
+	}
+	return hash, true
+}
+
+// newStrictValidationError returns an error with the manifest validation
+// warnings as details
+func newStrictValidationError(warnings slackerror.Warnings) error {
+	return slackerror.New(slackerror.ErrAppManifestValidate).
+		WithMessage("Warnings were raised during manifest validation").
+		WithRemediation("Resolve the warnings or remove the --strict flag to continue").
+		WithDetails(warningDetails(warnings))
+}
+
+// warningDetails returns the manifest validation warnings as error details
+func warningDetails(warnings slackerror.Warnings) slackerror.ErrorDetails {
+	details := slackerror.ErrorDetails{}
+	for _, warning := range warnings {
+		details = append(details, slackerror.ErrorDetail{
+			Code:        warning.Code,
+			Message:     warning.Message,
+			Pointer:     warning.Pointer,
+			Remediation: warning.Remediation,
+		})
+	}
+	return details
+}
+
+// filterBreakingChanges returns the warnings that are breaking changes
+func filterBreakingChanges(warnings slackerror.Warnings) slackerror.Warnings {
+	breaking := slackerror.Warnings{}
+	for _, warning := range warnings {
+		if warning.Code == "breaking_change" {
+			breaking = append(breaking, warning)
+		}
+	}
+	return breaking
+}
+
+// printBreakingChanges outputs the breaking changes of the app manifest and
+// errors if any exist unless the --force flag is set
+func printBreakingChanges(ctx context.Context, clients *shared.ClientFactory, breaking slackerror.Warnings) error {
+	switch {
+	case validateFlags.output == "json":
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(struct {
+			BreakingChanges slackerror.Warnings `json:"breaking_changes"`
+		}{
+			BreakingChanges: breaking,
+		})
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+	case len(breaking) == 0:
+		clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "white_check_mark",
+			Text:  "No breaking changes were found in the app manifest",
+		}))
+	case clients.Config.ForceFlag:
+		clients.IO.PrintWarning(ctx, "%s", breaking.WarningBySection(clients.Config.DebugEnabled, "The following breaking changes were found in the app manifest"))
+	}
+	if len(breaking) == 0 || clients.Config.ForceFlag {
+		return nil
+	}
+	return slackerror.New(slackerror.ErrAppManifestValidate).
+		WithMessage("Breaking changes were found in the app manifest").
+		WithRemediation("Review the breaking changes or continue with the --force flag").
+		WithDetails(warningDetails(breaking))
+}
+
 // gatherAuthenticationToken returns some user token and configures authentication
 // internals for API use
 func gatherAuthenticationToken(ctx context.Context, clients *shared.ClientFactory) (auth types.SlackAuth, err error) {
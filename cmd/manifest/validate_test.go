@@ -16,17 +16,22 @@ package manifest
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/hooks"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -36,8 +41,8 @@ type ManifestValidatePkgMock struct {
 	mock.Mock
 }
 
-func (m *ManifestValidatePkgMock) ManifestValidate(ctx context.Context, clients *shared.ClientFactory, app types.App, token string) (bool, slackerror.Warnings, error) {
-	args := m.Called(ctx, clients, app, token)
+func (m *ManifestValidatePkgMock) ManifestValidate(ctx context.Context, clients *shared.ClientFactory, app types.App, token string, slackManifest *types.SlackYaml, noPrompt bool, runtime string) (bool, slackerror.Warnings, error) {
+	args := m.Called(ctx, clients, app, token, slackManifest, noPrompt, runtime)
 	return args.Bool(0), args.Get(1).(slackerror.Warnings), args.Error(2)
 }
 
@@ -63,13 +68,13 @@ func TestManifestValidateCommand(t *testing.T) {
 	manifestValidatePkgMock := new(ManifestValidatePkgMock)
 	manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
 
-	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
+	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
 	err := cmd.ExecuteContext(ctx)
 	if err != nil {
 		assert.Fail(t, "cmd.Execute had unexpected error")
 	}
 
-	manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestManifestValidateCommand_HandleMissingAppInstallError_ZeroUserAuth(t *testing.T) {
@@ -138,7 +143,7 @@ func TestManifestValidateCommand_HandleMissingAppInstallError_OneUserAuth(t *tes
 	// Mock the manifest validate package
 	manifestValidatePkgMock := new(ManifestValidatePkgMock)
 	manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
-	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
+	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
 
 	// Should execute without error
 	err := cmd.ExecuteContext(ctx)
@@ -200,7 +205,7 @@ func TestManifestValidateCommand_HandleMissingAppInstallError_MoreThanOneUserAut
 	// Mock the manifest validate package
 	manifestValidatePkgMock := new(ManifestValidatePkgMock)
 	manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
-	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
+	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
 
 	// Should execute without error
 	err := cmd.ExecuteContext(ctx)
@@ -226,12 +231,329 @@ func TestManifestValidateCommand_InvalidManifest(t *testing.T) {
 
 	manifestValidatePkgMock := new(ManifestValidatePkgMock)
 	manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
-	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, slackerror.Warnings{}, nil)
+	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, slackerror.Warnings{}, nil)
 
 	err := cmd.ExecuteContext(ctx)
 	require.NoError(t, err)
 }
 
+func TestManifestValidateCommand_Strict(t *testing.T) {
+	tests := map[string]struct {
+		args             []string
+		warnings         slackerror.Warnings
+		expectedNoPrompt bool
+		expectedError    string
+	}{
+		"warnings are not errors by default": {
+			args:             []string{},
+			warnings:         slackerror.Warnings{{Code: "dummy_warning", Message: "A warning"}},
+			expectedNoPrompt: false,
+		},
+		"no prompt flag skips prompts": {
+			args:             []string{"--no-prompt"},
+			warnings:         slackerror.Warnings{},
+			expectedNoPrompt: true,
+		},
+		"strict flag without warnings succeeds": {
+			args:             []string{"--strict"},
+			warnings:         slackerror.Warnings{},
+			expectedNoPrompt: true,
+		},
+		"strict flag with warnings errors": {
+			args:             []string{"--strict"},
+			warnings:         slackerror.Warnings{{Code: "dummy_warning", Message: "A warning"}},
+			expectedNoPrompt: true,
+			expectedError:    slackerror.ErrAppManifestValidate,
+		},
+		"strict flag with breaking changes errors": {
+			args:             []string{"--strict", "--no-prompt"},
+			warnings:         slackerror.Warnings{{Code: "breaking_change", Message: "A breaking change"}},
+			expectedNoPrompt: true,
+			expectedError:    slackerror.ErrAppManifestValidate,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+
+			cmd := NewValidateCommand(clients)
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).Return(prompts.SelectedApp{}, nil)
+
+			manifestValidatePkgMock := new(ManifestValidatePkgMock)
+			manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
+			manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(len(tc.warnings) == 0, tc.warnings, nil)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				assert.Len(t, slackerror.ToSlackError(err).Details, len(tc.warnings))
+			} else {
+				require.NoError(t, err)
+			}
+			manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, tc.expectedNoPrompt, mock.Anything)
+		})
+	}
+}
+
+func TestManifestValidateCommand_DiffBreaking(t *testing.T) {
+	tests := map[string]struct {
+		args            []string
+		force           bool
+		warnings        slackerror.Warnings
+		expectedError   string
+		expectedDetails int
+		expectedStdout  []string
+	}{
+		"notes when no breaking changes exist": {
+			args:           []string{"--diff-breaking"},
+			warnings:       slackerror.Warnings{{Code: "dummy_warning", Message: "A warning"}},
+			expectedStdout: []string{"No breaking changes were found in the app manifest"},
+		},
+		"errors with only the breaking changes": {
+			args: []string{"--diff-breaking"},
+			warnings: slackerror.Warnings{
+				{Code: "dummy_warning", Message: "A warning"},
+				{Code: "breaking_change", Message: "A breaking change", Pointer: "/functions/greet"},
+			},
+			expectedError:   slackerror.ErrAppManifestValidate,
+			expectedDetails: 1,
+		},
+		"warns of breaking changes with the force flag": {
+			args:           []string{"--diff-breaking"},
+			force:          true,
+			warnings:       slackerror.Warnings{{Code: "breaking_change", Message: "A breaking change"}},
+			expectedStdout: []string{"A breaking change"},
+		},
+		"groups breaking changes by manifest section with the force flag": {
+			args:  []string{"--diff-breaking"},
+			force: true,
+			warnings: slackerror.Warnings{
+				{Code: "breaking_change", Message: "A removed function", Pointer: "/functions/greet"},
+				{Code: "breaking_change", Message: "A removed scope", Pointer: "/oauth_config/scopes/bot"},
+			},
+			expectedStdout: []string{"functions", "A removed function", "oauth_config", "A removed scope"},
+		},
+		"outputs breaking changes as json": {
+			args: []string{"--diff-breaking", "--output", "json"},
+			warnings: slackerror.Warnings{
+				{Code: "dummy_warning", Message: "A warning"},
+				{Code: "breaking_change", Message: "A breaking change", Pointer: "/functions/greet"},
+			},
+			expectedError:   slackerror.ErrAppManifestValidate,
+			expectedDetails: 1,
+			expectedStdout: []string{
+				`"breaking_changes": [`,
+				`"code": "breaking_change"`,
+				`"pointer": "/functions/greet"`,
+			},
+		},
+		"outputs an empty list as json without breaking changes": {
+			args:           []string{"--diff-breaking", "--output", "json"},
+			warnings:       slackerror.Warnings{},
+			expectedStdout: []string{`"breaking_changes": []`},
+		},
+		"errors if the output is set without diff breaking": {
+			args:          []string{"--output", "json"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+				clients.Config.ForceFlag = tc.force
+			})
+
+			cmd := NewValidateCommand(clients)
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).Return(prompts.SelectedApp{}, nil)
+
+			manifestValidatePkgMock := new(ManifestValidatePkgMock)
+			manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
+			manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(len(tc.warnings) == 0, tc.warnings, nil)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				assert.Len(t, slackerror.ToSlackError(err).Details, tc.expectedDetails)
+			} else {
+				require.NoError(t, err)
+				manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true, mock.Anything)
+			}
+			for _, expected := range tc.expectedStdout {
+				assert.Contains(t, clientsMock.GetStdoutOutput(), expected)
+			}
+		})
+	}
+}
+
+func TestManifestValidateCommand_Runtime(t *testing.T) {
+	tests := map[string]struct {
+		args            []string
+		expectedRuntime string
+		expectedError   string
+	}{
+		"runtime is not set by default": {
+			args:            []string{},
+			expectedRuntime: "",
+		},
+		"runtime flag validates as the runtime": {
+			args:            []string{"--runtime", "deno"},
+			expectedRuntime: "deno",
+		},
+		"runtime flag errors for an unknown runtime": {
+			args:          []string{"--runtime", "java"},
+			expectedError: slackerror.ErrInvalidFlag,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+
+			cmd := NewValidateCommand(clients)
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).Return(prompts.SelectedApp{}, nil)
+
+			manifestValidatePkgMock := new(ManifestValidatePkgMock)
+			manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
+			manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				manifestValidatePkgMock.AssertNotCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, tc.expectedRuntime)
+			}
+		})
+	}
+}
+
+func TestManifestValidateCommand_Against(t *testing.T) {
+	tests := map[string]struct {
+		args                []string
+		appFlag             string
+		selectErr           error
+		expectedEnvironment prompts.AppEnvironmentType
+		expectedAppFlag     string
+		expectedOutput      string
+		expectedError       string
+	}{
+		"selects from all apps by default": {
+			args:                []string{},
+			expectedEnvironment: prompts.ShowAllEnvironments,
+		},
+		"selects from the deployed apps": {
+			args:                []string{"--against", "deployed"},
+			expectedEnvironment: prompts.ShowHostedOnly,
+			expectedOutput:      "Validating the app manifest against app A0123456789 on example",
+		},
+		"selects from the local apps": {
+			args:                []string{"--against", "local"},
+			expectedEnvironment: prompts.ShowLocalOnly,
+			expectedOutput:      "Validating the app manifest against app A0123456789 on example",
+		},
+		"selects the app of an app ID": {
+			args:                []string{"--against", "A0123456789"},
+			expectedEnvironment: prompts.ShowAllEnvironments,
+			expectedAppFlag:     "A0123456789",
+			expectedOutput:      "Validating the app manifest against app A0123456789 on example",
+		},
+		"errors without an installed app to validate against": {
+			args:                []string{"--against", "deployed"},
+			selectErr:           slackerror.New(slackerror.ErrInstallationRequired),
+			expectedEnvironment: prompts.ShowHostedOnly,
+			expectedError:       slackerror.ErrInstallationRequired,
+		},
+		"errors with a different --app flag": {
+			args:          []string{"--against", "deployed"},
+			appFlag:       "local",
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for an unknown app to validate against": {
+			args:          []string{"--against", "staging"},
+			expectedError: slackerror.ErrInvalidFlag,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+				clients.Config.AppFlag = tc.appFlag
+			})
+
+			cmd := NewValidateCommand(clients)
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, mock.Anything, prompts.ShowInstalledAppsOnly).Return(prompts.SelectedApp{
+				App: types.App{AppID: "A0123456789", TeamDomain: "example"},
+			}, tc.selectErr)
+
+			manifestValidatePkgMock := new(ManifestValidatePkgMock)
+			manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
+			manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				manifestValidatePkgMock.AssertNotCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.Auth.AssertNotCalled(t, "Auths", mock.Anything)
+			} else {
+				require.NoError(t, err)
+				manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				assert.Equal(t, tc.expectedAppFlag, clients.Config.AppFlag)
+				assert.Contains(t, clientsMock.GetStdoutOutput(), tc.expectedOutput)
+			}
+			if tc.expectedError == slackerror.ErrMismatchedFlags || tc.expectedError == slackerror.ErrInvalidFlag {
+				appSelectMock.AssertNotCalled(t, "AppSelectPrompt", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				appSelectMock.AssertCalled(t, "AppSelectPrompt", mock.Anything, mock.Anything, tc.expectedEnvironment, prompts.ShowInstalledAppsOnly)
+			}
+		})
+	}
+}
+
 func TestManifestValidateCommand_HandleOtherErrors(t *testing.T) {
 	// Create mocks
 	ctx := slackcontext.MockContext(t.Context())
@@ -256,3 +578,156 @@ func TestManifestValidateCommand_HandleOtherErrors(t *testing.T) {
 	err := cmd.ExecuteContext(ctx)
 	require.ErrorContains(t, err, errMsg)
 }
+
+func TestManifestValidateCommand_Cache(t *testing.T) {
+	tests := map[string]struct {
+		args                    []string
+		force                   bool
+		savedMatches            bool
+		warnings                slackerror.Warnings
+		expectedValidateCalled  bool
+		expectedValidatedCached bool
+		expectedOutput          string
+	}{
+		"unchanged manifests that were validated skip the api": {
+			savedMatches:            true,
+			expectedValidateCalled:  false,
+			expectedValidatedCached: true,
+			expectedOutput:          "Valid (cached)",
+		},
+		"changed manifests are validated and cached": {
+			expectedValidateCalled:  true,
+			expectedValidatedCached: true,
+			expectedOutput:          "Valid",
+		},
+		"manifests with warnings are not cached": {
+			warnings:                slackerror.Warnings{{Code: "dummy_warning", Message: "A warning"}},
+			expectedValidateCalled:  true,
+			expectedValidatedCached: false,
+		},
+		"no cache flag validates unchanged manifests": {
+			args:                    []string{"--no-cache"},
+			savedMatches:            true,
+			expectedValidateCalled:  true,
+			expectedValidatedCached: true,
+			expectedOutput:          "Valid",
+		},
+		"runtime flag validates unchanged manifests": {
+			args:                    []string{"--runtime", "bolt"},
+			savedMatches:            true,
+			expectedValidateCalled:  true,
+			expectedValidatedCached: true,
+			expectedOutput:          "Valid",
+		},
+		"force flag validates unchanged manifests": {
+			force:                   true,
+			savedMatches:            true,
+			expectedValidateCalled:  true,
+			expectedValidatedCached: true,
+			expectedOutput:          "Valid",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			err := afero.WriteFile(clientsMock.Fs, config.GetProjectHooksJSONFilePath(slackdeps.MockWorkingDirectory), []byte("{}"), 0o600)
+			require.NoError(t, err)
+
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+			clients.Config.ForceFlag = tc.force
+
+			mockManifest := types.SlackYaml{
+				AppManifest: types.AppManifest{
+					DisplayInformation: types.DisplayInformation{Name: "example"},
+				},
+			}
+			manifestMock := &app.ManifestMockObject{}
+			manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+			clients.AppClient().Manifest = manifestMock
+
+			hash, err := clients.Config.ProjectConfig.Cache().NewManifestHash(ctx, mockManifest.AppManifest)
+			require.NoError(t, err)
+			if tc.savedMatches {
+				err = clients.Config.ProjectConfig.Cache().SetValidatedManifestHash(ctx, "A123", hash)
+				require.NoError(t, err)
+			}
+
+			cmd := NewValidateCommand(clients)
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).Return(prompts.SelectedApp{
+				App: types.App{AppID: "A123"},
+			}, nil)
+
+			manifestValidatePkgMock := new(ManifestValidatePkgMock)
+			manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
+			manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(len(tc.warnings) == 0, tc.warnings, nil)
+
+			err = cmd.ExecuteContext(ctx)
+			require.NoError(t, err)
+			if tc.expectedValidateCalled {
+				manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				manifestValidatePkgMock.AssertNotCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			saved, err := clients.Config.ProjectConfig.Cache().GetValidatedManifestHash(ctx, "A123")
+			require.NoError(t, err)
+			if tc.expectedValidatedCached {
+				assert.Equal(t, hash, saved)
+			} else {
+				assert.Empty(t, saved)
+			}
+			assert.Contains(t, clientsMock.GetStdoutOutput(), tc.expectedOutput)
+		})
+	}
+}
+
+func TestManifestValidateCommand_CacheErrors(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	err := afero.WriteFile(clientsMock.Fs, config.GetProjectHooksJSONFilePath(slackdeps.MockWorkingDirectory), []byte("{}"), 0o600)
+	require.NoError(t, err)
+	err = clientsMock.Fs.MkdirAll(filepath.Join(slackdeps.MockWorkingDirectory, ".slack", "cache"), 0o755)
+	require.NoError(t, err)
+	err = afero.WriteFile(clientsMock.Fs, filepath.Join(slackdeps.MockWorkingDirectory, ".slack", "cache", "manifests.json"), []byte("{"), 0o600)
+	require.NoError(t, err)
+
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+		clients.SDKConfig = hooks.NewSDKConfigMock()
+	})
+	mockManifest := types.SlackYaml{
+		AppManifest: types.AppManifest{
+			DisplayInformation: types.DisplayInformation{Name: "example"},
+		},
+	}
+	manifestMock := &app.ManifestMockObject{}
+	manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest, nil)
+	clients.AppClient().Manifest = manifestMock
+
+	cmd := NewValidateCommand(clients)
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectPromptFunc = appSelectMock.AppSelectPrompt
+	appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly).Return(prompts.SelectedApp{
+		App: types.App{AppID: "A123"},
+	}, nil)
+
+	manifestValidatePkgMock := new(ManifestValidatePkgMock)
+	manifestValidateFunc = manifestValidatePkgMock.ManifestValidate
+	manifestValidatePkgMock.On("ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, slackerror.Warnings{}, nil)
+
+	err = cmd.ExecuteContext(ctx)
+	require.NoError(t, err)
+	manifestMock.AssertNumberOfCalls(t, "GetManifestLocal", 1)
+	manifestValidatePkgMock.AssertCalled(t, "ManifestValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, &mockManifest, mock.Anything, mock.Anything)
+	assert.Contains(t, clientsMock.GetStdoutOutput(), "Valid")
+}
@@ -0,0 +1,57 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+// NewAppCommand returns a new Cobra command for open app
+func NewAppCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app [flags]",
+		Short: "Open the app directory page of an app",
+		Long:  "Open the app directory page of an app in a web browser",
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "open app", Meaning: "Open the app directory page of a selected app"},
+			{Command: "open app --app A0123456789 --no-open", Meaning: "Print the app directory URL of an app"},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOpenAppCommand(cmd, clients)
+		},
+	}
+	addNoOpenFlag(cmd)
+	return cmd
+}
+
+// runOpenAppCommand opens the app directory page of the selected app
+func runOpenAppCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndUninstalledApps)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.AppExists(selection.App, selection.Auth); err != nil {
+		return err
+	}
+	openURL(ctx, clients, "house", "App Directory", api.AppDirectoryURL(clients.API().Host(), selection.App.AppID))
+	return nil
+}
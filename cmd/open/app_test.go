@@ -0,0 +1,72 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOpenAppCommand(t *testing.T) {
+	var teardown func()
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"opens the app directory page of the app": {
+			ExpectedOutputs: []string{"App Directory", "https://slack.com/apps/A0123456789"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teardown = setupMockAppSelection(prompts.SelectedApp{App: types.App{AppID: "A0123456789"}}, nil)
+				cm.API.On("Host").Return("https://slack.com")
+			},
+			Teardown: func() {
+				teardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Browser.AssertCalled(t, "OpenURL", "https://slack.com/apps/A0123456789")
+			},
+		},
+		"prints the url without opening a browser": {
+			CmdArgs:         []string{"--no-open"},
+			ExpectedOutputs: []string{"https://slack.com/apps/A0123456789"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teardown = setupMockAppSelection(prompts.SelectedApp{App: types.App{AppID: "A0123456789"}}, nil)
+				cm.API.On("Host").Return("https://slack.com")
+			},
+			Teardown: func() {
+				teardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Browser.AssertNotCalled(t, "OpenURL", mock.Anything)
+			},
+		},
+		"errors if the app is not created": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teardown = setupMockAppSelection(prompts.SelectedApp{App: types.App{}}, nil)
+			},
+			Teardown: func() {
+				teardown()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppNotInstalled},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		return NewAppCommand(cf)
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"context"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type openCmdFlags struct {
+	noOpen bool
+}
+
+var openFlags openCmdFlags
+
+var appSelectPromptFunc = prompts.AppSelectPrompt
+
+// NewCommand returns a new Cobra command for open
+func NewCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open <subcommand>",
+		Short: "Open Slack pages of an app in a browser",
+		Long: strings.Join([]string{
+			"Open the Slack pages of an app and its triggers in a web browser.",
+			"",
+			"The URL is printed instead when a browser cannot be opened.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "open app --app A0123456789", Meaning: "Open the app directory page of an app"},
+			{Command: "open trigger --trigger-id Ft01234ABCD", Meaning: "Open the shortcut URL of a trigger"},
+			{Command: "open app --no-open", Meaning: "Print the URL of a selected app without opening a browser"},
+		}),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// Add child commands
+	cmd.AddCommand(NewAppCommand(clients))
+	cmd.AddCommand(NewTriggerCommand(clients))
+
+	return cmd
+}
+
+// addNoOpenFlag adds the flag to print the URL without opening a browser
+func addNoOpenFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&openFlags.noOpen, "no-open", false, "print the URL without opening a browser")
+}
+
+// openURL outputs the URL and opens it in a browser unless the --no-open flag
+// is set
+func openURL(ctx context.Context, clients *shared.ClientFactory, emoji string, text string, url string) {
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: emoji,
+		Text:  text,
+		Secondary: []string{
+			url,
+		},
+	}))
+	if !openFlags.noOpen {
+		clients.Browser().OpenURL(url)
+	}
+}
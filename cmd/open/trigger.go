@@ -0,0 +1,90 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type triggerCmdFlags struct {
+	triggerID string
+}
+
+var triggerFlags triggerCmdFlags
+
+// NewTriggerCommand returns a new Cobra command for open trigger
+func NewTriggerCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger --trigger-id <id>",
+		Short: "Open the shortcut URL of a trigger",
+		Long: strings.Join([]string{
+			"Open the shortcut URL of a link trigger in a web browser.",
+			"",
+			"Only link triggers have a shortcut URL that can be opened.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "open trigger --trigger-id Ft01234ABCD", Meaning: "Open the shortcut URL of a trigger"},
+			{Command: "open trigger --trigger-id Ft01234ABCD --no-open", Meaning: "Print the shortcut URL of a trigger"},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOpenTriggerCommand(cmd, clients)
+		},
+	}
+	cmd.Flags().StringVar(&triggerFlags.triggerID, "trigger-id", "", "the ID of the trigger")
+	addNoOpenFlag(cmd)
+	return cmd
+}
+
+// runOpenTriggerCommand opens the shortcut URL of a trigger of the selected app
+func runOpenTriggerCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	ctx := cmd.Context()
+	if triggerFlags.triggerID == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The --trigger-id flag is required").
+			WithRemediation("Find the ID of a trigger with %s", style.Commandf("trigger list", false))
+	}
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.AppExists(selection.App, selection.Auth); err != nil {
+		return err
+	}
+	token := selection.Auth.Token
+	ctx = config.SetContextToken(ctx, token)
+	trigger, err := clients.API().WorkflowsTriggersInfo(ctx, token, triggerFlags.triggerID)
+	if err != nil {
+		return err
+	}
+	if trigger.ShortcutURL == "" {
+		return slackerror.New(slackerror.ErrInvalidTriggerType).
+			WithMessage("The trigger %s does not have a shortcut URL", trigger.ID).
+			WithRemediation("Only link triggers have a shortcut URL that can be opened")
+	}
+	openURL(ctx, clients, "zap", "Trigger Shortcut", trigger.ShortcutURL)
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/prompts"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOpenTriggerCommand(t *testing.T) {
+	var teardown func()
+	installedApp := prompts.SelectedApp{Auth: types.SlackAuth{Token: "xoxp-example"}, App: types.App{AppID: "A0123456789"}}
+	shortcutURL := "https://slack.com/shortcuts/Ft01234ABCD/abc123"
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"opens the shortcut url of a trigger": {
+			CmdArgs:         []string{"--trigger-id", "Ft01234ABCD"},
+			ExpectedOutputs: []string{"Trigger Shortcut", shortcutURL},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teardown = setupMockAppSelection(installedApp, nil)
+				cm.API.On("WorkflowsTriggersInfo", mock.Anything, "xoxp-example", "Ft01234ABCD").
+					Return(types.DeployedTrigger{ID: "Ft01234ABCD", ShortcutURL: shortcutURL}, nil)
+			},
+			Teardown: func() {
+				teardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Browser.AssertCalled(t, "OpenURL", shortcutURL)
+			},
+		},
+		"prints the shortcut url without opening a browser": {
+			CmdArgs:         []string{"--trigger-id", "Ft01234ABCD", "--no-open"},
+			ExpectedOutputs: []string{shortcutURL},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teardown = setupMockAppSelection(installedApp, nil)
+				cm.API.On("WorkflowsTriggersInfo", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.DeployedTrigger{ID: "Ft01234ABCD", ShortcutURL: shortcutURL}, nil)
+			},
+			Teardown: func() {
+				teardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.Browser.AssertNotCalled(t, "OpenURL", mock.Anything)
+			},
+		},
+		"errors for a trigger without a shortcut url": {
+			CmdArgs: []string{"--trigger-id", "Ft01234ABCD"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				teardown = setupMockAppSelection(installedApp, nil)
+				cm.API.On("WorkflowsTriggersInfo", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.DeployedTrigger{ID: "Ft01234ABCD", Type: "webhook"}, nil)
+			},
+			Teardown: func() {
+				teardown()
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidTriggerType, "The trigger Ft01234ABCD does not have a shortcut URL"},
+		},
+		"errors without a trigger id": {
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag, "The --trigger-id flag is required"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewTriggerCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -18,13 +18,18 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/slackapi/slack-cli/cmd/app"
 	"github.com/slackapi/slack-cli/cmd/feedback"
 	"github.com/slackapi/slack-cli/cmd/triggers"
+	internalapp "github.com/slackapi/slack-cli/internal/app"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/deputil"
 	"github.com/slackapi/slack-cli/internal/hooks"
+	"github.com/slackapi/slack-cli/internal/manifest"
+	"github.com/slackapi/slack-cli/internal/pkg/apps"
 	"github.com/slackapi/slack-cli/internal/pkg/platform"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
@@ -42,9 +47,35 @@ var deployFunc = platform.Deploy
 // TODO - Same as above, but probably even worse
 var runAddCommandFunc = app.RunAddCommand
 
+// Handle to the install function used for testing
+var installManifestFunc = apps.Install
+
+// Handle to the manifest rollback function used for testing
+var rollbackManifestFunc = apps.RollbackManifest
+
+// Handle to the git details of a project used for testing
+var getGitMetadataFunc = deputil.GetGitMetadata
+
+// Handle to the git changes of a project used for testing
+var getGitChangesFunc = deputil.GetGitChanges
+
 type deployCmdFlags struct {
+	appFile             string
+	batch               cmdutil.BatchFlags
+	concurrency         int
+	failOnWarning       bool
+	gitMetadata         bool
 	hideTriggers        bool
+	ifChanged           bool
+	manifestVars        []string
+	maxUploadRetries    int
+	message             string
+	noInstall           bool
+	only                string
 	orgGrantWorkspaceID string
+	rollback            bool
+	sinceCommit         string
+	skipValidation      bool
 }
 
 var deployFlags deployCmdFlags
@@ -53,47 +84,117 @@ func NewDeployCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deploy [flags]",
 		Short: "Deploy the app to the Slack Platform",
-		Long:  `Deploy the app to the Slack Platform`,
+		Long: strings.Join([]string{
+			"Deploy the app to the Slack Platform",
+			"",
+			"The --app-file flag deploys each app listed in a YAML or JSON file. Each app",
+			"has an app_id with an optional team and a list of manifest_vars like:",
+			"",
+			"  apps:",
+			"    - app_id: A0123456",
+			"      team: T0123456",
+			"      manifest_vars:",
+			"        - display_information.name=Tasks",
+		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "platform deploy", Meaning: "Select the workspace to deploy to"},
 			{Command: "platform deploy --team T0123456", Meaning: "Deploy to a specific team"},
+			{Command: "platform deploy --no-install", Meaning: "Update the app manifest without installing"},
+			{Command: "platform deploy --fail-on-warning", Meaning: "Stop the deploy if the app manifest has warnings"},
+			{Command: "platform deploy --message \"release 1.4.2\"", Meaning: "Note the release in the deploy logs"},
+			{Command: "platform deploy --git-metadata", Meaning: "Note the git commit and branch in the deploy logs"},
+			{Command: "platform deploy --app all --concurrency 3", Meaning: "Deploy each deployed app, three at a time"},
+			{Command: "platform deploy --app all --fail-fast", Meaning: "Stop deploying apps after the first failed deploy"},
+			{Command: "platform deploy --app-file fleet.yaml --concurrency 2", Meaning: "Deploy each app listed in a file, two at a time"},
+			{Command: "platform deploy --max-upload-retries 5", Meaning: "Retry failed code uploads up to five times"},
+			{Command: "platform deploy --only A0123456", Meaning: "Deploy the one saved app with this app ID"},
+			{Command: "platform deploy --manifest-var settings.event_subscriptions.request_url=https://example.com/events", Meaning: "Override the request URL of the app manifest"},
+			{Command: "platform deploy --skip-validation", Meaning: "Skip app manifest validation that already happened"},
+			{Command: "platform deploy --if-changed", Meaning: "Skip the deploy if no project files changed since the last deploy"},
+			{Command: "platform deploy --since-commit 0123abc", Meaning: "Skip the deploy if no project files changed since a commit"},
+			{Command: "platform deploy --rollback --app A0123456", Meaning: "Revert the app manifest to the one saved before the last deploy"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
 			return cmdutil.IsValidProjectDirectory(clients)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowHostedOnly, prompts.ShowAllApps)
-			if err != nil {
-				return err
+			if deployFlags.maxUploadRetries < 0 {
+				return slackerror.New(slackerror.ErrInvalidFlag).
+					WithMessage("The --max-upload-retries flag must not be negative")
 			}
-			err = hasValidDeploymentMethod(ctx, clients, selection.App, selection.Auth)
-			if err != nil {
-				return err
+			if deployFlags.skipValidation && deployFlags.failOnWarning {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --skip-validation flag cannot be used with the --fail-on-warning flag")
 			}
-
-			ctx, installState, app, err := runAddCommandFunc(ctx, clients, &selection, deployFlags.orgGrantWorkspaceID)
-			if err != nil {
-				return err
+			if deployFlags.ifChanged && deployFlags.sinceCommit != "" {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --if-changed flag cannot be used with the --since-commit flag")
 			}
-			if installState == types.InstallRequestPending || installState == types.InstallRequestCancelled || installState == types.InstallRequestNotSent {
-				return nil
+			if deployFlags.rollback {
+				for _, flag := range []string{"app-file", "fail-on-warning", "if-changed", "manifest-var", "no-install", "since-commit", "skip-validation"} {
+					if cmd.Flags().Changed(flag) {
+						return slackerror.New(slackerror.ErrMismatchedFlags).
+							WithMessage("The --rollback flag cannot be used with the --%s flag", flag)
+					}
+				}
+				if clients.Config.AppFlag == deployAllAppFlag {
+					return slackerror.New(slackerror.ErrMismatchedFlags).
+						WithMessage("The --rollback flag cannot be used with --app all")
+				}
 			}
-
-			switch {
-			case clients.SDKConfig.Hooks.Deploy.IsAvailable():
-				err = deployHook(ctx, clients)
+			if deployFlags.appFile != "" {
+				if clients.Config.AppFlag != "" {
+					return slackerror.New(slackerror.ErrMismatchedFlags).
+						WithMessage("The --app-file flag cannot be used with the --app flag")
+				}
+				if cmd.Flags().Changed("only") {
+					return slackerror.New(slackerror.ErrMismatchedFlags).
+						WithMessage("The --app-file flag cannot be used with the --only flag")
+				}
+			}
+			if len(deployFlags.manifestVars) > 0 {
+				vars, err := manifest.ParseVars(deployFlags.manifestVars)
 				if err != nil {
 					return err
 				}
-			default:
-				showTriggers := triggers.ShowTriggers(clients, deployFlags.hideTriggers)
-				err = deployFunc(ctx, clients, showTriggers, app)
-				if err != nil {
+				clients.Config.ManifestVars = vars
+			}
+
+			// Record the deploy note in the debug log file and the session event
+			if deployFlags.message != "" {
+				clients.EventTracker.SetAppDeployMessage(deployFlags.message)
+				clients.IO.PrintDebug(ctx, "deploy message: %s", deployFlags.message)
+			}
+			recordGitMetadata(ctx, clients, cmd)
+
+			if cmd.Flags().Changed("only") {
+				if err := selectDeployOnlyApp(ctx, clients); err != nil {
 					return err
 				}
 			}
+			if deployFlags.rollback {
+				return rollbackDeploy(ctx, clients)
+			}
+			if clients.Config.AppFlag == deployAllAppFlag {
+				return runDeployAll(ctx, clients)
+			}
+			if deployFlags.appFile != "" {
+				return runDeployFile(ctx, clients)
+			}
+			for _, flag := range []string{"concurrency", cmdutil.FailFastFlag, cmdutil.KeepGoingFlag} {
+				if cmd.Flags().Changed(flag) {
+					return slackerror.New(slackerror.ErrMismatchedFlags).
+						WithMessage("The --%s flag can only be used with --app all or --app-file", flag)
+				}
+			}
+
+			deployed, err := deployAppFunc(ctx, clients)
+			if err != nil || !deployed {
+				return err
+			}
 
 			err = printDeployHostingCompletion(clients, cmd)
 			if err != nil {
@@ -107,12 +208,263 @@ func NewDeployCommand(clients *shared.ClientFactory) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&deployFlags.appFile, "app-file", "", "deploy each app listed in a YAML or JSON file")
+	deployFlags.batch.AddFlags(cmd)
+	cmd.Flags().IntVar(&deployFlags.concurrency, "concurrency", 1, "number of apps to deploy at once with --app all\n  or --app-file")
+	cmd.Flags().BoolVar(&deployFlags.failOnWarning, "fail-on-warning", false, "error on app manifest warnings without prompting\n  and even if the --force flag is set")
+	cmd.Flags().BoolVar(&deployFlags.gitMetadata, "git-metadata", false, "note the git commit and branch in the CLI logs")
 	cmd.Flags().BoolVar(&deployFlags.hideTriggers, "hide-triggers", false, "do not list triggers and skip trigger creation prompts")
+	cmd.Flags().BoolVar(&deployFlags.ifChanged, "if-changed", false, "skip the deploy if no project files changed since\n  the last deploy with this flag")
+	cmd.Flags().StringArrayVar(&deployFlags.manifestVars, "manifest-var", []string{}, "override an app manifest value with a path=value pair\n  like display_information.name=Tasks")
+	cmd.Flags().IntVar(&deployFlags.maxUploadRetries, "max-upload-retries", 3, "retry failed code uploads this many times")
+	cmd.Flags().StringVar(&deployFlags.message, "message", "", "note the deploy with a message in the CLI logs")
+	cmd.Flags().BoolVar(&deployFlags.noInstall, "no-install", false, "update the app manifest without installing the app\n  or running the deploy hook")
+	cmd.Flags().StringVar(&deployFlags.only, "only", "", "deploy the saved app with this app ID and error unless\n  exactly one saved app matches")
 	cmd.Flags().StringVar(&deployFlags.orgGrantWorkspaceID, cmdutil.OrgGrantWorkspaceFlag, "", cmdutil.OrgGrantWorkspaceDescription())
+	cmd.Flags().BoolVar(&deployFlags.rollback, "rollback", false, "update the app manifest to the one saved before\n  the last deploy without deploying the project")
+	cmd.Flags().StringVar(&deployFlags.sinceCommit, "since-commit", "", "skip the deploy if no project files changed since\n  a git commit")
+	cmd.Flags().BoolVar(&deployFlags.skipValidation, "skip-validation", false, "update the app manifest without validating it first")
+	_ = cmd.MarkFlagFilename("app-file")
 
 	return cmd
 }
 
+// deployApp selects and deploys an app and returns true if the app was deployed.
+// Updating the app manifest only or pending installs return false
+func deployApp(ctx context.Context, clients *shared.ClientFactory) (bool, error) {
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowHostedOnly, prompts.ShowAllApps)
+	if err != nil {
+		return false, err
+	}
+	if skip, err := skipUnchangedDeploy(ctx, clients, selection.App); err != nil || skip {
+		return false, err
+	}
+	if deployFlags.noInstall {
+		return false, deployManifestOnly(ctx, clients, selection)
+	}
+	err = hasValidDeploymentMethod(ctx, clients, selection.App, selection.Auth)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, installState, app, err := runAddCommandFunc(ctx, clients, &selection, deployFlags.orgGrantWorkspaceID)
+	if err != nil {
+		return false, err
+	}
+	if installState == types.InstallRequestPending || installState == types.InstallRequestCancelled || installState == types.InstallRequestNotSent {
+		return false, nil
+	}
+
+	switch {
+	case clients.SDKConfig.Hooks.Deploy.IsAvailable():
+		err = deployHook(ctx, clients)
+		if err != nil {
+			return false, err
+		}
+	default:
+		args := types.DeployArgs{
+			ShowTriggers:     triggers.ShowTriggers(clients, deployFlags.hideTriggers),
+			MaxUploadRetries: deployFlags.maxUploadRetries,
+		}
+		err = deployFunc(ctx, clients, args, app)
+		if err != nil {
+			return false, err
+		}
+	}
+	if deployFlags.ifChanged {
+		saveDeployedCommit(ctx, clients, app.AppID)
+	}
+	return true, nil
+}
+
+// skipUnchangedDeploy returns true and notes the skipped deploy if no project
+// files changed since the --since-commit commit or since the last deploy of the
+// app with the --if-changed flag
+func skipUnchangedDeploy(ctx context.Context, clients *shared.ClientFactory, app types.App) (bool, error) {
+	commit := deployFlags.sinceCommit
+	if deployFlags.ifChanged {
+		if app.IsNew() {
+			return false, nil
+		}
+		saved, err := clients.Config.ProjectConfig.Cache().GetDeployedCommit(ctx, app.AppID)
+		if err != nil {
+			return false, err
+		}
+		if saved == "" {
+			clients.IO.PrintDebug(ctx, "no deployed commit is saved for %s", app.AppID)
+			return false, nil
+		}
+		commit = saved
+	}
+	if commit == "" {
+		return false, nil
+	}
+	dirPath, err := clients.Os.Getwd()
+	if err != nil {
+		return false, err
+	}
+	changes, err := getGitChangesFunc(dirPath, commit)
+	if err != nil {
+		switch {
+		case deployFlags.ifChanged:
+			clients.IO.PrintDebug(ctx, "changes since the deployed commit %s are unavailable: %s", commit, err)
+			return false, nil
+		case slackerror.ToSlackError(err).Code == slackerror.ErrGitNotFound:
+			return false, err
+		default:
+			return false, slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("Changes since the commit %s could not be found", commit).
+				WithRemediation("Set the %s flag to a commit of the project repository", style.Highlight("--since-commit")).
+				WithRootCause(err)
+		}
+	}
+	if len(changes) > 0 {
+		clients.IO.PrintDebug(ctx, "%d project files changed since commit %s", len(changes), commit)
+		return false, nil
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "zzz",
+		Text:  "No changes, skipping the deploy",
+		Secondary: []string{
+			fmt.Sprintf("No project files changed since commit %s", commit),
+		},
+	}))
+	return true, nil
+}
+
+// saveDeployedCommit records the git commit of the project for the app so
+// later deploys with the --if-changed flag can skip unchanged projects
+func saveDeployedCommit(ctx context.Context, clients *shared.ClientFactory, appID string) {
+	dirPath, err := clients.Os.Getwd()
+	if err != nil {
+		return
+	}
+	metadata, err := getGitMetadataFunc(dirPath)
+	if err != nil {
+		clients.IO.PrintDebug(ctx, "git metadata is unavailable: %s", err)
+		return
+	}
+	if err := clients.Config.ProjectConfig.Cache().SetDeployedCommit(ctx, appID, metadata.Commit); err != nil {
+		clients.IO.PrintDebug(ctx, "failed to save the deployed commit: %s", err)
+	}
+}
+
+// rollbackDeploy updates the app manifest of a deployed app to the newest app
+// manifest saved before a deploy that differs from the current app manifest
+func rollbackDeploy(ctx context.Context, clients *shared.ClientFactory) error {
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowHostedOnly, prompts.ShowAllApps)
+	if err != nil {
+		return err
+	}
+	if selection.App.IsNew() {
+		return slackerror.New(slackerror.ErrAppNotFound).
+			WithMessage("The app must be deployed before the app manifest can be rolled back")
+	}
+	snapshot, err := rollbackManifestFunc(ctx, clients, selection.Auth, selection.App)
+	if err != nil {
+		return err
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "rewind",
+		Text:  "App Manifest",
+		Secondary: []string{
+			fmt.Sprintf("Rolled back the app manifest of %s to the snapshot from %s", selection.App.AppID, time.Unix(snapshot.CreatedAt, 0).Format("2006-01-02 15:04:05 Z07:00")),
+			"Deploy the project again with " + style.Commandf("deploy", false) + " to update function code",
+		},
+	}))
+	return nil
+}
+
+// selectDeployOnlyApp matches the --only app ID to exactly one saved deployed app
+// and selects that app and team for the deploy without prompts
+func selectDeployOnlyApp(ctx context.Context, clients *shared.ClientFactory) error {
+	if clients.Config.AppFlag != "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --only flag cannot be used with the --app flag")
+	}
+	if !types.IsAppID(deployFlags.only) {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --only flag must be an app ID")
+	}
+	deployedApps, _, err := clients.AppClient().GetDeployedAll(ctx)
+	if err != nil {
+		return err
+	}
+	matches := []types.App{}
+	for _, app := range deployedApps {
+		if app.AppID != deployFlags.only || app.IsDeleted() {
+			continue
+		}
+		switch clients.Config.TeamFlag {
+		case "", app.TeamID, app.TeamDomain:
+			matches = append(matches, app)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return slackerror.New(slackerror.ErrAppNotFound).
+			WithMessage("No saved app matches the app ID %s", deployFlags.only).
+			WithRemediation("List the saved apps of this project with %s", style.Commandf("app list", false))
+	case 1:
+		clients.Config.AppFlag = matches[0].AppID
+		clients.Config.TeamFlag = matches[0].TeamID
+		return nil
+	default:
+		return slackerror.New(slackerror.ErrAppFound).
+			WithMessage("Multiple saved apps match the app ID %s", deployFlags.only).
+			WithRemediation("Choose the team of the app with %s", style.Highlight("--team <team_id>"))
+	}
+}
+
+// recordGitMetadata notes the git commit and branch of the project in the debug
+// log file and the session event if enabled by flag or project configuration.
+// Nothing is noted outside of a git repository
+func recordGitMetadata(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) {
+	enabled := deployFlags.gitMetadata
+	if !cmd.Flags().Changed("git-metadata") {
+		enabled, _ = clients.Config.ProjectConfig.GetDeployGitMetadata(ctx)
+	}
+	if !enabled {
+		return
+	}
+	dirPath, err := clients.Os.Getwd()
+	if err != nil {
+		return
+	}
+	metadata, err := getGitMetadataFunc(dirPath)
+	if err != nil {
+		clients.IO.PrintDebug(ctx, "git metadata is unavailable: %s", err)
+		return
+	}
+	clients.EventTracker.SetAppGitMetadata(metadata.Commit, metadata.Branch)
+	clients.IO.PrintDebug(ctx, "deploy git commit: %s", metadata.Commit)
+	if metadata.Branch != "" {
+		clients.IO.PrintDebug(ctx, "deploy git branch: %s", metadata.Branch)
+	}
+}
+
+// deployManifestOnly creates or updates the app manifest and stops before the app
+// is installed, so the deploy hook and function deployments are skipped
+func deployManifestOnly(ctx context.Context, clients *shared.ClientFactory, selection prompts.SelectedApp) error {
+	if selection.App.TeamID == "" {
+		selection.App.TeamID = selection.Auth.TeamID
+	}
+	clients.Config.ManifestEnv = internalapp.SetManifestEnvTeamVars(clients.Config.ManifestEnv, selection.App.TeamDomain, selection.App.IsDev)
+	app, _, err := installManifestFunc(ctx, clients, selection.Auth, apps.CreateAppManifestOnly, selection.App, deployFlags.orgGrantWorkspaceID)
+	if err != nil {
+		return err
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "memo",
+		Text:  "App Manifest",
+		Secondary: []string{
+			fmt.Sprintf("Updated the app manifest of %s without installing the app", app.AppID),
+			"Install the app and deploy with " + style.Commandf("deploy", false),
+		},
+	}))
+	return nil
+}
+
 // hasValidDeploymentMethod errors if an app has no known ways to deploy
 func hasValidDeploymentMethod(
 	ctx context.Context,
@@ -180,8 +532,9 @@ func deployHook(ctx context.Context, clients *shared.ClientFactory) error {
 	// The hook executor attached to the provided clients might use either protocol
 	// so we instantiate the default here.
 	shell := hooks.HookExecutorDefaultProtocol{
-		IO: clients.IO,
-		Fs: clients.Fs,
+		IO:      clients.IO,
+		Fs:      clients.Fs,
+		Timeout: clients.Config.HookTimeoutFlag,
 	}
 	if _, err := shell.Execute(ctx, hookExecOpts); err != nil {
 		return err
@@ -0,0 +1,237 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/slackapi/slack-cli/internal/hooks"
+	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+)
+
+// deployAllAppFlag is the value of the --app flag that deploys each deployed app
+const deployAllAppFlag = "all"
+
+// Handle to the deploy of a single app used for testing
+var deployAppFunc = deployApp
+
+// deployAllTarget is an app to deploy with the overrides of that deploy
+type deployAllTarget struct {
+	app          types.App
+	team         string
+	manifestVars map[string]string
+}
+
+// deployAllResult is the outcome of deploying one app of the project
+type deployAllResult struct {
+	app     types.App
+	output  string
+	err     error
+	skipped bool
+}
+
+// deployAllOutput collects the outputs of one deploy from multiple writers
+type deployAllOutput struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (o *deployAllOutput) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buffer.Write(p)
+}
+
+func (o *deployAllOutput) String() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buffer.String()
+}
+
+// runDeployAll deploys each deployed app of the project with at most the
+// --concurrency count of deploys running at once
+//
+// Deploys continue after a failure unless the --fail-fast flag is set, which
+// skips deploys that have not started once any deploy fails.
+func runDeployAll(ctx context.Context, clients *shared.ClientFactory) error {
+	if err := validateDeployConcurrency(); err != nil {
+		return err
+	}
+	deployedApps, _, err := clients.AppClient().GetDeployedAll(ctx)
+	if err != nil {
+		return err
+	}
+	targets := []deployAllTarget{}
+	for _, app := range deployedApps {
+		if app.IsDeleted() {
+			continue
+		}
+		targets = append(targets, deployAllTarget{app: app})
+	}
+	if len(targets) == 0 {
+		return slackerror.New(slackerror.ErrAppNotFound).
+			WithMessage("No deployed apps were found in this project").
+			WithRemediation("Deploy an app to a team with %s", style.Commandf("deploy", false))
+	}
+	return deployEach(ctx, clients, targets)
+}
+
+// validateDeployConcurrency errors if the --concurrency flag is less than one
+func validateDeployConcurrency() error {
+	if deployFlags.concurrency < 1 {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --concurrency flag must be at least 1").
+			WithRemediation("Set the number of apps to deploy at once with %s", style.Highlight("--concurrency <number>"))
+	}
+	return nil
+}
+
+// deployEach deploys each target in turn or concurrently, then prints a summary
+// and errors if any deploy failed
+func deployEach(ctx context.Context, clients *shared.ClientFactory, targets []deployAllTarget) error {
+	batch, err := deployFlags.batch.NewBatch(true)
+	if err != nil {
+		return err
+	}
+	results := make([]deployAllResult, len(targets))
+	semaphore := make(chan struct{}, deployFlags.concurrency)
+	var printing sync.Mutex
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		app := target.app
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			if batch.Stopped() {
+				results[i] = deployAllResult{app: app, skipped: true}
+				return
+			}
+
+			// Buffer the output of each deploy to print as a block once complete
+			output := &deployAllOutput{}
+			_, err := deployAppFunc(ctx, deployAllClients(clients, target, output))
+			results[i] = deployAllResult{app: app, output: output.String(), err: err}
+			batch.Add(formatDeployAllApp(app), err)
+
+			printing.Lock()
+			defer printing.Unlock()
+			printDeployAllOutput(ctx, clients, results[i])
+		}()
+	}
+	wg.Wait()
+	printDeployAllSummary(ctx, clients, results)
+	return batch.Err(slackerror.ErrAppDeploy, "deploy", "apps", len(results))
+}
+
+// deployAllClients returns clients for the deploy of a single app that write
+// outputs to output and keep separate configurations from other deploys
+//
+// Manifest variables of the target override those of the --manifest-var flag.
+// The API, app, and auth clients are created from the configurations of this
+// deploy and prompts are skipped if more than one deploy runs at once.
+func deployAllClients(clients *shared.ClientFactory, target deployAllTarget, output io.Writer) *shared.ClientFactory {
+	config := *clients.Config
+	config.AppFlag = target.app.AppID
+	if target.team != "" {
+		config.TeamFlag = target.team
+	}
+	config.ManifestEnv = maps.Clone(clients.Config.ManifestEnv)
+	config.ManifestVars = maps.Clone(clients.Config.ManifestVars)
+	if len(target.manifestVars) > 0 {
+		if config.ManifestVars == nil {
+			config.ManifestVars = map[string]string{}
+		}
+		maps.Copy(config.ManifestVars, target.manifestVars)
+	}
+	streams := iostreams.NewIOStreams(&config, clients.Fs, clients.Os)
+	streams.Stdin = clients.IO.ReadIn()
+	streams.Stdout = log.New(output, "", 0)
+	streams.Stderr = log.New(output, "", 0)
+	streams.NonInteractive = deployFlags.concurrency > 1
+	return shared.NewClientFactory(func(cf *shared.ClientFactory) {
+		cf.Browser = clients.Browser
+		cf.Config = &config
+		cf.CredentialStores = clients.CredentialStores
+		cf.EventTracker = clients.EventTracker
+		cf.Fs = clients.Fs
+		cf.HookExecutor = hooks.GetHookExecutor(streams, clients.Fs, clients.SDKConfig, config.HookTimeoutFlag)
+		cf.IO = streams
+		cf.Os = clients.Os
+		cf.Runtime = clients.Runtime
+		cf.SDKConfig = clients.SDKConfig
+	})
+}
+
+// printDeployAllOutput prints the buffered output of a deploy as one section
+func printDeployAllOutput(ctx context.Context, clients *shared.ClientFactory, result deployAllResult) {
+	emoji := "white_check_mark"
+	if result.err != nil {
+		emoji = "x"
+	}
+	lines := []string{}
+	if output := strings.TrimSpace(result.output); output != "" {
+		lines = strings.Split(output, "\n")
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     emoji,
+		Text:      fmt.Sprintf("Deploy of %s", formatDeployAllApp(result.app)),
+		Secondary: lines,
+	}))
+}
+
+// printDeployAllSummary prints the outcome of each deploy
+func printDeployAllSummary(ctx context.Context, clients *shared.ClientFactory, results []deployAllResult) {
+	summary := []string{}
+	for _, result := range results {
+		switch {
+		case result.skipped:
+			summary = append(summary, fmt.Sprintf("%s: skipped", formatDeployAllApp(result.app)))
+		case result.err != nil:
+			summary = append(summary, fmt.Sprintf("%s: failed", formatDeployAllApp(result.app)))
+		default:
+			summary = append(summary, fmt.Sprintf("%s: deployed", formatDeployAllApp(result.app)))
+		}
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "clipboard",
+		Text:      "Deploy Summary",
+		Secondary: summary,
+	}))
+}
+
+// formatDeployAllApp returns the app ID and team domain of an app for outputs
+func formatDeployAllApp(app types.App) string {
+	switch {
+	case app.TeamDomain != "":
+		return fmt.Sprintf("%s (%s)", app.AppID, app.TeamDomain)
+	case app.TeamID != "":
+		return fmt.Sprintf("%s (%s)", app.AppID, app.TeamID)
+	default:
+		return app.AppID
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/slackapi/slack-cli/internal/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deployAllAppMock records the deploys of each app and fails for some apps
+type deployAllAppMock struct {
+	mu        sync.Mutex
+	active    int
+	maxActive int
+	calls     map[string]*shared.ClientFactory
+	failures  map[string]bool
+}
+
+func (m *deployAllAppMock) deploy(ctx context.Context, clients *shared.ClientFactory) (bool, error) {
+	appID := clients.Config.AppFlag
+	m.mu.Lock()
+	m.active++
+	m.maxActive = max(m.maxActive, m.active)
+	m.calls[appID] = clients
+	m.mu.Unlock()
+	clients.Config.ManifestEnv = map[string]string{"SLACK_WORKSPACE": appID}
+	time.Sleep(10 * time.Millisecond)
+	m.mu.Lock()
+	m.active--
+	m.mu.Unlock()
+	if m.failures[appID] {
+		_, _ = fmt.Fprintf(clients.IO.WriteErr(), "deploy of %s broke\n", appID)
+		return false, errors.New("deploy failed")
+	}
+	_, _ = fmt.Fprintf(clients.IO.WriteOut(), "deploy of %s complete\n", appID)
+	return true, nil
+}
+
+// setupDeployAllMocks saves deployed apps and mocks the deploy of each app
+func setupDeployAllMocks(t *testing.T, ctx context.Context, cm *shared.ClientsMock, failures map[string]bool, appIDs ...string) *deployAllAppMock {
+	cm.AddDefaultMocks()
+	cm.Config.AppFlag = "all"
+	for i, appID := range appIDs {
+		err := cm.AppClient.SaveDeployed(ctx, types.App{
+			AppID:      appID,
+			TeamID:     fmt.Sprintf("T00%d", i+1),
+			TeamDomain: fmt.Sprintf("team%d", i+1),
+		})
+		require.NoError(t, err)
+	}
+	appMock := &deployAllAppMock{calls: map[string]*shared.ClientFactory{}, failures: failures}
+	deployAppFunc = appMock.deploy
+	return appMock
+}
+
+func Test_DeployCommand_AppAll(t *testing.T) {
+	var appMock *deployAllAppMock
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"deploys each app with separate configurations": {
+			CmdArgs: []string{"--hide-triggers", "--concurrency", "2"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, nil, "A001", "A002", "A003")
+			},
+			ExpectedStdoutOutputs: []string{
+				"Deploy of A001 (team1)",
+				"deploy of A001 complete",
+				"deploy of A003 complete",
+				"Deploy Summary",
+				"A002 (team2): deployed",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 3)
+				assert.Equal(t, "A001", appMock.calls["A001"].Config.AppFlag)
+				assert.Equal(t, map[string]string{"SLACK_WORKSPACE": "A002"}, appMock.calls["A002"].Config.ManifestEnv)
+				assert.Equal(t, "all", cm.Config.AppFlag)
+				assert.Empty(t, cm.Config.ManifestEnv["SLACK_WORKSPACE"])
+				assert.LessOrEqual(t, appMock.maxActive, 2)
+				assert.False(t, appMock.calls["A001"].IO.IsTTY())
+				assert.IsType(t, &auth.Client{}, appMock.calls["A001"].Auth())
+			},
+		},
+		"continues after a failed deploy and errors with the failures": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, map[string]bool{"A001": true}, "A001", "A002")
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppDeploy, "Failed to deploy 1 of 2 apps"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 2)
+				assert.Contains(t, cm.GetStdoutOutput(), "deploy of A001 broke")
+				assert.Contains(t, cm.GetStdoutOutput(), "deploy of A002 complete")
+				assert.Contains(t, cm.GetStdoutOutput(), "A001 (team1): failed")
+			},
+		},
+		"skips deploys that have not started after a failure with the fail-fast flag": {
+			CmdArgs: []string{"--fail-fast"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, map[string]bool{"A001": true, "A002": true}, "A001", "A002")
+			},
+			ExpectedErrorStrings: []string{
+				slackerror.ErrAppDeploy,
+				"Failed to deploy 1 of 2 apps",
+				"Remaining apps were skipped after the first failure",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 1)
+				assert.Contains(t, cm.GetStdoutOutput(), ": failed")
+				assert.Contains(t, cm.GetStdoutOutput(), ": skipped")
+			},
+		},
+		"errors if the fail-fast and keep-going flags are both set": {
+			CmdArgs: []string{"--fail-fast", "--keep-going"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, nil, "A001")
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --fail-fast flag cannot be used with the --keep-going flag"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, appMock.calls)
+			},
+		},
+		"skips apps that are marked as deleted": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, nil, "A001")
+				err := cm.AppClient.SaveDeployed(ctx, types.App{
+					AppID:      "A002",
+					DeletedAt:  1700000000,
+					TeamID:     "T002",
+					TeamDomain: "team2",
+				})
+				require.NoError(t, err)
+			},
+			ExpectedStdoutOutputs: []string{"deploy of A001 complete"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 1)
+				assert.NotContains(t, appMock.calls, "A002")
+			},
+		},
+		"errors if no deployed apps exist": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupDeployAllMocks(t, ctx, cm, nil)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppNotFound, "No deployed apps were found in this project"},
+		},
+		"errors if the concurrency is less than one": {
+			CmdArgs: []string{"--concurrency", "0"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupDeployAllMocks(t, ctx, cm, nil, "A001")
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "The --concurrency flag must be at least 1"},
+		},
+		"errors if the concurrency is set without deploying all apps": {
+			CmdArgs:              []string{"--concurrency", "2"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --concurrency flag can only be used with --app all"},
+		},
+		"errors if the fail-fast flag is set without deploying all apps": {
+			CmdArgs:              []string{"--fail-fast"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --fail-fast flag can only be used with --app all"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewDeployCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+	deployAppFunc = deployApp
+}
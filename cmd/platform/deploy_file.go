@@ -0,0 +1,152 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/manifest"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v2"
+)
+
+// deployFile lists the apps to deploy with the --app-file flag
+type deployFile struct {
+	Apps []deployFileApp `json:"apps" yaml:"apps"`
+}
+
+// deployFileApp is an app of the --app-file with overrides for the deploy
+type deployFileApp struct {
+	AppID        string   `json:"app_id" yaml:"app_id"`
+	Team         string   `json:"team,omitempty" yaml:"team,omitempty"`
+	ManifestVars []string `json:"manifest_vars,omitempty" yaml:"manifest_vars,omitempty"`
+}
+
+// runDeployFile deploys each app listed in the --app-file with the overrides
+// of that app
+func runDeployFile(ctx context.Context, clients *shared.ClientFactory) error {
+	if err := validateDeployConcurrency(); err != nil {
+		return err
+	}
+	targets, err := readDeployFile(clients.Fs, deployFlags.appFile)
+	if err != nil {
+		return err
+	}
+
+	// Include the team domain of saved apps in outputs
+	deployedApps, _, err := clients.AppClient().GetDeployedAll(ctx)
+	if err != nil {
+		return err
+	}
+	for i, target := range targets {
+		for _, app := range deployedApps {
+			if app.AppID == target.app.AppID && (target.team == "" || target.team == app.TeamID || target.team == app.TeamDomain) {
+				targets[i].app = app
+				break
+			}
+		}
+	}
+	return deployEach(ctx, clients, targets)
+}
+
+// readDeployFile parses and validates the apps of an --app-file before any
+// app is deployed
+func readDeployFile(fs afero.Fs, path string) ([]deployAllTarget, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, slackerror.New(slackerror.ErrInvalidAppFile).
+			WithMessage("Failed to read the app file \"%s\"", path).
+			WithRootCause(err)
+	}
+	var file deployFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	default:
+		err = yaml.UnmarshalStrict(data, &file)
+	}
+	if err != nil {
+		return nil, slackerror.New(slackerror.ErrInvalidAppFile).
+			WithMessage("Failed to parse the app file \"%s\"", path).
+			WithRootCause(err)
+	}
+	if len(file.Apps) == 0 {
+		return nil, slackerror.New(slackerror.ErrInvalidAppFile).
+			WithMessage("No apps are listed in the app file \"%s\"", path)
+	}
+
+	details := slackerror.ErrorDetails{}
+	targets := []deployAllTarget{}
+	seen := map[string]bool{}
+	for i, entry := range file.Apps {
+		switch {
+		case entry.AppID == "":
+			details = append(details, slackerror.ErrorDetail{
+				Message: "The app ID is missing",
+				Pointer: fmt.Sprintf("/apps/%d/app_id", i),
+			})
+			continue
+		case !types.IsAppID(entry.AppID):
+			details = append(details, slackerror.ErrorDetail{
+				Message: fmt.Sprintf("The app ID %s is not valid", entry.AppID),
+				Pointer: fmt.Sprintf("/apps/%d/app_id", i),
+			})
+			continue
+		}
+		key := entry.AppID + " " + entry.Team
+		if seen[key] {
+			details = append(details, slackerror.ErrorDetail{
+				Message: fmt.Sprintf("The app %s is listed more than once", entry.AppID),
+				Pointer: fmt.Sprintf("/apps/%d", i),
+			})
+			continue
+		}
+		seen[key] = true
+		vars, err := manifest.ParseVars(entry.ManifestVars)
+		if err != nil {
+			detail := slackerror.ErrorDetail{
+				Message: err.Error(),
+				Pointer: fmt.Sprintf("/apps/%d/manifest_vars", i),
+			}
+			if slackErr := slackerror.ToSlackError(err); slackErr != nil {
+				detail.Message = slackErr.Message
+				detail.Remediation = slackErr.Remediation
+			}
+			details = append(details, detail)
+			continue
+		}
+		targets = append(targets, deployAllTarget{
+			app:          types.App{AppID: entry.AppID, TeamID: entry.Team},
+			team:         entry.Team,
+			manifestVars: vars,
+		})
+	}
+	if len(details) > 0 {
+		return nil, slackerror.New(slackerror.ErrInvalidAppFile).
+			WithMessage("The app file \"%s\" has invalid apps", path).
+			WithDetails(details)
+	}
+	return targets, nil
+}
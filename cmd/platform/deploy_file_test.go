@@ -0,0 +1,208 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"testing"
+
+	internalapp "github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/auth"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readDeployFile(t *testing.T) {
+	tests := map[string]struct {
+		path            string
+		data            string
+		expectedTargets []deployAllTarget
+		expectedErrors  []string
+	}{
+		"parses apps of a YAML file": {
+			path: "fleet.yaml",
+			data: "apps:\n  - app_id: A001\n    team: T001\n    manifest_vars:\n      - display_information.name=Tasks\n  - app_id: A002\n",
+			expectedTargets: []deployAllTarget{
+				{
+					app:          types.App{AppID: "A001", TeamID: "T001"},
+					team:         "T001",
+					manifestVars: map[string]string{"display_information.name": "Tasks"},
+				},
+				{
+					app:          types.App{AppID: "A002"},
+					manifestVars: map[string]string{},
+				},
+			},
+		},
+		"parses apps of a JSON file": {
+			path: "fleet.json",
+			data: `{"apps": [{"app_id": "A001", "team": "T001"}]}`,
+			expectedTargets: []deployAllTarget{
+				{
+					app:          types.App{AppID: "A001", TeamID: "T001"},
+					team:         "T001",
+					manifestVars: map[string]string{},
+				},
+			},
+		},
+		"errors if the file is missing": {
+			path:           "missing.yaml",
+			expectedErrors: []string{slackerror.ErrInvalidAppFile, `Failed to read the app file "missing.yaml"`},
+		},
+		"errors on unknown keys": {
+			path:           "fleet.json",
+			data:           `{"apps": [{"app_id": "A001", "workspace": "T001"}]}`,
+			expectedErrors: []string{slackerror.ErrInvalidAppFile, `Failed to parse the app file "fleet.json"`},
+		},
+		"errors if no apps are listed": {
+			path:           "fleet.yaml",
+			data:           "apps: []\n",
+			expectedErrors: []string{slackerror.ErrInvalidAppFile, `No apps are listed in the app file "fleet.yaml"`},
+		},
+		"errors with each invalid app": {
+			path: "fleet.yaml",
+			data: "apps:\n  - team: T001\n  - app_id: example\n  - app_id: A001\n  - app_id: A001\n  - app_id: A002\n    manifest_vars:\n      - unknown.path=value\n",
+			expectedErrors: []string{
+				slackerror.ErrInvalidAppFile,
+				`The app file "fleet.yaml" has invalid apps`,
+				"The app ID is missing",
+				"The app ID example is not valid",
+				"The app A001 is listed more than once",
+				"/apps/4/manifest_vars",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.data != "" {
+				require.NoError(t, afero.WriteFile(fs, tc.path, []byte(tc.data), 0644))
+			}
+			targets, err := readDeployFile(fs, tc.path)
+			if len(tc.expectedErrors) > 0 {
+				require.Error(t, err)
+				slackErr := slackerror.ToSlackError(err)
+				output := slackErr.Code + " " + slackErr.Message
+				for _, detail := range slackErr.Details {
+					output += " " + detail.Message + " " + detail.Pointer
+				}
+				for _, expected := range tc.expectedErrors {
+					assert.Contains(t, output, expected)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTargets, targets)
+		})
+	}
+}
+
+func Test_DeployCommand_AppFile(t *testing.T) {
+	var appMock *deployAllAppMock
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"deploys each app of the file with the overrides of each app": {
+			CmdArgs: []string{"--app-file", "fleet.yaml", "--manifest-var", "display_information.name=Fleet"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, nil, "A001", "A002")
+				cm.Config.AppFlag = ""
+				err := afero.WriteFile(cm.Fs, "fleet.yaml", []byte("apps:\n  - app_id: A001\n    manifest_vars:\n      - display_information.name=Tasks\n  - app_id: A003\n    team: T003\n"), 0644)
+				require.NoError(t, err)
+			},
+			ExpectedStdoutOutputs: []string{
+				"Deploy of A001 (team1)",
+				"deploy of A003 complete",
+				"A003 (T003): deployed",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 2)
+				assert.Equal(t, map[string]string{"display_information.name": "Tasks"}, appMock.calls["A001"].Config.ManifestVars)
+				assert.Equal(t, map[string]string{"display_information.name": "Fleet"}, appMock.calls["A003"].Config.ManifestVars)
+				assert.Equal(t, "T003", appMock.calls["A003"].Config.TeamFlag)
+				assert.Equal(t, map[string]string{"display_information.name": "Fleet"}, cm.Config.ManifestVars)
+			},
+		},
+		"deploys apps of the file to different teams": {
+			CmdArgs: []string{"--app-file", "fleet.yaml", "--concurrency", "2"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, nil, "A001", "A002")
+				cm.Config.AppFlag = ""
+				err := afero.WriteFile(cm.Fs, "fleet.yaml", []byte("apps:\n  - app_id: A001\n    team: T001\n  - app_id: A002\n    team: team2\n"), 0644)
+				require.NoError(t, err)
+			},
+			ExpectedStdoutOutputs: []string{
+				"A001 (team1): deployed",
+				"A002 (team2): deployed",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 2)
+				assert.Equal(t, "T001", appMock.calls["A001"].Config.TeamFlag)
+				assert.Equal(t, "team2", appMock.calls["A002"].Config.TeamFlag)
+				assert.NotSame(t, appMock.calls["A001"].Config, appMock.calls["A002"].Config)
+				assert.IsType(t, &auth.Client{}, appMock.calls["A001"].Auth())
+				assert.IsType(t, &internalapp.Client{}, appMock.calls["A002"].AppClient())
+				assert.Empty(t, cm.Config.TeamFlag)
+			},
+		},
+		"errors with the failed deploys of the file": {
+			CmdArgs: []string{"--app-file", "fleet.json"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, map[string]bool{"A002": true}, "A001", "A002")
+				cm.Config.AppFlag = ""
+				err := afero.WriteFile(cm.Fs, "fleet.json", []byte(`{"apps": [{"app_id": "A001"}, {"app_id": "A002"}]}`), 0644)
+				require.NoError(t, err)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppDeploy, "Failed to deploy 1 of 2 apps"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 2)
+				assert.Contains(t, cm.GetStdoutOutput(), "A002 (team2): failed")
+			},
+		},
+		"errors before any deploy if the file is not valid": {
+			CmdArgs: []string{"--app-file", "fleet.yaml"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				appMock = setupDeployAllMocks(t, ctx, cm, nil, "A001")
+				cm.Config.AppFlag = ""
+				err := afero.WriteFile(cm.Fs, "fleet.yaml", []byte("apps:\n  - app_id: A001\n  - team: T002\n"), 0644)
+				require.NoError(t, err)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAppFile, `The app file "fleet.yaml" has invalid apps`},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, appMock.calls)
+			},
+		},
+		"errors if the app flag is also set": {
+			CmdArgs: []string{"--app-file", "fleet.yaml"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				setupDeployAllMocks(t, ctx, cm, nil, "A001")
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --app-file flag cannot be used with the --app flag"},
+		},
+		"errors if the only flag is also set": {
+			CmdArgs:              []string{"--app-file", "fleet.yaml", "--only", "A001"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --app-file flag cannot be used with the --only flag"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewDeployCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+	deployAppFunc = deployApp
+}
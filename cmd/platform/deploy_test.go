@@ -22,9 +22,12 @@ import (
 	"testing"
 
 	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/cache"
 	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/deputil"
 	"github.com/slackapi/slack-cli/internal/hooks"
 	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/pkg/apps"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
@@ -43,8 +46,8 @@ type DeployPkgMock struct {
 	mock.Mock
 }
 
-func (m *DeployPkgMock) Deploy(ctx context.Context, clients *shared.ClientFactory, showPrompts bool, app types.App) error {
-	args := m.Called(ctx, clients, showPrompts, app)
+func (m *DeployPkgMock) Deploy(ctx context.Context, clients *shared.ClientFactory, deployArgs types.DeployArgs, app types.App) error {
+	args := m.Called(ctx, clients, deployArgs, app)
 	return args.Error(0)
 }
 
@@ -106,6 +109,596 @@ func TestDeployCommand(t *testing.T) {
 	deployPkgMock.AssertCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestDeployCommand_NoInstall(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+		projectConfigMock := config.NewProjectConfigMock()
+		projectConfigMock.AddDefaultMocks()
+		clients.Config.ProjectConfig = projectConfigMock
+		clients.SDKConfig = hooks.NewSDKConfigMock()
+		clients.SDKConfig.Hooks.Deploy = hooks.HookScript{Name: "Deploy", Command: "./deploy.sh"}
+	})
+
+	cmd := NewDeployCommand(clients)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+	cmd.SetArgs([]string{"--no-install"})
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	deployPkgMock := new(DeployPkgMock)
+	deployFunc = deployPkgMock.Deploy
+
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowAllApps).Return(prompts.SelectedApp{
+		App:  types.App{AppID: "A001"},
+		Auth: types.SlackAuth{TeamID: "T001", Token: "xoxp-example"},
+	}, nil)
+	appSelectPromptFunc = appSelectMock.AppSelectPrompt
+
+	appCmdMock := new(AppCmdMock)
+	runAddCommandFunc = appCmdMock.RunAddCommand
+	appCmdMock.On("RunAddCommand").Return()
+
+	var installed bool
+	installManifestFunc = func(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, onlyCreateUpdateAppManifest bool, app types.App, orgGrantWorkspaceID string) (types.App, types.InstallState, error) {
+		installed = !onlyCreateUpdateAppManifest
+		assert.Equal(t, "T001", app.TeamID)
+		return app, "", nil
+	}
+	defer func() {
+		installManifestFunc = apps.Install
+		deployFlags.noInstall = false
+	}()
+
+	err := cmd.ExecuteContext(ctx)
+	require.NoError(t, err)
+	assert.False(t, installed)
+	appCmdMock.AssertNotCalled(t, "RunAddCommand")
+	deployPkgMock.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	clientsMock.API.AssertNotCalled(t, "DeveloperAppInstall")
+	assert.Contains(t, clientsMock.GetStdoutOutput(), "Updated the app manifest of A001 without installing the app")
+}
+
+func TestDeployCommand_FailOnWarning(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+		clients.SDKConfig = hooks.NewSDKConfigMock()
+	})
+
+	cmd := NewDeployCommand(clients)
+	cmd.SetArgs([]string{"--fail-on-warning", "--no-install"})
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowAllApps).Return(prompts.SelectedApp{
+		App:  types.App{AppID: "A001"},
+		Auth: types.SlackAuth{TeamID: "T001", Token: "xoxp-example"},
+	}, nil)
+	appSelectPromptFunc = appSelectMock.AppSelectPrompt
+
+	installManifestFunc = func(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, onlyCreateUpdateAppManifest bool, app types.App, orgGrantWorkspaceID string) (types.App, types.InstallState, error) {
+		flag := clients.Config.Flags.Lookup("fail-on-warning")
+		require.NotNil(t, flag)
+		assert.Equal(t, "true", flag.Value.String())
+		return app, "", slackerror.New(slackerror.ErrAppManifestValidate)
+	}
+	defer func() {
+		installManifestFunc = apps.Install
+		deployFlags.failOnWarning = false
+		deployFlags.noInstall = false
+	}()
+
+	err := cmd.ExecuteContext(ctx)
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrAppManifestValidate, slackerror.ToSlackError(err).Code)
+}
+
+func TestDeployCommand_SkipValidation(t *testing.T) {
+	tests := map[string]struct {
+		args                 []string
+		expectedErrorCode    string
+		expectedErrorMessage string
+		expectedInstall      bool
+	}{
+		"passes the flag to the install": {
+			args:            []string{"--skip-validation", "--no-install"},
+			expectedInstall: true,
+		},
+		"errors with the --fail-on-warning flag": {
+			args:                 []string{"--skip-validation", "--fail-on-warning"},
+			expectedErrorCode:    slackerror.ErrMismatchedFlags,
+			expectedErrorMessage: "The --skip-validation flag cannot be used with the --fail-on-warning flag",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+
+			cmd := NewDeployCommand(clients)
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowAllApps).Return(prompts.SelectedApp{
+				App:  types.App{AppID: "A001"},
+				Auth: types.SlackAuth{TeamID: "T001", Token: "xoxp-example"},
+			}, nil)
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+
+			installed := false
+			installManifestFunc = func(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, onlyCreateUpdateAppManifest bool, app types.App, orgGrantWorkspaceID string) (types.App, types.InstallState, error) {
+				installed = true
+				flag := clients.Config.Flags.Lookup("skip-validation")
+				require.NotNil(t, flag)
+				assert.Equal(t, "true", flag.Value.String())
+				return app, "", nil
+			}
+			defer func() {
+				installManifestFunc = apps.Install
+				deployFlags.failOnWarning = false
+				deployFlags.noInstall = false
+				deployFlags.skipValidation = false
+			}()
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedErrorCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorCode, slackerror.ToSlackError(err).Code)
+				assert.Equal(t, tc.expectedErrorMessage, slackerror.ToSlackError(err).Message)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedInstall, installed)
+		})
+	}
+}
+
+func TestDeployCommand_Message(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+		clients.SDKConfig = hooks.NewSDKConfigMock()
+	})
+
+	cmd := NewDeployCommand(clients)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+	cmd.SetArgs([]string{"--message", "release 1.4.2", "--no-install"})
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowAllApps).Return(prompts.SelectedApp{
+		App:  types.App{AppID: "A001"},
+		Auth: types.SlackAuth{TeamID: "T001", Token: "xoxp-example"},
+	}, nil)
+	appSelectPromptFunc = appSelectMock.AppSelectPrompt
+
+	installManifestFunc = func(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, onlyCreateUpdateAppManifest bool, app types.App, orgGrantWorkspaceID string) (types.App, types.InstallState, error) {
+		return app, "", nil
+	}
+	defer func() {
+		installManifestFunc = apps.Install
+		deployFlags.message = ""
+		deployFlags.noInstall = false
+	}()
+
+	err := cmd.ExecuteContext(ctx)
+	require.NoError(t, err)
+	clientsMock.EventTracker.AssertCalled(t, "SetAppDeployMessage", "release 1.4.2")
+	clientsMock.IO.AssertCalled(t, "PrintDebug", mock.Anything, "deploy message: %s", []any{"release 1.4.2"})
+}
+
+func TestDeployCommand_Only(t *testing.T) {
+	var appMock *deployAllAppMock
+	saveApps := func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, apps ...types.App) {
+		cm.AddDefaultMocks()
+		for _, app := range apps {
+			require.NoError(t, cm.AppClient.SaveDeployed(ctx, app))
+		}
+		appMock = &deployAllAppMock{calls: map[string]*shared.ClientFactory{}}
+		deployAppFunc = appMock.deploy
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"deploys the one saved app that matches": {
+			CmdArgs: []string{"--only", "A002"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				saveApps(t, ctx, cm,
+					types.App{AppID: "A001", TeamID: "T001", TeamDomain: "team1"},
+					types.App{AppID: "A002", TeamID: "T002", TeamDomain: "team2"},
+				)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 1)
+				assert.Equal(t, "A002", appMock.calls["A002"].Config.AppFlag)
+				assert.Equal(t, "T002", appMock.calls["A002"].Config.TeamFlag)
+			},
+		},
+		"errors if no saved app matches": {
+			CmdArgs: []string{"--only", "A009"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				saveApps(t, ctx, cm, types.App{AppID: "A001", TeamID: "T001", TeamDomain: "team1"})
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppNotFound, "No saved app matches the app ID A009"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, appMock.calls)
+			},
+		},
+		"errors if multiple saved apps match": {
+			CmdArgs: []string{"--only", "A001"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				saveApps(t, ctx, cm,
+					types.App{AppID: "A001", TeamID: "T001", TeamDomain: "team1"},
+					types.App{AppID: "A001", TeamID: "E002", TeamDomain: "team2"},
+				)
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrAppFound, "Multiple saved apps match the app ID A001"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, appMock.calls)
+			},
+		},
+		"deploys the match of multiple saved apps on the provided team": {
+			CmdArgs: []string{"--only", "A001", "--team", "team2"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				saveApps(t, ctx, cm,
+					types.App{AppID: "A001", TeamID: "T001", TeamDomain: "team1"},
+					types.App{AppID: "A001", TeamID: "E002", TeamDomain: "team2"},
+				)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 1)
+				assert.Equal(t, "E002", appMock.calls["A001"].Config.TeamFlag)
+			},
+		},
+		"errors if the app flag is also set": {
+			CmdArgs:              []string{"--only", "A001", "--app", "A001"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The --only flag cannot be used with the --app flag"},
+		},
+		"errors if the value is not an app ID": {
+			CmdArgs:              []string{"--only", "deployed"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "The --only flag must be an app ID"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewDeployCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+	deployAppFunc = deployApp
+}
+
+func TestDeployCommand_ManifestVar(t *testing.T) {
+	var appMock *deployAllAppMock
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"sets the manifest variables for the deploy": {
+			CmdArgs: []string{
+				"--app", "A001",
+				"--manifest-var", "display_information.name=Tasks",
+				"--manifest-var", "settings.event_subscriptions.request_url=https://example.com/events",
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				appMock = &deployAllAppMock{calls: map[string]*shared.ClientFactory{}}
+				deployAppFunc = appMock.deploy
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.Len(t, appMock.calls, 1)
+				assert.Equal(t, map[string]string{
+					"display_information.name":                 "Tasks",
+					"settings.event_subscriptions.request_url": "https://example.com/events",
+				}, appMock.calls["A001"].Config.ManifestVars)
+			},
+		},
+		"errors for a path that is not part of the app manifest": {
+			CmdArgs: []string{"--app", "A001", "--manifest-var", "settings.unknown=true"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.AddDefaultMocks()
+				appMock = &deployAllAppMock{calls: map[string]*shared.ClientFactory{}}
+				deployAppFunc = appMock.deploy
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "The manifest variable path settings.unknown is not part of the app manifest"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, appMock.calls)
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewDeployCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+	deployAppFunc = deployApp
+}
+
+func TestDeployCommand_RecordGitMetadata(t *testing.T) {
+	tests := map[string]struct {
+		args            []string
+		configEnabled   bool
+		gitMetadata     deputil.GitMetadata
+		gitErr          error
+		expectedCommit  string
+		expectedBranch  string
+		expectedSkipped bool
+	}{
+		"notes the commit and branch with the flag": {
+			args:           []string{"--git-metadata"},
+			gitMetadata:    deputil.GitMetadata{Branch: "main", Commit: "0123456789abcdef"},
+			expectedCommit: "0123456789abcdef",
+			expectedBranch: "main",
+		},
+		"notes the commit and branch with the project configuration": {
+			configEnabled:  true,
+			gitMetadata:    deputil.GitMetadata{Commit: "0123456789abcdef"},
+			expectedCommit: "0123456789abcdef",
+		},
+		"skips the git details if the flag disables the project configuration": {
+			args:            []string{"--git-metadata=false"},
+			configEnabled:   true,
+			expectedSkipped: true,
+		},
+		"skips the git details by default": {
+			expectedSkipped: true,
+		},
+		"skips the git details outside of a repository": {
+			args:            []string{"--git-metadata"},
+			gitErr:          slackerror.New(slackerror.ErrGitNotFound),
+			expectedSkipped: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			projectConfigMock := config.NewProjectConfigMock()
+			projectConfigMock.On("GetDeployGitMetadata", mock.Anything).Return(tc.configEnabled, nil)
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.Config.ProjectConfig = projectConfigMock
+			})
+			getGitMetadataFunc = func(dirPath string) (deputil.GitMetadata, error) {
+				return tc.gitMetadata, tc.gitErr
+			}
+			defer func() {
+				getGitMetadataFunc = deputil.GetGitMetadata
+				deployFlags.gitMetadata = false
+			}()
+
+			cmd := NewDeployCommand(clients)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+			recordGitMetadata(ctx, clients, cmd)
+
+			if tc.expectedSkipped {
+				clientsMock.EventTracker.AssertNotCalled(t, "SetAppGitMetadata", mock.Anything, mock.Anything)
+			} else {
+				clientsMock.EventTracker.AssertCalled(t, "SetAppGitMetadata", tc.expectedCommit, tc.expectedBranch)
+			}
+		})
+	}
+}
+
+func TestDeployCommand_SinceCommit(t *testing.T) {
+	tests := map[string]struct {
+		args            []string
+		deployedCommit  string
+		changes         []string
+		changesErr      error
+		expectedCommit  string
+		expectedDeploy  bool
+		expectedSaved   bool
+		expectedError   string
+		expectedMessage string
+	}{
+		"skips the deploy without changes since the commit": {
+			args:            []string{"--since-commit", "0123abc"},
+			changes:         []string{},
+			expectedCommit:  "0123abc",
+			expectedMessage: "No project files changed since commit 0123abc",
+		},
+		"deploys with changes since the commit": {
+			args:           []string{"--since-commit", "0123abc"},
+			changes:        []string{"manifest.json"},
+			expectedCommit: "0123abc",
+			expectedDeploy: true,
+		},
+		"errors if changes since the commit are unknown": {
+			args:           []string{"--since-commit", "main~1000"},
+			changesErr:     slackerror.New("unknown revision"),
+			expectedCommit: "main~1000",
+			expectedError:  slackerror.ErrInvalidFlag,
+		},
+		"skips the deploy without changes since the deployed commit": {
+			args:            []string{"--if-changed"},
+			deployedCommit:  "fedc321",
+			changes:         []string{},
+			expectedCommit:  "fedc321",
+			expectedMessage: "No changes, skipping the deploy",
+		},
+		"deploys and saves the commit without a deployed commit": {
+			args:           []string{"--if-changed"},
+			expectedDeploy: true,
+			expectedSaved:  true,
+		},
+		"deploys and saves the commit if changes since the deployed commit are unknown": {
+			args:           []string{"--if-changed"},
+			deployedCommit: "fedc321",
+			changesErr:     slackerror.New("unknown revision"),
+			expectedCommit: "fedc321",
+			expectedDeploy: true,
+			expectedSaved:  true,
+		},
+		"errors if both flags are set": {
+			args:          []string{"--if-changed", "--since-commit", "0123abc"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			cacheMock := cache.NewCacheMock()
+			cacheMock.On("GetDeployedCommit", mock.Anything, "A001").Return(tc.deployedCommit, nil)
+			cacheMock.On("SetDeployedCommit", mock.Anything, "A001", "0123456789abcdef").Return(nil)
+			projectConfigMock := config.NewProjectConfigMock()
+			projectConfigMock.AddDefaultMocks()
+			projectConfigMock.On("Cache").Return(cacheMock)
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.Config.ProjectConfig = projectConfigMock
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+
+			deployPkgMock := new(DeployPkgMock)
+			deployFunc = deployPkgMock.Deploy
+			deployPkgMock.On("Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowAllApps).Return(prompts.SelectedApp{
+				App:  types.App{AppID: "A001"},
+				Auth: types.SlackAuth{TeamID: "T001", Token: "xoxp-example"},
+			}, nil)
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			manifestMock := &app.ManifestMockObject{}
+			manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+				AppManifest: types.AppManifest{
+					Settings: &types.AppSettings{
+						FunctionRuntime: types.SlackHosted,
+					},
+				},
+			}, nil)
+			clients.AppClient().Manifest = manifestMock
+			runAddCommandFunc = func(ctx context.Context, clients *shared.ClientFactory, selection *prompts.SelectedApp, orgGrant string) (context.Context, types.InstallState, types.App, error) {
+				return ctx, types.InstallSuccess, selection.App, nil
+			}
+			var changesCommit string
+			getGitChangesFunc = func(dirPath string, commit string) ([]string, error) {
+				changesCommit = commit
+				return tc.changes, tc.changesErr
+			}
+			getGitMetadataFunc = func(dirPath string) (deputil.GitMetadata, error) {
+				return deputil.GitMetadata{Commit: "0123456789abcdef"}, nil
+			}
+			defer func() {
+				getGitChangesFunc = deputil.GetGitChanges
+				getGitMetadataFunc = deputil.GetGitMetadata
+				deployFlags.ifChanged = false
+				deployFlags.sinceCommit = ""
+			}()
+
+			cmd := NewDeployCommand(clients)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+			cmd.SetArgs(append(tc.args, "--hide-triggers"))
+			testutil.MockCmdIO(clients.IO, cmd)
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				deployPkgMock.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCommit, changesCommit)
+			if tc.expectedDeploy {
+				deployPkgMock.AssertCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				deployPkgMock.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				assert.Contains(t, clientsMock.GetStdoutOutput(), tc.expectedMessage)
+			}
+			if tc.expectedSaved {
+				cacheMock.AssertCalled(t, "SetDeployedCommit", mock.Anything, "A001", "0123456789abcdef")
+			} else {
+				cacheMock.AssertNotCalled(t, "SetDeployedCommit", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestDeployCommand_Rollback(t *testing.T) {
+	tests := map[string]struct {
+		args             []string
+		selectedApp      types.App
+		rollbackErr      error
+		expectedError    string
+		expectedRollback bool
+		expectedMessage  string
+	}{
+		"rolls back the app manifest to the saved snapshot": {
+			args:             []string{"--rollback"},
+			selectedApp:      types.App{AppID: "A001"},
+			expectedRollback: true,
+			expectedMessage:  "Rolled back the app manifest of A001 to the snapshot from",
+		},
+		"errors without a saved snapshot": {
+			args:             []string{"--rollback"},
+			selectedApp:      types.App{AppID: "A001"},
+			rollbackErr:      slackerror.New(slackerror.ErrManifestSnapshotNotFound),
+			expectedError:    slackerror.ErrManifestSnapshotNotFound,
+			expectedRollback: true,
+		},
+		"errors if the app is not deployed": {
+			args:          []string{"--rollback"},
+			selectedApp:   types.App{},
+			expectedError: slackerror.ErrAppNotFound,
+		},
+		"errors if used with the no install flag": {
+			args:          []string{"--rollback", "--no-install"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				projectConfigMock := config.NewProjectConfigMock()
+				projectConfigMock.AddDefaultMocks()
+				clients.Config.ProjectConfig = projectConfigMock
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+
+			deployPkgMock := new(DeployPkgMock)
+			deployFunc = deployPkgMock.Deploy
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowHostedOnly, prompts.ShowAllApps).Return(prompts.SelectedApp{
+				App:  tc.selectedApp,
+				Auth: types.SlackAuth{TeamID: "T001", Token: "xoxp-example"},
+			}, nil)
+			appSelectPromptFunc = appSelectMock.AppSelectPrompt
+			var rolledBack bool
+			rollbackManifestFunc = func(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, app types.App) (cache.ManifestSnapshot, error) {
+				rolledBack = true
+				assert.Equal(t, "xoxp-example", auth.Token)
+				assert.Equal(t, "A001", app.AppID)
+				return cache.ManifestSnapshot{CreatedAt: 1700000000}, tc.rollbackErr
+			}
+			defer func() {
+				rollbackManifestFunc = apps.RollbackManifest
+				deployFlags.noInstall = false
+				deployFlags.rollback = false
+			}()
+
+			cmd := NewDeployCommand(clients)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+			cmd.SetArgs(tc.args)
+			testutil.MockCmdIO(clients.IO, cmd)
+			err := cmd.ExecuteContext(ctx)
+			assert.Equal(t, tc.expectedRollback, rolledBack)
+			deployPkgMock.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, clientsMock.GetStdoutOutput(), tc.expectedMessage)
+		})
+	}
+}
+
 func TestDeployCommand_HasValidDeploymentMethod(t *testing.T) {
 	tests := map[string]struct {
 		app                 types.App
@@ -279,7 +872,7 @@ func TestDeployCommand_DeployHook(t *testing.T) {
 
 			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
 				clients.SDKConfig = sdkConfigMock
-				clients.HookExecutor = hooks.GetHookExecutor(clientsMock.IO, clients.Fs, sdkConfigMock)
+				clients.HookExecutor = hooks.GetHookExecutor(clientsMock.IO, clients.Fs, sdkConfigMock, 0)
 			})
 			cmd := NewDeployCommand(clients)
 			cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
@@ -16,6 +16,8 @@ package platform
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/slackapi/slack-cli/cmd/help"
 	"github.com/slackapi/slack-cli/cmd/triggers"
@@ -31,10 +33,16 @@ import (
 
 type runCmdFlags struct {
 	activityLevel       string
+	attach              bool
 	noActivity          bool
+	noSocket            bool
 	cleanup             bool
 	hideTriggers        bool
+	inspect             bool
+	inspectPort         int
 	orgGrantWorkspaceID string
+	reconnectAttempts   int
+	reconnectBackoff    time.Duration
 }
 
 var runFlags runCmdFlags
@@ -42,6 +50,7 @@ var runFlags runCmdFlags
 // Create handle to the function for testing
 // TODO - Stopgap until we learn the correct way to structure our code for testing.
 var runFunc = platform.Run
+var runAttachFunc = platform.Attach
 var runRunCommandFunc = RunRunCommand
 var runAppSelectPromptFunc = prompts.AppSelectPrompt
 
@@ -50,12 +59,21 @@ func NewRunCommand(clients *shared.ClientFactory) *cobra.Command {
 		Use:     "run [app-file-path]",
 		Aliases: []string{"dev", "start-dev"}, // Aliases a few proposed alternative names
 		Short:   "Start a local server to develop and run the app locally",
-		Long:    `Start a local server to develop and run the app locally while watching for file changes`,
-		Args:    cobra.MaximumNArgs(1),
+		Long: strings.Join([]string{
+			"Start a local server to develop and run the app locally while watching for file changes",
+			"",
+			"Apps that serve HTTP requests can skip the socket connection with the --no-socket",
+			"flag. Activity from the Slack Platform might be limited while running this way.",
+		}, "\n"),
+		Args: cobra.MaximumNArgs(1),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "platform run", Meaning: "Start a local development server"},
 			{Command: "platform run ./src/app.py", Meaning: "Run a local development server with a custom app entry point"},
 			{Command: "platform run --cleanup", Meaning: "Run a local development server with cleanup"},
+			{Command: "platform run --attach", Meaning: "Stream activity of a development server started in another terminal"},
+			{Command: "platform run --inspect-port 9230", Meaning: "Run a local development server with the runtime debugger on a port"},
+			{Command: "platform run --no-socket", Meaning: "Run the start hook of an app that serves HTTP requests"},
+			{Command: "platform run --reconnect-attempts 10 --reconnect-backoff 2s", Meaning: "Retry a dropped socket connection up to 10 times"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			// Verify command is run in a project directory
@@ -68,13 +86,19 @@ func NewRunCommand(clients *shared.ClientFactory) *cobra.Command {
 
 	// Add flags
 	cmd.Flags().StringVar(&runFlags.activityLevel, "activity-level", platform.ActivityMinLevelDefault, "activity level to display")
+	cmd.Flags().BoolVar(&runFlags.attach, "attach", false, "stream activity of an already running local app")
 	cmd.Flags().BoolVar(&runFlags.noActivity, "no-activity", false, "hide Slack Platform log activity")
+	cmd.Flags().BoolVar(&runFlags.noSocket, "no-socket", false, "run the start hook without a CLI managed socket\n  for apps that serve HTTP requests")
 	cmd.Flags().BoolVar(&runFlags.cleanup, "cleanup", false, "uninstall the local app after exiting")
 	cmd.Flags().StringVar(&runFlags.orgGrantWorkspaceID, cmdutil.OrgGrantWorkspaceFlag, "", cmdutil.OrgGrantWorkspaceDescription())
 	cmd.Flags().BoolVar(&runFlags.hideTriggers, "hide-triggers", false, "do not list triggers and skip trigger creation prompts")
+	cmd.Flags().BoolVar(&runFlags.inspect, "inspect", false, fmt.Sprintf("enable the runtime debugger on port %d", platform.InspectPortDefault))
+	cmd.Flags().IntVar(&runFlags.inspectPort, "inspect-port", 0, "enable the runtime debugger on a port")
+	cmd.Flags().IntVar(&runFlags.reconnectAttempts, "reconnect-attempts", 0, "retry a dropped socket connection a number of times")
+	cmd.Flags().DurationVar(&runFlags.reconnectBackoff, "reconnect-backoff", time.Second, "wait before retrying a dropped socket connection\n  and double the wait after each attempt")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
-		style.ToggleStyles(clients.IO.IsTTY() && !clients.Config.NoColor)
+		style.ToggleStyles(clients.IsStyleEnabled())
 
 		cmd.Flag("activity-level").DefValue = ""
 		cmd.Flag("activity-level").Usage = fmt.Sprintf(
@@ -97,6 +121,36 @@ func RunRunCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []str
 	}
 	ctx := cmd.Context()
 
+	if runFlags.attach && (len(args) > 0 || runFlags.cleanup || runFlags.noActivity) {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --attach flag cannot be used with an app file path or the --cleanup or --no-activity flags")
+	}
+
+	inspectPort, err := resolveInspectPort(cmd)
+	if err != nil {
+		return err
+	}
+	if runFlags.attach && inspectPort > 0 {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --attach flag cannot be used with the --inspect or --inspect-port flags")
+	}
+	if runFlags.noSocket && runFlags.attach {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --no-socket flag cannot be used with the --attach flag")
+	}
+	if runFlags.noSocket && (cmd.Flags().Changed("reconnect-attempts") || cmd.Flags().Changed("reconnect-backoff")) {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --no-socket flag cannot be used with the --reconnect-attempts or --reconnect-backoff flags")
+	}
+	if runFlags.reconnectAttempts < 0 {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --reconnect-attempts flag cannot be negative")
+	}
+	if runFlags.reconnectBackoff <= 0 {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --reconnect-backoff flag must be a positive duration like 500ms or 2s")
+	}
+
 	var appFilePath string
 	if len(args) > 0 {
 		appFilePath = args[0]
@@ -136,6 +190,16 @@ func RunRunCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []str
 		}
 	}
 
+	// Stream activity of the session started by another run command
+	if runFlags.attach {
+		attachArgs := platform.AttachArgs{
+			ActivityLevel: runFlags.activityLevel,
+			App:           selection.App,
+			Auth:          selection.Auth,
+		}
+		return runAttachFunc(ctx, clients, attachArgs)
+	}
+
 	runFlags.orgGrantWorkspaceID, err = prompts.ValidateGetOrgWorkspaceGrant(ctx, clients, &selection, runFlags.orgGrantWorkspaceID, true /* top prompt option should be 'all workspaces' */)
 	if err != nil {
 		return err
@@ -150,6 +214,10 @@ func RunRunCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []str
 		AppFilePath:         appFilePath,
 		Auth:                selection.Auth,
 		Cleanup:             runFlags.cleanup,
+		InspectPort:         inspectPort,
+		NoSocket:            runFlags.noSocket,
+		ReconnectAttempts:   runFlags.reconnectAttempts,
+		ReconnectBackoff:    runFlags.reconnectBackoff,
 		ShowTriggers:        triggers.ShowTriggers(clients, runFlags.hideTriggers),
 		OrgGrantWorkspaceID: runFlags.orgGrantWorkspaceID,
 	}
@@ -161,3 +229,20 @@ func RunRunCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []str
 
 	return nil
 }
+
+// resolveInspectPort returns the runtime debugger port from flags or 0 if the
+// debugger is not enabled
+func resolveInspectPort(cmd *cobra.Command) (int, error) {
+	if cmd.Flags().Changed("inspect-port") {
+		if runFlags.inspectPort < 1 || runFlags.inspectPort > 65535 {
+			return 0, slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --inspect-port flag must be between 1 and 65535").
+				WithRemediation("Choose an available port, such as %d", platform.InspectPortDefault)
+		}
+		return runFlags.inspectPort, nil
+	}
+	if runFlags.inspect {
+		return platform.InspectPortDefault, nil
+	}
+	return 0, nil
+}
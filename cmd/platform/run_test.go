@@ -17,6 +17,7 @@ package platform
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/hooks"
@@ -53,6 +54,11 @@ func (m *RunPkgMock) Run(ctx context.Context, clients *shared.ClientFactory, run
 	return types.InstallSuccess, args.Error(0)
 }
 
+func (m *RunPkgMock) Attach(ctx context.Context, clients *shared.ClientFactory, attachArgs platform.AttachArgs) error {
+	args := m.Called(ctx, clients, attachArgs)
+	return args.Error(0)
+}
+
 func TestRunCommand_Flags(t *testing.T) {
 	tests := map[string]struct {
 		setup           func(cm *shared.ClientsMock)
@@ -78,6 +84,7 @@ func TestRunCommand_Flags(t *testing.T) {
 				Cleanup:             false,
 				ShowTriggers:        false,
 				OrgGrantWorkspaceID: "T123", // Flag passed through
+				ReconnectBackoff:    time.Second,
 			},
 			expectedErr: nil,
 		},
@@ -95,6 +102,7 @@ func TestRunCommand_Flags(t *testing.T) {
 				Cleanup:             false,
 				ShowTriggers:        true,
 				OrgGrantWorkspaceID: "T123", // Flag passed through
+				ReconnectBackoff:    time.Second,
 			},
 			expectedErr: nil,
 		},
@@ -131,6 +139,7 @@ func TestRunCommand_Flags(t *testing.T) {
 				Cleanup:             false,
 				ShowTriggers:        true,
 				OrgGrantWorkspaceID: "T123", // Flag passed through
+				ReconnectBackoff:    time.Second,
 			},
 		},
 		"Standalone workspace app": {
@@ -155,6 +164,7 @@ func TestRunCommand_Flags(t *testing.T) {
 				Cleanup:             false,
 				ShowTriggers:        true,
 				OrgGrantWorkspaceID: "", // Flag not passed through
+				ReconnectBackoff:    time.Second,
 			},
 			expectedErr: nil,
 		},
@@ -174,17 +184,19 @@ func TestRunCommand_Flags(t *testing.T) {
 					AppID: "A123",
 					IsDev: true,
 				},
-				Cleanup:      false,
-				ShowTriggers: true,
+				Cleanup:          false,
+				ShowTriggers:     true,
+				ReconnectBackoff: time.Second,
 			},
 		},
 		"Error if interrupted during app selection": {
 			selectedAppErr: slackerror.New(slackerror.ErrProcessInterrupted),
 			expectedRunArgs: platform.RunArgs{
-				Activity:      true,
-				ActivityLevel: "info",
-				Cleanup:       false,
-				ShowTriggers:  true,
+				Activity:         true,
+				ActivityLevel:    "info",
+				Cleanup:          false,
+				ShowTriggers:     true,
+				ReconnectBackoff: time.Second,
 			},
 			expectedErr: slackerror.New(slackerror.ErrProcessInterrupted),
 		},
@@ -198,15 +210,106 @@ func TestRunCommand_Flags(t *testing.T) {
 				Auth: types.SlackAuth{},
 			},
 			expectedRunArgs: platform.RunArgs{
-				Activity:      true,
-				ActivityLevel: "info",
-				App:           types.NewApp(),
-				AppFilePath:   "./src/app.py",
-				Auth:          types.SlackAuth{},
-				Cleanup:       false,
-				ShowTriggers:  true,
+				Activity:         true,
+				ActivityLevel:    "info",
+				App:              types.NewApp(),
+				AppFilePath:      "./src/app.py",
+				Auth:             types.SlackAuth{},
+				Cleanup:          false,
+				ShowTriggers:     true,
+				ReconnectBackoff: time.Second,
+			},
+		},
+		"Inspect flag sets the default InspectPort": {
+			cmdArgs: []string{"--inspect"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.NewApp(),
+				Auth: types.SlackAuth{},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:         true,
+				ActivityLevel:    "info",
+				App:              types.NewApp(),
+				Auth:             types.SlackAuth{},
+				InspectPort:      9229,
+				ShowTriggers:     true,
+				ReconnectBackoff: time.Second,
 			},
 		},
+		"Inspect port flag sets InspectPort": {
+			cmdArgs: []string{"--inspect", "--inspect-port", "9230"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.NewApp(),
+				Auth: types.SlackAuth{},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:         true,
+				ActivityLevel:    "info",
+				App:              types.NewApp(),
+				Auth:             types.SlackAuth{},
+				InspectPort:      9230,
+				ShowTriggers:     true,
+				ReconnectBackoff: time.Second,
+			},
+		},
+		"Reconnect flags set the reconnect attempts and backoff": {
+			cmdArgs: []string{"--reconnect-attempts", "5", "--reconnect-backoff", "250ms"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.NewApp(),
+				Auth: types.SlackAuth{},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:          true,
+				ActivityLevel:     "info",
+				App:               types.NewApp(),
+				Auth:              types.SlackAuth{},
+				ReconnectAttempts: 5,
+				ReconnectBackoff:  250 * time.Millisecond,
+				ShowTriggers:      true,
+			},
+		},
+		"No socket flag runs the start hook without a socket": {
+			cmdArgs: []string{"--no-socket"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.NewApp(),
+				Auth: types.SlackAuth{},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:         true,
+				ActivityLevel:    "info",
+				App:              types.NewApp(),
+				Auth:             types.SlackAuth{},
+				NoSocket:         true,
+				ReconnectBackoff: time.Second,
+				ShowTriggers:     true,
+			},
+		},
+		"Error if no socket is used with attach": {
+			cmdArgs: []string{"--no-socket", "--attach"},
+			expectedErr: slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --no-socket flag cannot be used with the --attach flag"),
+		},
+		"Error if no socket is used with reconnect flags": {
+			cmdArgs: []string{"--no-socket", "--reconnect-attempts", "3"},
+			expectedErr: slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --no-socket flag cannot be used with the --reconnect-attempts or --reconnect-backoff flags"),
+		},
+		"Error if reconnect attempts are negative": {
+			cmdArgs: []string{"--reconnect-attempts", "-1"},
+			expectedErr: slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --reconnect-attempts flag cannot be negative"),
+		},
+		"Error if reconnect backoff is not positive": {
+			cmdArgs: []string{"--reconnect-backoff", "0s"},
+			expectedErr: slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --reconnect-backoff flag must be a positive duration like 500ms or 2s"),
+		},
+		"Error if inspect port is out of range": {
+			cmdArgs: []string{"--inspect-port", "0"},
+			expectedErr: slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --inspect-port flag must be between 1 and 65535").
+				WithRemediation("Choose an available port, such as %d", 9229),
+		},
 		"Error if app file path does not exist": {
 			cmdArgs: []string{"./nonexistent/app.py"},
 			selectedAppAuth: prompts.SelectedApp{
@@ -277,6 +380,83 @@ func TestRunCommand_Flags(t *testing.T) {
 	}
 }
 
+func TestRunCommand_Attach(t *testing.T) {
+	tests := map[string]struct {
+		cmdArgs            []string
+		selectedAppAuth    prompts.SelectedApp
+		attachErr          error
+		expectedAttachArgs platform.AttachArgs
+		expectedErr        error
+	}{
+		"Attach to the session of the selected app": {
+			cmdArgs: []string{"--attach", "--activity-level", "debug"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.App{AppID: "A123", IsDev: true},
+				Auth: types.SlackAuth{TeamID: "T123"},
+			},
+			expectedAttachArgs: platform.AttachArgs{
+				ActivityLevel: "debug",
+				App:           types.App{AppID: "A123", IsDev: true},
+				Auth:          types.SlackAuth{TeamID: "T123"},
+			},
+		},
+		"Error if no session is running for the selected app": {
+			cmdArgs: []string{"--attach"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.App{AppID: "A123", IsDev: true},
+				Auth: types.SlackAuth{TeamID: "T123"},
+			},
+			attachErr:   slackerror.New(slackerror.ErrRunSessionNotFound),
+			expectedErr: slackerror.New(slackerror.ErrRunSessionNotFound),
+		},
+		"Error if attaching with the cleanup flag": {
+			cmdArgs: []string{"--attach", "--cleanup"},
+			expectedErr: slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --attach flag cannot be used with an app file path or the --cleanup or --no-activity flags"),
+		},
+		"Error if attaching with the inspect flag": {
+			cmdArgs: []string{"--attach", "--inspect"},
+			expectedErr: slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --attach flag cannot be used with the --inspect or --inspect-port flags"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.IO.On("IsTTY").Return(true)
+			clientsMock.IO.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+				clients.SDKConfig = hooks.NewSDKConfigMock()
+			})
+
+			appSelectMock := prompts.NewAppSelectMock()
+			appSelectMock.On("AppSelectPrompt", mock.Anything, mock.Anything, prompts.ShowLocalOnly, prompts.ShowAllApps).Return(tc.selectedAppAuth, nil)
+			runAppSelectPromptFunc = appSelectMock.AppSelectPrompt
+
+			runPkgMock := new(RunPkgMock)
+			runFunc = runPkgMock.Run
+			runAttachFunc = runPkgMock.Attach
+			runPkgMock.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			runPkgMock.On("Attach", mock.Anything, mock.Anything, mock.Anything).Return(tc.attachErr)
+
+			cmd := NewRunCommand(clients)
+			testutil.MockCmdIO(clients.IO, cmd)
+			cmd.SetArgs(tc.cmdArgs)
+
+			err := cmd.ExecuteContext(ctx)
+
+			runPkgMock.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+				runPkgMock.AssertCalled(t, "Attach", mock.Anything, mock.Anything, tc.expectedAttachArgs)
+			} else {
+				assert.Equal(t, tc.expectedErr, slackerror.ToSlackError(err))
+			}
+		})
+	}
+}
+
 func TestRunCommand_Help(t *testing.T) {
 	ctx := slackcontext.MockContext(t.Context())
 	clientsMock := shared.NewClientsMock()
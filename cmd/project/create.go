@@ -20,6 +20,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -36,10 +37,13 @@ import (
 
 // Flags
 var createAppNameFlag string
+var createChecksumFlag string
 var createEnvironmentFlag string
 var createGitBranchFlag string
 var createListFlag bool
+var createNoGitFlag bool
 var createSubdirFlag string
+var createTemplateRefFlag string
 var createTemplateURLFlag string
 
 // Handle to client's create function used for testing
@@ -56,6 +60,9 @@ type promptObject struct {
 
 const viewMoreSamples = "slack-cli#view-more-samples"
 
+// sha256ChecksumPattern matches the hexadecimal digest of a SHA-256 checksum
+var sha256ChecksumPattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
 func NewCreateCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		SuggestFor: []string{"new"},
@@ -64,14 +71,21 @@ func NewCreateCommand(clients *shared.ClientFactory) *cobra.Command {
 		Long: `Create a new Slack project on your local machine from an optional template.
 
 The 'agent' argument is a shortcut to create an AI Agent app. If you want to
-name your app 'agent' (not create an AI Agent), use the --name flag instead.`,
+name your app 'agent' (not create an AI Agent), use the --name flag instead.
+
+Private templates are cloned using the SSH agent, git credential helpers, or a
+token set in the SLACK_GIT_TOKEN environment variable. The token is only sent
+over HTTPS to github.com or to the host set in SLACK_GIT_TOKEN_HOST.`,
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "create my-project", Meaning: "Create a new project from a template"},
 			{Command: "create agent my-agent-app", Meaning: "Create a new AI Agent app"},
 			{Command: "create my-project -t slack-samples/deno-hello-world", Meaning: "Start a new project from a specific template"},
 			{Command: "create --name my-project", Meaning: "Create a project named 'my-project'"},
 			{Command: "create my-project -t org/monorepo --subdir apps/my-app", Meaning: "Create from a subdirectory of a template"},
+			{Command: "create my-project -t slack-samples/deno-hello-world --template-ref v1.0.0", Meaning: "Create from a specific tag or commit of a template"},
+			{Command: "create my-project -t slack-samples/deno-hello-world --template-ref v1.0.0 --checksum <sha256>", Meaning: "Verify the downloaded template archive before extraction"},
 			{Command: "create my-project -t slack-samples/bolt-js-starter-template --app A0123456789 --environment local", Meaning: "Create from template and link to an existing app"},
+			{Command: "create my-project --no-git", Meaning: "Create a project inside an existing repository without git"},
 		}),
 		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -83,10 +97,13 @@ name your app 'agent' (not create an AI Agent), use the --name flag instead.`,
 	// Add flags
 	cmd.Flags().StringVarP(&createTemplateURLFlag, "template", "t", "", "template URL for your app")
 	cmd.Flags().StringVarP(&createGitBranchFlag, "branch", "b", "", "name of git branch to checkout")
+	cmd.Flags().StringVar(&createTemplateRefFlag, "template-ref", "", "branch, tag, or commit of the template to use")
 	cmd.Flags().StringVarP(&createAppNameFlag, "name", "n", "", "name for your app (overrides the name argument)")
 	cmd.Flags().BoolVar(&createListFlag, "list", false, "list available app templates")
 	cmd.Flags().StringVar(&createSubdirFlag, "subdir", "", "subdirectory in the template to use as project")
 	cmd.Flags().StringVarP(&createEnvironmentFlag, "environment", "E", "", "environment to save existing app (local, deployed)")
+	cmd.Flags().StringVar(&createChecksumFlag, "checksum", "", "SHA-256 checksum to verify the template archive")
+	cmd.Flags().BoolVar(&createNoGitFlag, "no-git", false, "download the template archive without using git")
 
 	return cmd
 }
@@ -133,6 +150,24 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []
 			WithMessage("The --subdir flag requires the --template flag")
 	}
 
+	// --template-ref replaces --branch
+	if cmd.Flags().Changed("template-ref") && cmd.Flags().Changed("branch") {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --template-ref flag cannot be used with the --branch flag")
+	}
+
+	// --checksum requires --template and must be a SHA-256 hex digest
+	if cmd.Flags().Changed("checksum") {
+		if !templateFlagProvided {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --checksum flag requires the --template flag")
+		}
+		if !sha256ChecksumPattern.MatchString(createChecksumFlag) {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --checksum flag must be a SHA-256 checksum of 64 hexadecimal characters")
+		}
+	}
+
 	// --app must be an app ID when used with create
 	appFlagProvided := clients.Config.AppFlag != ""
 	if appFlagProvided && !types.IsAppID(clients.Config.AppFlag) {
@@ -205,7 +240,10 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []
 		DisplayName: displayName,
 		Template:    template,
 		GitBranch:   createGitBranchFlag,
+		GitRef:      createTemplateRefFlag,
 		Subdir:      subdir,
+		Checksum:    createChecksumFlag,
+		NoGit:       createNoGitFlag,
 	}
 	clients.EventTracker.SetAppTemplate(template.GetTemplatePath())
 
@@ -26,17 +26,22 @@ import (
 	"github.com/slackapi/slack-cli/cmd/app"
 	"github.com/slackapi/slack-cli/cmd/auth"
 	"github.com/slackapi/slack-cli/cmd/collaborators"
+	configcmd "github.com/slackapi/slack-cli/cmd/config"
 	"github.com/slackapi/slack-cli/cmd/datastore"
 	"github.com/slackapi/slack-cli/cmd/docgen"
 	"github.com/slackapi/slack-cli/cmd/docs"
 	"github.com/slackapi/slack-cli/cmd/doctor"
 	"github.com/slackapi/slack-cli/cmd/env"
+	experimentcmd "github.com/slackapi/slack-cli/cmd/experiment"
 	"github.com/slackapi/slack-cli/cmd/externalauth"
 	"github.com/slackapi/slack-cli/cmd/feedback"
 	"github.com/slackapi/slack-cli/cmd/fingerprint"
 	"github.com/slackapi/slack-cli/cmd/function"
 	"github.com/slackapi/slack-cli/cmd/help"
+	"github.com/slackapi/slack-cli/cmd/hooks"
+	"github.com/slackapi/slack-cli/cmd/icon"
 	"github.com/slackapi/slack-cli/cmd/manifest"
+	"github.com/slackapi/slack-cli/cmd/open"
 	"github.com/slackapi/slack-cli/cmd/openformresponse"
 	"github.com/slackapi/slack-cli/cmd/platform"
 	"github.com/slackapi/slack-cli/cmd/project"
@@ -45,8 +50,10 @@ import (
 	"github.com/slackapi/slack-cli/cmd/upgrade"
 	versioncmd "github.com/slackapi/slack-cli/cmd/version"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -82,6 +89,7 @@ var AliasMap = map[string]*AliasInfo{
 	"run":       {CommandFactory: platform.NewRunCommand, CanonicalName: "platform run", ParentName: "platform"},
 	"samples":   {CommandFactory: project.NewSamplesCommand, CanonicalName: "project samples", ParentName: "project"},
 	"uninstall": {CommandFactory: app.NewUninstallCommand, CanonicalName: "app uninstall", ParentName: "app"},
+	"whoami":    {CommandFactory: auth.NewWhoamiCommand, CanonicalName: "auth whoami", ParentName: "auth"},
 }
 var processName = cmdutil.GetProcessName()
 
@@ -117,6 +125,12 @@ func NewRootCommand(clients *shared.ClientFactory, updateNotification *update.Up
 			if isAlias {
 				clients.Config.CommandCanonical = aliasedCommand.CanonicalName
 			}
+			clients.IO.WriteEvent(ctx, iostreams.Event{Type: iostreams.EventCommandStart, Command: clients.Config.Command})
+
+			// Set flags of the command that were not set to values of a config file
+			if err := clients.Config.SetFlagsFromFile(cmd.Flags()); err != nil {
+				return err
+			}
 
 			// Set flag names provided by user (includes both root and subcommand flags, used for metrics)
 			flagset := cmd.Flags()
@@ -128,6 +142,15 @@ func NewRootCommand(clients *shared.ClientFactory, updateNotification *update.Up
 
 			// Check for an CLI update in the background while the command runs
 			updateNotification = update.New(clients, version.Raw(), "SLACK_SKIP_UPDATE")
+			interval, err := clients.Config.VersionCheckInterval(ctx)
+			if err != nil {
+				if slackerror.ToSlackError(err).Code == slackerror.ErrInvalidFlag {
+					return err
+				}
+				clients.IO.PrintDebug(ctx, "Failed to read the version check interval: %s", err)
+				interval = config.DefaultVersionCheckInterval
+			}
+			updateNotification.SetInterval(interval)
 			updateNotification.CheckForUpdateInBackground(ctx, false)
 
 			// Recommend the official Slack plugin when running inside Claude Code
@@ -168,13 +191,18 @@ func Init(ctx context.Context) (*cobra.Command, *shared.ClientFactory) {
 		app.NewCommand(clients),
 		auth.NewCommand(clients),
 		collaborators.NewCommand(clients),
+		configcmd.NewCommand(clients),
 		datastore.NewCommand(clients),
 		docgen.NewCommand(clients),
 		env.NewCommand(clients),
+		experimentcmd.NewCommand(clients),
 		externalauth.NewCommand(clients),
 		fingerprint.NewCommand(clients),
 		function.NewCommand(clients),
+		hooks.NewCommand(clients),
+		icon.NewCommand(clients),
 		manifest.NewCommand(clients),
+		open.NewCommand(clients),
 		openformresponse.NewCommand(clients),
 		platform.NewCommand(clients),
 		project.NewCommand(clients),
@@ -212,6 +240,10 @@ func Init(ctx context.Context) (*cobra.Command, *shared.ClientFactory) {
 	clients.Config.InitializeGlobalFlags(rootCmd)
 	clients.Config.SetFlags(rootCmd)
 
+	// Suggest saved values for global flags during shell completions
+	_ = rootCmd.RegisterFlagCompletionFunc("app", prompts.AppFlagCompletion(clients))
+	_ = rootCmd.RegisterFlagCompletionFunc("team", prompts.TeamFlagCompletion(clients))
+
 	rootCmd.SetHelpFunc(help.HelpFunc(clients, aliases))
 
 	// OnInitialize will execute before any root or child commands' Pre* methods.
@@ -234,6 +266,25 @@ func Init(ctx context.Context) (*cobra.Command, *shared.ClientFactory) {
 // Put global CLI initialization routines that rely on flag and argument parsing in here please!
 // TODO: consider using arguments for this function for certain dependencies, like working directory and other OS-specific strings, that OnInitialize above can provide during actual execution, but that we can override with test values for easier testing.
 func InitConfig(ctx context.Context, clients *shared.ClientFactory, rootCmd *cobra.Command) error {
+	// Change into a project directory outside of the current working directory
+	if clients.Config.ProjectDirFlag != "" {
+		projectDirPath, err := config.ResolveProjectDirPath(clients.Fs, clients.Os, clients.Config.ProjectDirFlag)
+		if err != nil {
+			return err
+		}
+		// Paths of flags are relative to the directory the command was run from
+		shellDirPath, err := clients.Os.Getwd()
+		if err != nil {
+			return err
+		}
+		if err := config.ResolveFilenameFlags(rootCmd, shellDirPath); err != nil {
+			return slackerror.Wrap(err, slackerror.ErrInvalidFlag)
+		}
+		if err := clients.Os.Chdir(projectDirPath); err != nil {
+			return slackerror.Wrap(err, slackerror.ErrInvalidAppDirectory)
+		}
+	}
+
 	// Get the current working directory (usually, but not always the project)
 	workingDirPath, err := clients.Os.Getwd()
 	if err != nil {
@@ -248,9 +299,32 @@ func InitConfig(ctx context.Context, clients *shared.ClientFactory, rootCmd *cob
 		return err
 	}
 
+	// Set global flags that were not set to values of a config file
+	if err := clients.Config.LoadFlagsFile(rootCmd); err != nil {
+		return err
+	}
+
 	// Set custom system config directory
 	if clients.Config.ConfigDirFlag != "" {
 		clients.Config.SystemConfig.SetCustomConfigDirPath(clients.Config.ConfigDirFlag)
+		if err := clients.Config.SystemConfig.InitCustomConfigDir(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Use the configurations of a named profile
+	if clients.Config.ProfileFlag != "" && clients.Config.ProfileFlag != config.DefaultProfileName {
+		if err := config.ValidateProfileName(clients.Config.ProfileFlag); err != nil {
+			return err
+		}
+		clients.Config.SystemConfig.SetProfile(clients.Config.ProfileFlag)
+	}
+
+	// Redaction is skipped with either --no-redact or --redact=false, which can
+	// also be set with a config file
+	redactSet := rootCmd.PersistentFlags().Changed("redact") || clients.Config.ConfigFileFlag != ""
+	if redactSet && !clients.Config.RedactFlag {
+		clients.Config.NoRedactFlag = true
 	}
 
 	// Accessible mode implies no-color
@@ -258,15 +332,44 @@ func InitConfig(ctx context.Context, clients *shared.ClientFactory, rootCmd *cob
 		clients.Config.NoColor = true
 	}
 
+	// Outputs are printed at or above the --log-level or with --verbose
+	if err := clients.Config.SetLogLevel(); err != nil {
+		return err
+	}
+
+	// Write lifecycle events as JSON lines to an open file descriptor
+	if clients.Config.EventsFDFlag != 0 {
+		events := os.NewFile(uintptr(clients.Config.EventsFDFlag), "events")
+		if events == nil {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The file descriptor %d of the --events-fd flag is not valid", clients.Config.EventsFDFlag)
+		}
+		if _, err := events.Stat(); err != nil {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The file descriptor %d of the --events-fd flag is not open", clients.Config.EventsFDFlag).
+				WithRootCause(err)
+		}
+		clients.IO.SetEventsWriter(events)
+	}
+
 	// Init color and formatting
-	style.ToggleStyles(clients.IO.IsTTY() && !clients.Config.NoColor)
-	style.ToggleSpinner(clients.IO.IsTTY() && !clients.Config.NoColor && !clients.Config.DebugEnabled)
+	style.ToggleStyles(clients.IsStyleEnabled())
+	style.ToggleSpinner(clients.IsStyleEnabled() && !clients.Config.DebugEnabled)
 
 	// Find and replace deprecated flags
 	if err := clients.Config.DeprecatedFlagSubstitutions(rootCmd); err != nil {
 		return err
 	}
 
+	// Expand a saved context into the team, app, and token flags not set
+	if clients.Config.ContextFlag != "" {
+		preset, err := clients.Config.SystemConfig.GetContextPreset(ctx, clients.Config.ContextFlag)
+		if err != nil {
+			return err
+		}
+		clients.Config.SetContextFlags(preset)
+	}
+
 	// Set the preference
 	trustSources, err := clients.Config.SystemConfig.GetTrustUnknownSources(ctx)
 	if err != nil {
@@ -275,6 +378,11 @@ func InitConfig(ctx context.Context, clients *shared.ClientFactory, rootCmd *cob
 	clients.Config.TrustUnknownSources = trustSources
 
 	// Init clients that use flags
+	if clients.Config.APIHostFlag != "" {
+		if err := clients.Auth().ValidateAPIHost(ctx, clients.Config.APIHostFlag); err != nil {
+			return err
+		}
+	}
 	clients.Config.APIHostResolved = clients.Auth().ResolveAPIHost(ctx, clients.Config.APIHostFlag, nil)
 	clients.Config.LogstashHostResolved = clients.Auth().ResolveLogstashHost(ctx, clients.Config.APIHostResolved)
 
@@ -368,6 +476,7 @@ func ExecuteContext(ctx context.Context, rootCmd *cobra.Command, clients *shared
 			go func() {
 				// Explicitly call cleanup as process interrupt handling below will break normal command execution/error handling
 				clients.IO.SetExitCode(iostreams.ExitCancel)
+				writeCommandEndEvent(ctx, clients, slackerror.ErrProcessInterrupted)
 				_ = clients.EventTracker.FlushToLogstash(ctx, clients.Config, clients.IO, iostreams.ExitCancel)
 				clients.IO.PrintDebug(ctx, "Root waiting for cleanup waitgroup...")
 				clients.CleanupWaitGroup.Wait()
@@ -388,6 +497,7 @@ func ExecuteContext(ctx context.Context, rootCmd *cobra.Command, clients *shared
 	}()
 
 	// The cleanup() method in the root command will invoke via `defer` from within Execute.
+	var errorCode string
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		if slackerror.Is(err, slackerror.ErrProcessInterrupted) {
 			clients.IO.SetExitCode(iostreams.ExitCancel)
@@ -406,6 +516,7 @@ func ExecuteContext(ctx context.Context, rootCmd *cobra.Command, clients *shared
 		clients.EventTracker.SetErrorMessage(err.Error())
 		if slackErr, ok := err.(*slackerror.Error); ok {
 			clients.EventTracker.SetErrorCode(slackErr.Code)
+			errorCode = slackErr.Code
 		}
 		defer clients.Os.Exit(int(clients.IO.GetExitCode()))
 		completedChan <- true
@@ -414,9 +525,22 @@ func ExecuteContext(ctx context.Context, rootCmd *cobra.Command, clients *shared
 	}
 
 	<-exitChan
+	writeCommandEndEvent(ctx, clients, errorCode)
 	_ = clients.EventTracker.FlushToLogstash(ctx, clients.Config, clients.IO, clients.IO.GetExitCode())
 }
 
+// writeCommandEndEvent writes the lifecycle event for the end of a command with
+// the exit code and any error code
+func writeCommandEndEvent(ctx context.Context, clients *shared.ClientFactory, errorCode string) {
+	exitCode := clients.IO.GetExitCode()
+	clients.IO.WriteEvent(ctx, iostreams.Event{
+		Type:      iostreams.EventCommandEnd,
+		Command:   clients.Config.Command,
+		ExitCode:  &exitCode,
+		ErrorCode: errorCode,
+	})
+}
+
 // cleanup is attached to the root command via cobra's OnFinalize event subscriber.
 // It is invoked by cobra via `defer` when the Execute method above finishes - regardless of an error occurring or not.
 func cleanup(ctx context.Context, clients *shared.ClientFactory) {
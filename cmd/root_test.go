@@ -263,6 +263,10 @@ func Test_Aliases(t *testing.T) {
 			args:     "uninstall --help",
 			expected: "app uninstall",
 		},
+		"Whoami alias": {
+			args:     "whoami --help",
+			expected: "auth whoami",
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -16,8 +16,11 @@ package triggers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
@@ -30,6 +33,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/text/cases"
@@ -48,6 +52,19 @@ type accessCmdFlags struct {
 	workspaces       string
 	organizations    string
 	includeAppCollab bool
+	excludeAppCollab bool
+	noPrompt         bool
+	output           string
+	setUsers         string
+	setChannels      string
+	setWorkspaces    string
+	setOrganizations string
+	dryRun           bool
+	usersFile        string
+	channelsFile     string
+	workspacesFile   string
+	revokeAll        bool
+	yes              bool
 }
 
 var accessFlags accessCmdFlags
@@ -63,6 +80,11 @@ func NewAccessCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "trigger access --trigger-id Ft01234ABCD --everyone", Meaning: "Grant everyone access to run a trigger"},
 			{Command: "trigger access --trigger-id Ft01234ABCD --grant \\\n    --channels C012345678", Meaning: "Grant certain channels access to run a trigger"},
 			{Command: "trigger access --trigger-id Ft01234ABCD --revoke \\\n    --users USLACKBOT,U012345678", Meaning: "Revoke certain users access to run a trigger"},
+			{Command: "trigger access --trigger-id Ft01234ABCD --info --output json", Meaning: "Print who can run a trigger as JSON"},
+			{Command: "trigger access --trigger-id Ft01234ABCD --grant --no-prompt \\\n    --users U012345678 --exclude-app-collaborators", Meaning: "Grant certain users access without prompts or app collaborators"},
+			{Command: "trigger access --trigger-id Ft01234ABCD --dry-run \\\n    --set-users U012345678,U023456789 --set-channels C012345678", Meaning: "Preview the changes to only allow certain users and channels"},
+			{Command: "trigger access --trigger-id Ft01234ABCD --grant \\\n    --users-file users.txt", Meaning: "Grant users listed in a file access to run a trigger"},
+			{Command: "trigger access --trigger-id Ft01234ABCD --revoke-all --yes", Meaning: "Reset access to only app collaborators without confirming"},
 		}),
 		FParseErrWhitelist: cobra.FParseErrWhitelist{
 			UnknownFlags: true,
@@ -77,11 +99,19 @@ func NewAccessCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&accessFlags.triggerID, "trigger-id", "T", "", "the ID of the trigger")
+	_ = cmd.RegisterFlagCompletionFunc("trigger-id", triggerIDCompletion(clients))
+	addRefreshFlag(cmd)
 
 	cmd.Flags().StringVarP(&accessFlags.users, "users", "U", "", "a comma-separated list of Slack user IDs")
 	cmd.Flags().StringVarP(&accessFlags.channels, "channels", "C", "", "a comma-separated list of Slack channel IDs")
 	cmd.Flags().StringVarP(&accessFlags.workspaces, "workspaces", "W", "", "a comma-separated list of Slack workspace IDs")
 	cmd.Flags().StringVarP(&accessFlags.organizations, "organizations", "O", "", "a comma-separated list of Slack organization IDs")
+	cmd.Flags().StringVar(&accessFlags.usersFile, "users-file", "", "a file of Slack user IDs separated by commas or lines")
+	cmd.Flags().StringVar(&accessFlags.channelsFile, "channels-file", "", "a file of Slack channel IDs separated by commas or lines")
+	cmd.Flags().StringVar(&accessFlags.workspacesFile, "workspaces-file", "", "a file of Slack workspace IDs separated by commas or lines")
+	_ = cmd.MarkFlagFilename("users-file")
+	_ = cmd.MarkFlagFilename("channels-file")
+	_ = cmd.MarkFlagFilename("workspaces-file")
 
 	cmd.Flags().BoolVarP(&accessFlags.grant, "grant", "G", false, "grant permission to --users or --channels to\n  run the trigger --trigger-id")
 	cmd.Flags().BoolVarP(&accessFlags.revoke, "revoke", "R", false, "revoke permission for --users or --channels to\n  run the trigger --trigger-id")
@@ -89,8 +119,19 @@ func NewAccessCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().BoolVarP(&accessFlags.everyone, "everyone", "E", false, "grant permission to everyone in your workspace")
 	cmd.Flags().BoolVarP(&accessFlags.appCollab, "app-collaborators", "A", false, "grant permission to only app collaborators")
 	cmd.Flags().BoolVarP(&accessFlags.info, "info", "I", false, "check who has access to the trigger --trigger-id")
+	cmd.Flags().BoolVar(&accessFlags.revokeAll, "revoke-all", false, "revoke access from everyone and named entities\n  and grant permission to only app collaborators")
+	cmd.Flags().BoolVar(&accessFlags.yes, "yes", false, "skip confirmation prompt when using --revoke-all")
 
 	cmd.Flags().BoolVar(&accessFlags.includeAppCollab, "include-app-collaborators", false, "include app collaborators into named\n entities to run the trigger --trigger-id")
+	cmd.Flags().BoolVar(&accessFlags.excludeAppCollab, "exclude-app-collaborators", false, "exclude app collaborators from named\n  entities to run the trigger --trigger-id")
+	cmd.Flags().BoolVar(&accessFlags.noPrompt, "no-prompt", false, "error instead of prompting for missing flags and\n  include app collaborators unless excluded")
+	cmd.Flags().StringVar(&accessFlags.output, "output", "text", "output format: text, json")
+
+	cmd.Flags().StringVar(&accessFlags.setUsers, "set-users", "", "replace the users that can run the trigger")
+	cmd.Flags().StringVar(&accessFlags.setChannels, "set-channels", "", "replace the channels that can run the trigger")
+	cmd.Flags().StringVar(&accessFlags.setWorkspaces, "set-workspaces", "", "replace the workspaces that can run the trigger")
+	cmd.Flags().StringVar(&accessFlags.setOrganizations, "set-organizations", "", "replace the organizations that can run the trigger")
+	cmd.Flags().BoolVar(&accessFlags.dryRun, "dry-run", false, "preview changes from the --set-* flags")
 
 	return cmd
 }
@@ -101,6 +142,51 @@ func runAccessCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	var span, _ = opentracing.StartSpanFromContext(ctx, "cmd.triggers.access")
 	defer span.Finish()
 
+	switch accessFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", accessFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+
+	if cmdutil.IsFlagChanged(cmd, "include-app-collaborators") && cmdutil.IsFlagChanged(cmd, "exclude-app-collaborators") {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --include-app-collaborators and --exclude-app-collaborators flags cannot be used together")
+	}
+
+	if err := readNamedEntityFiles(cmd, clients); err != nil {
+		return err
+	}
+
+	declaredEntities := setNamedEntitiesValMap(cmd)
+	if accessFlags.revokeAll {
+		if accessFlags.grant || accessFlags.revoke || accessFlags.everyone || accessFlags.appCollab || accessFlags.info ||
+			accessFlags.dryRun || len(declaredEntities) > 0 || nonEmptyNamedEntities() > 0 ||
+			cmdutil.IsFlagChanged(cmd, "include-app-collaborators") || cmdutil.IsFlagChanged(cmd, "exclude-app-collaborators") {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --revoke-all flag cannot be used with other access flags")
+		}
+	} else if accessFlags.yes {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --yes flag can only be used with the --revoke-all flag")
+	}
+	if len(declaredEntities) > 0 {
+		if accessFlags.grant || accessFlags.revoke || accessFlags.everyone || accessFlags.appCollab || accessFlags.info ||
+			nonEmptyNamedEntities() > 0 || cmdutil.IsFlagChanged(cmd, "include-app-collaborators") ||
+			cmdutil.IsFlagChanged(cmd, "exclude-app-collaborators") {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --set-* flags replace the access list and cannot be used with other access flags")
+		}
+		if accessFlags.dryRun && accessFlags.output == "json" {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --dry-run flag cannot be used with the --output json flag")
+		}
+	} else if accessFlags.dryRun {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --dry-run flag can only be used with the --set-* flags")
+	}
+
 	// Get the app selection and accompanying auth from the flag or prompt
 	selection, err := accessAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAppsOnly)
 	if err != nil {
@@ -116,6 +202,10 @@ func runAccessCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 
 	// Get trigger ID from flag or prompt
 	if accessFlags.triggerID == "" {
+		if accessFlags.noPrompt {
+			return slackerror.New(slackerror.ErrMissingFlag).
+				WithMessage("The --trigger-id flag is required with the --no-prompt flag")
+		}
 		accessFlags.triggerID, err = promptForTriggerID(ctx, cmd, clients, app, token, labelsIncludeAccessType)
 		if err != nil {
 			if slackerror.ToSlackError(err).Code == slackerror.ErrNoTriggers {
@@ -131,6 +221,16 @@ func runAccessCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 		return printAccess(cmd, clients, selection.Auth.Token, selection.App)
 	}
 
+	// If --revoke-all flag is passed, reset access to app collaborators
+	if accessFlags.revokeAll {
+		return revokeAllAccess(cmd, clients, selection.Auth.Token, selection.App)
+	}
+
+	// If --set-* flags are passed, converge to the declared access list
+	if len(declaredEntities) > 0 {
+		return setNamedEntities(cmd, clients, selection.Auth.Token, selection.App, declaredEntities)
+	}
+
 	// Get the current access for the trigger
 	currentAccessType, currentAuthorizedEntities, err := clients.API().TriggerPermissionsList(ctx, token, accessFlags.triggerID)
 	if err != nil {
@@ -152,6 +252,10 @@ func runAccessCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 			} else {
 				accessType = types.PermissionNamedEntities
 			}
+		} else if accessFlags.noPrompt {
+			return slackerror.New(slackerror.ErrMissingFlag).
+				WithMessage("An access flag is required with the --no-prompt flag").
+				WithRemediation("Use --everyone, --app-collaborators, or --grant with --users, --channels, --workspaces, or --organizations")
 		} else {
 			accessType, err = promptForAccessType(ctx, clients, token, currentAccessType)
 			if err != nil {
@@ -174,6 +278,10 @@ func runAccessCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	if accessType == types.PermissionNamedEntities {
 		err := manageNamedEntities(cmd, clients, selection.Auth.Token, selection.App, currentAccessType, currentAuthorizedEntities)
 		if err != nil {
+			if slackerror.ToSlackError(err).Code == slackerror.ErrInvalidTriggerAccess {
+				return slackerror.New(slackerror.ErrInvalidTriggerAccess).
+					WithRemediation("Grant access to a channel or workspace that includes these users instead")
+			}
 			return err
 		}
 	}
@@ -235,17 +343,18 @@ func manageNamedEntities(cmd *cobra.Command, clients *shared.ClientFactory, toke
 	accessFlags.organizations = goutils.UpperCaseTrimAll(accessFlags.organizations)
 	accessNamedEntities := nonEmptyNamedEntities()
 	action := ""
-	includeAppCollaborators := false
 
-	// set includeAppCollaborators only when `include-app-collaborators` flag is called in command
-	// If the flag is provided, we skip the prompt and set includeAppCollaborators as the flag value
-	// If the flag is not provided, display the prompt to include app collaborators
-	if cmdutil.IsFlagChanged(cmd, "include-app-collaborators") {
-		includeAppCollaborators = accessFlags.includeAppCollab
-	}
+	// set includeAppCollaborators from flags when an app collaborators flag or `no-prompt` is called in command
+	// If the choice is made with flags, we skip the prompt to include app collaborators
+	// If the choice is not made with flags, display the prompt to include app collaborators
+	includeAppCollaborators, hasAppCollabChoice := appCollaboratorsChoice(cmd)
 
 	// prompt if list of named_entities not passed in, and one of 'grant' or 'revoke' is not specified
 	if accessNamedEntities == 0 || accessFlags.grant == accessFlags.revoke {
+		if accessFlags.noPrompt {
+			return slackerror.New(slackerror.ErrMissingFlag).
+				WithMessage("Named entities and one of --grant or --revoke are required with the --no-prompt flag")
+		}
 		namedEntities := ""
 		accessAction, err := prompts.TriggerChooseNamedEntityActionPrompt(ctx, clients)
 		if err != nil {
@@ -259,7 +368,7 @@ func manageNamedEntities(cmd *cobra.Command, clients *shared.ClientFactory, toke
 			switch accessAction {
 			case "grant":
 				accessFlags.grant = true
-				if !cmdutil.IsFlagChanged(cmd, "include-app-collaborators") && currentAccessType != types.PermissionNamedEntities {
+				if !hasAppCollabChoice && currentAccessType != types.PermissionNamedEntities {
 					includeAppCollaborators, err = prompts.AddAppCollaboratorsToNamedEntitiesPrompt(ctx, clients.IO)
 					if err != nil {
 						return err
@@ -276,10 +385,11 @@ func manageNamedEntities(cmd *cobra.Command, clients *shared.ClientFactory, toke
 				return err
 			}
 
-			action, namedEntities, includeAppCollaborators, err = prompts.TriggerChooseNamedEntityPrompt(ctx, clients, accessAction, currentAccessType, cmdutil.IsFlagChanged(cmd, "include-app-collaborators"))
-			// Overwrite includeAppCollaborators from TriggerChooseNamedEntityPrompt() if flag is set
-			if cmdutil.IsFlagChanged(cmd, "include-app-collaborators") {
-				includeAppCollaborators = accessFlags.includeAppCollab
+			var promptedAppCollab bool
+			action, namedEntities, promptedAppCollab, err = prompts.TriggerChooseNamedEntityPrompt(ctx, clients, accessAction, currentAccessType, hasAppCollabChoice)
+			// Keep includeAppCollaborators from flags over TriggerChooseNamedEntityPrompt() if the choice is made with flags
+			if !hasAppCollabChoice {
+				includeAppCollaborators = promptedAppCollab
 			}
 
 			if err != nil {
@@ -300,7 +410,7 @@ func manageNamedEntities(cmd *cobra.Command, clients *shared.ClientFactory, toke
 			}
 		}
 	} else {
-		if !cmdutil.IsFlagChanged(cmd, "include-app-collaborators") && currentAccessType != types.PermissionNamedEntities && accessFlags.grant {
+		if !hasAppCollabChoice && currentAccessType != types.PermissionNamedEntities && accessFlags.grant {
 			var err error
 			includeAppCollaborators, err = prompts.AddAppCollaboratorsToNamedEntitiesPrompt(ctx, clients.IO)
 			if err != nil {
@@ -554,6 +664,194 @@ func manageNamedEntities(cmd *cobra.Command, clients *shared.ClientFactory, toke
 	return nil
 }
 
+// appCollaboratorsChoice returns if app collaborators are included in named entities
+// and if this choice is made with flags instead of a prompt. App collaborators are
+// included by default with the --no-prompt flag
+func appCollaboratorsChoice(cmd *cobra.Command) (bool, bool) {
+	switch {
+	case cmdutil.IsFlagChanged(cmd, "include-app-collaborators"):
+		return accessFlags.includeAppCollab, true
+	case cmdutil.IsFlagChanged(cmd, "exclude-app-collaborators"):
+		return !accessFlags.excludeAppCollab, true
+	case accessFlags.noPrompt:
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// namedEntityChange lists the entities of a type to add to and remove from the access list
+type namedEntityChange struct {
+	entityType string
+	add        []string
+	remove     []string
+}
+
+// setNamedEntities changes the access list of the trigger to match the declared
+// entities and switches the access type to named entities if needed
+func setNamedEntities(cmd *cobra.Command, clients *shared.ClientFactory, token string, app types.App, declaredEntities map[string][]string) error {
+	ctx := cmd.Context()
+
+	currentAccessType, currentAuthorizedEntities, err := clients.API().TriggerPermissionsList(ctx, token, accessFlags.triggerID)
+	if err != nil {
+		return err
+	}
+	changes := diffNamedEntities(currentAccessType, currentAuthorizedEntities, declaredEntities)
+	switchAccessType := currentAccessType != types.PermissionNamedEntities
+
+	if accessFlags.dryRun {
+		printNamedEntityChanges(ctx, clients, currentAccessType, changes)
+		return nil
+	}
+
+	if switchAccessType {
+		entities, entityType := "", ""
+		if len(changes) > 0 {
+			entityType = changes[0].entityType
+			entities = strings.Join(changes[0].add, ",")
+			clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf("%s added %s", namedEntityTypeLabel(entityType, len(changes[0].add)), style.Emoji("party_popper"))))
+			changes = changes[1:]
+		}
+		_, err = clients.API().TriggerPermissionsSet(ctx, token, accessFlags.triggerID, entities, types.PermissionNamedEntities, entityType)
+		if err != nil {
+			return err
+		}
+	}
+	for _, change := range changes {
+		if len(change.add) > 0 {
+			err = clients.API().TriggerPermissionsAddEntities(ctx, token, accessFlags.triggerID, strings.Join(change.add, ","), change.entityType)
+			if err != nil {
+				return err
+			}
+			clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf("%s added %s", namedEntityTypeLabel(change.entityType, len(change.add)), style.Emoji("party_popper"))))
+		}
+		if len(change.remove) > 0 {
+			err = clients.API().TriggerPermissionsRemoveEntities(ctx, token, accessFlags.triggerID, strings.Join(change.remove, ","), change.entityType)
+			if err != nil {
+				return err
+			}
+			clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf("%s removed %s", namedEntityTypeLabel(change.entityType, len(change.remove)), style.Emoji("firecracker"))))
+		}
+	}
+	return printAccess(cmd, clients, token, app)
+}
+
+// revokeAllAccess removes the access of everyone or all named entities and sets
+// the access type of the trigger back to app collaborators
+func revokeAllAccess(cmd *cobra.Command, clients *shared.ClientFactory, token string, app types.App) error {
+	ctx := cmd.Context()
+
+	currentAccessType, currentAuthorizedEntities, err := clients.API().TriggerPermissionsList(ctx, token, accessFlags.triggerID)
+	if err != nil {
+		return err
+	}
+	if currentAccessType == types.PermissionAppCollaborators {
+		clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf("Trigger '%s' can already be run by only app collaborators", accessFlags.triggerID)))
+		return printAccess(cmd, clients, token, app)
+	}
+
+	if !accessFlags.yes {
+		if accessFlags.noPrompt {
+			return slackerror.New(slackerror.ErrMissingFlag).
+				WithMessage("The --yes flag is required with the --revoke-all and --no-prompt flags")
+		}
+		revoked := types.GetAccessTypeDescriptionForEveryone(app)
+		if currentAccessType == types.PermissionNamedEntities {
+			revoked = fmt.Sprintf("%d %s", len(currentAuthorizedEntities), style.Pluralize("named entity", "named entities", len(currentAuthorizedEntities)))
+		}
+		proceed, err := clients.IO.ConfirmPrompt(ctx, fmt.Sprintf("Revoke access to trigger '%s' from %s and keep only app collaborators?", accessFlags.triggerID, revoked), false)
+		if err != nil {
+			if slackerror.Is(err, slackerror.ErrProcessInterrupted) {
+				clients.IO.SetExitCode(iostreams.ExitCancel)
+			}
+			return err
+		}
+		if !proceed {
+			clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+				Emoji: "thumbs_up",
+				Text:  "Access of the trigger was not changed",
+			}))
+			return nil
+		}
+	}
+
+	_, err = clients.API().TriggerPermissionsSet(ctx, token, accessFlags.triggerID, "", types.PermissionAppCollaborators, "")
+	if err != nil {
+		return err
+	}
+	if currentAccessType == types.PermissionNamedEntities {
+		clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf("%d %s removed %s", len(currentAuthorizedEntities), style.Pluralize("named entity", "named entities", len(currentAuthorizedEntities)), style.Emoji("firecracker"))))
+	}
+	return printAccess(cmd, clients, token, app)
+}
+
+// diffNamedEntities returns the changes that make the current access list match
+// the declared entities. Entity types that are not declared are left unchanged
+func diffNamedEntities(currentAccessType types.Permission, currentAuthorizedEntities []string, declaredEntities map[string][]string) []namedEntityChange {
+	currentEntities := map[string][]string{}
+	if currentAccessType == types.PermissionNamedEntities {
+		currentEntities = namedEntitiesAccessMap(currentAuthorizedEntities)
+		currentEntities["workspaces"] = currentEntities["teams"]
+	}
+	changes := []namedEntityChange{}
+	for _, entityType := range []string{"users", "channels", "workspaces", "organizations"} {
+		declared, ok := declaredEntities[entityType]
+		if !ok {
+			continue
+		}
+		change := namedEntityChange{
+			entityType: entityType,
+			add:        entitiesNotIn(declared, currentEntities[entityType]),
+			remove:     entitiesNotIn(currentEntities[entityType], declared),
+		}
+		if len(change.add) > 0 || len(change.remove) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// entitiesNotIn returns the entities that are not in the excluded entities
+func entitiesNotIn(entities []string, excluded []string) []string {
+	remaining := []string{}
+	for _, entity := range entities {
+		if !slices.Contains(excluded, entity) {
+			remaining = append(remaining, entity)
+		}
+	}
+	return remaining
+}
+
+// printNamedEntityChanges displays the changes that would be made to the access list
+func printNamedEntityChanges(ctx context.Context, clients *shared.ClientFactory, currentAccessType types.Permission, changes []namedEntityChange) {
+	secondary := []string{}
+	if currentAccessType != types.PermissionNamedEntities {
+		secondary = append(secondary, fmt.Sprintf("Change the access type from %s to %s", currentAccessType, types.PermissionNamedEntities))
+	}
+	for _, change := range changes {
+		if len(change.add) > 0 {
+			secondary = append(secondary, fmt.Sprintf("Add %s: %s", change.entityType, strings.Join(change.add, ", ")))
+		}
+		if len(change.remove) > 0 {
+			secondary = append(secondary, fmt.Sprintf("Remove %s: %s", change.entityType, strings.Join(change.remove, ", ")))
+		}
+	}
+	if len(secondary) == 0 {
+		secondary = append(secondary, "No changes are needed to the access list")
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "clipboard",
+		Text:      fmt.Sprintf("Trigger '%s' access changes (dry run)", accessFlags.triggerID),
+		Secondary: secondary,
+	}))
+}
+
+// namedEntityTypeLabel returns the singular or plural label of an entity type
+func namedEntityTypeLabel(entityType string, count int) string {
+	plural := cases.Title(language.Und, cases.NoLower).String(entityType)
+	return style.Pluralize(strings.TrimSuffix(plural, "s"), plural, count)
+}
+
 // printAccess formats and displays access information
 func printAccess(cmd *cobra.Command, clients *shared.ClientFactory, token string, app types.App) error {
 	ctx := cmd.Context()
@@ -564,6 +862,17 @@ func printAccess(cmd *cobra.Command, clients *shared.ClientFactory, token string
 		return err
 	}
 
+	if accessFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(newTriggerAccessInfo(accessFlags.triggerID, accessType, userAccessList))
+		if err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+		clients.IO.PrintTrace(ctx, slacktrace.TriggersAccessSuccess)
+		return nil
+	}
+
 	switch accessType {
 	case types.PermissionEveryone:
 		var everyoneAccessTypeDescription = types.GetAccessTypeDescriptionForEveryone(app)
@@ -578,6 +887,41 @@ func printAccess(cmd *cobra.Command, clients *shared.ClientFactory, token string
 	return err
 }
 
+// triggerAccessInfo is the access control list of a trigger with entity IDs
+type triggerAccessInfo struct {
+	TriggerID      string           `json:"trigger_id"`
+	PermissionType types.Permission `json:"permission_type"`
+	Users          []string         `json:"users"`
+	Channels       []string         `json:"channels"`
+	Workspaces     []string         `json:"workspaces"`
+	Organizations  []string         `json:"organizations"`
+}
+
+// newTriggerAccessInfo groups the entities with access to a trigger by type
+//
+// App collaborators are listed as users.
+func newTriggerAccessInfo(triggerID string, accessType types.Permission, accessList []string) triggerAccessInfo {
+	info := triggerAccessInfo{
+		TriggerID:      triggerID,
+		PermissionType: accessType,
+		Users:          []string{},
+		Channels:       []string{},
+		Workspaces:     []string{},
+		Organizations:  []string{},
+	}
+	switch accessType {
+	case types.PermissionAppCollaborators:
+		info.Users = append(info.Users, accessList...)
+	case types.PermissionNamedEntities:
+		entities := namedEntitiesAccessMap(accessList)
+		info.Users = append(info.Users, entities["users"]...)
+		info.Channels = append(info.Channels, entities["channels"]...)
+		info.Workspaces = append(info.Workspaces, entities["teams"]...)
+		info.Organizations = append(info.Organizations, entities["organizations"]...)
+	}
+	return info
+}
+
 // printCurrentAuthorizedEntities formats and displays current access information
 func printCurrentAuthorizedEntities(cmd *cobra.Command, clients *shared.ClientFactory, token string, app types.App, currentAccessList []string, currentAccessType types.Permission) error {
 	ctx := cmd.Context()
@@ -705,6 +1049,48 @@ func printNamedEntitiesHelper(cmd *cobra.Command, clients *shared.ClientFactory,
 	return nil
 }
 
+// readNamedEntityFiles merges the IDs listed in the --*-file flags with any
+// IDs passed to the matching named entity flag
+func readNamedEntityFiles(cmd *cobra.Command, clients *shared.ClientFactory) error {
+	files := []struct {
+		flag string
+		path string
+	}{
+		{flag: "users", path: accessFlags.usersFile},
+		{flag: "channels", path: accessFlags.channelsFile},
+		{flag: "workspaces", path: accessFlags.workspacesFile},
+	}
+	for _, file := range files {
+		if file.path == "" {
+			continue
+		}
+		bytes, err := afero.ReadFile(clients.Fs, file.path)
+		if err != nil {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("Failed to read the --%s-file %s", file.flag, file.path).
+				WithRootCause(err)
+		}
+		entities := []string{}
+		inline := cmd.Flags().Lookup(file.flag).Value.String()
+		ids := strings.FieldsFunc(inline+","+string(bytes), func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+		for _, id := range ids {
+			if !slices.Contains(entities, id) {
+				entities = append(entities, id)
+			}
+		}
+		if len(entities) == 0 {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("No IDs were found in the --%s-file %s", file.flag, file.path)
+		}
+		if err := cmd.Flags().Set(file.flag, strings.Join(entities, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // nonEmptyNamedEntities returns number of passed named_entities types from user
 func nonEmptyNamedEntities() int {
 	givenNamedEntities := 0
@@ -734,6 +1120,37 @@ func namedEntitiesValMap() map[string]string {
 	return namedEntitiesMap
 }
 
+// setNamedEntitiesValMap returns a map with key as named_entities type and value
+// as the entities passed with the matching --set-* flag
+func setNamedEntitiesValMap(cmd *cobra.Command) map[string][]string {
+	setFlags := map[string]string{
+		"users":         "set-users",
+		"channels":      "set-channels",
+		"workspaces":    "set-workspaces",
+		"organizations": "set-organizations",
+	}
+	setValues := map[string]string{
+		"users":         accessFlags.setUsers,
+		"channels":      accessFlags.setChannels,
+		"workspaces":    accessFlags.setWorkspaces,
+		"organizations": accessFlags.setOrganizations,
+	}
+	namedEntitiesMap := make(map[string][]string)
+	for entityType, flag := range setFlags {
+		if !cmdutil.IsFlagChanged(cmd, flag) {
+			continue
+		}
+		entities := []string{}
+		for _, entity := range strings.Split(goutils.UpperCaseTrimAll(setValues[entityType]), ",") {
+			if entity != "" && !slices.Contains(entities, entity) {
+				entities = append(entities, entity)
+			}
+		}
+		namedEntitiesMap[entityType] = entities
+	}
+	return namedEntitiesMap
+}
+
 // namedEntitiesAccessMap returns a map with key as named_entities type and value as slice of named_entities value
 func namedEntitiesAccessMap(entitiesAccessList []string) map[string][]string {
 	namedEntitiesAccessMap := make(map[string][]string)
@@ -24,8 +24,11 @@ import (
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -565,6 +568,421 @@ func TestTriggersAccessCommand(t *testing.T) {
 				appSelectTeardown()
 			},
 		},
+		"print the named entities with access as json": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--info", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				fmt.Sprintf(`"trigger_id": "%s"`, fakeTriggerID),
+				`"permission_type": "named_entities"`,
+				`"users": [
+    "U01234"
+  ]`,
+				`"channels": [
+    "C01234"
+  ]`,
+				`"workspaces": [
+    "T01234"
+  ]`,
+				`"organizations": []`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U01234", "C01234", "T01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "UsersInfo", mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertNotCalled(t, "ChannelsInfo", mock.Anything, mock.Anything, mock.Anything)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"print the app collaborators with access as json": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--info", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"permission_type": "app_collaborators"`,
+				`"users": [
+    "collaborator_ID"
+  ]`,
+				`"channels": []`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionAppCollaborators, []string{"collaborator_ID"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"print everyone with access as json": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--info", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"permission_type": "everyone"`,
+				`"users": []`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"set declared entities and switch the access type to named entities": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--set-users", "u01234, U05678", "--set-channels", "C01234", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"permission_type": "named_entities"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "U01234,U05678", types.PermissionNamedEntities, "users").
+					Return([]string{"U01234", "U05678"}, nil)
+				clientsMock.API.On("TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "C01234", "channels").
+					Return(nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U01234", "U05678", "C01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "U01234,U05678", types.PermissionNamedEntities, "users")
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "C01234", "channels")
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"set declared entities by adding and removing from named entities": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--set-users", "U05678,U09999", "--set-channels", "", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"permission_type": "named_entities"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U01234", "U05678", "C01234", "T01234"}, nil).Once()
+				clientsMock.API.On("TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "U09999", "users").
+					Return(nil)
+				clientsMock.API.On("TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, fakeTriggerID, "U01234", "users").
+					Return(nil)
+				clientsMock.API.On("TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, fakeTriggerID, "C01234", "channels").
+					Return(nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U05678", "U09999", "T01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "U09999", "users")
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, fakeTriggerID, "U01234", "users")
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, fakeTriggerID, "C01234", "channels")
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, fakeTriggerID, "T01234", "workspaces")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"preview the declared entity changes with a dry run": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--set-users", "U05678,U09999", "--set-workspaces", "T01234", "--dry-run"},
+			ExpectedStdoutOutputs: []string{
+				"Add users: U09999",
+				"Remove users: U01234",
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U01234", "U05678", "T01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsAddEntities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				assert.NotContains(t, clientsMock.GetStdoutOutput(), "workspaces")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"preview the change of access type with a dry run": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--set-users", "U01234", "--dry-run"},
+			ExpectedStdoutOutputs: []string{
+				"Change the access type from app_collaborators to named_entities",
+				"Add users: U01234",
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionAppCollaborators, []string{"U01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"errors when set flags are used with grant": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--set-users", "U01234", "--grant", "--users", "U05678"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "cannot be used with other access flags"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+		},
+		"errors when dry run is used without set flags": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--everyone", "--dry-run"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "can only be used with the --set-* flags"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+		},
+		"include app collaborators by default with no prompt (previous access: app collaborators)": {
+			CmdArgs:               []string{"--trigger-id", fakeTriggerID, "--users", "user1", "--grant", "--no-prompt", "--output", "json"},
+			ExpectedStdoutOutputs: []string{"App collaborator added", `"permission_type": "named_entities"`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionAppCollaborators, []string{"collaborator_ID"}, nil).Once()
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).
+					Return([]types.SlackUser{{ID: "collaborator_ID"}}, nil)
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "collaborator_ID", types.PermissionNamedEntities, "users").
+					Return([]string{"collaborator_ID"}, nil)
+				clientsMock.API.On("TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "USER1", "users").
+					Return(nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"collaborator_ID", "USER1"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, "Include app collaborators?", mock.Anything)
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "collaborator_ID", types.PermissionNamedEntities, "users")
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "USER1", "users")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"exclude app collaborators with no prompt (previous access: app collaborators)": {
+			CmdArgs:               []string{"--trigger-id", fakeTriggerID, "--users", "user1", "--grant", "--no-prompt", "--exclude-app-collaborators", "--output", "json"},
+			ExpectedStdoutOutputs: []string{`"permission_type": "named_entities"`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionAppCollaborators, []string{"collaborator_ID"}, nil).Once()
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "USER1", types.PermissionNamedEntities, "users").
+					Return([]string{"USER1"}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"USER1"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, "Include app collaborators?", mock.Anything)
+				clientsMock.API.AssertNotCalled(t, "ListCollaborators", mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "USER1", types.PermissionNamedEntities, "users")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"errors when including and excluding app collaborators": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--users", "user1", "--grant", "--include-app-collaborators", "--exclude-app-collaborators"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "cannot be used together"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+		},
+		"errors without an access flag with no prompt": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--no-prompt"},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag, "An access flag is required with the --no-prompt flag"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.IO.AssertNotCalled(t, "SelectPrompt", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"grant access to users from a file merged with inline users (previous access: named entities)": {
+			CmdArgs:               []string{"--trigger-id", fakeTriggerID, "--users", "user1", "--users-file", "users.txt", "--grant", "--no-prompt", "--output", "json"},
+			ExpectedStdoutOutputs: []string{`"permission_type": "named_entities"`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				err := afero.WriteFile(clientsMock.Fs, "users.txt", []byte("user2\nuser3, user1\n"), 0o600)
+				require.NoError(t, err)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"USER1"}, nil).Once()
+				clientsMock.API.On("TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "USER1,USER2,USER3", "users").
+					Return(nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"USER1", "USER2", "USER3"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err = clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "USER1,USER2,USER3", "users")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"errors with a clear message when too many users are granted access": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--users-file", "users.txt", "--grant", "--no-prompt"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidTriggerAccess, "channel or workspace"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				err := afero.WriteFile(clientsMock.Fs, "users.txt", []byte("U01,U02,U03,U04,U05,U06,U07,U08,U09,U10,U11"), 0o600)
+				require.NoError(t, err)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"USER1"}, nil).Once()
+				clientsMock.API.On("TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, mock.Anything, "users").
+					Return(slackerror.New(slackerror.ErrInvalidTriggerAccess))
+				clientsMock.AddDefaultMocks()
+				err = clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"errors when a users file cannot be read": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--users-file", "missing.txt", "--grant"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Failed to read the --users-file missing.txt"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"revoke all named entities after confirming": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--revoke-all", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"permission_type": "app_collaborators"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U01234", "C01234"}, nil).Once()
+				clientsMock.IO.On("ConfirmPrompt", mock.Anything, "Revoke access to trigger 'Ft123' from 2 named entities and keep only app collaborators?", false).
+					Return(true, nil)
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "", types.PermissionAppCollaborators, "").
+					Return([]string{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionAppCollaborators, []string{"U01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "", types.PermissionAppCollaborators, "")
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsRemoveEntities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"revoke all access without confirming with the yes flag": {
+			CmdArgs: []string{"--trigger-id", fakeTriggerID, "--revoke-all", "--yes", "--output", "json"},
+			ExpectedStdoutOutputs: []string{
+				`"permission_type": "app_collaborators"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "", types.PermissionAppCollaborators, "").
+					Return([]string{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionAppCollaborators, []string{"U01234"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "", types.PermissionAppCollaborators, "")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"keeps access when revoking all access is declined": {
+			CmdArgs:               []string{"--trigger-id", fakeTriggerID, "--revoke-all"},
+			ExpectedStdoutOutputs: []string{"Access of the trigger was not changed"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockAccessAppSelection(installedProdApp)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.PermissionNamedEntities, []string{"U01234"}, nil)
+				clientsMock.IO.On("ConfirmPrompt", mock.Anything, mock.Anything, false).Return(false, nil)
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"errors when revoking all access with other access flags": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--revoke-all", "--users", "U01234"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "--revoke-all"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors with the yes flag but without revoking all access": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--everyone", "--yes"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "--yes"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+		},
+		"errors on an unknown output format": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--info", "--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AddDefaultMocks()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 	}, func(clients *shared.ClientFactory) *cobra.Command {
 		cmd := NewAccessCommand(clients)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
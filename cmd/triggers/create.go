@@ -18,13 +18,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/cmd/app"
 	"github.com/slackapi/slack-cli/internal/api"
 	internalapp "github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/cache"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/goutils"
@@ -48,6 +52,25 @@ type createCmdFlags struct {
 	interactivity       bool
 	interactivityName   string
 	orgGrantWorkspaceID string
+	webhook             bool
+	schemaRef           string
+	schema              string
+	inputs              []string
+	scheduleStart       string
+	frequency           string
+	interval            int
+	endTime             string
+	event               string
+	channels            []string
+	filter              string
+	access              string
+	accessUsers         string
+	accessChannels      string
+	idempotencyKey      string
+	output              string
+	dryRun              bool
+	waitForWorkflow     bool
+	timeout             time.Duration
 }
 
 var createFlags createCmdFlags
@@ -56,6 +79,12 @@ var createAppSelectPromptFunc = prompts.AppSelectPrompt
 var workspaceInstallAppFunc = app.RunAddCommand
 var createPromptShouldRetryWithInteractivityFunc = promptShouldRetryCreateWithInteractivity
 
+// waitForWorkflowInterval is the first wait between attempts to create a
+// trigger while waiting for the workflow to exist
+var waitForWorkflowInterval = time.Second
+
+const waitForWorkflowIntervalMax = 10 * time.Second
+
 const dataInteractivityPayload = "{{data.interactivity}}"
 
 // NewCommand creates a new Cobra command instance
@@ -68,6 +97,15 @@ func NewCreateCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "trigger create", Meaning: "Create a trigger by selecting an app and trigger definition"},
 			{Command: "trigger create --trigger-def \"triggers/shortcut_trigger.ts\"", Meaning: "Create a trigger from a definition file"},
 			{Command: "trigger create --workflow \"#/workflows/my_workflow\"", Meaning: "Create a trigger for a workflow"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --input channel=C0123456789", Meaning: "Create a trigger with a value for a workflow input"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --webhook --schema-ref \"#/types/my_event\"", Meaning: "Create a webhook trigger with a schema reference"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --schedule-start \"2030-01-01T09:00:00Z\" --frequency daily", Meaning: "Create a scheduled trigger that runs every day"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --event reaction_added --channel C0123456789", Meaning: "Create an event trigger for reactions in a channel"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --access collaborators", Meaning: "Create a trigger that only app collaborators can run"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --access named --channels C0123456789", Meaning: "Create a trigger that members of a channel can run"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --idempotency-key release-42 --output json", Meaning: "Create a trigger once even if the command is retried"},
+			{Command: "trigger create --trigger-def \"triggers/shortcut_trigger.ts\" --dry-run", Meaning: "Print the trigger request without creating the trigger"},
+			{Command: "trigger create --workflow \"#/workflows/my_workflow\" --wait-for-workflow --timeout 2m", Meaning: "Create a trigger once a recently deployed workflow exists"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			clients.Config.SetFlags(cmd)
@@ -85,6 +123,25 @@ func NewCreateCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().BoolVar(&createFlags.interactivity, "interactivity", false, "when used with --workflow, adds a\n  \"slack#/types/interactivity\" parameter\n  to the trigger with the name specified\n  by --interactivity-name")
 	cmd.Flags().StringVar(&createFlags.interactivityName, "interactivity-name", "interactivity", "when used with --interactivity, specifies\n  the name of the interactivity parameter\n  to use")
 	cmd.Flags().StringVar(&createFlags.orgGrantWorkspaceID, cmdutil.OrgGrantWorkspaceFlag, "", cmdutil.OrgGrantWorkspaceDescription())
+	cmd.Flags().StringArrayVar(&createFlags.inputs, "input", []string{}, "a workflow input formatted as key=value.\n  Values are parsed as JSON when possible.\n  Repeat for each input. Overrides inputs\n  of a --trigger-def file.")
+	cmd.Flags().BoolVar(&createFlags.webhook, "webhook", false, "when used with --workflow, creates a webhook\n  trigger instead of a shortcut trigger")
+	cmd.Flags().StringVar(&createFlags.schemaRef, "schema-ref", "", "when used with --webhook, a reference to\n  the type of the webhook request body")
+	cmd.Flags().StringVar(&createFlags.schema, "schema", "", "when used with --webhook, an inline JSON\n  schema of the webhook request body")
+	cmd.Flags().StringVar(&createFlags.scheduleStart, "schedule-start", "", "when used with --workflow, creates a scheduled\n  trigger starting at an RFC 3339 time like\n  \"2030-01-01T09:00:00Z\"")
+	cmd.Flags().StringVar(&createFlags.frequency, "frequency", "", "when used with --schedule-start, repeats the\n  trigger \"hourly\", \"daily\", or \"weekly\"")
+	cmd.Flags().IntVar(&createFlags.interval, "interval", 0, "when used with --frequency, repeats the\n  trigger after this number of periods")
+	cmd.Flags().StringVar(&createFlags.endTime, "end-time", "", "when used with --frequency, stops repeating\n  the trigger after an RFC 3339 time")
+	cmd.Flags().StringVar(&createFlags.event, "event", "", "when used with --workflow, creates an event\n  trigger for an event type like \"reaction_added\"")
+	cmd.Flags().StringSliceVar(&createFlags.channels, "channel", []string{}, "when used with --event, a channel ID to listen\n  for events in. Repeat for each channel")
+	cmd.Flags().StringVar(&createFlags.filter, "filter", "", "when used with --event, an inline JSON filter\n  of the events that start the workflow")
+	cmd.Flags().StringVar(&createFlags.access, "access", "", "set who can run the created trigger:\n  everyone, collaborators, named")
+	cmd.Flags().StringVar(&createFlags.accessUsers, "users", "", "when used with --access named, a comma-separated\n  list of Slack user IDs that can run the trigger")
+	cmd.Flags().StringVar(&createFlags.accessChannels, "channels", "", "when used with --access named, a comma-separated\n  list of Slack channel IDs that can run the trigger")
+	cmd.Flags().StringVar(&createFlags.idempotencyKey, "idempotency-key", "", "return the trigger created for the workflow\n  with this key instead of creating another")
+	cmd.Flags().StringVar(&createFlags.output, "output", "text", "output format: text, json")
+	cmd.Flags().BoolVar(&createFlags.dryRun, "dry-run", false, "print the trigger request as JSON without\n  installing the app or creating the trigger")
+	cmd.Flags().BoolVar(&createFlags.waitForWorkflow, "wait-for-workflow", false, "retry creating the trigger until the workflow\n  exists or the --timeout passes")
+	cmd.Flags().DurationVar(&createFlags.timeout, "timeout", time.Minute, "when used with --wait-for-workflow, the most\n  time to wait for the workflow to exist")
 	return &cmd
 }
 
@@ -93,6 +150,25 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	var span, _ = opentracing.StartSpanFromContext(ctx, "cmd.triggers.create")
 	defer span.Finish()
 
+	switch createFlags.output {
+	case "text", "json":
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid output format: %s", createFlags.output).
+			WithRemediation("Use one of: text, json")
+	}
+	if cmd.Flags().Changed("timeout") && !createFlags.waitForWorkflow {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --timeout flag can only be used with --wait-for-workflow")
+	}
+	if createFlags.waitForWorkflow && createFlags.timeout <= 0 {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --timeout flag must be a positive duration like 30s or 2m")
+	}
+	if err := validateAccessCmdFlags(&createFlags); err != nil {
+		return err
+	}
+
 	// Get the app selection and accompanying auth from the flag or prompt
 	selection, err := createAppSelectPromptFunc(ctx, clients, prompts.ShowAllEnvironments, prompts.ShowInstalledAndNewApps)
 	if err != nil {
@@ -104,7 +180,7 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 
 	clients.Config.ManifestEnv = internalapp.SetManifestEnvTeamVars(clients.Config.ManifestEnv, selection.App.TeamDomain, selection.App.IsDev)
 
-	if selection.App.IsNew() || selection.App.AppID == "" {
+	if (selection.App.IsNew() || selection.App.AppID == "") && !createFlags.dryRun {
 		_ctx, installState, _app, err := workspaceInstallAppFunc(ctx, clients, &selection, createFlags.orgGrantWorkspaceID)
 		if err != nil {
 			return err
@@ -130,19 +206,47 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	} else {
 		triggerArg = triggerRequestFromFlags(createFlags, app.IsDev)
 	}
+	triggerArg.Inputs, err = mergeTriggerInputs(triggerArg.Inputs, createFlags.inputs)
+	if err != nil {
+		return err
+	}
 
 	// Fix the app ID selected from the menu. In the --trigger-def case, this lets you use the same
 	// def file for dev and prod.
 	triggerArg.WorkflowAppID = app.AppID
 
-	createdTrigger, err := clients.API().WorkflowsTriggersCreate(ctx, token, triggerArg)
+	if createFlags.dryRun {
+		return printTriggerRequest(clients, triggerArg)
+	}
+
+	var idempotencyRecord cache.TriggerIdempotencyKey
+	if createFlags.idempotencyKey != "" {
+		existingTrigger, record, err := findIdempotentTrigger(ctx, clients, token, app.AppID, triggerArg)
+		if err != nil {
+			return err
+		}
+		if existingTrigger.ID != "" {
+			return printCreatedTrigger(cmd, clients, existingTrigger, app, true)
+		}
+		idempotencyRecord = record
+	}
+
+	var createdTrigger types.DeployedTrigger
+	if createFlags.waitForWorkflow {
+		createdTrigger, err = createTriggerWaitingForWorkflow(ctx, clients, token, triggerArg, createFlags.timeout)
+	} else {
+		createdTrigger, err = clients.API().WorkflowsTriggersCreate(ctx, token, triggerArg)
+	}
 	if extendedErr, ok := err.(*api.TriggerCreateOrUpdateError); ok {
+		missing := extendedErr.MissingParameterDetail
+		isInteractivity := missing.Type == "slack#/types/interactivity"
 		// If the user used --workflow and the creation failed because we were missing the interactivity
 		// context, lets prompt and optionally add it
-		if createFlags.workflow != "" && extendedErr.MissingParameterDetail.Type == "slack#/types/interactivity" {
-			triggerArg.Inputs = api.Inputs{
-				extendedErr.MissingParameterDetail.Name: &api.Input{Value: dataInteractivityPayload},
+		if createFlags.workflow != "" && isInteractivity {
+			if triggerArg.Inputs == nil {
+				triggerArg.Inputs = make(api.Inputs)
 			}
+			triggerArg.Inputs[extendedErr.MissingParameterDetail.Name] = &api.Input{Value: dataInteractivityPayload}
 			var retryTriggerCreate bool
 			retryTriggerCreate, err = createPromptShouldRetryWithInteractivityFunc(cmd, clients.IO, triggerArg)
 			if err != nil {
@@ -151,9 +255,23 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 			if retryTriggerCreate {
 				createdTrigger, err = clients.API().WorkflowsTriggersCreate(ctx, token, triggerArg)
 			}
+		} else if createFlags.triggerDef != "" && isInteractivity {
+			return slackerror.ToSlackError(extendedErr.Err).
+				WithRemediation("Add the \"%s\" input with the value %s to the trigger definition file", missing.Name, style.Highlight(dataInteractivityPayload))
+		} else if missing.Name != "" && !isInteractivity {
+			return slackerror.New(slackerror.ErrInvalidTriggerInputs).
+				WithMessage("The \"%s\" input is required by the workflow", missing.Name).
+				WithRemediation("Provide a value for the input with the %s flag", style.Highlight("--input "+missing.Name+"=<value>")).
+				WithRootCause(err)
 		}
 	}
 
+	if err != nil && createFlags.event != "" && slackerror.Is(err, slackerror.ErrInvalidTriggerEventType) {
+		return slackerror.New(slackerror.ErrInvalidTriggerEventType).
+			WithMessage("The event type \"%s\" is not allowed for this app", createFlags.event).
+			WithRemediation("Check that the app manifest includes the scopes of the event").
+			WithRootCause(err)
+	}
 	if err != nil {
 		// If the error is workflow_not_found, show it to the user and prompt them to re-install
 		if strings.Contains(err.Error(), "workflow_not_found") {
@@ -177,28 +295,220 @@ func runCreateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	if createdTrigger.ID == "" {
 		return nil
 	}
+	expireCachedTriggers(ctx, clients, app.AppID)
 
-	cmd.Printf("\n%s", style.Sectionf(style.TextSection{
-		Emoji: "zap",
-		Text:  "Trigger successfully created!",
-	}))
-	trigs, err := sprintTrigger(ctx, createdTrigger, clients, true, app)
-	if err != nil {
+	if createFlags.idempotencyKey != "" {
+		idempotencyRecord.TriggerID = createdTrigger.ID
+		err = clients.Config.ProjectConfig.Cache().SetTriggerIdempotencyKey(ctx, app.AppID, idempotencyRecord)
+		if err != nil {
+			clients.IO.PrintWarning(ctx, "Failed to save the idempotency key of trigger %s: %s", createdTrigger.ID, err)
+		}
+	}
+	if err := setCreatedTriggerAccess(ctx, clients, token, createdTrigger.ID); err != nil {
+		return slackerror.ToSlackError(err).
+			WithRemediation("The trigger %s was created. Set who can run it with %s", createdTrigger.ID, style.Commandf(fmt.Sprintf("trigger access --trigger-id %s", createdTrigger.ID), false))
+	}
+	return printCreatedTrigger(cmd, clients, createdTrigger, app, false)
+}
+
+// validateAccessCmdFlags checks the access type of the --access flag and the
+// named entities that can run the trigger
+func validateAccessCmdFlags(flags *createCmdFlags) error {
+	named := flags.accessUsers != "" || flags.accessChannels != ""
+	switch flags.access {
+	case "", "everyone", "collaborators":
+		if named {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --users and --channels flags can only be used with --access named")
+		}
+	case "named":
+		if !named {
+			return slackerror.New(slackerror.ErrMissingFlag).
+				WithMessage("The --access named flag requires --users or --channels")
+		}
+	default:
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid access type: %s", flags.access).
+			WithRemediation("Use one of: everyone, collaborators, named")
+	}
+	return nil
+}
+
+// setCreatedTriggerAccess sets who can run a created trigger from the --access
+// flag. The access of the trigger is left unchanged without the flag
+func setCreatedTriggerAccess(ctx context.Context, clients *shared.ClientFactory, token string, triggerID string) error {
+	switch createFlags.access {
+	case "everyone":
+		_, err := clients.API().TriggerPermissionsSet(ctx, token, triggerID, "", types.PermissionEveryone, "")
 		return err
+	case "collaborators":
+		_, err := clients.API().TriggerPermissionsSet(ctx, token, triggerID, "", types.PermissionAppCollaborators, "")
+		return err
+	case "named":
+		entities := []struct {
+			entityType string
+			values     string
+		}{
+			{entityType: "users", values: goutils.UpperCaseTrimAll(createFlags.accessUsers)},
+			{entityType: "channels", values: goutils.UpperCaseTrimAll(createFlags.accessChannels)},
+		}
+		accessSet := false
+		for _, entity := range entities {
+			if entity.values == "" {
+				continue
+			}
+			var err error
+			if !accessSet {
+				_, err = clients.API().TriggerPermissionsSet(ctx, token, triggerID, entity.values, types.PermissionNamedEntities, entity.entityType)
+				accessSet = true
+			} else {
+				err = clients.API().TriggerPermissionsAddEntities(ctx, token, triggerID, entity.values, entity.entityType)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createTriggerWaitingForWorkflow retries creating a trigger with a backoff
+// while the workflow is not found, such as just after a deploy, until the
+// timeout passes
+//
+// Other errors are returned without a retry. The last error is returned after
+// the timeout so the workflow not found error can still be handled.
+func createTriggerWaitingForWorkflow(ctx context.Context, clients *shared.ClientFactory, token string, triggerArg api.TriggerRequest, timeout time.Duration) (types.DeployedTrigger, error) {
+	deadline := time.Now().Add(timeout)
+	interval := waitForWorkflowInterval
+	for attempt := 1; ; attempt++ {
+		trigger, err := clients.API().WorkflowsTriggersCreate(ctx, token, triggerArg)
+		if err == nil || !slackerror.Is(err, slackerror.ErrWorkflowNotFound) {
+			return trigger, err
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return trigger, err
+		}
+		wait := min(interval, remaining)
+		clients.IO.PrintDebug(ctx, "Workflow %s was not found on attempt %d, retrying in %s", triggerArg.Workflow, attempt, wait)
+		select {
+		case <-ctx.Done():
+			return trigger, err
+		case <-time.After(wait):
+		}
+		interval = min(interval*2, waitForWorkflowIntervalMax)
+	}
+}
+
+// printTriggerRequest outputs the request that would create a trigger
+func printTriggerRequest(clients *shared.ClientFactory, triggerArg api.TriggerRequest) error {
+	encoder := json.NewEncoder(clients.IO.WriteOut())
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(triggerArg); err != nil {
+		return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+	}
+	return nil
+}
+
+// printCreatedTrigger outputs the details of a trigger that was created now or
+// that already existed for the idempotency key
+func printCreatedTrigger(cmd *cobra.Command, clients *shared.ClientFactory, trigger types.DeployedTrigger, app types.App, existing bool) error {
+	ctx := cmd.Context()
+	if createFlags.output == "json" {
+		encoder := json.NewEncoder(clients.IO.WriteOut())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(trigger); err != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).WithRootCause(err)
+		}
+	} else {
+		text := "Trigger successfully created!"
+		if existing {
+			text = fmt.Sprintf("Trigger already created with the idempotency key %s", createFlags.idempotencyKey)
+		}
+		cmd.Printf("\n%s", style.Sectionf(style.TextSection{
+			Emoji: "zap",
+			Text:  text,
+		}))
+		trigs, err := sprintTrigger(ctx, trigger, clients, true, app)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("%s\n", strings.Join(trigs, "\n"))
+		cmd.Println()
 	}
-	cmd.Printf("%s\n", strings.Join(trigs, "\n"))
-	cmd.Println()
 
 	clients.IO.PrintTrace(ctx, slacktrace.TriggersCreateSuccess)
-	if createdTrigger.Type == "shortcut" {
-		clients.IO.PrintTrace(ctx, slacktrace.TriggersCreateURL, createdTrigger.ShortcutURL)
+	if trigger.Type == "shortcut" {
+		clients.IO.PrintTrace(ctx, slacktrace.TriggersCreateURL, trigger.ShortcutURL)
 	}
-	if createdTrigger.Type == "webhook" {
-		clients.IO.PrintTrace(ctx, slacktrace.TriggersCreateURL, createdTrigger.Webhook)
+	if trigger.Type == "webhook" {
+		clients.IO.PrintTrace(ctx, slacktrace.TriggersCreateURL, trigger.Webhook)
 	}
 	return nil
 }
 
+// idempotencyClockSkew allows for differences between the local and server
+// clocks when matching a trigger to a pending idempotency key
+const idempotencyClockSkew = 5 * time.Minute
+
+// findIdempotentTrigger returns the trigger created for the workflow with the
+// idempotency key or records a pending create request for the key
+//
+// Triggers recorded with a key are matched by ID. If the response to an earlier
+// create request was lost, the newest trigger of the workflow with the same name
+// that was created since that request is matched instead.
+func findIdempotentTrigger(ctx context.Context, clients *shared.ClientFactory, token string, appID string, triggerArg api.TriggerRequest) (types.DeployedTrigger, cache.TriggerIdempotencyKey, error) {
+	if _, err := config.GetProjectDirPath(clients.Fs, clients.Os); err != nil {
+		return types.DeployedTrigger{}, cache.TriggerIdempotencyKey{}, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --idempotency-key flag requires a project to record keys").
+			WithRootCause(err)
+	}
+	triggerCache := clients.Config.ProjectConfig.Cache()
+	record, ok, err := triggerCache.GetTriggerIdempotencyKey(ctx, appID, triggerArg.Workflow, createFlags.idempotencyKey)
+	if err != nil {
+		return types.DeployedTrigger{}, cache.TriggerIdempotencyKey{}, err
+	}
+	if ok {
+		args := api.TriggerListRequest{
+			AppID: appID,
+			Limit: 0,     // 0 means no pagation
+			Type:  "all", // all means showing all types of triggers
+		}
+		triggers, _, err := clients.API().WorkflowsTriggersList(ctx, token, args)
+		if err != nil {
+			return types.DeployedTrigger{}, cache.TriggerIdempotencyKey{}, err
+		}
+		since := record.CreatedAt - int64(idempotencyClockSkew.Seconds())
+		var match types.DeployedTrigger
+		for _, tr := range triggers {
+			switch {
+			case record.TriggerID != "":
+				if tr.ID == record.TriggerID {
+					match = tr
+				}
+			case tr.Name == triggerArg.Name &&
+				"#/workflows/"+tr.Workflow.CallbackID == triggerArg.Workflow &&
+				int64(tr.DateCreated) >= since &&
+				tr.DateCreated >= match.DateCreated:
+				match = tr
+			}
+		}
+		if match.ID != "" {
+			record.TriggerID = match.ID
+			err = triggerCache.SetTriggerIdempotencyKey(ctx, appID, record)
+			return match, record, err
+		}
+	}
+	record = cache.TriggerIdempotencyKey{
+		Key:       createFlags.idempotencyKey,
+		Workflow:  triggerArg.Workflow,
+		CreatedAt: time.Now().Unix(),
+	}
+	err = triggerCache.SetTriggerIdempotencyKey(ctx, appID, record)
+	return types.DeployedTrigger{}, record, err
+}
+
 // promptShouldInstallAndRetry will prompt to re-install the app to apply any local code changes before attempting to create the trigger
 func promptShouldInstallAndRetry(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, selectedApp prompts.SelectedApp, token string, triggerArg api.TriggerRequest) (types.DeployedTrigger, bool, error) {
 	shouldRetry, err := clients.IO.ConfirmPrompt(ctx, "Re-install app to apply local file changes and try again?", true)
@@ -295,6 +605,21 @@ func triggerRequestFromFlags(flags createCmdFlags, isDev bool) api.TriggerReques
 		Description: flags.description,
 		Workflow:    flags.workflow,
 	}
+	if flags.webhook {
+		req.Type = types.TriggerTypeWebhook
+		req.Shortcut = nil
+		req.WebHook = webhookFromFlags(flags)
+	}
+	if flags.scheduleStart != "" {
+		req.Type = types.TriggerTypeScheduled
+		req.Shortcut = nil
+		req.Schedule = scheduleFromFlags(flags)
+	}
+	if flags.event != "" {
+		req.Type = types.TriggerTypeEvent
+		req.Shortcut = nil
+		req.Event = eventFromFlags(flags)
+	}
 	if flags.interactivity {
 		req.Inputs = make(api.Inputs)
 		req.Inputs[flags.interactivityName] = &api.Input{Value: dataInteractivityPayload}
@@ -305,6 +630,278 @@ func triggerRequestFromFlags(flags createCmdFlags, isDev bool) api.TriggerReques
 	return req
 }
 
+// mergeTriggerInputs adds the key=value inputs from flags to the inputs of a
+// trigger, replacing inputs with the same key
+func mergeTriggerInputs(inputs api.Inputs, flagInputs []string) (api.Inputs, error) {
+	for _, flagInput := range flagInputs {
+		key, value, ok := strings.Cut(flagInput, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --input flag must be formatted as key=value: %s", flagInput)
+		}
+		if inputs == nil {
+			inputs = make(api.Inputs)
+		}
+		inputs[key] = &api.Input{Value: parseTriggerInputValue(value)}
+	}
+	return inputs, nil
+}
+
+// parseTriggerInputValue returns the typed value of numbers, booleans, and
+// other JSON values or the unchanged string otherwise
+func parseTriggerInputValue(value string) any {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	var parsed any
+	if err := decoder.Decode(&parsed); err != nil || decoder.More() || parsed == nil {
+		return value
+	}
+	return parsed
+}
+
+// webhookFromFlags returns the webhook configuration with the schema_ref or
+// schema from flags
+func webhookFromFlags(flags createCmdFlags) *types.RawJSON {
+	var webhook json.RawMessage
+	switch {
+	case flags.schemaRef != "":
+		webhook, _ = json.Marshal(map[string]string{"schema_ref": flags.schemaRef})
+	case flags.schema != "":
+		webhook = json.RawMessage(fmt.Sprintf(`{"schema":%s}`, flags.schema))
+	default:
+		webhook = json.RawMessage(`{}`)
+	}
+	return &types.RawJSON{JSONData: &webhook}
+}
+
+// validateWebhookCmdFlags checks the webhook flags before a trigger is created
+//
+// Only one of the schema_ref or schema can be provided for a webhook trigger.
+func validateWebhookCmdFlags(flags *createCmdFlags) error {
+	if !flags.webhook {
+		if flags.schemaRef != "" || flags.schema != "" {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --schema-ref and --schema flags require the --webhook flag")
+		}
+		return nil
+	}
+	if flags.workflow == "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --webhook flag requires the --workflow flag")
+	}
+	if flags.interactivity {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --interactivity flag cannot be used with the --webhook flag")
+	}
+	if flags.schemaRef != "" && flags.schema != "" {
+		return slackerror.New(slackerror.ErrInvalidWebhookConfig).
+			WithRemediation("Use either the --schema-ref flag or the --schema flag")
+	}
+	if flags.schemaRef != "" && !strings.Contains(flags.schemaRef, "#/types/") {
+		return slackerror.New(slackerror.ErrInvalidWebhookSchemaRef).
+			WithMessage("The schema ref \"%s\" is not a reference to a type", flags.schemaRef).
+			WithRemediation("Reference a type such as \"#/types/my_event\"")
+	}
+	if flags.schema != "" {
+		var schema map[string]any
+		if err := json.Unmarshal([]byte(flags.schema), &schema); err != nil {
+			return slackerror.New(slackerror.ErrInvalidWebhookConfig).
+				WithMessage("The --schema flag must be a JSON object").
+				WithRootCause(err)
+		}
+	}
+	return nil
+}
+
+// triggerSchedule is the schedule of a scheduled trigger
+type triggerSchedule struct {
+	StartTime string                    `json:"start_time"`
+	EndTime   string                    `json:"end_time,omitempty"`
+	Frequency *triggerScheduleFrequency `json:"frequency,omitempty"`
+}
+
+// triggerScheduleFrequency is how often a scheduled trigger repeats
+type triggerScheduleFrequency struct {
+	Type         string   `json:"type"`
+	RepeatsEvery int      `json:"repeats_every,omitempty"`
+	OnDays       []string `json:"on_days,omitempty"`
+}
+
+// scheduleFrequencies are the repeating frequencies of the --frequency flag
+var scheduleFrequencies = []string{"hourly", "daily", "weekly"}
+
+// scheduleFromFlags returns the schedule of a scheduled trigger from flags
+//
+// Weekly schedules repeat on the weekday of the start time.
+func scheduleFromFlags(flags createCmdFlags) *types.RawJSON {
+	schedule := triggerSchedule{StartTime: flags.scheduleStart}
+	if flags.frequency != "" {
+		schedule.EndTime = flags.endTime
+		schedule.Frequency = &triggerScheduleFrequency{
+			Type:         flags.frequency,
+			RepeatsEvery: flags.interval,
+		}
+		if flags.frequency == "weekly" {
+			if start, err := time.Parse(time.RFC3339, flags.scheduleStart); err == nil {
+				schedule.Frequency.OnDays = []string{start.Weekday().String()}
+			}
+		}
+	}
+	data, _ := json.Marshal(schedule)
+	raw := json.RawMessage(data)
+	return &types.RawJSON{JSONData: &raw}
+}
+
+// validateScheduleCmdFlags checks the schedule flags before a trigger is created
+func validateScheduleCmdFlags(flags *createCmdFlags) error {
+	if flags.scheduleStart == "" {
+		if flags.frequency != "" || flags.endTime != "" || flags.interval != 0 {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --frequency, --interval, and --end-time flags require the --schedule-start flag")
+		}
+		return nil
+	}
+	if flags.workflow == "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --schedule-start flag requires the --workflow flag")
+	}
+	if flags.webhook || flags.interactivity {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --schedule-start flag cannot be used with the --webhook or --interactivity flags")
+	}
+	start, err := time.Parse(time.RFC3339, flags.scheduleStart)
+	if err != nil {
+		return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+			WithMessage("The --schedule-start flag must be an RFC 3339 time: %s", flags.scheduleStart).
+			WithRemediation("Use a time such as \"2030-01-01T09:00:00Z\"").
+			WithRootCause(err)
+	}
+	if flags.frequency == "" {
+		if flags.endTime != "" || flags.interval != 0 {
+			return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+				WithMessage("The --interval and --end-time flags require the --frequency flag")
+		}
+		return nil
+	}
+	if !slices.Contains(scheduleFrequencies, flags.frequency) {
+		return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+			WithMessage("The frequency \"%s\" is not supported", flags.frequency).
+			WithRemediation("Use one of the frequencies: %s", strings.Join(scheduleFrequencies, ", "))
+	}
+	if flags.interval < 0 {
+		return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+			WithMessage("The --interval flag must be a positive number")
+	}
+	if flags.endTime != "" {
+		end, err := time.Parse(time.RFC3339, flags.endTime)
+		if err != nil {
+			return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+				WithMessage("The --end-time flag must be an RFC 3339 time: %s", flags.endTime).
+				WithRemediation("Use a time such as \"2030-12-31T09:00:00Z\"").
+				WithRootCause(err)
+		}
+		if !end.After(start) {
+			return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+				WithMessage("The --end-time flag must be after the --schedule-start flag")
+		}
+	}
+	return nil
+}
+
+// triggerEventPrefix starts the references of event types on the Slack platform
+const triggerEventPrefix = "slack#/events/"
+
+// triggerEventTypes are the event types of the --event flag and if events of
+// the type happen in channels that must be listed with the --channel flag
+var triggerEventTypes = map[string]bool{
+	"app_mentioned":                  true,
+	"channel_archived":               false,
+	"channel_created":                false,
+	"channel_deleted":                false,
+	"channel_renamed":                false,
+	"channel_shared":                 false,
+	"channel_unarchived":             false,
+	"channel_unshared":               false,
+	"dnd_updated":                    false,
+	"emoji_changed":                  false,
+	"message_pinned":                 true,
+	"message_posted":                 true,
+	"reaction_added":                 true,
+	"reaction_removed":               true,
+	"shared_channel_invite_accepted": false,
+	"shared_channel_invite_approved": false,
+	"shared_channel_invite_declined": false,
+	"shared_channel_invite_received": false,
+	"user_joined_channel":            true,
+	"user_joined_team":               false,
+	"user_left_channel":              true,
+}
+
+// triggerEvent is the event of an event trigger
+type triggerEvent struct {
+	EventType  string          `json:"event_type"`
+	ChannelIDs []string        `json:"channel_ids,omitempty"`
+	Filter     json.RawMessage `json:"filter,omitempty"`
+}
+
+// eventFromFlags returns the event of an event trigger from flags
+func eventFromFlags(flags createCmdFlags) *types.RawJSON {
+	event := triggerEvent{
+		EventType:  triggerEventPrefix + strings.TrimPrefix(flags.event, triggerEventPrefix),
+		ChannelIDs: flags.channels,
+	}
+	if flags.filter != "" {
+		event.Filter = json.RawMessage(flags.filter)
+	}
+	data, _ := json.Marshal(event)
+	raw := json.RawMessage(data)
+	return &types.RawJSON{JSONData: &raw}
+}
+
+// validateEventCmdFlags checks the event flags before a trigger is created
+//
+// Event types are checked against the known event types so mistakes are caught
+// before a request is made.
+func validateEventCmdFlags(flags *createCmdFlags) error {
+	if flags.event == "" {
+		if len(flags.channels) > 0 || flags.filter != "" {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --channel and --filter flags require the --event flag")
+		}
+		return nil
+	}
+	if flags.workflow == "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --event flag requires the --workflow flag")
+	}
+	if flags.webhook || flags.scheduleStart != "" || flags.interactivity {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --event flag cannot be used with the --webhook, --schedule-start, or --interactivity flags")
+	}
+	name := strings.TrimPrefix(flags.event, triggerEventPrefix)
+	channelEvent, ok := triggerEventTypes[name]
+	if !ok {
+		return slackerror.New(slackerror.ErrInvalidTriggerEventType).
+			WithMessage("The event type \"%s\" is not supported", flags.event).
+			WithRemediation("Use one of the event types: %s", strings.Join(slices.Sorted(maps.Keys(triggerEventTypes)), ", "))
+	}
+	if channelEvent && len(flags.channels) == 0 {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("The %s event requires the --channel flag", name).
+			WithRemediation("Provide the channel IDs to listen in with %s", style.Highlight("--channel <channel_id>"))
+	}
+	if flags.filter != "" {
+		var filter map[string]any
+		if err := json.Unmarshal([]byte(flags.filter), &filter); err != nil {
+			return slackerror.New(slackerror.ErrInvalidTriggerConfig).
+				WithMessage("The --filter flag must be a JSON object").
+				WithRootCause(err)
+		}
+	}
+	return nil
+}
+
 func triggerRequestViaHook(ctx context.Context, clients *shared.ClientFactory, path string, isDev bool) (api.TriggerRequest, error) {
 	if !clients.SDKConfig.Hooks.GetTrigger.IsAvailable() {
 		return api.TriggerRequest{}, slackerror.New(slackerror.ErrSDKHookNotFound).
@@ -16,17 +16,22 @@ package triggers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/cmd/app"
 	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/afero"
@@ -192,6 +197,306 @@ func TestTriggersCreateCommand(t *testing.T) {
 				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
 			},
 		},
+		"pass --webhook with --schema-ref": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--webhook", "--schema-ref", "#/types/my_event", "--title", "unit tests", "--description", "are the best"},
+			ExpectedOutputs: []string{"Trigger successfully created!", "unit tests"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, "unit tests", fakeAppID, "webhook")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				expectedTriggerRequest := api.TriggerRequest{
+					Type:          types.TriggerTypeWebhook,
+					Name:          "unit tests",
+					Description:   "are the best",
+					Workflow:      "#/workflows/my_workflow",
+					WorkflowAppID: fakeAppID,
+					WebHook:       types.ToRawJSON(`{"schema_ref":"#/types/my_event"}`),
+				}
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
+			},
+		},
+		"pass --webhook with both --schema and --schema-ref": {
+			CmdArgs:       []string{"--workflow", "#/workflows/my_workflow", "--webhook", "--schema-ref", "#/types/my_event", "--schema", `{"type":"object"}`},
+			ExpectedError: slackerror.New(slackerror.ErrInvalidWebhookConfig),
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"pass --webhook with an invalid --schema": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--webhook", "--schema", "not json"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidWebhookConfig},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"pass --webhook with an invalid --schema-ref": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--webhook", "--schema-ref", "my_event"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidWebhookSchemaRef},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"pass --schema-ref without --webhook": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--schema-ref", "#/types/my_event"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"pass --schedule-start with --frequency": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--schedule-start", "2030-01-01T09:00:00Z", "--frequency", "daily", "--title", "unit tests", "--description", "are the best"},
+			ExpectedOutputs: []string{"Trigger successfully created!", "Ft123 (scheduled)"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, "unit tests", fakeAppID, "scheduled")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				expectedTriggerRequest := api.TriggerRequest{
+					Type:          types.TriggerTypeScheduled,
+					Name:          "unit tests",
+					Description:   "are the best",
+					Workflow:      "#/workflows/my_workflow",
+					WorkflowAppID: fakeAppID,
+					Schedule:      types.ToRawJSON(`{"start_time":"2030-01-01T09:00:00Z","frequency":{"type":"daily"}}`),
+				}
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
+			},
+		},
+		"pass --schedule-start with an unsupported --frequency": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--schedule-start", "2030-01-01T09:00:00Z", "--frequency", "monthly"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidTriggerConfig, "The frequency \"monthly\" is not supported"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"pass --event with --channel": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--event", "reaction_added", "--channel", "C0123456789", "--title", "unit tests", "--description", "are the best"},
+			ExpectedOutputs: []string{"Trigger successfully created!", "Ft123 (event)"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, "unit tests", fakeAppID, "event")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				expectedTriggerRequest := api.TriggerRequest{
+					Type:          types.TriggerTypeEvent,
+					Name:          "unit tests",
+					Description:   "are the best",
+					Workflow:      "#/workflows/my_workflow",
+					WorkflowAppID: fakeAppID,
+					Event:         types.ToRawJSON(`{"event_type":"slack#/events/reaction_added","channel_ids":["C0123456789"]}`),
+				}
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
+			},
+		},
+		"pass --event that is not allowed by the API": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--event", "emoji_changed"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidTriggerEventType, "The event type \"emoji_changed\" is not allowed for this app"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.DeployedTrigger{}, slackerror.New(slackerror.ErrInvalidTriggerEventType))
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+		},
+		"pass --access collaborators": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--access", "collaborators"},
+			ExpectedOutputs: []string{"Trigger successfully created!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "shortcut")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]string{}, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionAppCollaborators, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "", types.PermissionAppCollaborators, "")
+				clientsMock.API.AssertNumberOfCalls(t, "TriggerPermissionsSet", 1)
+			},
+		},
+		"pass --access named with --users and --channels": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--access", "named", "--users", "U0123456789, u0987654321", "--channels", "C0123456789"},
+			ExpectedOutputs: []string{"Trigger successfully created!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "shortcut")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]string{}, nil)
+				clientsMock.API.On("TriggerPermissionsAddEntities", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionNamedEntities, []string{"U0123456789", "U0987654321", "C0123456789"}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "U0123456789,U0987654321", types.PermissionNamedEntities, "users")
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsAddEntities", mock.Anything, mock.Anything, fakeTriggerID, "C0123456789", "channels")
+			},
+		},
+		"pass --access that fails after the trigger is created": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--access", "everyone"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidPermissionType, "trigger access --trigger-id " + fakeTriggerID},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "shortcut")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("TriggerPermissionsSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]string{}, slackerror.New(slackerror.ErrInvalidPermissionType))
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "TriggerPermissionsSet", mock.Anything, mock.Anything, fakeTriggerID, "", types.PermissionEveryone, "")
+			},
+		},
+		"pass --users without --access named": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--users", "U0123456789"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"pass --access named without entities": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--access", "named"},
+			ExpectedErrorStrings: []string{slackerror.ErrMissingFlag},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"pass an unknown --access type": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--access", "admins"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid access type: admins"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"pass --input values": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--title", "unit tests", "--description", "are the best", "--input", "channel=C0123456789", "--input", "count=3", "--input", "enabled=true"},
+			ExpectedOutputs: []string{"Trigger successfully created!", "unit tests"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, "unit tests", fakeAppID, "shortcut")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				expectedTriggerRequest := api.TriggerRequest{
+					Type:          types.TriggerTypeShortcut,
+					Name:          "unit tests",
+					Description:   "are the best",
+					Shortcut:      &api.Shortcut{},
+					Workflow:      "#/workflows/my_workflow",
+					WorkflowAppID: fakeAppID,
+					Inputs: api.Inputs{
+						"channel": &api.Input{Value: "C0123456789"},
+						"count":   &api.Input{Value: json.Number("3")},
+						"enabled": &api.Input{Value: true},
+					},
+				}
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
+			},
+		},
+		"pass --input without a key": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--input", "=C0123456789"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "key=value"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 		"api call fails": {
 			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow"},
 			ExpectedErrorStrings: []string{"invalid_auth"},
@@ -250,6 +555,48 @@ func TestTriggersCreateCommand(t *testing.T) {
 				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
 			},
 		},
+		"pass --trigger-def with --input": {
+			CmdArgs:         []string{"--trigger-def", "trigger_def.json", "--input", "channel=C0123456789"},
+			ExpectedOutputs: []string{"Trigger successfully created!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, "name", fakeAppID, "shortcut")
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.PermissionEveryone, []string{}, nil).Once()
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+				jsonPayload := `{
+								"type":"shortcut",
+								"name":"name",
+								"description":"desc",
+								"workflow":"#/workflows/my_workflow",
+								"inputs":{"channel":{"value":"C0000000000"},"user":{"value":"{{data.user_id}}"}}
+							}
+							`
+				err = afero.WriteFile(clients.Fs, "trigger_def.json", []byte(jsonPayload), 0600)
+				require.NoError(t, err, "Cant write trigger_def.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				expectedTriggerRequest := api.TriggerRequest{
+					Type:          types.TriggerTypeShortcut,
+					Name:          "name",
+					Description:   "desc",
+					Workflow:      "#/workflows/my_workflow",
+					WorkflowAppID: fakeAppID,
+					Inputs: api.Inputs{
+						"channel": &api.Input{Value: "C0123456789"},
+						"user":    &api.Input{Value: "{{data.user_id}}"},
+					},
+				}
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, expectedTriggerRequest)
+			},
+		},
 		"--trigger-def, file missing": {
 			CmdArgs:              []string{"--trigger-def", "foo.json"},
 			ExpectedErrorStrings: []string{"File not found"},
@@ -419,7 +766,7 @@ func TestTriggersCreateCommand_MissingParameters(t *testing.T) {
 		},
 		"initial api call fails, missing a different type": {
 			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow"},
-			ExpectedErrorStrings: []string{"invalid_trigger_inputs"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidTriggerInputs, "my-num", "--input my-num=<value>"},
 			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
 				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
 				promptForInteractivityTeardown = setupMockCreatePromptForInteractivity()
@@ -445,6 +792,54 @@ func TestTriggersCreateCommand_MissingParameters(t *testing.T) {
 				promptForInteractivityTeardown()
 			},
 		},
+		"initial api call fails, missing interactivity with a trigger definition file": {
+			CmdArgs:              []string{"--trigger-def", "trigger_def.json"},
+			ExpectedErrorStrings: []string{"invalid_trigger_inputs", `Add the "my-interactivity" input with the value {{data.interactivity}} to the trigger definition file`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				promptForInteractivityTeardown = setupMockCreatePromptForInteractivity()
+				extendedErr := &api.TriggerCreateOrUpdateError{
+					Err: errors.New("invalid_trigger_inputs"),
+					MissingParameterDetail: api.MissingParameterDetail{
+						Name: "my-interactivity",
+						Type: "slack#/types/interactivity",
+					},
+				}
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(types.DeployedTrigger{}, extendedErr)
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+				jsonPayload := `{"type":"shortcut","name":"name","workflow":"#/workflows/my_workflow"}`
+				err = afero.WriteFile(clients.Fs, "trigger_def.json", []byte(jsonPayload), 0600)
+				require.NoError(t, err, "Cant write trigger_def.json")
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNumberOfCalls(t, "WorkflowsTriggersCreate", 1)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				promptForInteractivityTeardown()
+			},
+		},
+		"initial api call fails without a missing parameter": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow"},
+			ExpectedErrorStrings: []string{"invalid_trigger_type"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+				promptForInteractivityTeardown = setupMockCreatePromptForInteractivity()
+				extendedErr := &api.TriggerCreateOrUpdateError{
+					Err: errors.New("invalid_trigger_type"),
+				}
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).Return(types.DeployedTrigger{}, extendedErr)
+				clientsMock.AddDefaultMocks()
+				err := clients.AppClient().SaveDeployed(ctx, fakeApp)
+				require.NoError(t, err, "Cant write apps.json")
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				promptForInteractivityTeardown()
+			},
+		},
 	}, func(clients *shared.ClientFactory) *cobra.Command {
 		cmd := NewCreateCommand(clients)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
@@ -544,6 +939,196 @@ func TestTriggersCreateCommand_AppSelection(t *testing.T) {
 	})
 }
 
+func TestTriggersCreateCommand_IdempotencyKey(t *testing.T) {
+	var appSelectTeardown func()
+	var triggerCache cache.TriggerCacher
+	setupIdempotencyMocks := func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory, records ...cache.TriggerIdempotencyKey) {
+		appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+		clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+		clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+			Return(types.PermissionEveryone, []string{}, nil)
+		clientsMock.AddDefaultMocks()
+		err := afero.WriteFile(clientsMock.Fs, config.GetProjectHooksJSONFilePath(slackdeps.MockWorkingDirectory), []byte("{}"), 0o600)
+		require.NoError(t, err)
+		triggerCache = clients.Config.ProjectConfig.Cache()
+		for _, record := range records {
+			require.NoError(t, triggerCache.SetTriggerIdempotencyKey(ctx, fakeAppID, record))
+		}
+	}
+	existingTrigger := createFakeTrigger("Ft001", fakeTriggerName, fakeAppID, "shortcut")
+	existingTrigger.Workflow.CallbackID = "my_workflow"
+	existingTrigger.DateCreated = int(time.Now().Unix())
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"creates a trigger and records the key": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--idempotency-key", "release-42"},
+			ExpectedOutputs: []string{"Trigger successfully created!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).
+					Return(createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "shortcut"), nil)
+				setupIdempotencyMocks(t, ctx, clientsMock, clients)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything)
+				record, ok, err := triggerCache.GetTriggerIdempotencyKey(ctx, fakeAppID, "#/workflows/my_workflow", "release-42")
+				require.NoError(t, err)
+				assert.True(t, ok)
+				assert.Equal(t, fakeTriggerID, record.TriggerID)
+			},
+		},
+		"returns the recorded trigger of a retried key": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--idempotency-key", "release-42"},
+			ExpectedOutputs: []string{"Trigger already created with the idempotency key release-42", "Ft001"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything).
+					Return([]types.DeployedTrigger{existingTrigger}, "", nil)
+				setupIdempotencyMocks(t, ctx, clientsMock, clients, cache.TriggerIdempotencyKey{
+					Key:       "release-42",
+					Workflow:  "#/workflows/my_workflow",
+					TriggerID: "Ft001",
+				})
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"returns the matching trigger of a lost response as json": {
+			CmdArgs:               []string{"--workflow", "#/workflows/my_workflow", "--idempotency-key", "release-42", "--output", "json"},
+			ExpectedStdoutOutputs: []string{`"id": "Ft001"`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				otherTrigger := createFakeTrigger("Ft002", "Another Trigger", fakeAppID, "shortcut")
+				otherTrigger.Workflow.CallbackID = "my_workflow"
+				otherTrigger.DateCreated = existingTrigger.DateCreated
+				clientsMock.API.On("WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything).
+					Return([]types.DeployedTrigger{otherTrigger, existingTrigger}, "", nil)
+				setupIdempotencyMocks(t, ctx, clientsMock, clients, cache.TriggerIdempotencyKey{
+					Key:       "release-42",
+					Workflow:  "#/workflows/my_workflow",
+					CreatedAt: int64(existingTrigger.DateCreated),
+				})
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+				record, _, err := triggerCache.GetTriggerIdempotencyKey(ctx, fakeAppID, "#/workflows/my_workflow", "release-42")
+				require.NoError(t, err)
+				assert.Equal(t, "Ft001", record.TriggerID)
+			},
+		},
+		"creates another trigger if the recorded trigger was deleted": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--idempotency-key", "release-42"},
+			ExpectedOutputs: []string{"Trigger successfully created!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything).
+					Return([]types.DeployedTrigger{}, "", nil)
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).
+					Return(createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "shortcut"), nil)
+				setupIdempotencyMocks(t, ctx, clientsMock, clients, cache.TriggerIdempotencyKey{
+					Key:       "release-42",
+					Workflow:  "#/workflows/my_workflow",
+					TriggerID: "Ft001",
+				})
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+				record, _, err := triggerCache.GetTriggerIdempotencyKey(ctx, fakeAppID, "#/workflows/my_workflow", "release-42")
+				require.NoError(t, err)
+				assert.Equal(t, fakeTriggerID, record.TriggerID)
+			},
+		},
+		"errors with an unknown output format": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--output", "yaml"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "Invalid output format: yaml"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewCreateCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return nil
+		}
+		return cmd
+	})
+}
+
+func TestTriggersCreateCommand_WaitForWorkflow(t *testing.T) {
+	var appSelectTeardown func()
+	setupWaitMocks := func(t *testing.T, clientsMock *shared.ClientsMock) {
+		appSelectTeardown = setupMockCreateAppSelection(installedProdApp)
+		clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+		clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).
+			Return(types.PermissionEveryone, []string{}, nil)
+		clientsMock.AddDefaultMocks()
+		err := afero.WriteFile(clientsMock.Fs, config.GetProjectHooksJSONFilePath(slackdeps.MockWorkingDirectory), []byte("{}"), 0o600)
+		require.NoError(t, err)
+	}
+	interval := waitForWorkflowInterval
+	waitForWorkflowInterval = time.Millisecond
+	defer func() {
+		waitForWorkflowInterval = interval
+	}()
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"creates the trigger once the workflow exists": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--wait-for-workflow"},
+			ExpectedOutputs: []string{"Trigger successfully created!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.DeployedTrigger{}, slackerror.New(slackerror.ErrWorkflowNotFound)).Twice()
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).
+					Return(createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "shortcut"), nil)
+				setupWaitMocks(t, clientsMock)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNumberOfCalls(t, "WorkflowsTriggersCreate", 3)
+			},
+		},
+		"returns other errors without a retry": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--wait-for-workflow"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidAuth},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.API.On("WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.DeployedTrigger{}, slackerror.New(slackerror.ErrInvalidAuth))
+				setupWaitMocks(t, clientsMock)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNumberOfCalls(t, "WorkflowsTriggersCreate", 1)
+			},
+		},
+		"errors with a timeout but without waiting for the workflow": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--timeout", "2m"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "--wait-for-workflow"},
+		},
+		"errors with a timeout that is not positive": {
+			CmdArgs:              []string{"--workflow", "#/workflows/my_workflow", "--wait-for-workflow", "--timeout", "0s"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "positive duration"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewCreateCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return nil
+		}
+		return cmd
+	})
+}
+
 func TestTriggersCreateCommand_promptShouldInstallAndRetry(t *testing.T) {
 
 	testcases := []struct {
@@ -670,6 +1255,62 @@ func TestTriggersCreateCommand_promptShouldDisplayTriggerDefinitionFiles(t *test
 	}
 }
 
+func TestTriggersCreateCommand_DryRun(t *testing.T) {
+	var appSelectTeardown func()
+	var workspaceInstallAppTeardown func()
+	var appCommandMock *app.AppMock
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"prints the request with the local name suffix": {
+			CmdArgs: []string{"--workflow", "#/workflows/my_workflow", "--dry-run"},
+			ExpectedOutputs: []string{
+				`"name": "My Trigger (local)"`,
+				`"workflow": "#/workflows/my_workflow"`,
+				`"workflow_app_id": "` + fakeAppID + `"`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(prompts.SelectedApp{App: types.App{AppID: fakeAppID, IsDev: true}})
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"does not install a new app": {
+			CmdArgs:         []string{"--workflow", "#/workflows/my_workflow", "--dry-run"},
+			ExpectedOutputs: []string{`"name": "My Trigger"`},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockCreateAppSelection(newProdApp)
+				appCommandMock = app.NewAppCommandMock()
+				var originalWorkspaceInstallAppFunc = workspaceInstallAppFunc
+				workspaceInstallAppFunc = appCommandMock.RunAddCommand
+				workspaceInstallAppTeardown = func() {
+					workspaceInstallAppFunc = originalWorkspaceInstallAppFunc
+				}
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+				workspaceInstallAppTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				appCommandMock.AssertNotCalled(t, "RunAddCommand", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersCreate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewCreateCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return nil
+		}
+		return cmd
+	})
+}
+
 func setupMockCreateAppSelection(selectedApp prompts.SelectedApp) func() {
 	appSelectMock := prompts.NewAppSelectMock()
 	var originalPromptFunc = createAppSelectPromptFunc
@@ -713,3 +1354,265 @@ func Test_triggerRequestFromFlags(t *testing.T) {
 	prodReq2 := triggerRequestFromFlags(flagsEmptyTitle, false)
 	assert.Equal(t, "", prodReq2.Name, "should NOT have (local) suffix")
 }
+
+func Test_webhookFromFlags(t *testing.T) {
+	tests := map[string]struct {
+		flags    createCmdFlags
+		expected *types.RawJSON
+	}{
+		"uses the schema ref": {
+			flags:    createCmdFlags{webhook: true, schemaRef: "#/types/my_event"},
+			expected: types.ToRawJSON(`{"schema_ref":"#/types/my_event"}`),
+		},
+		"uses the inline schema": {
+			flags:    createCmdFlags{webhook: true, schema: `{"type":"object"}`},
+			expected: types.ToRawJSON(`{"schema":{"type":"object"}}`),
+		},
+		"is empty without a schema": {
+			flags:    createCmdFlags{webhook: true},
+			expected: types.ToRawJSON(`{}`),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, webhookFromFlags(tc.flags))
+		})
+	}
+}
+
+func Test_scheduleFromFlags(t *testing.T) {
+	tests := map[string]struct {
+		flags    createCmdFlags
+		expected *types.RawJSON
+	}{
+		"starts once without a frequency": {
+			flags:    createCmdFlags{scheduleStart: "2030-01-01T09:00:00Z"},
+			expected: types.ToRawJSON(`{"start_time":"2030-01-01T09:00:00Z"}`),
+		},
+		"repeats with the frequency and interval until the end": {
+			flags:    createCmdFlags{scheduleStart: "2030-01-01T09:00:00Z", frequency: "daily", interval: 2, endTime: "2030-02-01T09:00:00Z"},
+			expected: types.ToRawJSON(`{"start_time":"2030-01-01T09:00:00Z","end_time":"2030-02-01T09:00:00Z","frequency":{"type":"daily","repeats_every":2}}`),
+		},
+		"repeats weekly on the weekday of the start": {
+			flags:    createCmdFlags{scheduleStart: "2030-01-01T09:00:00Z", frequency: "weekly"},
+			expected: types.ToRawJSON(`{"start_time":"2030-01-01T09:00:00Z","frequency":{"type":"weekly","on_days":["Tuesday"]}}`),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scheduleFromFlags(tc.flags))
+		})
+	}
+}
+
+func Test_validateScheduleCmdFlags(t *testing.T) {
+	tests := map[string]struct {
+		flags         createCmdFlags
+		expectedError string
+	}{
+		"allows no schedule": {
+			flags: createCmdFlags{workflow: "#/workflows/my_workflow"},
+		},
+		"allows a repeating schedule": {
+			flags: createCmdFlags{workflow: "#/workflows/my_workflow", scheduleStart: "2030-01-01T09:00:00Z", frequency: "hourly", interval: 6, endTime: "2030-01-02T09:00:00Z"},
+		},
+		"errors for a frequency without a start": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", frequency: "daily"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for a start without a workflow": {
+			flags:         createCmdFlags{scheduleStart: "2030-01-01T09:00:00Z"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for a start with a webhook": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", webhook: true, scheduleStart: "2030-01-01T09:00:00Z"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for an invalid start": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", scheduleStart: "tomorrow"},
+			expectedError: slackerror.ErrInvalidTriggerConfig,
+		},
+		"errors for an end without a frequency": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", scheduleStart: "2030-01-01T09:00:00Z", endTime: "2030-02-01T09:00:00Z"},
+			expectedError: slackerror.ErrInvalidTriggerConfig,
+		},
+		"errors for an unsupported frequency": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", scheduleStart: "2030-01-01T09:00:00Z", frequency: "yearly"},
+			expectedError: slackerror.ErrInvalidTriggerConfig,
+		},
+		"errors for a negative interval": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", scheduleStart: "2030-01-01T09:00:00Z", frequency: "daily", interval: -1},
+			expectedError: slackerror.ErrInvalidTriggerConfig,
+		},
+		"errors for an end before the start": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", scheduleStart: "2030-01-01T09:00:00Z", frequency: "daily", endTime: "2029-01-01T09:00:00Z"},
+			expectedError: slackerror.ErrInvalidTriggerConfig,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateScheduleCmdFlags(&tc.flags)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+			}
+		})
+	}
+}
+
+func Test_eventFromFlags(t *testing.T) {
+	tests := map[string]struct {
+		flags    createCmdFlags
+		expected *types.RawJSON
+	}{
+		"references the event type with channels": {
+			flags:    createCmdFlags{event: "reaction_added", channels: []string{"C0123456789", "C0987654321"}},
+			expected: types.ToRawJSON(`{"event_type":"slack#/events/reaction_added","channel_ids":["C0123456789","C0987654321"]}`),
+		},
+		"keeps a referenced event type with a filter": {
+			flags:    createCmdFlags{event: "slack#/events/message_posted", channels: []string{"C0123456789"}, filter: `{"version":1,"root":{"statement":"{{data.text}} == hello"}}`},
+			expected: types.ToRawJSON(`{"event_type":"slack#/events/message_posted","channel_ids":["C0123456789"],"filter":{"version":1,"root":{"statement":"{{data.text}} == hello"}}}`),
+		},
+		"omits channels of workspace events": {
+			flags:    createCmdFlags{event: "user_joined_team"},
+			expected: types.ToRawJSON(`{"event_type":"slack#/events/user_joined_team"}`),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, eventFromFlags(tc.flags))
+		})
+	}
+}
+
+func Test_validateEventCmdFlags(t *testing.T) {
+	tests := map[string]struct {
+		flags         createCmdFlags
+		expectedError string
+	}{
+		"allows no event": {
+			flags: createCmdFlags{workflow: "#/workflows/my_workflow"},
+		},
+		"allows a channel event with channels": {
+			flags: createCmdFlags{workflow: "#/workflows/my_workflow", event: "slack#/events/reaction_added", channels: []string{"C0123456789"}},
+		},
+		"allows a workspace event without channels": {
+			flags: createCmdFlags{workflow: "#/workflows/my_workflow", event: "channel_created"},
+		},
+		"errors for channels without an event": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", channels: []string{"C0123456789"}},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for an event without a workflow": {
+			flags:         createCmdFlags{event: "channel_created"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for an event with a schedule": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", event: "channel_created", scheduleStart: "2030-01-01T09:00:00Z"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+		"errors for an unknown event type": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", event: "reaction_changed"},
+			expectedError: slackerror.ErrInvalidTriggerEventType,
+		},
+		"errors for a channel event without channels": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", event: "message_posted"},
+			expectedError: slackerror.ErrMissingFlag,
+		},
+		"errors for a filter that is not an object": {
+			flags:         createCmdFlags{workflow: "#/workflows/my_workflow", event: "channel_created", filter: "[]"},
+			expectedError: slackerror.ErrInvalidTriggerConfig,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateEventCmdFlags(&tc.flags)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+			}
+		})
+	}
+}
+
+func Test_mergeTriggerInputs(t *testing.T) {
+	tests := map[string]struct {
+		inputs        api.Inputs
+		flagInputs    []string
+		expected      api.Inputs
+		expectedError string
+	}{
+		"returns the inputs unchanged without flags": {
+			inputs:   api.Inputs{"channel": &api.Input{Value: "C0000000000"}},
+			expected: api.Inputs{"channel": &api.Input{Value: "C0000000000"}},
+		},
+		"returns no inputs without inputs or flags": {
+			expected: nil,
+		},
+		"parses typed values from flags": {
+			flagInputs: []string{
+				"text=hello world",
+				"count=42",
+				"ratio=0.5",
+				"enabled=false",
+				`tags=["a","b"]`,
+				`options={"key":"value"}`,
+				`quoted="123"`,
+				"user={{data.user_id}}",
+				"empty=",
+				"equation=a=b",
+			},
+			expected: api.Inputs{
+				"text":     &api.Input{Value: "hello world"},
+				"count":    &api.Input{Value: json.Number("42")},
+				"ratio":    &api.Input{Value: json.Number("0.5")},
+				"enabled":  &api.Input{Value: false},
+				"tags":     &api.Input{Value: []any{"a", "b"}},
+				"options":  &api.Input{Value: map[string]any{"key": "value"}},
+				"quoted":   &api.Input{Value: "123"},
+				"user":     &api.Input{Value: "{{data.user_id}}"},
+				"empty":    &api.Input{Value: ""},
+				"equation": &api.Input{Value: "a=b"},
+			},
+		},
+		"replaces inputs of the same key": {
+			inputs: api.Inputs{
+				"channel":       &api.Input{Value: "C0000000000"},
+				"interactivity": &api.Input{Value: "{{data.interactivity}}"},
+			},
+			flagInputs: []string{"channel=C0123456789"},
+			expected: api.Inputs{
+				"channel":       &api.Input{Value: "C0123456789"},
+				"interactivity": &api.Input{Value: "{{data.interactivity}}"},
+			},
+		},
+		"uses the last value of a repeated key": {
+			flagInputs: []string{"count=1", "count=2"},
+			expected:   api.Inputs{"count": &api.Input{Value: json.Number("2")}},
+		},
+		"errors without a separator": {
+			flagInputs:    []string{"channel"},
+			expectedError: slackerror.ErrInvalidFlag,
+		},
+		"errors without a key": {
+			flagInputs:    []string{"=C0123456789"},
+			expectedError: slackerror.ErrInvalidFlag,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inputs, err := mergeTriggerInputs(tc.inputs, tc.flagInputs)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, inputs)
+			}
+		})
+	}
+}
@@ -56,6 +56,8 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&deleteFlags.triggerID, "trigger-id", "", "the ID of the trigger")
+	_ = cmd.RegisterFlagCompletionFunc("trigger-id", triggerIDCompletion(clients))
+	addRefreshFlag(&cmd)
 
 	return &cmd
 }
@@ -94,6 +96,7 @@ func runDeleteCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	expireCachedTriggers(ctx, clients, app.AppID)
 
 	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
 		Emoji: "wastebasket",
@@ -119,6 +119,7 @@ func TriggerGenerate(ctx context.Context, clients *shared.ClientFactory, app typ
 	if err != nil {
 		return nil, err
 	}
+	expireCachedTriggers(ctx, clients, app.AppID)
 
 	fmt.Printf("\n%s", style.Sectionf(style.TextSection{
 		Emoji: "zap",
@@ -268,6 +269,36 @@ func validateCreateCmdFlags(ctx context.Context, clients *shared.ClientFactory,
 		if clients.Config.Flags.Lookup("workflow").Changed {
 			details = append(details, mismatchedFlagDetail("workflow"))
 		}
+		if createFlags.webhook {
+			details = append(details, mismatchedFlagDetail("webhook"))
+		}
+		if createFlags.schemaRef != "" {
+			details = append(details, mismatchedFlagDetail("schema-ref"))
+		}
+		if createFlags.schema != "" {
+			details = append(details, mismatchedFlagDetail("schema"))
+		}
+		if createFlags.scheduleStart != "" {
+			details = append(details, mismatchedFlagDetail("schedule-start"))
+		}
+		if createFlags.frequency != "" {
+			details = append(details, mismatchedFlagDetail("frequency"))
+		}
+		if createFlags.interval != 0 {
+			details = append(details, mismatchedFlagDetail("interval"))
+		}
+		if createFlags.endTime != "" {
+			details = append(details, mismatchedFlagDetail("end-time"))
+		}
+		if createFlags.event != "" {
+			details = append(details, mismatchedFlagDetail("event"))
+		}
+		if len(createFlags.channels) > 0 {
+			details = append(details, mismatchedFlagDetail("channel"))
+		}
+		if createFlags.filter != "" {
+			details = append(details, mismatchedFlagDetail("filter"))
+		}
 		if len(details) > 0 {
 			details = append([]slackerror.ErrorDetail{{
 				Message: "The --trigger-def flag overrides other property setting flags",
@@ -278,6 +309,18 @@ func validateCreateCmdFlags(ctx context.Context, clients *shared.ClientFactory,
 		}
 	}
 
+	if createFlags.triggerDef == "" {
+		if err := validateWebhookCmdFlags(createFlags); err != nil {
+			return err
+		}
+		if err := validateScheduleCmdFlags(createFlags); err != nil {
+			return err
+		}
+		if err := validateEventCmdFlags(createFlags); err != nil {
+			return err
+		}
+	}
+
 	if createFlags.triggerDef == "" && createFlags.workflow == "" {
 		return maybeSetTriggerDefFlag(ctx, clients, createFlags)
 	}
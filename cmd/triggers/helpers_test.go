@@ -52,6 +52,13 @@ func createFakeTrigger(fakeTriggerID string, fakeTriggerName string, fakeAppID s
 			ID:   fakeTriggerID,
 			Type: "scheduled",
 		}
+	case "webhook":
+		fakeTrigger = types.DeployedTrigger{
+			ID:      fakeTriggerID,
+			Type:    "webhook",
+			Name:    fakeTriggerName,
+			Webhook: "https://hooks.slack.com/triggers/" + fakeTriggerID,
+		}
 	}
 	fakeTrigger.Workflow.AppID = fakeAppID
 	return fakeTrigger
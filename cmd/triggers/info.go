@@ -55,6 +55,8 @@ func NewInfoCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&infoFlags.triggerID, "trigger-id", "", "the ID of the trigger")
+	_ = cmd.RegisterFlagCompletionFunc("trigger-id", triggerIDCompletion(clients))
+	addRefreshFlag(cmd)
 
 	return cmd
 }
@@ -109,6 +109,9 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 			triggers = append(triggers, t)
 		}
 	}
+	if cursor == "" && listFlags.triggerType == "all" {
+		cacheTriggers(ctx, clients, app.AppID, triggers)
+	}
 
 	return outputTriggersList(ctx, triggers, cmd, clients, app, cursor, listFlags.triggerType)
 }
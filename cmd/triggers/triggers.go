@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/cache"
 	"github.com/slackapi/slack-cli/internal/cmdutil"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
@@ -340,6 +341,9 @@ func sprintTriggers(ctx context.Context, triggers []types.DeployedTrigger, clien
 	return formattedText, nil
 }
 
+// triggerPromptCacheTTL is how long listed triggers are reused for prompts
+const triggerPromptCacheTTL = 2 * time.Minute
+
 type promptForTriggerIDLabelOption int
 
 const (
@@ -349,12 +353,7 @@ const (
 )
 
 func promptForTriggerID(ctx context.Context, cmd *cobra.Command, clients *shared.ClientFactory, app types.App, token string, labelOption promptForTriggerIDLabelOption) (string, error) {
-	args := api.TriggerListRequest{
-		AppID: app.AppID,
-		Limit: 0,     // 0 means no pagation
-		Type:  "all", // all means showing all types of triggers
-	}
-	triggers, _, err := clients.API().WorkflowsTriggersList(ctx, token, args)
+	triggers, err := listTriggersForPrompt(ctx, cmd, clients, app, token)
 	if err != nil {
 		return "", err
 	}
@@ -401,6 +400,116 @@ func promptForTriggerID(ctx context.Context, cmd *cobra.Command, clients *shared
 	return selectedTriggerID, nil
 }
 
+// addRefreshFlag adds a flag to list triggers for the trigger prompt instead of
+// reusing triggers that were recently listed
+func addRefreshFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("refresh", false, "list triggers again instead of reusing\n  recently listed triggers in prompts")
+}
+
+// listTriggersForPrompt returns the triggers of an app that were listed within
+// the cache TTL or lists and caches the triggers of the app
+//
+// Recently listed triggers are not reused if the --refresh flag is set.
+func listTriggersForPrompt(ctx context.Context, cmd *cobra.Command, clients *shared.ClientFactory, app types.App, token string) ([]types.DeployedTrigger, error) {
+	refresh := cmd.Flags().Lookup("refresh")
+	_, err := config.GetProjectDirPath(clients.Fs, clients.Os)
+	if err == nil && (refresh == nil || refresh.Value.String() != "true") {
+		items, ok, err := clients.Config.ProjectConfig.Cache().GetRecentTriggers(ctx, app.AppID, triggerPromptCacheTTL)
+		if err != nil {
+			clients.IO.PrintDebug(ctx, "failed to read the cached triggers of app %s: %s", app.AppID, err.Error())
+		} else if ok {
+			triggers := []types.DeployedTrigger{}
+			for _, item := range items {
+				triggers = append(triggers, types.DeployedTrigger{ID: item.ID, Name: item.Name})
+			}
+			return triggers, nil
+		}
+	}
+	args := api.TriggerListRequest{
+		AppID: app.AppID,
+		Limit: 0,     // 0 means no pagation
+		Type:  "all", // all means showing all types of triggers
+	}
+	triggers, _, err := clients.API().WorkflowsTriggersList(ctx, token, args)
+	if err != nil {
+		return nil, err
+	}
+	cacheTriggers(ctx, clients, app.AppID, triggers)
+	return triggers, nil
+}
+
+// expireCachedTriggers marks the cached triggers of an app as outdated after
+// triggers change so prompts list the triggers again
+func expireCachedTriggers(ctx context.Context, clients *shared.ClientFactory, appID string) {
+	if _, err := config.GetProjectDirPath(clients.Fs, clients.Os); err != nil {
+		return
+	}
+	if err := clients.Config.ProjectConfig.Cache().ExpireTriggers(ctx, appID); err != nil {
+		clients.IO.PrintDebug(ctx, "failed to expire the cached triggers of app %s: %s", appID, err.Error())
+	}
+}
+
+// cacheTriggers saves the listed triggers of an app to the project cache for
+// use in shell completions and prompts
+func cacheTriggers(ctx context.Context, clients *shared.ClientFactory, appID string, triggers []types.DeployedTrigger) {
+	if _, err := config.GetProjectDirPath(clients.Fs, clients.Os); err != nil {
+		return
+	}
+	items := []cache.TriggerCacheItem{}
+	for _, tr := range triggers {
+		items = append(items, cache.TriggerCacheItem{ID: tr.ID, Name: tr.Name})
+	}
+	if err := clients.Config.ProjectConfig.Cache().SetTriggers(ctx, appID, items); err != nil {
+		clients.IO.PrintDebug(ctx, "failed to cache the triggers of app %s: %s", appID, err.Error())
+	}
+}
+
+// triggerIDCompletion suggests trigger IDs for the trigger ID flag from the
+// triggers cached for the selected app or all saved apps
+//
+// The cache is updated when triggers are listed or prompted for so that
+// completions avoid network requests.
+func triggerIDCompletion(clients *shared.ClientFactory) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		ctx := cmd.Context()
+		if _, err := config.GetProjectDirPath(clients.Fs, clients.Os); err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		appIDs := []string{}
+		switch {
+		case types.IsAppID(clients.Config.AppFlag):
+			appIDs = append(appIDs, clients.Config.AppFlag)
+		default:
+			if !types.IsAppFlagLocal(clients.Config.AppFlag) {
+				if apps, _, err := clients.AppClient().GetDeployedAll(ctx); err == nil {
+					for _, app := range apps {
+						appIDs = append(appIDs, app.AppID)
+					}
+				}
+			}
+			if !types.IsAppFlagDeploy(clients.Config.AppFlag) {
+				if apps, err := clients.AppClient().GetLocalAll(ctx); err == nil {
+					for _, app := range apps {
+						appIDs = append(appIDs, app.AppID)
+					}
+				}
+			}
+		}
+		completions := []cobra.Completion{}
+		for _, appID := range appIDs {
+			triggers, err := clients.Config.ProjectConfig.Cache().GetTriggers(ctx, appID)
+			if err != nil {
+				continue
+			}
+			for _, tr := range triggers {
+				completions = append(completions, cobra.CompletionWithDesc(tr.ID, tr.Name))
+			}
+		}
+		sort.Strings(completions)
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 func printNoTriggersMessage(ctx context.Context, IO iostreams.IOStreamer) {
 	fmt.Println()
 	IO.PrintInfo(ctx, true, "%s", style.Sectionf(style.TextSection{
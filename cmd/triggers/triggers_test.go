@@ -17,19 +17,26 @@ package triggers
 import (
 	"testing"
 
+	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/hooks"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTriggersCommand(t *testing.T) {
 	// Create mocks
 	ctx := slackcontext.MockContext(t.Context())
 	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
 
 	// Create clients that is mocked for testing
 	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
@@ -54,3 +61,127 @@ func TestTriggersCommand(t *testing.T) {
 	clientsMock.API.AssertCalled(t, "WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything)
 
 }
+
+func TestTriggerIDCompletion(t *testing.T) {
+	tests := map[string]struct {
+		mockAppFlag         string
+		mockProject         bool
+		mockCachedTriggers  map[string][]cache.TriggerCacheItem
+		expectedCompletions []string
+	}{
+		"suggests cached triggers of the app flag": {
+			mockAppFlag: "A0001",
+			mockProject: true,
+			mockCachedTriggers: map[string][]cache.TriggerCacheItem{
+				"A0001": {{ID: "Ft002", Name: "Farewells"}, {ID: "Ft001", Name: "Greetings"}},
+				"A0002": {{ID: "Ft003", Name: "Reminders"}},
+			},
+			expectedCompletions: []string{"Ft001\tGreetings", "Ft002\tFarewells"},
+		},
+		"suggests nothing without cached triggers": {
+			mockAppFlag:         "A0001",
+			mockProject:         true,
+			expectedCompletions: []string{},
+		},
+		"suggests nothing outside of a project": {
+			mockAppFlag:         "A0001",
+			expectedCompletions: nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			if tc.mockProject {
+				err := afero.WriteFile(clientsMock.Fs, config.GetProjectHooksJSONFilePath(slackdeps.MockWorkingDirectory), []byte("{}"), 0o600)
+				require.NoError(t, err)
+			}
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(cf *shared.ClientFactory) {
+				cf.Config.AppFlag = tc.mockAppFlag
+			})
+			for appID, triggers := range tc.mockCachedTriggers {
+				err := clients.Config.ProjectConfig.Cache().SetTriggers(ctx, appID, triggers)
+				require.NoError(t, err)
+			}
+			cmd := &cobra.Command{}
+			cmd.SetContext(ctx)
+			completions, directive := triggerIDCompletion(clients)(cmd, nil, "")
+			assert.Equal(t, tc.expectedCompletions, completions)
+			assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+		})
+	}
+}
+
+func Test_listTriggersForPrompt(t *testing.T) {
+	listedTriggers := []types.DeployedTrigger{{ID: "Ft0002", Name: "Listed"}}
+	tests := map[string]struct {
+		mockCachedTriggers []cache.TriggerCacheItem
+		mockExpired        bool
+		mockProject        bool
+		mockRefresh        bool
+		expectedTriggers   []types.DeployedTrigger
+		expectedList       bool
+	}{
+		"reuses recently listed triggers": {
+			mockCachedTriggers: []cache.TriggerCacheItem{{ID: "Ft0001", Name: "Cached"}},
+			mockProject:        true,
+			expectedTriggers:   []types.DeployedTrigger{{ID: "Ft0001", Name: "Cached"}},
+		},
+		"lists triggers with the --refresh flag": {
+			mockCachedTriggers: []cache.TriggerCacheItem{{ID: "Ft0001", Name: "Cached"}},
+			mockProject:        true,
+			mockRefresh:        true,
+			expectedTriggers:   listedTriggers,
+			expectedList:       true,
+		},
+		"lists triggers after the cached triggers expire": {
+			mockCachedTriggers: []cache.TriggerCacheItem{{ID: "Ft0001", Name: "Cached"}},
+			mockExpired:        true,
+			mockProject:        true,
+			expectedTriggers:   listedTriggers,
+			expectedList:       true,
+		},
+		"lists triggers without cached triggers": {
+			mockProject:      true,
+			expectedTriggers: listedTriggers,
+			expectedList:     true,
+		},
+		"lists triggers outside of a project": {
+			expectedTriggers: listedTriggers,
+			expectedList:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clientsMock.API.On("WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything).Return(listedTriggers, "", nil)
+			if tc.mockProject {
+				err := afero.WriteFile(clientsMock.Fs, config.GetProjectHooksJSONFilePath(slackdeps.MockWorkingDirectory), []byte("{}"), 0o600)
+				require.NoError(t, err)
+			}
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			if tc.mockCachedTriggers != nil {
+				require.NoError(t, clients.Config.ProjectConfig.Cache().SetTriggers(ctx, "A0001", tc.mockCachedTriggers))
+			}
+			if tc.mockExpired {
+				expireCachedTriggers(ctx, clients, "A0001")
+			}
+			cmd := &cobra.Command{}
+			addRefreshFlag(cmd)
+			if tc.mockRefresh {
+				require.NoError(t, cmd.Flags().Set("refresh", "true"))
+			}
+			triggers, err := listTriggersForPrompt(ctx, cmd, clients, types.App{AppID: "A0001"}, "xoxp-example")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTriggers, triggers)
+			if tc.expectedList {
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersList", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
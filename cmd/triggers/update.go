@@ -15,6 +15,8 @@
 package triggers
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/cobra"
@@ -33,7 +36,8 @@ import (
 
 type updateCmdFlags struct {
 	createCmdFlags
-	triggerID string
+	triggerID  string
+	fromRemote bool
 }
 
 var updateFlags updateCmdFlags
@@ -47,10 +51,11 @@ func NewUpdateCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "update --trigger-id <id> [flags]",
 		Short: "Updates an existing trigger",
-		Long:  `Updates an existing trigger with the provided definition. Only supports full replacement, no partial update.`,
+		Long:  `Updates an existing trigger with the provided definition. Only supports full replacement unless the --from-remote flag starts from the existing definition.`,
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "trigger update --trigger-id Ft01234ABCD", Meaning: "Update a trigger definition with a selected file"},
 			{Command: "trigger update --trigger-id Ft01234ABCD \\\n    --workflow \"#/workflows/my_workflow\" --title \"Updated trigger\"", Meaning: "Update a trigger with a workflow id and title"},
+			{Command: "trigger update --trigger-id Ft01234ABCD --from-remote --title \"Updated trigger\"", Meaning: "Update the title of the existing trigger definition"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			clients.Config.SetFlags(cmd)
@@ -62,9 +67,13 @@ func NewUpdateCommand(clients *shared.ClientFactory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&updateFlags.triggerID, "trigger-id", "", "the ID of the trigger to update")
+	_ = cmd.RegisterFlagCompletionFunc("trigger-id", triggerIDCompletion(clients))
+	addRefreshFlag(&cmd)
 	cmd.Flags().StringVar(&updateFlags.workflow, "workflow", "", "a reference to the workflow to execute\n  formatted as:\n  \"#/workflows/<workflow_callback_id>\"")
 	cmd.Flags().StringVar(&updateFlags.title, "title", "My Trigger", "the title of this trigger\n  ")
 	cmd.Flags().StringVar(&updateFlags.description, "description", "", "the description of this trigger")
+	cmd.Flags().BoolVar(&updateFlags.fromRemote, "from-remote", false, "start from the existing trigger definition and\n  override it with the --title, --description,\n  and --input flags")
+	cmd.Flags().StringArrayVar(&updateFlags.inputs, "input", []string{}, "a workflow input formatted as key=value.\n  Values are parsed as JSON when possible.\n  Repeat for each input.")
 	cmd.Flags().StringVar(&updateFlags.triggerDef, "trigger-def", "", "path to a JSON file containing the trigger\n  definition. Overrides other flags setting\n  trigger properties.")
 	cmd.Flags().BoolVar(&updateFlags.interactivity, "interactivity", false, "when used with --workflow, adds a\n  \"slack#/types/interactivity\" parameter\n  to the trigger with the name specified\n  by --interactivity-name")
 	cmd.Flags().StringVar(&updateFlags.interactivityName, "interactivity-name", "interactivity", "when used with --interactivity, specifies\n  the name of the interactivity parameter\n  to use")
@@ -105,7 +114,11 @@ func runUpdateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 		}
 	}
 
-	err = validateCreateCmdFlags(ctx, clients, &updateFlags.createCmdFlags)
+	if updateFlags.fromRemote {
+		err = validateFromRemoteFlags(cmd)
+	} else {
+		err = validateCreateCmdFlags(ctx, clients, &updateFlags.createCmdFlags)
+	}
 	if err != nil {
 		return err
 	}
@@ -116,14 +129,24 @@ func runUpdateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	}))
 
 	var triggerArg api.TriggerRequest
-	if updateFlags.triggerDef != "" {
+	switch {
+	case updateFlags.fromRemote:
+		triggerArg, err = triggerRequestFromRemote(ctx, clients, cmd, token, updateFlags.triggerID, app.IsDev)
+		if err != nil {
+			return err
+		}
+	case updateFlags.triggerDef != "":
 		triggerArg, err = triggerRequestFromDef(ctx, clients, updateFlags.createCmdFlags, app.IsDev)
 		if err != nil {
 			return err
 		}
-	} else {
+	default:
 		triggerArg = triggerRequestFromFlags(updateFlags.createCmdFlags, app.IsDev)
 	}
+	triggerArg.Inputs, err = mergeTriggerInputs(triggerArg.Inputs, updateFlags.inputs)
+	if err != nil {
+		return err
+	}
 
 	// Fix the app ID selected from the menu. In the --trigger-def case, this lets you use the same
 	// def file for dev and prod.
@@ -163,6 +186,7 @@ func runUpdateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	expireCachedTriggers(ctx, clients, app.AppID)
 
 	trigs, err := sprintTrigger(ctx, updatedTrigger, clients, true, app)
 	if err != nil {
@@ -176,6 +200,66 @@ func runUpdateCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
 	return nil
 }
 
+// validateFromRemoteFlags errors if the --from-remote flag is used with flags
+// that replace the existing trigger definition
+func validateFromRemoteFlags(cmd *cobra.Command) error {
+	for _, name := range []string{"trigger-def", "workflow", "interactivity", "interactivity-name"} {
+		if cmd.Flags().Changed(name) {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --from-remote flag cannot be used with the --%s flag", name).
+				WithRemediation("Override the existing trigger with the --title, --description, or --input flags")
+		}
+	}
+	return nil
+}
+
+// triggerRequestFromRemote returns the definition of an existing trigger with
+// the --title and --description flags applied on top
+//
+// Only shortcut triggers are supported since the definitions of other trigger
+// types are not returned with the trigger information.
+func triggerRequestFromRemote(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, token string, triggerID string, isDev bool) (api.TriggerRequest, error) {
+	trigger, err := clients.API().WorkflowsTriggersInfo(ctx, token, triggerID)
+	if err != nil {
+		switch slackerror.ToSlackError(err).Code {
+		case slackerror.ErrTriggerNotFound, slackerror.ErrTriggerDoesNotExist:
+			return api.TriggerRequest{}, slackerror.New(slackerror.ErrTriggerNotFound).
+				WithMessage("The trigger %s cannot be found", triggerID).
+				WithRootCause(err)
+		}
+		return api.TriggerRequest{}, err
+	}
+	if !strings.EqualFold(trigger.Type, types.TriggerTypeShortcut) {
+		return api.TriggerRequest{}, slackerror.New(slackerror.ErrInvalidTriggerType).
+			WithMessage("The --from-remote flag only supports shortcut triggers and %s is a %s trigger", triggerID, trigger.Type).
+			WithRemediation("Update the trigger with the --trigger-def flag instead")
+	}
+	triggerArg := api.TriggerRequest{
+		Type:        types.TriggerTypeShortcut,
+		Shortcut:    &api.Shortcut{},
+		Name:        trigger.Name,
+		Description: trigger.Description,
+		Workflow:    fmt.Sprintf("#/workflows/%s", trigger.Workflow.CallbackID),
+	}
+	if trigger.Inputs != nil && trigger.Inputs.JSONData != nil {
+		if err := json.Unmarshal(*trigger.Inputs.JSONData, &triggerArg.Inputs); err != nil {
+			return api.TriggerRequest{}, slackerror.New(slackerror.ErrUnableToParseJSON).
+				WithMessage("Failed to read the inputs of the trigger %s", triggerID).
+				WithRootCause(err)
+		}
+	}
+	if cmd.Flags().Changed("title") {
+		triggerArg.Name = updateFlags.title
+		if isDev {
+			triggerArg.Name = style.LocalRunDisplayName(triggerArg.Name)
+		}
+	}
+	if cmd.Flags().Changed("description") {
+		triggerArg.Description = updateFlags.description
+	}
+	return triggerArg, nil
+}
+
 func promptShouldRetryUpdateWithInteractivity(cmd *cobra.Command, IO iostreams.IOStreamer, triggerArg api.TriggerRequest) (bool, error) {
 	return promptShouldRetryWithInteractivity("Would you like to update the trigger with this definition?", cmd, IO, triggerArg)
 }
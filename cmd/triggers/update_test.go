@@ -16,6 +16,7 @@ package triggers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/prompts"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/test/testutil"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -367,6 +369,109 @@ func TestTriggersUpdateCommand(t *testing.T) {
 	})
 }
 
+func TestTriggersUpdateCommand_FromRemote(t *testing.T) {
+	var appSelectTeardown func()
+	remoteInputs := json.RawMessage(`{"channel":{"value":"C0123456789"}}`)
+	remoteTrigger := types.DeployedTrigger{
+		ID:          fakeTriggerID,
+		Type:        "shortcut",
+		Name:        "Existing trigger",
+		Description: "Existing description",
+		Workflow:    types.TriggerWorkflow{CallbackID: "my_workflow", AppID: fakeAppID},
+		Inputs:      &types.RawJSON{JSONData: &remoteInputs},
+	}
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"merges flag overrides with the existing trigger": {
+			CmdArgs:         []string{"--trigger-id", fakeTriggerID, "--from-remote", "--title", "Updated trigger", "--input", "user=U0123456789"},
+			ExpectedOutputs: []string{"Trigger successfully updated!"},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockUpdateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+				clientsMock.API.On("WorkflowsTriggersInfo", mock.Anything, mock.Anything, fakeTriggerID).Return(remoteTrigger, nil)
+				fakeTrigger := createFakeTrigger(fakeTriggerID, "Updated trigger", fakeAppID, "shortcut")
+				clientsMock.API.On("WorkflowsTriggersUpdate", mock.Anything, mock.Anything, mock.Anything).Return(fakeTrigger, nil)
+				clientsMock.API.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).Return([]types.SlackUser{}, nil)
+				clientsMock.API.On("TriggerPermissionsList", mock.Anything, mock.Anything, mock.Anything).Return(types.PermissionEveryone, []string{}, nil)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				expectedTriggerRequest := api.TriggerUpdateRequest{
+					TriggerID: fakeTriggerID,
+					TriggerRequest: api.TriggerRequest{
+						Type:          types.TriggerTypeShortcut,
+						Shortcut:      &api.Shortcut{},
+						Name:          "Updated trigger",
+						Description:   "Existing description",
+						Workflow:      "#/workflows/my_workflow",
+						WorkflowAppID: fakeAppID,
+						Inputs: api.Inputs{
+							"channel": &api.Input{Value: "C0123456789"},
+							"user":    &api.Input{Value: "U0123456789"},
+						},
+					},
+				}
+				clientsMock.API.AssertCalled(t, "WorkflowsTriggersUpdate", mock.Anything, mock.Anything, expectedTriggerRequest)
+			},
+		},
+		"errors if the trigger cannot be found": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--from-remote"},
+			ExpectedErrorStrings: []string{slackerror.ErrTriggerNotFound},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockUpdateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+				clientsMock.API.On("WorkflowsTriggersInfo", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(types.DeployedTrigger{}, slackerror.New(slackerror.ErrTriggerNotFound))
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersUpdate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors for a trigger that is not a shortcut": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--from-remote"},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidTriggerType},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockUpdateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+				clientsMock.API.On("WorkflowsTriggersInfo", mock.Anything, mock.Anything, fakeTriggerID).
+					Return(createFakeTrigger(fakeTriggerID, fakeTriggerName, fakeAppID, "event"), nil)
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersUpdate", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors with the --workflow flag": {
+			CmdArgs:              []string{"--trigger-id", fakeTriggerID, "--from-remote", "--workflow", "#/workflows/my_workflow"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				appSelectTeardown = setupMockUpdateAppSelection(installedProdApp)
+				clientsMock.AddDefaultMocks()
+			},
+			Teardown: func() {
+				appSelectTeardown()
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock) {
+				clientsMock.API.AssertNotCalled(t, "WorkflowsTriggersInfo", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		cmd := NewUpdateCommand(clients)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			clients.Config.SetFlags(cmd)
+			return nil
+		}
+		return cmd
+	})
+}
+
 func TestTriggersUpdateCommand_MissingParameters(t *testing.T) {
 	var appSelectTeardown func()
 	var promptForInteractivityTeardown func()
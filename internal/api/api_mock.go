@@ -145,6 +145,11 @@ func (m *APIMock) UsersInfo(ctx context.Context, token, userID string) (*types.U
 	return args.Get(0).(*types.UserInfo), args.Error(1)
 }
 
+func (m *APIMock) UsersLookupByEmail(ctx context.Context, token, email string) (*types.UserInfo, error) {
+	args := m.Called(ctx, token, email)
+	return args.Get(0).(*types.UserInfo), args.Error(1)
+}
+
 // ChannelClient
 
 func (m *APIMock) ChannelsInfo(ctx context.Context, token, channelID string) (*types.ChannelInfo, error) {
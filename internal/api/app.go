@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/shared/types"
@@ -708,6 +709,17 @@ func (c *Client) handleAppApprovalStates(ctx context.Context, IO iostreams.IOStr
 	span, ctx = opentracing.StartSpanFromContext(ctx, "apiclient.handleAppApprovalStates")
 	defer span.Finish()
 
+	// Check on a pending request without prompts while waiting for approval. A
+	// request that is eligible again was denied or cancelled by an admin.
+	if config.GetContextInstallApprovalWait(ctx) {
+		switch resp {
+		case slackerror.ErrAppApprovalRequestPending:
+			return types.InstallRequestPending, nil
+		case slackerror.ErrAppApprovalRequestEligible:
+			return types.InstallRequestCancelled, nil
+		}
+	}
+
 	var alternativeSuggestion = "Alternatively, retry on a workspace without administrator approval turned on"
 	if resp == slackerror.ErrAppApprovalRequestEligible {
 		return c.handleAppRequestEligibleState(ctx, IO, resp, token, appID, teamID, scopes, outgoingDomains, alternativeSuggestion, autoRequestAAA)
@@ -738,7 +750,8 @@ func (c *Client) handleAppRequestEligibleState(ctx context.Context, IO iostreams
 
 	var shouldSendApprovalRequest bool
 	var err error
-	if autoRequestAAA {
+	var requestReason = config.GetContextRequestReason(ctx)
+	if autoRequestAAA || requestReason != "" {
 		shouldSendApprovalRequest = true
 	} else {
 		// prompt as to whether to sent AAA request
@@ -755,7 +768,10 @@ func (c *Client) handleAppRequestEligibleState(ctx context.Context, IO iostreams
 	var reason string
 	if shouldSendApprovalRequest {
 		IO.PrintTrace(ctx, slacktrace.AdminAppApprovalRequestShouldSend)
-		if !autoRequestAAA {
+		switch {
+		case requestReason != "":
+			reason = requestReason
+		case !autoRequestAAA:
 			// prompt for reason
 			reason, err = c.io.InputPrompt(ctx, "Enter a reason for installing this app:", iostreams.InputPromptConfig{
 				Required: false,
@@ -763,7 +779,7 @@ func (c *Client) handleAppRequestEligibleState(ctx context.Context, IO iostreams
 			if err != nil {
 				return "", err
 			}
-		} else {
+		default:
 			reason = "This request has been automatically generated according to project environment settings."
 		}
 		IO.PrintTrace(ctx, slacktrace.AdminAppApprovalRequestReasonSubmitted, reason)
@@ -796,6 +812,11 @@ func (c *Client) handleAppRequestPendingState(ctx context.Context, IO iostreams.
 	}))
 	fmt.Println(status)
 
+	// Keep the current request without prompts when a reason is provided
+	if config.GetContextRequestReason(ctx) != "" {
+		return types.InstallRequestPending, nil
+	}
+
 	shouldCancelRequest, err := c.io.ConfirmPrompt(ctx, "Cancel the current request to install this app?", false)
 	if err != nil {
 		return "", err
@@ -528,6 +528,8 @@ func TestClient_DeveloperAppInstall_RequestAppApproval(t *testing.T) {
 		app                 types.App
 		orgGrantWorkspaceID string
 		teamID              string
+		autoRequestAAA      bool
+		requestReason       string
 		requestJSON         string
 		wantErr             bool
 		errMessage          string
@@ -537,6 +539,7 @@ func TestClient_DeveloperAppInstall_RequestAppApproval(t *testing.T) {
 			app:                 types.App{AppID: "A1234", TeamID: "T1234"},
 			orgGrantWorkspaceID: "",
 			teamID:              "T1234",
+			autoRequestAAA:      true,
 			requestJSON:         `{"app":"A1234","reason":"This request has been automatically generated according to project environment settings.","team_id":"T1234"}`,
 		},
 		`User tried to install to a single workspace in an org, AAA is requested \
@@ -544,6 +547,7 @@ func TestClient_DeveloperAppInstall_RequestAppApproval(t *testing.T) {
 			app:                 types.App{AppID: "A1234", EnterpriseID: "E1234", TeamID: "E1234"},
 			orgGrantWorkspaceID: "T1234",
 			teamID:              "T1234",
+			autoRequestAAA:      true,
 			requestJSON:         `{"app":"A1234","reason":"This request has been automatically generated according to project environment settings.","team_id":"T1234"}`,
 		},
 		`User tried to install to all workspaces in an org, AAA is requested \
@@ -551,12 +555,30 @@ func TestClient_DeveloperAppInstall_RequestAppApproval(t *testing.T) {
 			app:                 types.App{AppID: "A1234", EnterpriseID: "E1234", TeamID: "E1234"},
 			orgGrantWorkspaceID: "all",
 			teamID:              "E1234",
+			autoRequestAAA:      true,
 			requestJSON:         `{"app":"A1234","reason":"This request has been automatically generated according to project environment settings."}`,
 		},
+		`Standalone workspace, AAA is requested with the provided reason \
+			(no prompts are shown for the request)`: {
+			app:           types.App{AppID: "A1234", TeamID: "T1234"},
+			teamID:        "T1234",
+			requestReason: "Posts release notes",
+			requestJSON:   `{"app":"A1234","reason":"Posts release notes","team_id":"T1234"}`,
+		},
+		`Standalone workspace, AAA is requested with the provided reason over the automatic reason`: {
+			app:            types.App{AppID: "A1234", TeamID: "T1234"},
+			teamID:         "T1234",
+			autoRequestAAA: true,
+			requestReason:  "Posts release notes",
+			requestJSON:    `{"app":"A1234","reason":"Posts release notes","team_id":"T1234"}`,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctx := slackcontext.MockContext(t.Context())
+			if tc.requestReason != "" {
+				ctx = config.SetContextRequestReason(ctx, tc.requestReason)
+			}
 
 			// prepare
 			handlerFunc := func(w http.ResponseWriter, r *http.Request) {
@@ -588,8 +610,72 @@ func TestClient_DeveloperAppInstall_RequestAppApproval(t *testing.T) {
 			iostreamMock.On("PrintTrace", mock.Anything, mock.Anything, mock.Anything).Return()
 
 			// execute
-			_, _, err := c.DeveloperAppInstall(ctx, iostreamMock, "token", tc.app, []string{}, []string{}, tc.orgGrantWorkspaceID, true)
+			_, installState, err := c.DeveloperAppInstall(ctx, iostreamMock, "token", tc.app, []string{}, []string{}, tc.orgGrantWorkspaceID, tc.autoRequestAAA)
+			require.NoError(t, err)
+			assert.Equal(t, types.InstallRequestPending, installState)
+		})
+	}
+}
+
+func TestClient_DeveloperAppInstall_RequestPendingWithReason(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	ctx = config.SetContextRequestReason(ctx, "Posts release notes")
+	handlerFunc := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, appDeveloperInstallMethod):
+			_, err := fmt.Fprintf(w, `{"ok":false,"error":"%s","team_id":"T1234"}`, slackerror.ErrAppApprovalRequestPending)
+			require.NoError(t, err)
+		default:
+			assert.Fail(t, "unexpected request", r.URL.Path)
+		}
+	}
+	ts := httptest.NewServer(http.HandlerFunc(handlerFunc))
+	defer ts.Close()
+	c := NewClient(&http.Client{}, ts.URL, nil)
+	iostreamMock := iostreams.NewIOStreamsMock(&config.Config{}, &slackdeps.FsMock{}, &slackdeps.OsMock{})
+	iostreamMock.On("PrintTrace", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	_, installState, err := c.DeveloperAppInstall(ctx, iostreamMock, "token", types.App{AppID: "A1234", TeamID: "T1234"}, []string{}, []string{}, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, types.InstallRequestPending, installState)
+}
+
+func TestClient_DeveloperAppInstall_InstallApprovalWait(t *testing.T) {
+	tests := map[string]struct {
+		installError  string
+		expectedState types.InstallState
+	}{
+		"a pending request remains pending without prompts": {
+			installError:  slackerror.ErrAppApprovalRequestPending,
+			expectedState: types.InstallRequestPending,
+		},
+		"an eligible request was reviewed without approval": {
+			installError:  slackerror.ErrAppApprovalRequestEligible,
+			expectedState: types.InstallRequestCancelled,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			ctx = config.SetContextInstallApprovalWait(ctx, true)
+			handlerFunc := func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.Contains(r.URL.Path, appDeveloperInstallMethod):
+					_, err := fmt.Fprintf(w, `{"ok":false,"error":"%s","team_id":"T1234"}`, tc.installError)
+					require.NoError(t, err)
+				default:
+					assert.Fail(t, "unexpected request", r.URL.Path)
+				}
+			}
+			ts := httptest.NewServer(http.HandlerFunc(handlerFunc))
+			defer ts.Close()
+			c := NewClient(&http.Client{}, ts.URL, nil)
+			iostreamMock := iostreams.NewIOStreamsMock(&config.Config{}, &slackdeps.FsMock{}, &slackdeps.OsMock{})
+			iostreamMock.On("PrintTrace", mock.Anything, mock.Anything, mock.Anything).Return()
+
+			_, installState, err := c.DeveloperAppInstall(ctx, iostreamMock, "token", types.App{AppID: "A1234", TeamID: "T1234"}, []string{}, []string{}, "", false)
 			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, installState)
 		})
 	}
 }
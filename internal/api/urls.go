@@ -0,0 +1,39 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// AppDirectoryURL returns the app directory page of an app on the API host
+func AppDirectoryURL(host string, appID string) string {
+	return fmt.Sprintf("%s/apps/%s", host, appID)
+}
+
+// AppSettingsURL returns the app settings URL for an app on the API host or
+// the list of all apps without an app ID
+func AppSettingsURL(host string, appID string) (string, error) {
+	parsed, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+	parsed.Host = "api." + parsed.Host
+	if appID == "" {
+		return fmt.Sprintf("%s/apps", parsed.String()), nil
+	}
+	return fmt.Sprintf("%s/apps/%s", parsed.String(), appID), nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AppDirectoryURL(t *testing.T) {
+	assert.Equal(t, "https://slack.com/apps/A0123456789", AppDirectoryURL("https://slack.com", "A0123456789"))
+}
+
+func Test_AppSettingsURL(t *testing.T) {
+	tests := map[string]struct {
+		host        string
+		appID       string
+		expectedURL string
+	}{
+		"returns the url to all apps without an app id": {
+			host:        "https://slack.com",
+			expectedURL: "https://api.slack.com/apps",
+		},
+		"returns the url to an app in production": {
+			host:        "https://slack.com",
+			appID:       "A0123456789",
+			expectedURL: "https://api.slack.com/apps/A0123456789",
+		},
+		"returns the url to an app in development": {
+			host:        "https://dev1234.slack.com",
+			appID:       "A0123456789",
+			expectedURL: "https://api.dev1234.slack.com/apps/A0123456789",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settingsURL, err := AppSettingsURL(tc.host, tc.appID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedURL, settingsURL)
+		})
+	}
+}
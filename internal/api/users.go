@@ -25,11 +25,13 @@ import (
 )
 
 const (
-	usersInfoMethod = "users.info"
+	usersInfoMethod          = "users.info"
+	usersLookupByEmailMethod = "users.lookupByEmail"
 )
 
 type UserClient interface {
 	UsersInfo(ctx context.Context, token, userID string) (*types.UserInfo, error)
+	UsersLookupByEmail(ctx context.Context, token, email string) (*types.UserInfo, error)
 }
 
 type UserInfoResponse struct {
@@ -69,3 +71,36 @@ func (c *Client) UsersInfo(ctx context.Context, token, userID string) (*types.Us
 
 	return &resp.User, nil
 }
+
+// UsersLookupByEmail returns information about the user with an email address
+func (c *Client) UsersLookupByEmail(ctx context.Context, token, email string) (*types.UserInfo, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "apiclient.usersLookupByEmail")
+	defer span.Finish()
+
+	var values = url.Values{}
+	values.Add("token", token)
+	values.Add("email", email)
+
+	b, err := c.postForm(ctx, usersLookupByEmailMethod, values)
+	if err != nil {
+		return nil, errHTTPRequestFailed.WithRootCause(err)
+	}
+
+	if b == nil {
+		return nil, errHTTPResponseInvalid.WithRootCause(slackerror.New("empty body"))
+	}
+
+	resp := UserInfoResponse{}
+	err = goutils.JSONUnmarshal(b, &resp)
+
+	if err != nil {
+		return nil, errHTTPResponseInvalid.WithRootCause(err).AddAPIMethod(usersLookupByEmailMethod)
+	}
+
+	if !resp.Ok {
+		return nil, slackerror.NewAPIError(resp.Error, resp.Description, resp.Errors, usersLookupByEmailMethod)
+	}
+
+	return &resp.User, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_API_UsersLookupByEmail(t *testing.T) {
+	tests := map[string]struct {
+		argsToken             string
+		argsEmail             string
+		httpResponseJSON      string
+		expectedUserInfo      *types.UserInfo
+		expectedErrorContains string
+	}{
+		"Successful request": {
+			argsToken:        "xoxp-123",
+			argsEmail:        "bot@slack.com",
+			httpResponseJSON: `{"ok": true, "user": {"id": "U123", "name": "bot", "real_name": "Bot", "profile": {"email": "bot@slack.com"}}}`,
+			expectedUserInfo: &types.UserInfo{
+				ID:       "U123",
+				Name:     "bot",
+				RealName: "Bot",
+				Profile:  types.UserProfile{Email: "bot@slack.com"},
+			},
+		},
+		"Response contains an error": {
+			argsToken:             "xoxp-123",
+			argsEmail:             "nobody@slack.com",
+			httpResponseJSON:      `{"ok": false, "error": "users_not_found"}`,
+			expectedErrorContains: "users_not_found",
+		},
+		"Response contains invalid JSON": {
+			argsToken:             "xoxp-123",
+			argsEmail:             "bot@slack.com",
+			httpResponseJSON:      `this is not valid json {"ok": true}`,
+			expectedErrorContains: errHTTPResponseInvalid.Code,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			c, teardown := NewFakeClient(t, FakeClientParams{
+				ExpectedMethod: usersLookupByEmailMethod,
+				Response:       tc.httpResponseJSON,
+			})
+			defer teardown()
+			actual, err := c.UsersLookupByEmail(ctx, tc.argsToken, tc.argsEmail)
+			if tc.expectedErrorContains == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedUserInfo, actual)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErrorContains)
+			}
+		})
+	}
+}
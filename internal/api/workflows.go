@@ -42,8 +42,8 @@ type Shortcut struct {
 }
 
 type Input struct {
-	Value        string `json:"value,omitempty"`
-	Customizable bool   `json:"customizable,omitempty"`
+	Value        any  `json:"value,omitempty"`
+	Customizable bool `json:"customizable,omitempty"`
 }
 
 type Inputs map[string]*Input
@@ -23,6 +23,7 @@ import (
 
 	"github.com/slackapi/slack-cli/internal/api"
 	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/spf13/afero"
 )
@@ -37,12 +38,13 @@ type Client struct {
 func NewClient(
 	apiClient api.APIInterface,
 	config *config.Config,
+	io iostreams.IOStreamer,
 	fs afero.Fs,
 	os types.Os,
 ) *Client {
 	return &Client{
-		Manifest:           NewManifestClient(apiClient, config),
-		AppClientInterface: NewAppClient(config, fs, os),
+		Manifest:           NewManifestClient(apiClient, config, fs),
+		AppClientInterface: NewAppClient(config, io, fs, os),
 	}
 }
 
@@ -17,12 +17,15 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/goutils"
+	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
@@ -34,6 +37,10 @@ const deployedAppsFilename = ".slack/apps.json"
 const devAppsFilename = ".slack/apps.dev.json"
 const defaultProdAppTeamDomain = "prod"
 
+// appsFileMutex guards each read and write of the apps files that saves changes
+// so concurrent deploys of one process do not overwrite saves of another
+var appsFileMutex sync.Mutex
+
 type AppClientInterface interface {
 	NewDeployed(ctx context.Context, teamID string) (types.App, error)
 	GetDeployed(ctx context.Context, teamID string) (types.App, error)
@@ -53,6 +60,7 @@ type AppClientInterface interface {
 type AppClient struct {
 	// Internal dependencies
 	config *config.Config
+	io     iostreams.IOStreamer
 	apps   types.Apps
 	// External dependencies
 	// fs is the file system module that's shared by all packages and enables testing & mocking of the file system
@@ -63,9 +71,10 @@ type AppClient struct {
 
 // NewAppClient returns a new, empty instance of the AppClient
 // TODO(@mbrooks) - Should this constructor read the file (looks like all public funcs always read first)?
-func NewAppClient(config *config.Config, fs afero.Fs, os types.Os) *AppClient {
+func NewAppClient(config *config.Config, io iostreams.IOStreamer, fs afero.Fs, os types.Os) *AppClient {
 	var client = AppClient{
 		config: config,
+		io:     io,
 		apps: types.Apps{
 			DeployedApps: map[string]types.App{},
 			LocalApps:    map[string]types.App{},
@@ -132,6 +141,9 @@ func (ac *AppClient) GetDeployedAll(ctx context.Context) ([]types.App, string, e
 
 // SaveDeployed saves the provided app to the deployed apps file
 func (ac *AppClient) SaveDeployed(ctx context.Context, app types.App) error {
+	appsFileMutex.Lock()
+	defer appsFileMutex.Unlock()
+
 	var err = ac.readDeployedApps()
 	if err != nil {
 		return err
@@ -151,6 +163,9 @@ func (ac *AppClient) SaveDeployed(ctx context.Context, app types.App) error {
 
 // RemoveDeployed removes the app with teamID from the apps.json file
 func (ac *AppClient) RemoveDeployed(ctx context.Context, teamID string) (types.App, error) {
+	appsFileMutex.Lock()
+	defer appsFileMutex.Unlock()
+
 	var err = ac.readDeployedApps()
 	if err != nil {
 		return types.App{}, err
@@ -188,6 +203,9 @@ func (ac *AppClient) GetLocalAll(ctx context.Context) ([]types.App, error) {
 
 // SaveLocal saves the provided app as the local app for the provided teamID
 func (ac *AppClient) SaveLocal(ctx context.Context, app types.App) error {
+	appsFileMutex.Lock()
+	defer appsFileMutex.Unlock()
+
 	if err := ac.readLocalApps(); err != nil {
 		return err
 	}
@@ -205,6 +223,9 @@ func (ac *AppClient) SaveLocal(ctx context.Context, app types.App) error {
 
 // RemoveLocal removes the app with the provided teamID from apps.dev.json
 func (ac *AppClient) RemoveLocal(ctx context.Context, teamID string) (types.App, error) {
+	appsFileMutex.Lock()
+	defer appsFileMutex.Unlock()
+
 	err := ac.readLocalApps()
 	if err != nil {
 		return types.App{}, err
@@ -315,10 +336,14 @@ func (ac *AppClient) readDeployedApps() error {
 	}
 
 	if err = json.Unmarshal(f, &ac.apps); err != nil {
-		return slackerror.New(slackerror.ErrUnableToParseJSON).
-			WithMessage("Failed to parse contents of deployed apps file").
-			WithRootCause(err).
-			WithRemediation("Check that %s is valid JSON", style.HomePath(deployedAppsPath))
+		repaired, ok := ac.repairAppsFile(deployedAppsPath, f)
+		if !ok || json.Unmarshal(repaired, &ac.apps) != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).
+				WithMessage("Failed to parse contents of deployed apps file").
+				WithRootCause(err).
+				WithRemediation("Check that %s is valid JSON or run %s to back up and reset the file", style.HomePath(deployedAppsPath), style.Commandf("doctor --fix", false))
+		}
+		f = repaired
 	}
 	// TODO: on the next major version we can drop this last bit of backwards compatibility code
 	// some (hacky) backwards compatibility checking: if only a single apps.json file exists in the project, and it contains a "dev" key,
@@ -401,10 +426,13 @@ func (ac *AppClient) readLocalApps() error {
 
 	err = json.Unmarshal(f, &ac.apps.LocalApps)
 	if err != nil {
-		return slackerror.New(slackerror.ErrUnableToParseJSON).
-			WithMessage("Failed to parse contents of local apps file").
-			WithRootCause(err).
-			WithRemediation("Check that %s is valid JSON", style.HomePath(devAppsPath))
+		repaired, ok := ac.repairAppsFile(devAppsPath, f)
+		if !ok || json.Unmarshal(repaired, &ac.apps.LocalApps) != nil {
+			return slackerror.New(slackerror.ErrUnableToParseJSON).
+				WithMessage("Failed to parse contents of local apps file").
+				WithRootCause(err).
+				WithRemediation("Check that %s is valid JSON or run %s to back up and reset the file", style.HomePath(devAppsPath), style.Commandf("doctor --fix", false))
+		}
 	}
 
 	// The isDev bool is used to set the SLACK_ENV environment variable
@@ -436,6 +464,41 @@ func (ac *AppClient) saveLocalApps() error {
 	return afero.WriteFile(ac.fs, path, data, 0600)
 }
 
+// repairAppsFile rewrites an apps file that has content following valid JSON
+// with just the valid JSON after saving a backup of the original contents
+func (ac *AppClient) repairAppsFile(path string, data []byte) ([]byte, bool) {
+	repaired, ok := goutils.TrimTrailingJSON(data)
+	if !ok {
+		return data, false
+	}
+	backup := BackupFilePath(ac.fs, path)
+	if err := afero.WriteFile(ac.fs, backup, data, 0600); err != nil {
+		return data, false
+	}
+	if err := afero.WriteFile(ac.fs, path, repaired, 0600); err != nil {
+		return data, false
+	}
+	_, _ = fmt.Fprintln(ac.io.WriteErr(), style.Sectionf(style.TextSection{
+		Emoji: "warning",
+		Text:  fmt.Sprintf("Repaired unexpected content in %s", style.HomePath(path)),
+		Secondary: []string{
+			fmt.Sprintf("The original contents were saved to %s", style.HomePath(backup)),
+		},
+	}))
+	return repaired, true
+}
+
+// BackupFilePath returns an unused path to save a backup of the file at path
+func BackupFilePath(fs afero.Fs, path string) string {
+	backup := path + ".bak"
+	for i := 1; ; i++ {
+		if _, err := fs.Stat(backup); err != nil {
+			return backup
+		}
+		backup = fmt.Sprintf("%s.%d.bak", path, i)
+	}
+}
+
 // readAllApps loads the latest App info, both deployed and dev.
 func (ac *AppClient) readAllApps() error {
 	err := ac.readDeployedApps()
@@ -15,17 +15,22 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -40,7 +45,8 @@ func setup(t *testing.T) (appClient *AppClient, fsMock *slackdeps.FsMock, osMock
 	os := slackdeps.NewOsMock()
 	os.AddDefaultMocks()
 	cfg := config.NewConfig(fs, os)
-	ac := NewAppClient(cfg, fs, os)
+	io := iostreams.NewIOStreamsMock(cfg, fs, os)
+	ac := NewAppClient(cfg, io, fs, os)
 	dir, _ := os.Getwd()
 	pathToAppsJSON = filepath.Join(dir, deployedAppsFilename)
 	pathToDevAppsJSON = filepath.Join(dir, devAppsFilename)
@@ -98,6 +104,30 @@ func Test_AppClient_SaveDeployedApps(t *testing.T) {
 	assert.Equal(t, "A123", myApp.AppID)
 }
 
+// Test that concurrent saves of separate app clients keep each deployed app
+func Test_AppClient_SaveDeployed_Concurrent(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	ac, _, _, _, _, teardown := setup(t)
+	defer teardown(t)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := NewAppClient(ac.config, ac.io, ac.fs, ac.os)
+			err := client.SaveDeployed(ctx, types.App{
+				TeamID: fmt.Sprintf("T00%d", i),
+				AppID:  fmt.Sprintf("A00%d", i),
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	apps, _, err := ac.GetDeployedAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, apps, 8)
+}
+
 // Test that dev app details get written to apps.json
 func Test_AppClient_SaveLocalApps(t *testing.T) {
 	ac, _, _, _, pathToDevAppsJSON, teardown := setup(t)
@@ -163,6 +193,29 @@ func Test_AppClient_ReadDeployedApps_BrokenAppsJSON(t *testing.T) {
 	err = ac.readDeployedApps()
 	require.Error(t, err)
 	assert.Equal(t, err.(*slackerror.Error).Code, slackerror.ErrUnableToParseJSON)
+	assert.Contains(t, err.(*slackerror.Error).Remediation, "doctor --fix")
+}
+
+// Test that an apps.json with content following valid JSON (e.g. from a
+// shorter write over a longer file) is repaired after saving a backup.
+func Test_AppClient_ReadDeployedApps_TrailingAppsJSON(t *testing.T) {
+	ac, _, _, pathToAppsJSON, _, teardown := setup(t)
+	defer teardown(t)
+	jsonContents := []byte(`{"apps":{"T123":{"app_id":"A123","team_id":"T123"}}}` + "\n" + `"}}}`)
+	err := afero.WriteFile(ac.fs, pathToAppsJSON, jsonContents, 0600)
+	require.NoError(t, err)
+	err = ac.readDeployedApps()
+	require.NoError(t, err)
+	assert.Equal(t, "A123", ac.apps.DeployedApps["T123"].AppID)
+	backup, err := afero.ReadFile(ac.fs, pathToAppsJSON+".bak")
+	require.NoError(t, err)
+	assert.Equal(t, jsonContents, backup)
+	stderr := ac.io.WriteErr().(*bytes.Buffer)
+	assert.Contains(t, stderr.String(), "Repaired unexpected content in")
+	assert.Contains(t, stderr.String(), "The original contents were saved to "+style.HomePath(pathToAppsJSON+".bak"))
+	f, err := afero.ReadFile(ac.fs, pathToAppsJSON)
+	require.NoError(t, err)
+	assert.NotContains(t, string(f), `"}}}`)
 }
 
 // Test that a zero-byte or whitespace-only apps.json (e.g. from a truncated
@@ -229,6 +282,35 @@ func Test_AppClient_ReadDevApps_BrokenAppsJSON(t *testing.T) {
 	err = ac.readLocalApps()
 	require.Error(t, err)
 	assert.Equal(t, err.(*slackerror.Error).Code, slackerror.ErrUnableToParseJSON)
+	assert.Contains(t, err.(*slackerror.Error).Remediation, "doctor --fix")
+}
+
+// Test that an apps.dev.json with content following valid JSON is repaired
+// after saving a backup. See Test_AppClient_ReadDeployedApps_TrailingAppsJSON.
+func Test_AppClient_ReadDevApps_TrailingAppsJSON(t *testing.T) {
+	ac, _, _, _, pathToDevAppsJSON, teardown := setup(t)
+	defer teardown(t)
+	jsonContents := []byte(`{"T456":{"app_id":"A123","team_id":"T456"}}` + "\n" + `}`)
+	err := afero.WriteFile(ac.fs, pathToDevAppsJSON, jsonContents, 0600)
+	require.NoError(t, err)
+	err = ac.readLocalApps()
+	require.NoError(t, err)
+	assert.Equal(t, "A123", ac.apps.GetLocalByTeamID("T456").AppID)
+	backup, err := afero.ReadFile(ac.fs, pathToDevAppsJSON+".bak")
+	require.NoError(t, err)
+	assert.Equal(t, jsonContents, backup)
+	stderr := ac.io.WriteErr().(*bytes.Buffer)
+	assert.Contains(t, stderr.String(), "The original contents were saved to "+style.HomePath(pathToDevAppsJSON+".bak"))
+}
+
+func Test_BackupFilePath(t *testing.T) {
+	fs := slackdeps.NewFsMock()
+	path := filepath.Join(slackdeps.MockWorkingDirectory, ".slack", "apps.json")
+	assert.Equal(t, path+".bak", BackupFilePath(fs, path))
+	require.NoError(t, afero.WriteFile(fs, path+".bak", []byte("{"), 0600))
+	assert.Equal(t, path+".1.bak", BackupFilePath(fs, path))
+	require.NoError(t, afero.WriteFile(fs, path+".1.bak", []byte("{"), 0600))
+	assert.Equal(t, path+".2.bak", BackupFilePath(fs, path))
 }
 
 // Test that a zero-byte or whitespace-only apps.dev.json (e.g. from a
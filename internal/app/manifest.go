@@ -17,19 +17,26 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/slackapi/slack-cli/internal/api"
 	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/hooks"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v2"
 )
 
 // ManifestClient can manage the state of the project's app manifest file
 type ManifestClient struct {
 	apiClient        api.APIInterface
 	domainAuthTokens string
+	fs               afero.Fs
+	manifestPath     string
 	Env              map[string]string
 }
 
@@ -59,19 +66,27 @@ func SetManifestEnvTeamVars(manifestEnv map[string]string, appTeamDomain string,
 func NewManifestClient(
 	apiClient api.APIInterface,
 	config *config.Config,
+	fs afero.Fs,
 ) *ManifestClient {
 	client := &ManifestClient{
 		apiClient:        apiClient,
 		domainAuthTokens: config.DomainAuthTokens,
+		fs:               fs,
+		manifestPath:     config.ManifestPathFlag,
 		Env:              config.ManifestEnv,
 	}
 	return client
 }
 
-// GetManifestLocal gathers manifest content from the "get-manifest" hook
+// GetManifestLocal gathers manifest content from the "get-manifest" hook or the
+// file of the --manifest-path flag
 func (c *ManifestClient) GetManifestLocal(ctx context.Context, sdkConfig hooks.SDKCLIConfig, hookExecutor hooks.HookExecutor) (types.SlackYaml, error) {
 	var sl types.SlackYaml
 
+	if c.manifestPath != "" {
+		return c.getManifestFile()
+	}
+
 	if !sdkConfig.Hooks.GetManifest.IsAvailable() {
 		return sl, slackerror.New(slackerror.ErrSDKHookNotFound).
 			WithMessage("The `get-manifest` script was not found")
@@ -114,6 +129,53 @@ func (c *ManifestClient) GetManifestLocal(ctx context.Context, sdkConfig hooks.S
 	return sl, err
 }
 
+// getManifestFile reads the manifest from a JSON or YAML file instead of a hook
+func (c *ManifestClient) getManifestFile() (types.SlackYaml, error) {
+	var sl types.SlackYaml
+
+	data, err := afero.ReadFile(c.fs, c.manifestPath)
+	if err != nil {
+		return sl, slackerror.New(slackerror.ErrInvalidManifest).
+			WithMessage("Failed to read the app manifest file \"%s\"", c.manifestPath).
+			WithRootCause(err)
+	}
+	switch strings.ToLower(filepath.Ext(c.manifestPath)) {
+	case ".yaml", ".yml":
+		var manifest any
+		if err := yaml.Unmarshal(data, &manifest); err != nil {
+			return sl, slackerror.New(slackerror.ErrYaml).WithRootCause(err)
+		}
+		data, err = json.Marshal(stringKeys(manifest))
+		if err != nil {
+			return sl, slackerror.New(slackerror.ErrYaml).WithRootCause(err)
+		}
+	}
+	if err := goutils.JSONUnmarshal(data, &sl); err != nil {
+		return sl, err
+	}
+	return sl, nil
+}
+
+// stringKeys converts the map keys of decoded YAML to strings so the values can
+// be encoded as JSON
+func stringKeys(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = stringKeys(val)
+		}
+		return m
+	case []any:
+		for i, val := range v {
+			v[i] = stringKeys(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
 // GetManifestRemote retrieves the current app manifest from app settings
 func (c *ManifestClient) GetManifestRemote(ctx context.Context, token string, appID string) (types.SlackYaml, error) {
 	response, err := c.apiClient.ExportAppManifest(ctx, token, appID)
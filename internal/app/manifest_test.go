@@ -24,6 +24,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -71,6 +72,8 @@ func Test_AppManifest_GetManifestLocal(t *testing.T) {
 	tests := map[string]struct {
 		mockManifestInfo string
 		mockManifestErr  error
+		mockManifestPath string
+		mockManifestFile string
 		expectedErr      error
 		expectedManifest types.SlackYaml
 	}{
@@ -106,6 +109,42 @@ func Test_AppManifest_GetManifestLocal(t *testing.T) {
 			mockManifestInfo: `...unknown`,
 			expectedErr:      slackerror.New(slackerror.ErrInvalidManifest),
 		},
+		"reads a json manifest from the manifest path without the hook": {
+			mockManifestPath: "config/manifest.json",
+			mockManifestFile: `{"display_information":{"name":"my-file-app"}}`,
+			expectedManifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					DisplayInformation: types.DisplayInformation{
+						Name: "my-file-app",
+					},
+				},
+			},
+		},
+		"reads a yaml manifest from the manifest path without the hook": {
+			mockManifestPath: "config/manifest.yml",
+			mockManifestFile: "display_information:\n  name: my-yaml-app\n",
+			expectedManifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					DisplayInformation: types.DisplayInformation{
+						Name: "my-yaml-app",
+					},
+				},
+			},
+		},
+		"errors if the manifest path file does not exist": {
+			mockManifestPath: "config/missing.json",
+			expectedErr:      slackerror.New(slackerror.ErrInvalidManifest),
+		},
+		"errors if the manifest path file has invalid yaml": {
+			mockManifestPath: "config/manifest.yaml",
+			mockManifestFile: "display_information: [",
+			expectedErr:      slackerror.New(slackerror.ErrYaml),
+		},
+		"errors if the manifest path file has invalid json": {
+			mockManifestPath: "config/manifest.json",
+			mockManifestFile: `{"display_information":`,
+			expectedErr:      slackerror.New(slackerror.ErrUnableToParseJSON),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -129,7 +168,12 @@ func Test_AppManifest_GetManifestLocal(t *testing.T) {
 			configMock := config.NewConfig(fsMock, osMock)
 			configMock.DomainAuthTokens = "api.slack.com"
 			configMock.ManifestEnv = mockManifestEnv
-			manifestClient := NewManifestClient(&api.APIMock{}, configMock)
+			configMock.ManifestPathFlag = tc.mockManifestPath
+			if tc.mockManifestFile != "" {
+				err := afero.WriteFile(fsMock, tc.mockManifestPath, []byte(tc.mockManifestFile), 0600)
+				require.NoError(t, err)
+			}
+			manifestClient := NewManifestClient(&api.APIMock{}, configMock, fsMock)
 
 			actualManifest, err := manifestClient.GetManifestLocal(ctx, mockSDKConfig, mockHookExecutor)
 			if tc.expectedErr != nil {
@@ -186,7 +230,7 @@ func Test_AppManifest_GetManifestRemote(t *testing.T) {
 			apic := &api.APIMock{}
 			apic.On("ExportAppManifest", mock.Anything, mock.Anything, mock.Anything).
 				Return(api.ExportAppResult{Manifest: tc.mockManifestResponse}, tc.mockManifestError)
-			manifestClient := NewManifestClient(apic, configMock)
+			manifestClient := NewManifestClient(apic, configMock, fsMock)
 
 			manifest, err := manifestClient.GetManifestRemote(ctx, tc.mockToken, tc.mockAppID)
 			if tc.expectedError != nil {
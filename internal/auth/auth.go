@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"net/url"
 	"os"
-	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -47,6 +46,9 @@ const defaultHost = "slack.com"
 
 var localBuildGitSHAInVersionRegex = regexp.MustCompile(`(?mi)-g[a-f0-9]{1,40}$`)
 
+// slackAPIHostDomains are the domains of known Slack API hosts
+var slackAPIHostDomains = []string{"slack.com", "slack-gov.com"}
+
 // Client can manage the state of the system's user/workspace authentications.
 type Client struct {
 	api       api.APIInterface
@@ -54,6 +56,11 @@ type Client struct {
 	config    *config.Config
 	io        iostreams.IOStreamer
 	fs        afero.Fs
+
+	// keychain returns the secret store of the operating system if available
+	keychain func(account string) (credentialStore, bool)
+	// stores are the credential stores in use after each is first resolved
+	stores *CredentialStores
 }
 
 type AuthInterface interface {
@@ -90,6 +97,8 @@ type AuthInterface interface {
 	IsAPIHostSlackDev(host string) bool
 	// IsAPIHostSlackProd returns true if host is the production endpoint target
 	IsAPIHostSlackProd(host string) bool
+	// ValidateAPIHost errors if the host is not a URL and warns for hosts that are not Slack
+	ValidateAPIHost(ctx context.Context, host string) error
 
 	// FilterKnownAuthErrors catches known error codes that can be ignored to allow
 	// the process to proceed safely without exiting.
@@ -104,11 +113,30 @@ func NewClient(apiClient api.APIInterface, appClient *app.Client, config *config
 		config:    config,
 		io:        io,
 		fs:        fs,
+		keychain:  newKeychainStore,
+		stores:    NewCredentialStores(),
+	}
+
+	// Secret stores of the operating system are only used alongside the file
+	// system of the operating system so in-memory tests never read a keychain
+	if _, ok := fs.(*afero.OsFs); !ok {
+		client.keychain = func(account string) (credentialStore, bool) {
+			return nil, false
+		}
 	}
 
 	return &client
 }
 
+// WithCredentialStores shares the credential stores resolved by other clients
+// so the secret store is detected once for each process
+func (c *Client) WithCredentialStores(stores *CredentialStores) *Client {
+	if stores != nil {
+		c.stores = stores
+	}
+	return c
+}
+
 // Getters
 // AuthWithTeamDomain finds an auth with a given team domain
 // FIXME: This is an unsafe method, since team domain does not guarantee unique auth if org and workspace are named the same
@@ -199,19 +227,18 @@ func (c *Client) auths(ctx context.Context) (map[string]types.SlackAuth, error)
 
 	var auths types.AuthByTeamDomain
 
-	c.io.PrintDebug(ctx, "reading credentials file")
-	dir, err := c.config.SystemConfig.SlackConfigDir(ctx)
+	c.io.PrintDebug(ctx, "reading credentials")
+	store, err := c.credentials(ctx)
 	if err != nil {
 		return auths, err
 	}
-	var path = filepath.Join(dir, credentialsFileName)
-
-	if _, err := c.fs.Stat(path); os.IsNotExist(err) {
+	path, err := store.Location(ctx)
+	if err != nil {
 		return auths, err
 	}
 
 	c.io.PrintDebug(ctx, "found authorizations at %s reading", path)
-	raw, err := afero.ReadFile(c.fs, path)
+	raw, err := store.Read(ctx)
 	if err != nil {
 		return auths, err
 	}
@@ -231,7 +258,7 @@ func (c *Client) auths(ctx context.Context) (map[string]types.SlackAuth, error)
 		return auths, slackerror.New(slackerror.ErrUnableToParseJSON).
 			WithMessage("Failed to parse contents of credentials file").
 			WithRootCause(err).
-			WithRemediation("Check that %s is valid JSON", style.HomePath(path))
+			WithRemediation("Check that the credentials saved to %s are valid JSON", style.HomePath(path))
 	}
 
 	var updatedAuthsByName types.AuthByTeamDomain
@@ -354,7 +381,7 @@ func (c *Client) rotateToken(ctx context.Context, auth types.SlackAuth) (types.S
 	return auth, true /* tokenIsUpdated */, nil
 }
 
-// setAuths sets the user's authorizations to the credentials file and returns the filepath of the credentials file in success cases
+// setAuths sets the user's authorizations to the credential store and returns the location of the credentials in success cases
 func (c *Client) setAuths(ctx context.Context, auths types.AuthByTeamDomain) (path string, err error) {
 	var b []byte
 	b, err = json.MarshalIndent(auths, "", "  ")
@@ -363,13 +390,16 @@ func (c *Client) setAuths(ctx context.Context, auths types.AuthByTeamDomain) (pa
 		return "", err
 	}
 
-	dir, err := c.config.SystemConfig.SlackConfigDir(ctx)
+	store, err := c.credentials(ctx)
+	if err != nil {
+		return "", err
+	}
+	path, err = store.Location(ctx)
 	if err != nil {
 		return "", err
 	}
-	path = filepath.Join(dir, credentialsFileName)
 
-	err = afero.WriteFile(c.fs, path, b, 0600)
+	err = store.Write(ctx, b)
 	if err != nil {
 		return path, err
 	}
@@ -466,6 +496,43 @@ func (c *Client) IsAPIHostSlackProd(host string) bool {
 	return host == defaultProdAPIClientHost
 }
 
+// isAPIHostSlack returns true if host is a known Slack endpoint (slack.com, dev.slack.com, slack-gov.com, etc)
+func (c *Client) isAPIHostSlack(host string) bool {
+	u, err := url.Parse(host)
+	if err != nil {
+		return false
+	}
+	hostname := strings.ToLower(u.Hostname())
+	for _, domain := range slackAPIHostDomains {
+		if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAPIHost returns an error if the host is not a well-formed URL
+//
+// A warning is shown for hosts that are not known Slack endpoints unless custom
+// API hosts are allowed with a flag.
+func (c *Client) ValidateAPIHost(ctx context.Context, host string) error {
+	apiHost := goutils.ToHTTPS(host)
+	u, err := url.Parse(apiHost)
+	if err != nil || u.Hostname() == "" || strings.Contains(u.Hostname(), " ") || (u.Path != "" && u.Path != "/") {
+		return slackerror.New(slackerror.ErrInvalidAPIHost).
+			WithMessage("The API host \"%s\" is not a valid URL", host)
+	}
+	if !c.isAPIHostSlack(apiHost) && !c.config.AllowCustomAPIHostFlag {
+		c.io.PrintWarning(
+			ctx,
+			"The API host \"%s\" is not a known Slack host. Requests might fail or send credentials to an unexpected server. Use %s to allow a custom API host",
+			u.Hostname(),
+			style.Highlight("--allow-custom-apihost"),
+		)
+	}
+	return nil
+}
+
 // ResolveAPIHost returns the API Host based on the API Host Flag, Dev Flag, Project Config, and Stored Auth API Host.
 func (c *Client) ResolveAPIHost(ctx context.Context, apiHostFlag string, customAuth *types.SlackAuth) string {
 	// TODO - Update this comment
@@ -96,6 +96,11 @@ func (m *AuthMock) IsAPIHostSlackProd(host string) bool {
 	return args.Bool(0)
 }
 
+func (m *AuthMock) ValidateAPIHost(ctx context.Context, host string) error {
+	args := m.Called(ctx, host)
+	return args.Error(0)
+}
+
 func (m *AuthMock) FilterKnownAuthErrors(ctx context.Context, err error) (bool, error) {
 	args := m.Called(ctx, err)
 	return args.Bool(0), args.Error(1)
@@ -32,6 +32,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,12 +89,12 @@ func Test_AuthWithToken(t *testing.T) {
 				Response:        tc.response,
 			})
 			defer teardown()
-			appc := app.NewClient(apic, config, fs, os)
 			fsMock := slackdeps.NewFsMock()
 			osMock := slackdeps.NewOsMock()
 			osMock.AddDefaultMocks()
 			ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
 			ioMock.AddDefaultMocks()
+			appc := app.NewClient(apic, config, ioMock, fs, os)
 			c := NewClient(apic, appc, config, ioMock, fs)
 
 			auth, err := c.AuthWithToken(ctx, tc.token)
@@ -638,6 +639,76 @@ func Test_IsAPIHostSlackDev(t *testing.T) {
 	}
 }
 
+func Test_ValidateAPIHost(t *testing.T) {
+	tests := map[string]struct {
+		host            string
+		allowCustomHost bool
+		expectedError   string
+		expectedWarning bool
+	}{
+		"production host is valid": {
+			host: "https://slack.com",
+		},
+		"dev host is valid": {
+			host: "https://dev1234.slack.com",
+		},
+		"gov host is valid": {
+			host: "https://slack-gov.com",
+		},
+		"insecure scheme is upgraded and valid": {
+			host: "http://dev.slack.com",
+		},
+		"host without a scheme is upgraded and valid": {
+			host: "dev.slack.com",
+		},
+		"host with a path errors": {
+			host:          "https://slack.com/api",
+			expectedError: slackerror.ErrInvalidAPIHost,
+		},
+		"malformed host errors": {
+			host:          "https://dev slack.com",
+			expectedError: slackerror.ErrInvalidAPIHost,
+		},
+		"custom host warns": {
+			host:            "https://example.com",
+			expectedWarning: true,
+		},
+		"lookalike host warns": {
+			host:            "https://notslack.com",
+			expectedWarning: true,
+		},
+		"custom host is allowed with the flag": {
+			host:            "https://localhost:8080",
+			allowCustomHost: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			osMock.AddDefaultMocks()
+			config := config.NewConfig(fsMock, osMock)
+			config.AllowCustomAPIHostFlag = tc.allowCustomHost
+			ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+			ioMock.AddDefaultMocks()
+			authClient := NewClient(nil, nil, config, ioMock, fsMock)
+			err := authClient.ValidateAPIHost(ctx, tc.host)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedWarning {
+				ioMock.AssertCalled(t, "PrintWarning", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				ioMock.AssertNotCalled(t, "PrintWarning", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
 func Test_FilterKnownAuthErrors(t *testing.T) {
 	tests := map[string]struct {
 		err        *slackerror.Error
@@ -81,7 +81,7 @@ func Test_AuthRevokeToken(t *testing.T) {
 				Response:        tc.response,
 			})
 			defer teardown()
-			appc := app.NewClient(apic, config, fs, os)
+			appc := app.NewClient(apic, config, io, fs, os)
 			auth := NewClient(apic, appc, config, io, fs)
 			err := auth.RevokeToken(ctx, tc.token)
 			assert.Equal(t, tc.expected, err)
@@ -0,0 +1,217 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/goutils"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+// keychainService and keychainAccount identify the credentials saved to the
+// secret store of the operating system
+const (
+	keychainService = "slack-cli"
+	keychainAccount = "credentials"
+)
+
+// keychainProfileAccount returns the keychain account of the credentials of a
+// profile so each profile saves a separate secret
+func keychainProfileAccount(profile string) string {
+	if profile == "" {
+		return keychainAccount
+	}
+	return keychainAccount + ":" + profile
+}
+
+// CredentialStores remembers the credential store of each keychain account once
+// resolved so auth clients created for each call share the same store
+type CredentialStores struct {
+	mu     sync.Mutex
+	stores map[string]credentialStore
+}
+
+// NewCredentialStores returns an empty set of resolved credential stores
+func NewCredentialStores() *CredentialStores {
+	return &CredentialStores{stores: map[string]credentialStore{}}
+}
+
+// credentialStore saves the credentials of all authorizations as one secret
+type credentialStore interface {
+	// Location describes where the credentials are saved
+	Location(ctx context.Context) (string, error)
+	// Read returns the saved credentials or empty bytes if none are saved
+	Read(ctx context.Context) ([]byte, error)
+	// Write replaces the saved credentials
+	Write(ctx context.Context, data []byte) error
+}
+
+// fileCredentialStore saves credentials to the credentials.json file
+type fileCredentialStore struct {
+	config *config.Config
+	fs     afero.Fs
+}
+
+// Location returns the path of the credentials file
+func (f *fileCredentialStore) Location(ctx context.Context) (string, error) {
+	dir, err := f.config.SystemConfig.SlackConfigDir(ctx)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, credentialsFileName), nil
+}
+
+// Read returns the contents of the credentials file
+func (f *fileCredentialStore) Read(ctx context.Context) ([]byte, error) {
+	path, err := f.Location(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.fs.Stat(path); os.IsNotExist(err) {
+		return nil, err
+	}
+	return afero.ReadFile(f.fs, path)
+}
+
+// Write replaces the contents of the credentials file
+func (f *fileCredentialStore) Write(ctx context.Context, data []byte) error {
+	path, err := f.Location(ctx)
+	if err != nil {
+		return err
+	}
+	return afero.WriteFile(f.fs, path, data, 0600)
+}
+
+// credentials returns the credential store selected by the system config and
+// offers to move saved credentials into the secret store of the operating
+// system on first use
+func (c *Client) credentials(ctx context.Context) (credentialStore, error) {
+	account := keychainProfileAccount(c.config.SystemConfig.GetProfile())
+	c.stores.mu.Lock()
+	defer c.stores.mu.Unlock()
+	if store, ok := c.stores.stores[account]; ok {
+		return store, nil
+	}
+	file := &fileCredentialStore{config: c.config, fs: c.fs}
+	selected, err := c.config.SystemConfig.GetCredentialStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var store credentialStore
+	switch selected {
+	case config.CredentialStoreFile:
+		store = file
+	case config.CredentialStoreKeychain:
+		keychain, ok := c.keychain(account)
+		if !ok {
+			return nil, slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+				WithMessage("The secret store of the operating system is not available")
+		}
+		store, err = c.migrateCredentials(ctx, file, keychain, false)
+	default:
+		keychain, ok := c.keychain(account)
+		if !ok {
+			c.io.PrintDebug(ctx, "no secret store was found so using the credentials file")
+			store = file
+		} else {
+			store, err = c.migrateCredentials(ctx, file, keychain, true)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.stores.stores[account] = store
+	return store, nil
+}
+
+// migrateCredentials moves credentials from the file into the keychain if the
+// keychain is empty and returns the store that should be used. Confirmation is
+// prompted for when the keychain was detected instead of selected.
+func (c *Client) migrateCredentials(ctx context.Context, file credentialStore, keychain credentialStore, confirm bool) (credentialStore, error) {
+	saved, err := keychain.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hasCredentials(saved) {
+		return keychain, nil
+	}
+	existing, err := file.Read(ctx)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if !hasCredentials(existing) {
+		return keychain, nil
+	}
+	if !json.Valid(existing) {
+		// Keep using the file so parsing errors point to the file to fix
+		return file, nil
+	}
+	fileLocation, err := file.Location(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keychainLocation, err := keychain.Location(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if confirm {
+		if !c.io.IsTTY() {
+			return file, nil
+		}
+		move, err := c.io.ConfirmPrompt(ctx, "Move saved authorizations from the credentials file to "+keychainLocation+"?", true)
+		if err != nil {
+			return nil, err
+		}
+		if !move {
+			// Remember the choice so this is not asked again
+			return file, c.config.SystemConfig.SetCredentialStore(ctx, config.CredentialStoreFile)
+		}
+	}
+	err = keychain.Write(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	err = file.Write(ctx, []byte("{}"))
+	if err != nil {
+		return nil, err
+	}
+	_, _ = fmt.Fprint(c.io.WriteErr(), style.Sectionf(style.TextSection{
+		Emoji: "key",
+		Text:  fmt.Sprintf("Moved saved authorizations from %s to %s", style.HomePath(fileLocation), keychainLocation),
+	}))
+	return keychain, nil
+}
+
+// hasCredentials returns true if the raw credentials contain an authorization
+func hasCredentials(raw []byte) bool {
+	if goutils.IsEmptyJSON(raw) {
+		return false
+	}
+	var auths types.AuthByTeamDomain
+	if err := json.Unmarshal(raw, &auths); err != nil {
+		return true
+	}
+	return len(auths) > 0
+}
@@ -0,0 +1,146 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build darwin
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+// keychainItemNotFound is the exit code of the security command when no item
+// matches the search
+const keychainItemNotFound = 44
+
+// keychainChunkSize is the length of each part of the encoded credentials that
+// fits on a line of interactive mode of the security command
+const keychainChunkSize = 2048
+
+// keychainStore saves credentials to the macOS Keychain with the security
+// command
+type keychainStore struct {
+	security string
+	account  string
+}
+
+// newKeychainStore returns the macOS Keychain if the security command exists
+func newKeychainStore(account string) (credentialStore, bool) {
+	security, err := exec.LookPath("security")
+	if err != nil {
+		return nil, false
+	}
+	return &keychainStore{security: security, account: account}, true
+}
+
+// Location describes the macOS Keychain
+func (k *keychainStore) Location(ctx context.Context) (string, error) {
+	return "the macOS Keychain", nil
+}
+
+// Read returns the credentials saved to the macOS Keychain
+func (k *keychainStore) Read(ctx context.Context) ([]byte, error) {
+	chunks, err := k.readChunks(ctx)
+	if err != nil || len(chunks) == 0 {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(strings.Join(chunks, ""))
+}
+
+// readChunks returns each part of the encoded credentials saved to the macOS
+// Keychain in order
+func (k *keychainStore) readChunks(ctx context.Context) ([]string, error) {
+	chunks := []string{}
+	for i := 0; ; i++ {
+		cmd := exec.CommandContext(ctx, k.security, "find-generic-password", "-s", keychainService, "-a", keychainChunkAccount(k.account, i), "-w")
+		out, err := cmd.Output()
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) && exitErr.ExitCode() == keychainItemNotFound {
+				return chunks, nil
+			}
+			return nil, slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+				WithMessage("Failed to read credentials from the macOS Keychain").
+				WithRootCause(err)
+		}
+		chunks = append(chunks, strings.TrimSpace(string(out)))
+	}
+}
+
+// Write saves credentials to the macOS Keychain
+func (k *keychainStore) Write(ctx context.Context, data []byte) error {
+	saved, err := k.readChunks(ctx)
+	if err != nil {
+		return err
+	}
+	// The secret is written to interactive mode of the security command on stdin
+	// so it is not shown in process lists
+	cmd := exec.CommandContext(ctx, k.security, "-i")
+	cmd.Stdin = strings.NewReader(keychainWriteCommands(k.account, data, len(saved)))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		err = errors.New(msg)
+	}
+	if err != nil {
+		return slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+			WithMessage("Failed to save credentials to the macOS Keychain").
+			WithRootCause(err)
+	}
+	return nil
+}
+
+// keychainChunkAccount returns the account of a part of the saved credentials
+func keychainChunkAccount(account string, index int) string {
+	if index == 0 {
+		return account
+	}
+	return fmt.Sprintf("%s.%d", account, index)
+}
+
+// keychainWriteCommands returns the lines of interactive mode of the security
+// command that replace the saved credentials and remove parts of earlier
+// credentials that are no longer used
+//
+// Interactive mode limits the length of each line and credentials of many
+// teams are longer than that, so the encoded credentials are saved in parts.
+func keychainWriteCommands(account string, data []byte, saved int) string {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	lines := []string{}
+	index := 0
+	for ; index == 0 || len(encoded) > 0; index++ {
+		chunk := encoded[:min(len(encoded), keychainChunkSize)]
+		encoded = encoded[len(chunk):]
+		lines = append(lines, fmt.Sprintf(
+			"add-generic-password -U -s %s -a %s -w %s",
+			keychainService, keychainChunkAccount(account, index), chunk,
+		))
+	}
+	for ; index < saved; index++ {
+		lines = append(lines, fmt.Sprintf(
+			"delete-generic-password -s %s -a %s",
+			keychainService, keychainChunkAccount(account, index),
+		))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
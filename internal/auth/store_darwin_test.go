@@ -0,0 +1,58 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build darwin
+
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_keychainWriteCommands(t *testing.T) {
+	// Credentials of many teams are longer than a line of interactive mode
+	data := []byte(`{"T0":"` + strings.Repeat("x", 16*1024) + `"}`)
+	commands := keychainWriteCommands("credentials", data, 14)
+	lines := strings.Split(strings.TrimSuffix(commands, "\n"), "\n")
+	encoded := ""
+	added := 0
+	for i, line := range lines {
+		assert.Less(t, len(line), 4096)
+		if strings.HasPrefix(line, "delete-generic-password") {
+			assert.Equal(t, fmt.Sprintf("delete-generic-password -s slack-cli -a credentials.%d", i), line)
+			continue
+		}
+		fields := strings.Fields(line)
+		require.Len(t, fields, 8)
+		assert.Equal(t, []string{"add-generic-password", "-U", "-s", "slack-cli", "-a", keychainChunkAccount("credentials", i), "-w"}, fields[:7])
+		encoded += fields[7]
+		added++
+	}
+	assert.Equal(t, 11, added)
+	assert.Len(t, lines, 14)
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, data, decoded)
+}
+
+func Test_keychainWriteCommands_Empty(t *testing.T) {
+	commands := keychainWriteCommands("credentials:dev", []byte("{}"), 0)
+	assert.Equal(t, "add-generic-password -U -s slack-cli -a credentials:dev -w e30=\n", commands)
+}
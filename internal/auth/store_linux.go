@@ -0,0 +1,86 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+// keychainStore saves credentials to the Secret Service of the desktop session
+// with the secret-tool command of libsecret
+type keychainStore struct {
+	secretTool string
+	account    string
+}
+
+// newKeychainStore returns the Secret Service if the secret-tool command exists
+// and a desktop session bus is running
+func newKeychainStore(account string) (credentialStore, bool) {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return nil, false
+	}
+	secretTool, err := exec.LookPath("secret-tool")
+	if err != nil {
+		return nil, false
+	}
+	return &keychainStore{secretTool: secretTool, account: account}, true
+}
+
+// Location describes the Secret Service
+func (k *keychainStore) Location(ctx context.Context) (string, error) {
+	return "the Secret Service keyring", nil
+}
+
+// Read returns the credentials saved to the Secret Service
+func (k *keychainStore) Read(ctx context.Context) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, k.secretTool, "lookup", "service", keychainService, "account", k.account)
+	out, err := cmd.Output()
+	if err != nil {
+		// A missing secret exits with an error and no output
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(out) == 0 && len(exitErr.Stderr) == 0 {
+			return nil, nil
+		}
+		return nil, slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+			WithMessage("Failed to read credentials from the Secret Service keyring").
+			WithRootCause(err)
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+}
+
+// Write saves credentials to the Secret Service
+func (k *keychainStore) Write(ctx context.Context, data []byte) error {
+	// The secret is read from stdin so it is not shown in process lists
+	cmd := exec.CommandContext(ctx, k.secretTool, "store", "--label", "Slack CLI credentials", "service", keychainService, "account", k.account)
+	cmd.Stdin = strings.NewReader(base64.StdEncoding.EncodeToString(data))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			err = errors.New(msg)
+		}
+		return slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+			WithMessage("Failed to save credentials to the Secret Service keyring").
+			WithRootCause(err)
+	}
+	return nil
+}
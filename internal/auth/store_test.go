@@ -0,0 +1,287 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/iostreams"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryCredentialStore saves credentials in memory for tests
+type memoryCredentialStore struct {
+	data []byte
+}
+
+func (m *memoryCredentialStore) Location(ctx context.Context) (string, error) {
+	return "the test keychain", nil
+}
+
+func (m *memoryCredentialStore) Read(ctx context.Context) ([]byte, error) {
+	return m.data, nil
+}
+
+func (m *memoryCredentialStore) Write(ctx context.Context, data []byte) error {
+	m.data = data
+	return nil
+}
+
+func Test_Client_credentials(t *testing.T) {
+	savedAuths := `{"T123456789A":{"token":"xoxe.xoxp-1","team_domain":"aaa","team_id":"T123456789A"}}`
+
+	tests := map[string]struct {
+		configured      config.CredentialStore
+		keychain        *memoryCredentialStore
+		fileData        string
+		setup           func(*iostreams.IOStreamsMock)
+		expectedStore   string
+		expectedFile    string
+		expectedKeys    string
+		expectedConfig  config.CredentialStore
+		expectedStderr  string
+		expectedErrCode string
+	}{
+		"uses the file when selected": {
+			configured:     config.CredentialStoreFile,
+			keychain:       &memoryCredentialStore{},
+			fileData:       savedAuths,
+			expectedStore:  "file",
+			expectedFile:   savedAuths,
+			expectedConfig: config.CredentialStoreFile,
+		},
+		"uses the file when no keychain is detected": {
+			fileData:       savedAuths,
+			expectedStore:  "file",
+			expectedFile:   savedAuths,
+			expectedConfig: config.CredentialStoreAuto,
+		},
+		"errors when the selected keychain is not available": {
+			configured:      config.CredentialStoreKeychain,
+			expectedErrCode: slackerror.ErrCredentialStoreUnavailable,
+		},
+		"moves credentials into the selected keychain without prompting": {
+			configured:     config.CredentialStoreKeychain,
+			keychain:       &memoryCredentialStore{},
+			fileData:       savedAuths,
+			expectedStore:  "keychain",
+			expectedFile:   "{}",
+			expectedKeys:   savedAuths,
+			expectedConfig: config.CredentialStoreKeychain,
+			expectedStderr: "Moved saved authorizations from",
+		},
+		"uses a detected keychain that has credentials": {
+			keychain:       &memoryCredentialStore{data: []byte(savedAuths)},
+			fileData:       "{}",
+			expectedStore:  "keychain",
+			expectedFile:   "{}",
+			expectedKeys:   savedAuths,
+			expectedConfig: config.CredentialStoreAuto,
+		},
+		"uses a detected keychain when no credentials are saved": {
+			keychain:       &memoryCredentialStore{},
+			fileData:       "{}",
+			expectedStore:  "keychain",
+			expectedFile:   "{}",
+			expectedConfig: config.CredentialStoreAuto,
+		},
+		"keeps using the file without prompting when not interactive": {
+			keychain:       &memoryCredentialStore{},
+			fileData:       savedAuths,
+			expectedStore:  "file",
+			expectedFile:   savedAuths,
+			expectedConfig: config.CredentialStoreAuto,
+		},
+		"moves credentials into a detected keychain after confirming": {
+			keychain: &memoryCredentialStore{},
+			fileData: savedAuths,
+			setup: func(io *iostreams.IOStreamsMock) {
+				io.On("IsTTY").Return(true)
+				io.On("ConfirmPrompt", mock.Anything, "Move saved authorizations from the credentials file to the test keychain?", true).
+					Return(true, nil)
+			},
+			expectedStore:  "keychain",
+			expectedFile:   "{}",
+			expectedKeys:   savedAuths,
+			expectedConfig: config.CredentialStoreAuto,
+			expectedStderr: "Moved saved authorizations from",
+		},
+		"remembers to use the file when moving credentials is declined": {
+			keychain: &memoryCredentialStore{},
+			fileData: savedAuths,
+			setup: func(io *iostreams.IOStreamsMock) {
+				io.On("IsTTY").Return(true)
+				io.On("ConfirmPrompt", mock.Anything, mock.Anything, true).
+					Return(false, nil)
+			},
+			expectedStore:  "file",
+			expectedFile:   savedAuths,
+			expectedConfig: config.CredentialStoreFile,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			osMock.AddDefaultMocks()
+			config := config.NewConfig(fsMock, osMock)
+			ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+			if tc.setup != nil {
+				tc.setup(ioMock)
+			}
+			ioMock.AddDefaultMocks()
+			client := NewClient(nil, nil, config, ioMock, fsMock)
+			client.keychain = func(account string) (credentialStore, bool) {
+				return tc.keychain, tc.keychain != nil
+			}
+			if tc.configured != "" {
+				require.NoError(t, config.SystemConfig.SetCredentialStore(ctx, tc.configured))
+			}
+			file := &fileCredentialStore{config: config, fs: fsMock}
+			require.NoError(t, file.Write(ctx, []byte(tc.fileData)))
+
+			store, err := client.credentials(ctx)
+			if tc.expectedErrCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrCode, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			switch tc.expectedStore {
+			case "file":
+				assert.IsType(t, &fileCredentialStore{}, store)
+			case "keychain":
+				assert.Equal(t, tc.keychain, store)
+			}
+			fileData, err := file.Read(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFile, string(fileData))
+			if tc.keychain != nil {
+				assert.Equal(t, tc.expectedKeys, string(tc.keychain.data))
+			}
+			selected, err := config.SystemConfig.GetCredentialStore(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConfig, selected)
+			stderr := ioMock.Stderr.Writer().(*bytes.Buffer).String()
+			if tc.expectedStderr != "" {
+				assert.Contains(t, stderr, tc.expectedStderr)
+			} else {
+				assert.NotContains(t, stderr, "Moved saved authorizations")
+			}
+		})
+	}
+}
+
+func Test_Client_SetAuth_keychain(t *testing.T) {
+	mockAuth := types.SlackAuth{
+		Token:      "xoxe.xoxp-1",
+		TeamDomain: "aaa",
+		TeamID:     "T123456789A",
+		UserID:     "U123",
+	}
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	osMock.AddDefaultMocks()
+	config := config.NewConfig(fsMock, osMock)
+	ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+	ioMock.AddDefaultMocks()
+	keychain := &memoryCredentialStore{}
+	client := NewClient(nil, nil, config, ioMock, fsMock)
+	client.keychain = func(account string) (credentialStore, bool) {
+		return keychain, true
+	}
+
+	_, location, err := client.SetAuth(ctx, mockAuth)
+	require.NoError(t, err)
+	assert.Equal(t, "the test keychain", location)
+	assert.Contains(t, string(keychain.data), mockAuth.TeamID)
+
+	auth, err := client.AuthWithTeamID(ctx, mockAuth.TeamID)
+	require.NoError(t, err)
+	assert.Equal(t, mockAuth.TeamDomain, auth.TeamDomain)
+}
+
+func Test_Client_credentials_profile(t *testing.T) {
+	tests := map[string]struct {
+		profile         string
+		expectedAccount string
+	}{
+		"uses the default account without a profile": {
+			profile:         "",
+			expectedAccount: "credentials",
+		},
+		"uses a separate account for each profile": {
+			profile:         "work",
+			expectedAccount: "credentials:work",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			osMock.AddDefaultMocks()
+			config := config.NewConfig(fsMock, osMock)
+			config.SystemConfig.SetProfile(tc.profile)
+			ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+			ioMock.AddDefaultMocks()
+			client := NewClient(nil, nil, config, ioMock, fsMock)
+			var account string
+			client.keychain = func(a string) (credentialStore, bool) {
+				account = a
+				return &memoryCredentialStore{}, true
+			}
+
+			_, err := client.auths(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAccount, account)
+		})
+	}
+}
+
+func Test_Client_credentials_sharedStores(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	osMock.AddDefaultMocks()
+	config := config.NewConfig(fsMock, osMock)
+	ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+	ioMock.AddDefaultMocks()
+	stores := NewCredentialStores()
+	keychain := &memoryCredentialStore{}
+	detected := 0
+	for range 3 {
+		client := NewClient(nil, nil, config, ioMock, fsMock).WithCredentialStores(stores)
+		client.keychain = func(account string) (credentialStore, bool) {
+			detected++
+			return keychain, true
+		}
+		store, err := client.credentials(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, keychain, store)
+	}
+	assert.Equal(t, 1, detected)
+}
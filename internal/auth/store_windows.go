@@ -0,0 +1,198 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+
+	// credMaxBlobSize is the largest secret saved to one credential so larger
+	// credentials are split across numbered targets
+	credMaxBlobSize = 5 * 512
+)
+
+var (
+	advapi32       = windows.NewLazySystemDLL("advapi32.dll")
+	procCredReadW  = advapi32.NewProc("CredReadW")
+	procCredWriteW = advapi32.NewProc("CredWriteW")
+	procCredDelete = advapi32.NewProc("CredDeleteW")
+	procCredFree   = advapi32.NewProc("CredFree")
+)
+
+// credential matches the CREDENTIALW structure of the Windows API
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        windows.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// keychainStore saves credentials to the Windows Credential Manager
+type keychainStore struct {
+	account string
+}
+
+// newKeychainStore returns the Windows Credential Manager if it can be loaded
+func newKeychainStore(account string) (credentialStore, bool) {
+	if err := procCredReadW.Find(); err != nil {
+		return nil, false
+	}
+	return &keychainStore{account: account}, true
+}
+
+// Location describes the Windows Credential Manager
+func (k *keychainStore) Location(ctx context.Context) (string, error) {
+	return "the Windows Credential Manager", nil
+}
+
+// Read returns the credentials saved to the Windows Credential Manager
+func (k *keychainStore) Read(ctx context.Context) ([]byte, error) {
+	var encoded []byte
+	for index := 0; ; index++ {
+		chunk, found, err := readCredential(credentialTarget(k.account, index))
+		if err != nil {
+			return nil, slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+				WithMessage("Failed to read credentials from the Windows Credential Manager").
+				WithRootCause(err)
+		}
+		if !found {
+			break
+		}
+		encoded = append(encoded, chunk...)
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(string(encoded))
+}
+
+// Write saves credentials to the Windows Credential Manager
+func (k *keychainStore) Write(ctx context.Context, data []byte) error {
+	encoded := []byte(base64.StdEncoding.EncodeToString(data))
+	index := 0
+	for start := 0; start < len(encoded); start += credMaxBlobSize {
+		end := min(start+credMaxBlobSize, len(encoded))
+		if err := writeCredential(credentialTarget(k.account, index), k.account, encoded[start:end]); err != nil {
+			return slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+				WithMessage("Failed to save credentials to the Windows Credential Manager").
+				WithRootCause(err)
+		}
+		index++
+	}
+	// Remove chunks remaining from credentials that were saved before
+	for ; ; index++ {
+		deleted, err := deleteCredential(credentialTarget(k.account, index))
+		if err != nil {
+			return slackerror.New(slackerror.ErrCredentialStoreUnavailable).
+				WithMessage("Failed to save credentials to the Windows Credential Manager").
+				WithRootCause(err)
+		}
+		if !deleted {
+			return nil
+		}
+	}
+}
+
+// credentialTarget returns the name of a numbered credential of an account
+func credentialTarget(account string, index int) string {
+	return fmt.Sprintf("%s:%s:%d", keychainService, account, index)
+}
+
+// readCredential returns the secret of a credential and if it was found
+func readCredential(target string) ([]byte, bool, error) {
+	targetName, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return nil, false, err
+	}
+	var cred *credential
+	r, _, err := procCredReadW.Call(
+		uintptr(unsafe.Pointer(targetName)),
+		credTypeGeneric,
+		0,
+		uintptr(unsafe.Pointer(&cred)),
+	)
+	if r == 0 {
+		if errors.Is(err, windows.ERROR_NOT_FOUND) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+	blob := make([]byte, cred.CredentialBlobSize)
+	copy(blob, unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize))
+	return blob, true, nil
+}
+
+// writeCredential creates or replaces the secret of a credential
+func writeCredential(target string, account string, blob []byte) error {
+	targetName, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return err
+	}
+	userName, err := windows.UTF16PtrFromString(account)
+	if err != nil {
+		return err
+	}
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         targetName,
+		CredentialBlobSize: uint32(len(blob)),
+		CredentialBlob:     &blob[0],
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	r, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if r == 0 {
+		return err
+	}
+	return nil
+}
+
+// deleteCredential removes a credential and reports if it existed
+func deleteCredential(target string) (bool, error) {
+	targetName, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return false, err
+	}
+	r, _, err := procCredDelete.Call(uintptr(unsafe.Pointer(targetName)), credTypeGeneric, 0)
+	if r == 0 {
+		if errors.Is(err, windows.ERROR_NOT_FOUND) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -25,6 +25,9 @@ import (
 // Cacher saves and retrieves specific values
 type Cacher interface {
 	ManifestCacher
+	ManifestSnapshotCacher
+	RunSessionCacher
+	TriggerCacher
 }
 
 // Cache contains cached values for a path
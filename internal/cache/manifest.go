@@ -30,6 +30,10 @@ type ManifestCacher interface {
 	GetManifestHash(ctx context.Context, appID string) (Hash, error)
 	NewManifestHash(ctx context.Context, manifest types.AppManifest) (Hash, error)
 	SetManifestHash(ctx context.Context, appID string, hash Hash) error
+	GetValidatedManifestHash(ctx context.Context, appID string) (Hash, error)
+	SetValidatedManifestHash(ctx context.Context, appID string, hash Hash) error
+	GetDeployedCommit(ctx context.Context, appID string) (string, error)
+	SetDeployedCommit(ctx context.Context, appID string, commit string) error
 }
 
 // ManifestCache stores values of an app manifest
@@ -39,7 +43,9 @@ type ManifestCache struct {
 
 // ManifestCacheApp contains cache details for a specific app manifest
 type ManifestCacheApp struct {
-	Hash Hash `json:"hash"` // Hash is a computed value unique to a manifest
+	Hash           Hash   `json:"hash"`                      // Hash is a computed value unique to a manifest
+	ValidatedHash  Hash   `json:"validated_hash,omitempty"`  // ValidatedHash is the hash of the last manifest validated without problems
+	DeployedCommit string `json:"deployed_commit,omitempty"` // DeployedCommit is the git commit of the project at the last deploy
 }
 
 // GetManifestHash loads the saved manifest hash from cache
@@ -75,9 +81,64 @@ func (c *Cache) SetManifestHash(ctx context.Context, appID string, hash Hash) er
 	if err != nil {
 		return err
 	}
-	cache[appID] = ManifestCacheApp{
-		Hash: hash,
+	entry := cache[appID]
+	entry.Hash = hash
+	cache[appID] = entry
+	c.Apps = cache
+	return c.writeManifestCache(ctx)
+}
+
+// GetValidatedManifestHash loads the hash of the last manifest that passed
+// validation without errors or warnings
+func (c *Cache) GetValidatedManifestHash(ctx context.Context, appID string) (Hash, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetValidatedManifestHash")
+	defer span.Finish()
+	cache, err := c.readManifestCache(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cache[appID].ValidatedHash, nil
+}
+
+// SetValidatedManifestHash saves the hash of a manifest that passed validation
+// for an app ID
+func (c *Cache) SetValidatedManifestHash(ctx context.Context, appID string, hash Hash) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "SetValidatedManifestHash")
+	defer span.Finish()
+	cache, err := c.readManifestCache(ctx)
+	if err != nil {
+		return err
+	}
+	entry := cache[appID]
+	entry.ValidatedHash = hash
+	cache[appID] = entry
+	c.Apps = cache
+	return c.writeManifestCache(ctx)
+}
+
+// GetDeployedCommit loads the git commit of the project at the last deploy of
+// an app
+func (c *Cache) GetDeployedCommit(ctx context.Context, appID string) (string, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetDeployedCommit")
+	defer span.Finish()
+	cache, err := c.readManifestCache(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cache[appID].DeployedCommit, nil
+}
+
+// SetDeployedCommit saves the git commit of the project deployed for an app ID
+func (c *Cache) SetDeployedCommit(ctx context.Context, appID string, commit string) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "SetDeployedCommit")
+	defer span.Finish()
+	cache, err := c.readManifestCache(ctx)
+	if err != nil {
+		return err
 	}
+	entry := cache[appID]
+	entry.DeployedCommit = commit
+	cache[appID] = entry
 	c.Apps = cache
 	return c.writeManifestCache(ctx)
 }
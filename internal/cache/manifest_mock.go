@@ -34,3 +34,23 @@ func (cm *CacheMock) SetManifestHash(ctx context.Context, appID string, hash Has
 	args := cm.Called(ctx, appID, hash)
 	return args.Error(0)
 }
+
+func (cm *CacheMock) GetValidatedManifestHash(ctx context.Context, appID string) (Hash, error) {
+	args := cm.Called(ctx, appID)
+	return args.Get(0).(Hash), args.Error(1)
+}
+
+func (cm *CacheMock) SetValidatedManifestHash(ctx context.Context, appID string, hash Hash) error {
+	args := cm.Called(ctx, appID, hash)
+	return args.Error(0)
+}
+
+func (cm *CacheMock) GetDeployedCommit(ctx context.Context, appID string) (string, error) {
+	args := cm.Called(ctx, appID)
+	return args.String(0), args.Error(1)
+}
+
+func (cm *CacheMock) SetDeployedCommit(ctx context.Context, appID string, commit string) error {
+	args := cm.Called(ctx, appID, commit)
+	return args.Error(0)
+}
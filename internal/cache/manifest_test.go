@@ -62,6 +62,76 @@ func TestCache_Manifest(t *testing.T) {
 	}
 }
 
+func TestCache_Manifest_ValidatedHash(t *testing.T) {
+	tests := map[string]struct {
+		mockAppID             string
+		mockHash              Hash
+		mockValidatedHash     Hash
+		expectedHash          Hash
+		expectedValidatedHash Hash
+	}{
+		"missing validated hashes return an empty hash": {
+			mockAppID:             "A123",
+			mockHash:              Hash("xoxo"),
+			expectedHash:          Hash("xoxo"),
+			expectedValidatedHash: Hash(""),
+		},
+		"validated hashes are saved alongside the manifest hash": {
+			mockAppID:             "A123",
+			mockHash:              Hash("xoxo"),
+			mockValidatedHash:     Hash("abcd"),
+			expectedHash:          Hash("xoxo"),
+			expectedValidatedHash: Hash("abcd"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			projectDirPath := "/path/to/project-name"
+			err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+			require.NoError(t, err)
+			cache := NewCache(fsMock, osMock, projectDirPath)
+			if tc.mockValidatedHash != "" {
+				err = cache.SetValidatedManifestHash(ctx, tc.mockAppID, tc.mockValidatedHash)
+				require.NoError(t, err)
+			}
+			err = cache.SetManifestHash(ctx, tc.mockAppID, tc.mockHash)
+			require.NoError(t, err)
+			hash, err := cache.GetManifestHash(ctx, tc.mockAppID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedHash, hash)
+			validated, err := cache.GetValidatedManifestHash(ctx, tc.mockAppID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedValidatedHash, validated)
+		})
+	}
+}
+
+func TestCache_Manifest_DeployedCommit(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	projectDirPath := "/path/to/project-name"
+	err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+	require.NoError(t, err)
+	cache := NewCache(fsMock, osMock, projectDirPath)
+	commit, err := cache.GetDeployedCommit(ctx, "A123")
+	assert.NoError(t, err)
+	assert.Empty(t, commit)
+	err = cache.SetManifestHash(ctx, "A123", Hash("xoxo"))
+	require.NoError(t, err)
+	err = cache.SetDeployedCommit(ctx, "A123", "0123456789abcdef")
+	require.NoError(t, err)
+	commit, err = cache.GetDeployedCommit(ctx, "A123")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", commit)
+	hash, err := cache.GetManifestHash(ctx, "A123")
+	assert.NoError(t, err)
+	assert.Equal(t, Hash("xoxo"), hash)
+}
+
 func TestCache_Manifest_NewManifestHash(t *testing.T) {
 	tests := map[string]struct {
 		mockManifest types.AppManifest
@@ -0,0 +1,124 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/spf13/afero"
+)
+
+// RunSessionCacher saves and retrieves the local run session of an app
+type RunSessionCacher interface {
+	GetRunSession(ctx context.Context, appID string) (RunSession, error)
+	SetRunSession(ctx context.Context, appID string, session RunSession) error
+	DeleteRunSession(ctx context.Context, appID string) error
+}
+
+// RunSession describes a "run" command that is serving an app
+type RunSession struct {
+	PID       int    `json:"pid"`
+	TeamID    string `json:"team_id"`
+	StartedAt int64  `json:"started_at"`
+}
+
+// Exists returns if the session was saved
+func (s RunSession) Exists() bool {
+	return s.StartedAt != 0
+}
+
+// GetRunSession loads the saved run session for an app ID from cache
+func (c *Cache) GetRunSession(ctx context.Context, appID string) (RunSession, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetRunSession")
+	defer span.Finish()
+	cache, err := c.readRunSessionCache(ctx)
+	if err != nil {
+		return RunSession{}, err
+	}
+	return cache[appID], nil
+}
+
+// SetRunSession saves the run session for an app ID
+func (c *Cache) SetRunSession(ctx context.Context, appID string, session RunSession) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "SetRunSession")
+	defer span.Finish()
+	cache, err := c.readRunSessionCache(ctx)
+	if err != nil {
+		return err
+	}
+	cache[appID] = session
+	return c.writeRunSessionCache(ctx, cache)
+}
+
+// DeleteRunSession removes the run session for an app ID
+func (c *Cache) DeleteRunSession(ctx context.Context, appID string) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "DeleteRunSession")
+	defer span.Finish()
+	cache, err := c.readRunSessionCache(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := cache[appID]; !ok {
+		return nil
+	}
+	delete(cache, appID)
+	return c.writeRunSessionCache(ctx, cache)
+}
+
+// readRunSessionCache loads the run session cache from file
+func (c *Cache) readRunSessionCache(ctx context.Context) (cache map[string]RunSession, err error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "readRunSessionCache")
+	defer span.Finish()
+	path := filepath.Join(c.path, ".slack", "cache", "run.json")
+	bytes, err := afero.ReadFile(c.fs, path)
+	switch {
+	case os.IsNotExist(err):
+		return map[string]RunSession{}, nil
+	case err != nil:
+		return map[string]RunSession{}, err
+	}
+	err = json.Unmarshal(bytes, &cache)
+	if err != nil {
+		return map[string]RunSession{}, err
+	}
+	if cache == nil {
+		cache = map[string]RunSession{}
+	}
+	return cache, nil
+}
+
+// writeRunSessionCache saves the run session cache to file
+func (c *Cache) writeRunSessionCache(ctx context.Context, cache map[string]RunSession) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "writeRunSessionCache")
+	defer span.Finish()
+	err := c.createCacheDir()
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+	bytes, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(c.path, ".slack", "cache", "run.json")
+	err = afero.WriteFile(c.fs, path, bytes, 0o644)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+)
+
+func (cm *CacheMock) GetRunSession(ctx context.Context, appID string) (RunSession, error) {
+	args := cm.Called(ctx, appID)
+	return args.Get(0).(RunSession), args.Error(1)
+}
+
+func (cm *CacheMock) SetRunSession(ctx context.Context, appID string, session RunSession) error {
+	args := cm.Called(ctx, appID, session)
+	return args.Error(0)
+}
+
+func (cm *CacheMock) DeleteRunSession(ctx context.Context, appID string) error {
+	args := cm.Called(ctx, appID)
+	return args.Error(0)
+}
@@ -0,0 +1,80 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_RunSession(t *testing.T) {
+	tests := map[string]struct {
+		mockAppID       string
+		mockSessions    map[string]RunSession
+		mockDeleted     []string
+		expectedSession RunSession
+		expectedExists  bool
+	}{
+		"missing cache entries return no session": {
+			mockAppID:       "A123",
+			expectedSession: RunSession{},
+		},
+		"existing cache entries return the session": {
+			mockAppID: "A123",
+			mockSessions: map[string]RunSession{
+				"A123": {PID: 4242, TeamID: "T001", StartedAt: 1700000000},
+				"A456": {PID: 4343, TeamID: "T002", StartedAt: 1700000001},
+			},
+			expectedSession: RunSession{PID: 4242, TeamID: "T001", StartedAt: 1700000000},
+			expectedExists:  true,
+		},
+		"deleted cache entries return no session": {
+			mockAppID: "A123",
+			mockSessions: map[string]RunSession{
+				"A123": {PID: 4242, TeamID: "T001", StartedAt: 1700000000},
+			},
+			mockDeleted:     []string{"A123", "A789"},
+			expectedSession: RunSession{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			projectDirPath := "/path/to/project-name"
+			err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+			require.NoError(t, err)
+			cache := NewCache(fsMock, osMock, projectDirPath)
+			for appID, session := range tc.mockSessions {
+				err = cache.SetRunSession(ctx, appID, session)
+				require.NoError(t, err)
+			}
+			for _, appID := range tc.mockDeleted {
+				err = cache.DeleteRunSession(ctx, appID)
+				require.NoError(t, err)
+			}
+			session, err := cache.GetRunSession(ctx, tc.mockAppID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSession, session)
+			assert.Equal(t, tc.expectedExists, session.Exists())
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/spf13/afero"
+)
+
+// ManifestSnapshotLimit is the number of app manifest snapshots kept per app
+const ManifestSnapshotLimit = 5
+
+// ManifestSnapshotCacher saves and retrieves the app manifests of an app from
+// before deploys
+type ManifestSnapshotCacher interface {
+	GetManifestSnapshots(ctx context.Context, appID string) ([]ManifestSnapshot, error)
+	AddManifestSnapshot(ctx context.Context, appID string, snapshot ManifestSnapshot) error
+}
+
+// ManifestSnapshot contains an app manifest of an app at a point in time
+type ManifestSnapshot struct {
+	CreatedAt int64             `json:"created_at"`
+	Manifest  types.AppManifest `json:"manifest"`
+}
+
+// GetManifestSnapshots loads the saved snapshots for an app ID from oldest to
+// newest
+func (c *Cache) GetManifestSnapshots(ctx context.Context, appID string) ([]ManifestSnapshot, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetManifestSnapshots")
+	defer span.Finish()
+	cache, err := c.readManifestSnapshotCache(ctx)
+	if err != nil {
+		return []ManifestSnapshot{}, err
+	}
+	return cache[appID], nil
+}
+
+// AddManifestSnapshot saves a snapshot for an app ID and removes the oldest
+// snapshots past the ManifestSnapshotLimit
+func (c *Cache) AddManifestSnapshot(ctx context.Context, appID string, snapshot ManifestSnapshot) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "AddManifestSnapshot")
+	defer span.Finish()
+	cache, err := c.readManifestSnapshotCache(ctx)
+	if err != nil {
+		return err
+	}
+	snapshots := append(cache[appID], snapshot)
+	if len(snapshots) > ManifestSnapshotLimit {
+		snapshots = snapshots[len(snapshots)-ManifestSnapshotLimit:]
+	}
+	cache[appID] = snapshots
+	return c.writeManifestSnapshotCache(ctx, cache)
+}
+
+// readManifestSnapshotCache loads the snapshot cache from file
+func (c *Cache) readManifestSnapshotCache(ctx context.Context) (cache map[string][]ManifestSnapshot, err error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "readManifestSnapshotCache")
+	defer span.Finish()
+	path := filepath.Join(c.path, ".slack", "cache", "snapshots.json")
+	bytes, err := afero.ReadFile(c.fs, path)
+	switch {
+	case os.IsNotExist(err):
+		return map[string][]ManifestSnapshot{}, nil
+	case err != nil:
+		return map[string][]ManifestSnapshot{}, err
+	}
+	err = json.Unmarshal(bytes, &cache)
+	if err != nil {
+		return map[string][]ManifestSnapshot{}, err
+	}
+	if cache == nil {
+		cache = map[string][]ManifestSnapshot{}
+	}
+	return cache, nil
+}
+
+// writeManifestSnapshotCache saves the snapshot cache to file
+func (c *Cache) writeManifestSnapshotCache(ctx context.Context, cache map[string][]ManifestSnapshot) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "writeManifestSnapshotCache")
+	defer span.Finish()
+	err := c.createCacheDir()
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+	bytes, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(c.path, ".slack", "cache", "snapshots.json")
+	err = afero.WriteFile(c.fs, path, bytes, 0o644)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+)
+
+func (cm *CacheMock) GetManifestSnapshots(ctx context.Context, appID string) ([]ManifestSnapshot, error) {
+	args := cm.Called(ctx, appID)
+	return args.Get(0).([]ManifestSnapshot), args.Error(1)
+}
+
+func (cm *CacheMock) AddManifestSnapshot(ctx context.Context, appID string, snapshot ManifestSnapshot) error {
+	args := cm.Called(ctx, appID, snapshot)
+	return args.Error(0)
+}
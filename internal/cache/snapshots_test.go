@@ -0,0 +1,61 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_ManifestSnapshots(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	projectDirPath := "/path/to/project-name"
+	err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+	require.NoError(t, err)
+	cache := NewCache(fsMock, osMock, projectDirPath)
+
+	snapshots, err := cache.GetManifestSnapshots(ctx, "A123")
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+
+	for i := range ManifestSnapshotLimit + 2 {
+		snapshot := ManifestSnapshot{
+			CreatedAt: int64(1700000000 + i),
+			Manifest: types.AppManifest{
+				DisplayInformation: types.DisplayInformation{Name: "tasks"},
+			},
+		}
+		require.NoError(t, cache.AddManifestSnapshot(ctx, "A123", snapshot))
+	}
+	require.NoError(t, cache.AddManifestSnapshot(ctx, "A456", ManifestSnapshot{CreatedAt: 1700000100}))
+
+	snapshots, err = cache.GetManifestSnapshots(ctx, "A123")
+	require.NoError(t, err)
+	require.Len(t, snapshots, ManifestSnapshotLimit)
+	assert.Equal(t, int64(1700000002), snapshots[0].CreatedAt)
+	assert.Equal(t, int64(1700000006), snapshots[ManifestSnapshotLimit-1].CreatedAt)
+	assert.Equal(t, "tasks", snapshots[0].Manifest.DisplayInformation.Name)
+	snapshots, err = cache.GetManifestSnapshots(ctx, "A456")
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+}
@@ -0,0 +1,204 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/spf13/afero"
+)
+
+// TriggerCacher saves and retrieves the triggers known for an app
+type TriggerCacher interface {
+	GetTriggers(ctx context.Context, appID string) ([]TriggerCacheItem, error)
+	SetTriggers(ctx context.Context, appID string, triggers []TriggerCacheItem) error
+	GetRecentTriggers(ctx context.Context, appID string, ttl time.Duration) ([]TriggerCacheItem, bool, error)
+	ExpireTriggers(ctx context.Context, appID string) error
+	GetTriggerIdempotencyKey(ctx context.Context, appID string, workflow string, key string) (TriggerIdempotencyKey, bool, error)
+	SetTriggerIdempotencyKey(ctx context.Context, appID string, record TriggerIdempotencyKey) error
+}
+
+// TriggerCacheApp contains the triggers last listed for an app
+//
+// The listed time is unset after triggers change so that the saved triggers are
+// not reused as a recent list.
+type TriggerCacheApp struct {
+	Triggers        []TriggerCacheItem      `json:"triggers"`
+	ListedAt        int64                   `json:"listed_at,omitempty"`
+	IdempotencyKeys []TriggerIdempotencyKey `json:"idempotency_keys,omitempty"`
+}
+
+// TriggerIdempotencyKey records the trigger created for a workflow with a key
+//
+// The trigger ID is empty while a create request is pending or if the response
+// of the request was lost.
+type TriggerIdempotencyKey struct {
+	Key       string `json:"key"`
+	Workflow  string `json:"workflow"`
+	TriggerID string `json:"trigger_id,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// TriggerCacheItem contains the identifying details of a trigger
+type TriggerCacheItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetTriggers loads the saved triggers for an app ID from cache
+func (c *Cache) GetTriggers(ctx context.Context, appID string) ([]TriggerCacheItem, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetTriggers")
+	defer span.Finish()
+	cache, err := c.readTriggerCache(ctx)
+	if err != nil {
+		return []TriggerCacheItem{}, err
+	}
+	return cache[appID].Triggers, nil
+}
+
+// SetTriggers saves the triggers for an app ID
+func (c *Cache) SetTriggers(ctx context.Context, appID string, triggers []TriggerCacheItem) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "SetTriggers")
+	defer span.Finish()
+	cache, err := c.readTriggerCache(ctx)
+	if err != nil {
+		return err
+	}
+	app := cache[appID]
+	app.Triggers = triggers
+	app.ListedAt = time.Now().Unix()
+	cache[appID] = app
+	return c.writeTriggerCache(ctx, cache)
+}
+
+// GetRecentTriggers loads the saved triggers for an app ID and reports if the
+// triggers were listed within the ttl and have not expired since
+func (c *Cache) GetRecentTriggers(ctx context.Context, appID string, ttl time.Duration) ([]TriggerCacheItem, bool, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetRecentTriggers")
+	defer span.Finish()
+	cache, err := c.readTriggerCache(ctx)
+	if err != nil {
+		return []TriggerCacheItem{}, false, err
+	}
+	app, ok := cache[appID]
+	if !ok || app.ListedAt == 0 {
+		return []TriggerCacheItem{}, false, nil
+	}
+	if time.Since(time.Unix(app.ListedAt, 0)) >= ttl {
+		return []TriggerCacheItem{}, false, nil
+	}
+	return app.Triggers, true, nil
+}
+
+// ExpireTriggers marks the saved triggers for an app ID as outdated while
+// keeping the triggers for suggestions
+func (c *Cache) ExpireTriggers(ctx context.Context, appID string) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "ExpireTriggers")
+	defer span.Finish()
+	cache, err := c.readTriggerCache(ctx)
+	if err != nil {
+		return err
+	}
+	app, ok := cache[appID]
+	if !ok || app.ListedAt == 0 {
+		return nil
+	}
+	app.ListedAt = 0
+	cache[appID] = app
+	return c.writeTriggerCache(ctx, cache)
+}
+
+// GetTriggerIdempotencyKey loads the record of an idempotency key for the
+// workflow of an app and reports if the record exists
+func (c *Cache) GetTriggerIdempotencyKey(ctx context.Context, appID string, workflow string, key string) (TriggerIdempotencyKey, bool, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "GetTriggerIdempotencyKey")
+	defer span.Finish()
+	cache, err := c.readTriggerCache(ctx)
+	if err != nil {
+		return TriggerIdempotencyKey{}, false, err
+	}
+	for _, record := range cache[appID].IdempotencyKeys {
+		if record.Workflow == workflow && record.Key == key {
+			return record, true, nil
+		}
+	}
+	return TriggerIdempotencyKey{}, false, nil
+}
+
+// SetTriggerIdempotencyKey saves the record of an idempotency key for an app
+// and replaces any record with the same workflow and key
+func (c *Cache) SetTriggerIdempotencyKey(ctx context.Context, appID string, record TriggerIdempotencyKey) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "SetTriggerIdempotencyKey")
+	defer span.Finish()
+	cache, err := c.readTriggerCache(ctx)
+	if err != nil {
+		return err
+	}
+	app := cache[appID]
+	app.IdempotencyKeys = slices.DeleteFunc(app.IdempotencyKeys, func(saved TriggerIdempotencyKey) bool {
+		return saved.Workflow == record.Workflow && saved.Key == record.Key
+	})
+	app.IdempotencyKeys = append(app.IdempotencyKeys, record)
+	cache[appID] = app
+	return c.writeTriggerCache(ctx, cache)
+}
+
+// readTriggerCache loads the trigger cache from file
+func (c *Cache) readTriggerCache(ctx context.Context) (cache map[string]TriggerCacheApp, err error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "readTriggerCache")
+	defer span.Finish()
+	path := filepath.Join(c.path, ".slack", "cache", "triggers.json")
+	bytes, err := afero.ReadFile(c.fs, path)
+	switch {
+	case os.IsNotExist(err):
+		return map[string]TriggerCacheApp{}, nil
+	case err != nil:
+		return map[string]TriggerCacheApp{}, err
+	}
+	err = json.Unmarshal(bytes, &cache)
+	if err != nil {
+		return map[string]TriggerCacheApp{}, err
+	}
+	if cache == nil {
+		cache = map[string]TriggerCacheApp{}
+	}
+	return cache, nil
+}
+
+// writeTriggerCache saves the trigger cache to file
+func (c *Cache) writeTriggerCache(ctx context.Context, cache map[string]TriggerCacheApp) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "writeTriggerCache")
+	defer span.Finish()
+	err := c.createCacheDir()
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+	bytes, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(c.path, ".slack", "cache", "triggers.json")
+	err = afero.WriteFile(c.fs, path, bytes, 0o644)
+	if err != nil {
+		return err
+	}
+	return nil
+}
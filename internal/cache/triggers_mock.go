@@ -0,0 +1,50 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+func (cm *CacheMock) GetTriggers(ctx context.Context, appID string) ([]TriggerCacheItem, error) {
+	args := cm.Called(ctx, appID)
+	return args.Get(0).([]TriggerCacheItem), args.Error(1)
+}
+
+func (cm *CacheMock) SetTriggers(ctx context.Context, appID string, triggers []TriggerCacheItem) error {
+	args := cm.Called(ctx, appID, triggers)
+	return args.Error(0)
+}
+
+func (cm *CacheMock) GetRecentTriggers(ctx context.Context, appID string, ttl time.Duration) ([]TriggerCacheItem, bool, error) {
+	args := cm.Called(ctx, appID, ttl)
+	return args.Get(0).([]TriggerCacheItem), args.Bool(1), args.Error(2)
+}
+
+func (cm *CacheMock) ExpireTriggers(ctx context.Context, appID string) error {
+	args := cm.Called(ctx, appID)
+	return args.Error(0)
+}
+
+func (cm *CacheMock) GetTriggerIdempotencyKey(ctx context.Context, appID string, workflow string, key string) (TriggerIdempotencyKey, bool, error) {
+	args := cm.Called(ctx, appID, workflow, key)
+	return args.Get(0).(TriggerIdempotencyKey), args.Bool(1), args.Error(2)
+}
+
+func (cm *CacheMock) SetTriggerIdempotencyKey(ctx context.Context, appID string, record TriggerIdempotencyKey) error {
+	args := cm.Called(ctx, appID, record)
+	return args.Error(0)
+}
@@ -0,0 +1,130 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Triggers(t *testing.T) {
+	tests := map[string]struct {
+		mockAppID        string
+		mockTriggers     map[string][]TriggerCacheItem
+		expectedTriggers []TriggerCacheItem
+	}{
+		"missing cache entries return no triggers": {
+			mockAppID:        "A123",
+			expectedTriggers: nil,
+		},
+		"existing cache entries return the triggers": {
+			mockAppID: "A123",
+			mockTriggers: map[string][]TriggerCacheItem{
+				"A123": {{ID: "Ft001", Name: "Greetings"}},
+				"A456": {{ID: "Ft002", Name: "Farewells"}},
+			},
+			expectedTriggers: []TriggerCacheItem{{ID: "Ft001", Name: "Greetings"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			projectDirPath := "/path/to/project-name"
+			err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+			require.NoError(t, err)
+			cache := NewCache(fsMock, osMock, projectDirPath)
+			for appID, triggers := range tc.mockTriggers {
+				err = cache.SetTriggers(ctx, appID, triggers)
+				require.NoError(t, err)
+			}
+			triggers, err := cache.GetTriggers(ctx, tc.mockAppID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedTriggers, triggers)
+		})
+	}
+}
+
+func TestCache_RecentTriggers(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	projectDirPath := "/path/to/project-name"
+	err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+	require.NoError(t, err)
+	cache := NewCache(fsMock, osMock, projectDirPath)
+	triggers := []TriggerCacheItem{{ID: "Ft001", Name: "Greetings"}}
+
+	_, ok, err := cache.GetRecentTriggers(ctx, "A123", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, cache.SetTriggers(ctx, "A123", triggers))
+	recent, ok, err := cache.GetRecentTriggers(ctx, "A123", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, triggers, recent)
+
+	_, ok, err = cache.GetRecentTriggers(ctx, "A123", 0)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, cache.ExpireTriggers(ctx, "A123"))
+	_, ok, err = cache.GetRecentTriggers(ctx, "A123", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	saved, err := cache.GetTriggers(ctx, "A123")
+	require.NoError(t, err)
+	assert.Equal(t, triggers, saved)
+}
+
+func TestCache_TriggerIdempotencyKeys(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	projectDirPath := "/path/to/project-name"
+	err := fsMock.MkdirAll(filepath.Dir(projectDirPath), 0o755)
+	require.NoError(t, err)
+	cache := NewCache(fsMock, osMock, projectDirPath)
+
+	_, ok, err := cache.GetTriggerIdempotencyKey(ctx, "A123", "#/workflows/greet", "release-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	pending := TriggerIdempotencyKey{Key: "release-1", Workflow: "#/workflows/greet", CreatedAt: 1700000000}
+	require.NoError(t, cache.SetTriggerIdempotencyKey(ctx, "A123", pending))
+	created := pending
+	created.TriggerID = "Ft001"
+	require.NoError(t, cache.SetTriggerIdempotencyKey(ctx, "A123", created))
+	require.NoError(t, cache.SetTriggers(ctx, "A123", []TriggerCacheItem{{ID: "Ft001", Name: "Greetings"}}))
+
+	record, ok, err := cache.GetTriggerIdempotencyKey(ctx, "A123", "#/workflows/greet", "release-1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, created, record)
+	_, ok, err = cache.GetTriggerIdempotencyKey(ctx, "A123", "#/workflows/farewell", "release-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, ok, err = cache.GetTriggerIdempotencyKey(ctx, "A456", "#/workflows/greet", "release-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,135 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmdutil
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/cobra"
+)
+
+// Flag names of commands that operate on multiple items
+const (
+	FailFastFlag  = "fail-fast"
+	KeepGoingFlag = "keep-going"
+)
+
+// BatchFlags decide if a command that operates on multiple items stops at the
+// first failure or continues with the remaining items
+type BatchFlags struct {
+	FailFast  bool
+	KeepGoing bool
+}
+
+// AddFlags adds the --fail-fast and --keep-going flags to the command
+func (f *BatchFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.FailFast, FailFastFlag, false, "stop at the first item that fails")
+	cmd.Flags().BoolVar(&f.KeepGoing, KeepGoingFlag, false, "continue with remaining items after a failure")
+}
+
+// NewBatch returns a batch that continues after failures unless the flags or
+// the default of the command decide otherwise
+func (f *BatchFlags) NewBatch(keepGoingDefault bool) (*Batch, error) {
+	if f.FailFast && f.KeepGoing {
+		return nil, slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --%s flag cannot be used with the --%s flag", FailFastFlag, KeepGoingFlag)
+	}
+	keepGoing := keepGoingDefault
+	switch {
+	case f.FailFast:
+		keepGoing = false
+	case f.KeepGoing:
+		keepGoing = true
+	}
+	return &Batch{KeepGoing: keepGoing}, nil
+}
+
+// BatchError is the error of one item of a batch
+type BatchError struct {
+	Item string
+	Err  error
+}
+
+// Batch collects the errors of an operation on multiple items. Items can be
+// added from multiple goroutines
+type Batch struct {
+	KeepGoing bool
+
+	mu        sync.Mutex
+	attempted int
+	errors    []BatchError
+}
+
+// Stopped returns if remaining items should be skipped after a failure
+func (b *Batch) Stopped() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.KeepGoing && len(b.errors) > 0
+}
+
+// Add records the outcome of an attempted item
+func (b *Batch) Add(item string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.attempted++
+	if err != nil {
+		b.errors = append(b.errors, BatchError{Item: item, Err: err})
+	}
+}
+
+// Run calls run for each item in order and records the outcome with the name
+// of the item. Remaining items are skipped after a failure unless the batch
+// keeps going
+func Run[T any](b *Batch, items []T, name func(T) string, run func(T) error) {
+	for _, item := range items {
+		if b.Stopped() {
+			return
+		}
+		b.Add(name(item), run(item))
+	}
+}
+
+// Errors returns the errors of failed items in the order these were added
+func (b *Batch) Errors() []BatchError {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]BatchError{}, b.errors...)
+}
+
+// Err returns an error with the code and details of each failed item or nil
+// if no items failed. The message notes the count of failed and total items
+// with a verb like "add" and a noun like "collaborators"
+func (b *Batch) Err(code string, verb string, noun string, total int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.errors) == 0 {
+		return nil
+	}
+	details := slackerror.ErrorDetails{}
+	for _, batchErr := range b.errors {
+		details = append(details, slackerror.ErrorDetail{
+			Message: fmt.Sprintf("%s: %s", batchErr.Item, batchErr.Err),
+		})
+	}
+	err := slackerror.New(code).
+		WithMessage("Failed to %s %d of %d %s", verb, len(b.errors), total, noun).
+		WithDetails(details)
+	if b.attempted < total {
+		err = err.WithRemediation("Remaining %s were skipped after the first failure. Continue past failures with the --%s flag", noun, KeepGoingFlag)
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmdutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchFlags_NewBatch(t *testing.T) {
+	tests := map[string]struct {
+		args             []string
+		keepGoingDefault bool
+		expectedKeep     bool
+		expectedError    string
+	}{
+		"uses the default of the command without flags": {
+			keepGoingDefault: true,
+			expectedKeep:     true,
+		},
+		"stops at the first failure with the fail-fast flag": {
+			args:             []string{"--fail-fast"},
+			keepGoingDefault: true,
+			expectedKeep:     false,
+		},
+		"continues after failures with the keep-going flag": {
+			args:             []string{"--keep-going"},
+			keepGoingDefault: false,
+			expectedKeep:     true,
+		},
+		"errors if both flags are set": {
+			args:          []string{"--fail-fast", "--keep-going"},
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			flags := BatchFlags{}
+			cmd := &cobra.Command{Use: "test"}
+			flags.AddFlags(cmd)
+			require.NoError(t, cmd.Flags().Parse(tc.args))
+			batch, err := flags.NewBatch(tc.keepGoingDefault)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedKeep, batch.KeepGoing)
+		})
+	}
+}
+
+func Test_Batch_Run(t *testing.T) {
+	tests := map[string]struct {
+		keepGoing           bool
+		expectedRuns        []string
+		expectedMessage     string
+		expectedRemediation string
+	}{
+		"skips remaining items after a failure": {
+			keepGoing:           false,
+			expectedRuns:        []string{"a", "b"},
+			expectedMessage:     "Failed to check 1 of 3 items",
+			expectedRemediation: "Remaining items were skipped after the first failure",
+		},
+		"continues with remaining items after a failure": {
+			keepGoing:       true,
+			expectedRuns:    []string{"a", "b", "c"},
+			expectedMessage: "Failed to check 1 of 3 items",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			batch := &Batch{KeepGoing: tc.keepGoing}
+			runs := []string{}
+			Run(batch, []string{"a", "b", "c"}, func(item string) string { return item }, func(item string) error {
+				runs = append(runs, item)
+				if item == "b" {
+					return errors.New("broken")
+				}
+				return nil
+			})
+			assert.Equal(t, tc.expectedRuns, runs)
+			assert.Equal(t, []BatchError{{Item: "b", Err: errors.New("broken")}}, batch.Errors())
+			err := slackerror.ToSlackError(batch.Err(slackerror.ErrInvalidArgs, "check", "items", 3))
+			assert.Equal(t, slackerror.ErrInvalidArgs, err.Code)
+			assert.Equal(t, tc.expectedMessage, err.Message)
+			assert.Contains(t, err.Remediation, tc.expectedRemediation)
+			require.Len(t, err.Details, 1)
+			assert.Equal(t, "b: broken", err.Details[0].Message)
+		})
+	}
+}
+
+func Test_Batch_Err(t *testing.T) {
+	batch := &Batch{}
+	batch.Add("a", nil)
+	assert.False(t, batch.Stopped())
+	assert.NoError(t, batch.Err(slackerror.ErrInvalidArgs, "check", "items", 1))
+}
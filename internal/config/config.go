@@ -15,6 +15,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/spf13/afero"
@@ -22,11 +24,16 @@ import (
 )
 
 // Environment Variable constants
+const noColorEnv = "NO_COLOR"
 const slackAccessibleEnv = "ACCESSIBLE"
 const slackAutoRequestAAAEnv = "SLACK_AUTO_REQUEST_AAA"
 const slackCLIAppIconPathEnv = "SLACK_CLI_APP_ICON_PATH"
+const slackCLIConfigDirEnv = "SLACK_CLI_CONFIG_DIR"
+const slackCLIProfileEnv = "SLACK_CLI_PROFILE"
 const slackConfigDirEnv = "SLACK_CONFIG_DIR"
 const slackDisableTelemetryEnv = "SLACK_DISABLE_TELEMETRY"
+const slackGitTokenEnv = "SLACK_GIT_TOKEN"
+const slackGitTokenHostEnv = "SLACK_GIT_TOKEN_HOST"
 const slackTestTraceEnv = "SLACK_TEST_TRACE"
 
 type Config struct {
@@ -40,38 +47,59 @@ type Config struct {
 	// Raw flags (for metrics)
 	RawFlags []string
 	// Command flags
-	AccessibleFlag          bool
-	APIHostFlag             string
-	APIHostResolved         string
-	AppFlag                 string
-	AppIconPathFlag         string
-	AutoRequestAAAFlag      bool
-	ConfigDirFlag           string
-	DebugEnabled            bool
-	DeprecatedDevAppFlag    bool
-	DeprecatedDevFlag       bool
-	DeprecatedWorkspaceFlag string
-	DisableTelemetryFlag    bool
-	ForceFlag               bool
-	LogstashHostResolved    string
-	NoColor                 bool
-	RuntimeFlag             string
-	RuntimeName             string
-	RuntimeVersion          string
-	SkipUpdateFlag          bool
-	SlackDevFlag            bool
-	SlackTestTraceFlag      bool
-	TeamFlag                string
-	TokenFlag               string
+	AccessibleFlag           bool
+	AllowCustomAPIHostFlag   bool
+	APIHostFlag              string
+	APIHostResolved          string
+	AppFlag                  string
+	AppIconPathFlag          string
+	AutoRequestAAAFlag       bool
+	ConfigDirFlag            string
+	ConfigFileFlag           string
+	ContextFlag              string
+	DebugEnabled             bool
+	DeprecatedDevAppFlag     bool
+	DeprecatedDevFlag        bool
+	DeprecatedWorkspaceFlag  string
+	DisableTelemetryFlag     bool
+	EventsFDFlag             int
+	ForceFlag                bool
+	GitToken                 string
+	GitTokenHost             string
+	HookTimeoutFlag          time.Duration
+	LogLevel                 LogLevel
+	LogLevelFlag             string
+	LogstashHostResolved     string
+	ManifestPathFlag         string
+	NoColor                  bool
+	NoRedactFlag             bool
+	ProfileFlag              string
+	ProjectDirFlag           string
+	RedactFlag               bool
+	RuntimeFlag              string
+	RuntimeName              string
+	RuntimeVersion           string
+	SkipUpdateFlag           bool
+	SlackDevFlag             bool
+	SlackTestTraceFlag       bool
+	TeamFlag                 string
+	TokenFlag                string
+	VersionCheckIntervalFlag string
 
 	// Feature experiments
 	ExperimentsFlag []string
 	experiments     map[experiment.Experiment]bool
 
+	// flagsFile holds the default values of flags from the --config file
+	flagsFile map[string]any
+
 	// Eventually this will also load the global and project slack config files
 	DomainAuthTokens string
 	ManifestEnv      map[string]string
 
+	// ManifestVars are overrides of app manifest values at dotted paths
+	ManifestVars map[string]string
+
 	// ProjectID is uuid for the project
 	ProjectID string
 
@@ -25,6 +25,8 @@ const contextTeamID contextKey = "team_id"
 const contextTeamDomain contextKey = "team_domain" // e.g. "subarachnoid"
 const contextUserID contextKey = "user_id"
 const contextEnterpriseID contextKey = "enterprise_id"
+const contextRequestReason contextKey = "request_reason"
+const contextInstallApprovalWait contextKey = "install_approval_wait"
 
 func SetContextToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, ContextToken, token)
@@ -84,3 +86,31 @@ func GetContextUserID(ctx context.Context) string {
 	}
 	return userID
 }
+
+// SetContextRequestReason sets the reason submitted with app approval requests
+// in place of prompting for one
+func SetContextRequestReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, contextRequestReason, reason)
+}
+
+func GetContextRequestReason(ctx context.Context) string {
+	reason, ok := ctx.Value(contextRequestReason).(string)
+	if !ok {
+		return ""
+	}
+	return reason
+}
+
+// SetContextInstallApprovalWait marks installs that check on a pending app
+// approval request while waiting for an admin to review it
+func SetContextInstallApprovalWait(ctx context.Context, waiting bool) context.Context {
+	return context.WithValue(ctx, contextInstallApprovalWait, waiting)
+}
+
+func GetContextInstallApprovalWait(ctx context.Context) bool {
+	waiting, ok := ctx.Value(contextInstallApprovalWait).(bool)
+	if !ok {
+		return false
+	}
+	return waiting
+}
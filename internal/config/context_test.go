@@ -150,3 +150,37 @@ func Test_Context_UserID(t *testing.T) {
 		assert.Equal(t, "", GetContextUserID(context.Background()))
 	})
 }
+
+func Test_Context_RequestReason(t *testing.T) {
+	tests := map[string]struct {
+		reason   string
+		expected string
+	}{
+		"set and get a request reason": {
+			reason:   "Automates release notes",
+			expected: "Automates release notes",
+		},
+		"set and get an empty request reason": {
+			reason:   "",
+			expected: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := SetContextRequestReason(context.Background(), tc.reason)
+			assert.Equal(t, tc.expected, GetContextRequestReason(ctx))
+		})
+	}
+
+	t.Run("get from empty context returns empty string", func(t *testing.T) {
+		assert.Equal(t, "", GetContextRequestReason(context.Background()))
+	})
+}
+
+func Test_Context_InstallApprovalWait(t *testing.T) {
+	ctx := SetContextInstallApprovalWait(context.Background(), true)
+	assert.True(t, GetContextInstallApprovalWait(ctx))
+	ctx = SetContextInstallApprovalWait(ctx, false)
+	assert.False(t, GetContextInstallApprovalWait(ctx))
+	assert.False(t, GetContextInstallApprovalWait(context.Background()))
+}
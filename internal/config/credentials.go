@@ -0,0 +1,50 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// CredentialStore is where the authorizations of the system are saved
+type CredentialStore string
+
+const (
+	// CredentialStoreAuto uses the secret store of the operating system when
+	// one is available and otherwise uses the credentials file
+	CredentialStoreAuto CredentialStore = "auto"
+	// CredentialStoreFile uses the credentials.json file of the system
+	CredentialStoreFile CredentialStore = "file"
+	// CredentialStoreKeychain uses the secret store of the operating system
+	CredentialStoreKeychain CredentialStore = "keychain"
+)
+
+// CredentialStores are the known credential stores
+var CredentialStores = []CredentialStore{
+	CredentialStoreAuto,
+	CredentialStoreFile,
+	CredentialStoreKeychain,
+}
+
+// IsValid returns true if the credential store is known
+func (cs CredentialStore) IsValid() bool {
+	for _, store := range CredentialStores {
+		if cs == store {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the string value of a credential store
+func (cs CredentialStore) String() string {
+	return string(cs)
+}
@@ -33,6 +33,13 @@ func (c *Config) LoadEnvironmentVariables() error {
 		c.AccessibleFlag = true
 	}
 
+	// Remove colors from outputs if NO_COLOR is set to any value
+	//
+	// https://no-color.org
+	if c.os.Getenv(noColorEnv) != "" {
+		c.NoColor = true
+	}
+
 	// Load slackTestTraceFlag from environment variables
 	var testTrace = strings.TrimSpace(c.os.Getenv(slackTestTraceEnv))
 	if testTrace != "" && testTrace != "false" && testTrace != "0" {
@@ -45,18 +52,38 @@ func (c *Config) LoadEnvironmentVariables() error {
 		c.AutoRequestAAAFlag = true
 	}
 
-	// Load slackConfigDirEnv from environment variables
-	var configDir = strings.TrimSpace(c.os.Getenv(slackConfigDirEnv))
+	// Load the config directory from environment variables, preferring
+	// SLACK_CLI_CONFIG_DIR to SLACK_CONFIG_DIR
+	var configDir = strings.TrimSpace(c.os.Getenv(slackCLIConfigDirEnv))
+	if configDir == "" {
+		configDir = strings.TrimSpace(c.os.Getenv(slackConfigDirEnv))
+	}
 	if configDir != "" {
 		c.ConfigDirFlag = configDir
 	}
 
+	// Load the profile from environment variables unless set with a flag
+	var profile = strings.TrimSpace(c.os.Getenv(slackCLIProfileEnv))
+	if profile != "" && c.ProfileFlag == "" {
+		c.ProfileFlag = profile
+	}
+
 	// Load app icon path from environment variables
 	var appIconPath = strings.TrimSpace(c.os.Getenv(slackCLIAppIconPathEnv))
 	if appIconPath != "" {
 		c.AppIconPathFlag = appIconPath
 	}
 
+	// Load the token used to clone private templates from environment variables
+	var gitToken = strings.TrimSpace(c.os.Getenv(slackGitTokenEnv))
+	if gitToken != "" {
+		c.GitToken = gitToken
+	}
+	var gitTokenHost = strings.TrimSpace(c.os.Getenv(slackGitTokenHostEnv))
+	if gitTokenHost != "" {
+		c.GitTokenHost = gitTokenHost
+	}
+
 	// Disable telemetry if either disable-telemetry or test-version environment variables
 	var disableTelemetry = strings.TrimSpace(c.os.Getenv(slackDisableTelemetryEnv))
 	var testVersion = strings.TrimSpace(c.os.Getenv(version.EnvTestVersion))
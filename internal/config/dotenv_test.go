@@ -19,6 +19,7 @@ import (
 
 	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_DotEnv_LoadEnvironmentVariables(t *testing.T) {
@@ -27,6 +28,27 @@ func Test_DotEnv_LoadEnvironmentVariables(t *testing.T) {
 		envValue       string
 		assertOnConfig func(t *testing.T, cfg *Config)
 	}{
+		"NO_COLOR=1 should enable NoColor": {
+			envName:  "NO_COLOR",
+			envValue: "1",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, true, cfg.NoColor)
+			},
+		},
+		"NO_COLOR=false should still enable NoColor": {
+			envName:  "NO_COLOR",
+			envValue: "false",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, true, cfg.NoColor)
+			},
+		},
+		"NO_COLOR unset should not enable NoColor": {
+			envName:  "NO_COLOR",
+			envValue: "",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, false, cfg.NoColor)
+			},
+		},
 		"SLACK_TEST_TRACE=true should enable SlackTestTraceFlag": {
 			envName:  "SLACK_TEST_TRACE",
 			envValue: "true",
@@ -188,6 +210,62 @@ func Test_DotEnv_LoadEnvironmentVariables(t *testing.T) {
 				assert.Equal(t, "", cfg.ConfigDirFlag)
 			},
 		},
+		"SLACK_CLI_CONFIG_DIR=/path/to/config should set the config dir": {
+			envName:  "SLACK_CLI_CONFIG_DIR",
+			envValue: "/path/to/config",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "/path/to/config", cfg.ConfigDirFlag)
+			},
+		},
+		"SLACK_CLI_CONFIG_DIR= should not set config dir": {
+			envName:  "SLACK_CLI_CONFIG_DIR",
+			envValue: "",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "", cfg.ConfigDirFlag)
+			},
+		},
+		"SLACK_CLI_PROFILE=work should set the profile": {
+			envName:  "SLACK_CLI_PROFILE",
+			envValue: "work",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "work", cfg.ProfileFlag)
+			},
+		},
+		"SLACK_CLI_PROFILE= should not set the profile": {
+			envName:  "SLACK_CLI_PROFILE",
+			envValue: "",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "", cfg.ProfileFlag)
+			},
+		},
+		"SLACK_GIT_TOKEN=ghp_example should set the git token": {
+			envName:  "SLACK_GIT_TOKEN",
+			envValue: "ghp_example",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "ghp_example", cfg.GitToken)
+			},
+		},
+		"SLACK_GIT_TOKEN= should not set the git token": {
+			envName:  "SLACK_GIT_TOKEN",
+			envValue: "",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "", cfg.GitToken)
+			},
+		},
+		"SLACK_GIT_TOKEN_HOST=github.example.com should set the git token host": {
+			envName:  "SLACK_GIT_TOKEN_HOST",
+			envValue: "github.example.com",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "github.example.com", cfg.GitTokenHost)
+			},
+		},
+		"SLACK_GIT_TOKEN_HOST= should not set the git token host": {
+			envName:  "SLACK_GIT_TOKEN_HOST",
+			envValue: "",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "", cfg.GitTokenHost)
+			},
+		},
 		"ACCESSIBLE=true should set Accessible to true": {
 			envName:  "ACCESSIBLE",
 			envValue: "true",
@@ -245,3 +323,58 @@ func Test_DotEnv_LoadEnvironmentVariables(t *testing.T) {
 		})
 	}
 }
+
+func Test_DotEnv_LoadEnvironmentVariables_ProfileFlag(t *testing.T) {
+	fs := slackdeps.NewFsMock()
+	os := slackdeps.NewOsMock()
+	os.On("Getenv", "SLACK_CLI_PROFILE").Return("work")
+	os.AddDefaultMocks()
+
+	config := NewConfig(fs, os)
+	config.ProfileFlag = "personal"
+	err := config.LoadEnvironmentVariables()
+	require.NoError(t, err)
+	assert.Equal(t, "personal", config.ProfileFlag)
+}
+
+func Test_DotEnv_LoadEnvironmentVariables_ConfigDir(t *testing.T) {
+	tests := map[string]struct {
+		env      map[string]string
+		flag     string
+		expected string
+	}{
+		"prefers SLACK_CLI_CONFIG_DIR to SLACK_CONFIG_DIR": {
+			env:      map[string]string{"SLACK_CLI_CONFIG_DIR": "/path/to/cli", "SLACK_CONFIG_DIR": "/path/to/legacy"},
+			expected: "/path/to/cli",
+		},
+		"uses SLACK_CONFIG_DIR without SLACK_CLI_CONFIG_DIR": {
+			env:      map[string]string{"SLACK_CONFIG_DIR": "/path/to/legacy"},
+			expected: "/path/to/legacy",
+		},
+		"prefers environment variables to the --config-dir flag": {
+			env:      map[string]string{"SLACK_CLI_CONFIG_DIR": "/path/to/cli"},
+			flag:     "/path/to/flag",
+			expected: "/path/to/cli",
+		},
+		"uses the --config-dir flag without environment variables": {
+			flag:     "/path/to/flag",
+			expected: "/path/to/flag",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			for key, value := range tc.env {
+				os.On("Getenv", key).Return(value)
+			}
+			os.AddDefaultMocks()
+
+			config := NewConfig(fs, os)
+			config.ConfigDirFlag = tc.flag
+			err := config.LoadEnvironmentVariables()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, config.ConfigDirFlag)
+		})
+	}
+}
@@ -15,12 +15,16 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // SetFlags saves the provided command flags to the config
@@ -31,23 +35,41 @@ func (c *Config) SetFlags(cmd *cobra.Command) {
 // InitializeGlobalFlags configures flags and creates links from cmd to config
 func (c *Config) InitializeGlobalFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVarP(&c.AccessibleFlag, "accessible", "", false, "use accessible prompts for screen readers")
+	cmd.PersistentFlags().BoolVar(&c.AllowCustomAPIHostFlag, "allow-custom-apihost", false, "allow an API host that is not a Slack host")
 	cmd.PersistentFlags().StringVar(&c.APIHostFlag, "apihost", "", "Slack API host")
 	cmd.PersistentFlags().StringVarP(&c.AppFlag, "app", "a", "", "use a specific app ID or environment")
+	cmd.PersistentFlags().StringVar(&c.ConfigFileFlag, "config", "", "use default values of flags from a YAML or JSON file")
 	cmd.PersistentFlags().StringVarP(&c.ConfigDirFlag, "config-dir", "", "", "use a custom path for system config directory")
+	cmd.PersistentFlags().StringVar(&c.ContextFlag, "context", "", "use the team, app, and token of a saved context")
 	cmd.PersistentFlags().BoolVarP(&c.DeprecatedDevAppFlag, "local-run", "l", false, "use the local run app created by the `run` command") // deprecated
 	cmd.PersistentFlags().BoolVarP(&c.DeprecatedDevFlag, "dev", "d", false, "use dev apis")                                                // Can be removed after v0.25.0
+	cmd.PersistentFlags().IntVar(&c.EventsFDFlag, "events-fd", 0, "write lifecycle events as JSON lines to\n  an open file descriptor")
 	cmd.PersistentFlags().StringSliceVarP(&c.ExperimentsFlag, "experiment", "e", nil, "use the experiment(s) in the command")
 	cmd.PersistentFlags().BoolVarP(&c.ForceFlag, "force", "f", false, "ignore warnings and continue executing command")
+	cmd.PersistentFlags().DurationVar(&c.HookTimeoutFlag, "hook-timeout", 0, "stop hooks except start that run longer than a duration like 5m")
+	cmd.PersistentFlags().StringVar(&c.LogLevelFlag, "log-level", "", "print outputs at or above a level:\n  error, warn, info, debug")
+	cmd.PersistentFlags().StringVar(&c.ManifestPathFlag, "manifest-path", "", "use a manifest file instead of the get-manifest hook")
 	cmd.PersistentFlags().BoolVarP(&c.NoColor, "no-color", "", false, "remove styles and formatting from outputs")
+	cmd.PersistentFlags().BoolVarP(&c.NoRedactFlag, "no-redact", "", false, "print tokens and emails in debug and error outputs")
+	cmd.PersistentFlags().StringVar(&c.ProfileFlag, "profile", "", "use the authorizations and configurations of a profile")
+	cmd.PersistentFlags().StringVar(&c.ProjectDirFlag, "project-dir", "", "use a project in another directory")
+	cmd.PersistentFlags().BoolVar(&c.RedactFlag, "redact", true, "redact tokens and emails in debug and error outputs")
 	cmd.PersistentFlags().StringVarP(&c.RuntimeFlag, "runtime", "r", "", "the project's runtime language:\n  deno (default), deno1.1, deno1.x, etc")
 	cmd.PersistentFlags().BoolVarP(&c.SkipUpdateFlag, "skip-update", "s", false, "skip checking for latest version of CLI")
 	cmd.PersistentFlags().BoolVarP(&c.SlackDevFlag, "slackdev", "", false, "shorthand for --apihost=https://dev.slack.com")
 	// TODO - next semver MAJOR can consider a new shorthand flag, right now -t and -T are used by other commands
 	cmd.PersistentFlags().StringVarP(&c.TeamFlag, "team", "w", "", "select workspace or organization by team name or ID")
 	cmd.PersistentFlags().StringVarP(&c.TokenFlag, "token", "", "", "set the access token associated with a team")
+	cmd.PersistentFlags().StringVar(&c.VersionCheckIntervalFlag, "version-check-interval", "", "check for a newer version after a duration like\n  12h or use 0 or off to disable (default 24h)")
 	cmd.PersistentFlags().BoolVarP(&c.DebugEnabled, "verbose", "v", false, "print debug logging and additional info")
 	cmd.PersistentFlags().StringVarP(&c.DeprecatedWorkspaceFlag, "workspace", "", "", "select workspace or organization by domain name or team ID")
 
+	cmd.MarkFlagsMutuallyExclusive("no-redact", "redact")
+	_ = cmd.MarkPersistentFlagFilename("config")
+	_ = cmd.MarkPersistentFlagFilename("config-dir")
+	_ = cmd.MarkPersistentFlagFilename("manifest-path")
+
+	cmd.PersistentFlags().Lookup("allow-custom-apihost").Hidden = true
 	cmd.PersistentFlags().Lookup("apihost").Hidden = true
 	cmd.PersistentFlags().Lookup("dev").Hidden = true
 	cmd.PersistentFlags().Lookup("local-run").Hidden = true
@@ -57,6 +79,7 @@ func (c *Config) InitializeGlobalFlags(cmd *cobra.Command) {
 
 	for _, arg := range os.Args {
 		if arg == "--verbose" || arg == "-v" {
+			cmd.PersistentFlags().Lookup("allow-custom-apihost").Hidden = false
 			cmd.PersistentFlags().Lookup("apihost").Hidden = false
 			cmd.PersistentFlags().Lookup("runtime").Hidden = false
 			cmd.PersistentFlags().Lookup("slackdev").Hidden = false
@@ -64,6 +87,33 @@ func (c *Config) InitializeGlobalFlags(cmd *cobra.Command) {
 	}
 }
 
+// SetContextFlags sets the team and app flags that are not already set to the
+// values of a saved context
+func (c *Config) SetContextFlags(preset ContextPreset) {
+	if c.TeamFlag == "" {
+		c.TeamFlag = preset.Team
+	}
+	if c.AppFlag == "" {
+		c.AppFlag = preset.App
+	}
+}
+
+// VersionCheckInterval returns the time between checks for a newer version from
+// the --version-check-interval flag or the system config where 0 disables
+// the checks
+func (c *Config) VersionCheckInterval(ctx context.Context) (time.Duration, error) {
+	if c.VersionCheckIntervalFlag == "" {
+		return c.SystemConfig.GetVersionCheckInterval(ctx)
+	}
+	interval, err := ParseVersionCheckInterval(c.VersionCheckIntervalFlag)
+	if err != nil {
+		return 0, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --version-check-interval flag must be a duration like 12h, 0, or off").
+			WithRootCause(err)
+	}
+	return interval, nil
+}
+
 // DeprecatedFlagSubstitutions displays warnings when using deprecated flags and
 // provides alternatives when possible
 func (c *Config) DeprecatedFlagSubstitutions(cmd *cobra.Command) error {
@@ -129,3 +179,31 @@ func (c *Config) DeprecatedFlagSubstitutions(cmd *cobra.Command) error {
 	}
 	return nil
 }
+
+// ResolveFilenameFlags makes relative paths of the filename flags that were set
+// absolute from the working directory
+//
+// Flags are marked as filenames with MarkFlagFilename so these paths point to
+// the same files after changing into a project directory.
+func ResolveFilenameFlags(cmd *cobra.Command, workingDirPath string) error {
+	var err error
+	resolve := func(flag *pflag.Flag) {
+		if err != nil || !flag.Changed || flag.Value.String() == "" || filepath.IsAbs(flag.Value.String()) {
+			return
+		}
+		if _, ok := flag.Annotations[cobra.BashCompFilenameExt]; !ok {
+			return
+		}
+		err = flag.Value.Set(filepath.Join(workingDirPath, flag.Value.String()))
+	}
+	var visit func(*cobra.Command)
+	visit = func(c *cobra.Command) {
+		c.PersistentFlags().VisitAll(resolve)
+		c.Flags().VisitAll(resolve)
+		for _, child := range c.Commands() {
+			visit(child)
+		}
+	}
+	visit(cmd)
+	return err
+}
@@ -16,12 +16,16 @@ package config
 
 import (
 	"bytes"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_SetFlags(t *testing.T) {
@@ -59,9 +63,15 @@ func Test_InitializeGlobalFlags(t *testing.T) {
 			longform:  "app",
 			shorthand: "a",
 		},
+		"config": {
+			longform: "config",
+		},
 		"config-dir": {
 			longform: "config-dir",
 		},
+		"context": {
+			longform: "context",
+		},
 		"experiment": {
 			longform: "experiment",
 		},
@@ -109,6 +119,99 @@ func Test_InitializeGlobalFlags(t *testing.T) {
 	}
 }
 
+func Test_SetContextFlags(t *testing.T) {
+	tests := map[string]struct {
+		team          string
+		app           string
+		token         string
+		preset        ContextPreset
+		expectedTeam  string
+		expectedApp   string
+		expectedToken string
+	}{
+		"sets the unset flags to the context values": {
+			preset:       ContextPreset{Team: "T0123456789", App: "A0123456789"},
+			expectedTeam: "T0123456789",
+			expectedApp:  "A0123456789",
+		},
+		"keeps the values of flags that are set": {
+			team:         "T0000000001",
+			app:          "local",
+			preset:       ContextPreset{Team: "T0123456789", App: "A0123456789"},
+			expectedTeam: "T0000000001",
+			expectedApp:  "local",
+		},
+		"keeps flags unset without context values": {
+			token:         "xoxp-flag",
+			preset:        ContextPreset{Team: "T0123456789"},
+			expectedTeam:  "T0123456789",
+			expectedToken: "xoxp-flag",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := NewConfig(slackdeps.NewFsMock(), slackdeps.NewOsMock())
+			config.TeamFlag = tc.team
+			config.AppFlag = tc.app
+			config.TokenFlag = tc.token
+			config.SetContextFlags(tc.preset)
+			assert.Equal(t, tc.expectedTeam, config.TeamFlag)
+			assert.Equal(t, tc.expectedApp, config.AppFlag)
+			assert.Equal(t, tc.expectedToken, config.TokenFlag)
+		})
+	}
+}
+
+func Test_VersionCheckInterval(t *testing.T) {
+	tests := map[string]struct {
+		flag              string
+		systemInterval    time.Duration
+		expectedInterval  time.Duration
+		expectedErrorCode string
+	}{
+		"uses the interval of the flag": {
+			flag:             "12h",
+			systemInterval:   DefaultVersionCheckInterval,
+			expectedInterval: 12 * time.Hour,
+		},
+		"disables checks with an off flag": {
+			flag:             "off",
+			systemInterval:   DefaultVersionCheckInterval,
+			expectedInterval: 0,
+		},
+		"uses the interval of the system config without a flag": {
+			systemInterval:   6 * time.Hour,
+			expectedInterval: 6 * time.Hour,
+		},
+		"errors for a flag that is not a duration": {
+			flag:              "daily",
+			expectedErrorCode: slackerror.ErrInvalidFlag,
+		},
+		"errors for a negative flag": {
+			flag:              "-1h",
+			expectedErrorCode: slackerror.ErrInvalidFlag,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			systemConfigMock := &SystemConfigMock{}
+			systemConfigMock.On("GetVersionCheckInterval", mock.Anything).Return(tc.systemInterval, nil)
+			config := NewConfig(slackdeps.NewFsMock(), slackdeps.NewOsMock())
+			config.SystemConfig = systemConfigMock
+			config.VersionCheckIntervalFlag = tc.flag
+			interval, err := config.VersionCheckInterval(ctx)
+			if tc.expectedErrorCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorCode, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInterval, interval)
+		})
+	}
+}
+
 func TestDeprecatedFlagSubstitutions(t *testing.T) {
 	tests := map[string]struct {
 		expectedWarnings    []string
@@ -214,3 +317,45 @@ func TestDeprecatedFlagSubstitutions(t *testing.T) {
 		})
 	}
 }
+
+func Test_ResolveFilenameFlags(t *testing.T) {
+	tests := map[string]struct {
+		args         []string
+		expectedFile string
+		expectedName string
+	}{
+		"makes relative paths of filename flags absolute": {
+			args:         []string{"child", "--file", "data/users.txt", "--name", "data/users.txt"},
+			expectedFile: filepath.Join("/path/to/shell", "data", "users.txt"),
+			expectedName: "data/users.txt",
+		},
+		"keeps absolute paths of filename flags": {
+			args:         []string{"child", "--file", "/tmp/users.txt"},
+			expectedFile: "/tmp/users.txt",
+		},
+		"keeps filename flags that are not set": {
+			args: []string{"child"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var file, name string
+			root := &cobra.Command{Use: "root"}
+			child := &cobra.Command{
+				Use: "child",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					return ResolveFilenameFlags(cmd.Root(), "/path/to/shell")
+				},
+			}
+			child.Flags().StringVar(&file, "file", "", "a file")
+			child.Flags().StringVar(&name, "name", "", "not a file")
+			_ = child.MarkFlagFilename("file")
+			root.AddCommand(child)
+			root.SetArgs(tc.args)
+			err := root.Execute()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFile, file)
+			assert.Equal(t, tc.expectedName, name)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// flagsFileUnsupportedKeys are flags used before a config file is read
+var flagsFileUnsupportedKeys = []string{"config", "help", "project-dir", "version"}
+
+// flagsFileContextKeys are flags set from a saved context of the --context flag
+var flagsFileContextKeys = []string{"app", "team", "token"}
+
+// LoadFlagsFile reads the default values of flags from the --config file and
+// sets the global flags of the command that were not set otherwise
+//
+// Keys of the file are flag names without dashes. Flags that are set with a
+// flag or an environment variable keep that value.
+func (c *Config) LoadFlagsFile(cmd *cobra.Command) error {
+	if c.ConfigFileFlag == "" {
+		return nil
+	}
+	data, err := afero.ReadFile(c.fs, c.ConfigFileFlag)
+	if err != nil {
+		return slackerror.New(slackerror.ErrInvalidConfigFile).
+			WithMessage("Failed to read the config file \"%s\"", c.ConfigFileFlag).
+			WithRootCause(err)
+	}
+	values := map[string]any{}
+	switch strings.ToLower(filepath.Ext(c.ConfigFileFlag)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&values)
+	default:
+		err = yaml.Unmarshal(data, &values)
+	}
+	if err != nil {
+		return slackerror.New(slackerror.ErrInvalidConfigFile).
+			WithMessage("Failed to parse the config file \"%s\"", c.ConfigFileFlag).
+			WithRootCause(err)
+	}
+	known := map[string]bool{}
+	var visit func(*cobra.Command)
+	visit = func(command *cobra.Command) {
+		for _, flags := range []*pflag.FlagSet{command.PersistentFlags(), command.Flags()} {
+			flags.VisitAll(func(flag *pflag.Flag) {
+				known[flag.Name] = true
+			})
+		}
+		for _, child := range command.Commands() {
+			visit(child)
+		}
+	}
+	visit(cmd.Root())
+	unknown := []string{}
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		if !known[key] || slices.Contains(flagsFileUnsupportedKeys, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		return slackerror.New(slackerror.ErrInvalidConfigFile).
+			WithMessage("The config file \"%s\" has unknown keys: %s", c.ConfigFileFlag, strings.Join(unknown, ", ")).
+			WithRemediation("Use flag names without dashes as keys, like %s or %s", style.Highlight("team"), style.Highlight("app"))
+	}
+	c.flagsFile = values
+	return c.SetFlagsFromFile(cmd.PersistentFlags())
+}
+
+// SetFlagsFromFile sets the flags in the flag set that were not set otherwise
+// to the values of the --config file
+//
+// Values of a saved context are preferred to the file when --context is set.
+func (c *Config) SetFlagsFromFile(flags *pflag.FlagSet) error {
+	for _, key := range slices.Sorted(maps.Keys(c.flagsFile)) {
+		flag := flags.Lookup(key)
+		if flag == nil || flag.Changed || flag.Value.String() != flag.DefValue {
+			continue
+		}
+		if c.ContextFlag != "" && slices.Contains(flagsFileContextKeys, key) {
+			continue
+		}
+		values, err := flagsFileValues(c.flagsFile[key])
+		if err == nil {
+			err = c.setFlagFromFile(flag, values)
+		}
+		if err != nil {
+			return slackerror.New(slackerror.ErrInvalidConfigFile).
+				WithMessage("The %s value of the config file \"%s\" is not valid", key, c.ConfigFileFlag).
+				WithRootCause(err)
+		}
+	}
+	return nil
+}
+
+// setFlagFromFile sets the flag to values of the config file and resolves the
+// paths of filename flags from the directory of the file
+func (c *Config) setFlagFromFile(flag *pflag.Flag, values []string) error {
+	if slice, ok := flag.Value.(pflag.SliceValue); ok {
+		return slice.Replace(values)
+	}
+	if len(values) != 1 {
+		return fmt.Errorf("the --%s flag does not accept a list", flag.Name)
+	}
+	value := values[0]
+	if _, ok := flag.Annotations[cobra.BashCompFilenameExt]; ok && value != "" && !filepath.IsAbs(value) {
+		value = filepath.Join(filepath.Dir(c.ConfigFileFlag), value)
+	}
+	return flag.Value.Set(value)
+}
+
+// flagsFileValues returns the value of a config file key as flag values
+func flagsFileValues(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, fmt.Errorf("the value is empty")
+	case []any:
+		values := []string{}
+		for _, item := range v {
+			switch item.(type) {
+			case nil, []any, map[any]any, map[string]any:
+				return nil, fmt.Errorf("the list must contain only values")
+			}
+			values = append(values, fmt.Sprint(item))
+		}
+		return values, nil
+	case map[any]any, map[string]any:
+		return nil, fmt.Errorf("the value must be a value or a list of values")
+	default:
+		return []string{fmt.Sprint(v)}, nil
+	}
+}
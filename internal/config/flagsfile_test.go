@@ -0,0 +1,187 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LoadFlagsFile(t *testing.T) {
+	tests := map[string]struct {
+		path                string
+		data                string
+		flags               map[string]string
+		expectedError       string
+		expectedTeam        string
+		expectedApp         string
+		expectedExperiments []string
+		expectedManifest    string
+		expectedTimeout     time.Duration
+	}{
+		"sets global flags from a YAML file": {
+			path:                "/path/to/slack.yaml",
+			data:                "team: T0123456789\napp: A0123456789\nexperiment:\n  - charm\n  - lipgloss\nhook-timeout: 5m\n",
+			expectedTeam:        "T0123456789",
+			expectedApp:         "A0123456789",
+			expectedExperiments: []string{"charm", "lipgloss"},
+			expectedTimeout:     5 * time.Minute,
+		},
+		"sets global flags from a JSON file": {
+			path:                "/path/to/slack.json",
+			data:                `{"team": "T0123456789", "experiment": ["charm"]}`,
+			expectedTeam:        "T0123456789",
+			expectedExperiments: []string{"charm"},
+		},
+		"keeps the values of flags that are set": {
+			path:         "/path/to/slack.yaml",
+			data:         "team: T0123456789\napp: A0123456789\n",
+			flags:        map[string]string{"team": "T0000000001"},
+			expectedTeam: "T0000000001",
+			expectedApp:  "A0123456789",
+		},
+		"prefers the values of a saved context": {
+			path:         "/path/to/slack.yaml",
+			data:         "team: T0123456789\n",
+			flags:        map[string]string{"context": "dev"},
+			expectedTeam: "",
+		},
+		"resolves filename flags from the directory of the file": {
+			path:             "/path/to/slack.yaml",
+			data:             "manifest-path: manifest.json\n",
+			expectedManifest: filepath.Join("/path/to", "manifest.json"),
+		},
+		"allows the flags of commands": {
+			path: "/path/to/slack.yaml",
+			data: "concurrency: 3\n",
+		},
+		"errors for keys that are not flags": {
+			path:          "/path/to/slack.yaml",
+			data:          "team: T0123456789\nteams: T0123456789\n",
+			expectedError: slackerror.ErrInvalidConfigFile,
+		},
+		"errors for flags used before the file is read": {
+			path:          "/path/to/slack.yaml",
+			data:          "project-dir: /path/to/project\n",
+			expectedError: slackerror.ErrInvalidConfigFile,
+		},
+		"errors for a list of a flag that is not a list": {
+			path:          "/path/to/slack.yaml",
+			data:          "team:\n  - T0123456789\n  - T0000000001\n",
+			expectedError: slackerror.ErrInvalidConfigFile,
+		},
+		"errors for a file that cannot be parsed": {
+			path:          "/path/to/slack.json",
+			data:          `{"team": `,
+			expectedError: slackerror.ErrInvalidConfigFile,
+		},
+		"errors for a file that does not exist": {
+			path:          "/path/to/missing.yaml",
+			expectedError: slackerror.ErrInvalidConfigFile,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			if tc.data != "" {
+				err := afero.WriteFile(fs, tc.path, []byte(tc.data), 0600)
+				require.NoError(t, err)
+			}
+			config := NewConfig(fs, os)
+			root := &cobra.Command{Use: "root"}
+			child := &cobra.Command{Use: "deploy"}
+			child.Flags().Int("concurrency", 1, "number of apps to deploy at once")
+			root.AddCommand(child)
+			config.InitializeGlobalFlags(root)
+			for flag, value := range tc.flags {
+				require.NoError(t, root.PersistentFlags().Set(flag, value))
+			}
+			config.ConfigFileFlag = tc.path
+			err := config.LoadFlagsFile(root)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTeam, config.TeamFlag)
+			assert.Equal(t, tc.expectedApp, config.AppFlag)
+			assert.Equal(t, tc.expectedExperiments, config.ExperimentsFlag)
+			assert.Equal(t, tc.expectedManifest, config.ManifestPathFlag)
+			assert.Equal(t, tc.expectedTimeout, config.HookTimeoutFlag)
+		})
+	}
+}
+
+func Test_SetFlagsFromFile(t *testing.T) {
+	tests := map[string]struct {
+		args                []string
+		data                string
+		expectedConcurrency int
+	}{
+		"sets the flags of a command from the file": {
+			args:                []string{"deploy"},
+			data:                "concurrency: 3\n",
+			expectedConcurrency: 3,
+		},
+		"keeps the flags of a command that are set": {
+			args:                []string{"deploy", "--concurrency", "2"},
+			data:                "concurrency: 3\n",
+			expectedConcurrency: 2,
+		},
+		"keeps the defaults of flags without file values": {
+			args:                []string{"deploy"},
+			data:                "team: T0123456789\n",
+			expectedConcurrency: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			err := afero.WriteFile(fs, "/path/to/slack.yaml", []byte(tc.data), 0600)
+			require.NoError(t, err)
+			config := NewConfig(fs, os)
+			config.ConfigFileFlag = "/path/to/slack.yaml"
+			var concurrency int
+			root := &cobra.Command{Use: "root"}
+			child := &cobra.Command{
+				Use: "deploy",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					if err := config.LoadFlagsFile(cmd.Root()); err != nil {
+						return err
+					}
+					return config.SetFlagsFromFile(cmd.Flags())
+				},
+			}
+			child.Flags().IntVar(&concurrency, "concurrency", 1, "number of apps to deploy at once")
+			root.AddCommand(child)
+			config.InitializeGlobalFlags(root)
+			root.SetArgs(tc.args)
+			err = root.Execute()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConcurrency, concurrency)
+		})
+	}
+}
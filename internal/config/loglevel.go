@@ -0,0 +1,71 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+// LogLevel is the threshold of outputs that are printed
+type LogLevel string
+
+const (
+	LogLevelError LogLevel = "error"
+	LogLevelWarn  LogLevel = "warn"
+	LogLevelInfo  LogLevel = "info"
+	LogLevelDebug LogLevel = "debug"
+)
+
+// logLevels are the known levels from the least to the most verbose
+var logLevels = []LogLevel{LogLevelError, LogLevelWarn, LogLevelInfo, LogLevelDebug}
+
+// Includes returns true if outputs at the level are printed at this threshold
+//
+// An unset threshold prints outputs through the info level.
+func (l LogLevel) Includes(level LogLevel) bool {
+	threshold := l
+	if threshold == "" {
+		threshold = LogLevelInfo
+	}
+	return slices.Index(logLevels, level) <= slices.Index(logLevels, threshold)
+}
+
+// SetLogLevel sets the threshold of outputs from the --log-level flag where the
+// --verbose flag is an alias of the debug level
+func (c *Config) SetLogLevel() error {
+	if c.LogLevelFlag == "" {
+		c.LogLevel = LogLevelInfo
+		if c.DebugEnabled {
+			c.LogLevel = LogLevelDebug
+		}
+		return nil
+	}
+	level := LogLevel(strings.ToLower(c.LogLevelFlag))
+	if !slices.Contains(logLevels, level) {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("Invalid log level: %s", c.LogLevelFlag).
+			WithRemediation("Use one of: error, warn, info, debug")
+	}
+	if c.DebugEnabled && level != LogLevelDebug {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --verbose flag cannot be used with the --log-level %s flag", level)
+	}
+	c.LogLevel = level
+	c.DebugEnabled = level == LogLevelDebug
+	return nil
+}
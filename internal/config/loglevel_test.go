@@ -0,0 +1,117 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LogLevel_Includes(t *testing.T) {
+	tests := map[string]struct {
+		threshold LogLevel
+		level     LogLevel
+		expected  bool
+	}{
+		"errors are included at the error level": {
+			threshold: LogLevelError,
+			level:     LogLevelError,
+			expected:  true,
+		},
+		"warnings are excluded at the error level": {
+			threshold: LogLevelError,
+			level:     LogLevelWarn,
+			expected:  false,
+		},
+		"info is included at the debug level": {
+			threshold: LogLevelDebug,
+			level:     LogLevelInfo,
+			expected:  true,
+		},
+		"info is included without a threshold": {
+			level:    LogLevelInfo,
+			expected: true,
+		},
+		"debug is excluded without a threshold": {
+			level:    LogLevelDebug,
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.threshold.Includes(tc.level))
+		})
+	}
+}
+
+func Test_Config_SetLogLevel(t *testing.T) {
+	tests := map[string]struct {
+		logLevelFlag  string
+		debugEnabled  bool
+		expectedLevel LogLevel
+		expectedDebug bool
+		expectedError string
+	}{
+		"defaults to the info level": {
+			expectedLevel: LogLevelInfo,
+		},
+		"uses the debug level with the verbose flag": {
+			debugEnabled:  true,
+			expectedLevel: LogLevelDebug,
+			expectedDebug: true,
+		},
+		"enables debug outputs with the debug level": {
+			logLevelFlag:  "DEBUG",
+			expectedLevel: LogLevelDebug,
+			expectedDebug: true,
+		},
+		"sets the warn level": {
+			logLevelFlag:  "warn",
+			expectedLevel: LogLevelWarn,
+		},
+		"allows the verbose flag with the debug level": {
+			logLevelFlag:  "debug",
+			debugEnabled:  true,
+			expectedLevel: LogLevelDebug,
+			expectedDebug: true,
+		},
+		"errors for an unknown level": {
+			logLevelFlag:  "trace",
+			expectedError: slackerror.ErrInvalidFlag,
+		},
+		"errors for the verbose flag with another level": {
+			logLevelFlag:  "error",
+			debugEnabled:  true,
+			expectedError: slackerror.ErrMismatchedFlags,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &Config{LogLevelFlag: tc.logLevelFlag, DebugEnabled: tc.debugEnabled}
+			err := c.SetLogLevel()
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLevel, c.LogLevel)
+			assert.Equal(t, tc.expectedDebug, c.DebugEnabled)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -52,6 +53,7 @@ type ProjectConfigManager interface {
 	GetProjectID(ctx context.Context) (string, error)
 	SetProjectID(ctx context.Context, projectID string) (string, error)
 	GetManifestSource(ctx context.Context) (ManifestSource, error)
+	GetDeployGitMetadata(ctx context.Context) (bool, error)
 	GetSurveyConfig(ctx context.Context, name string) (SurveyConfig, error)
 	SetSurveyConfig(ctx context.Context, name string, surveyConfig SurveyConfig) error
 
@@ -60,6 +62,7 @@ type ProjectConfigManager interface {
 
 // ProjectConfig is the project-level config file
 type ProjectConfig struct {
+	Deploy      *DeployConfig           `json:"deploy,omitempty"`
 	Experiments map[string]bool         `json:"experiments,omitempty"`
 	Manifest    *ManifestConfig         `json:"manifest,omitempty"`
 	ProjectID   string                  `json:"project_id,omitempty"`
@@ -72,6 +75,12 @@ type ProjectConfig struct {
 	os types.Os
 }
 
+// DeployConfig holds the deploy preferences of a project
+type DeployConfig struct {
+	// GitMetadata notes the git commit and branch of each deploy in the CLI logs
+	GitMetadata bool `json:"git_metadata,omitempty"`
+}
+
 // NewProjectConfig read and writes to the project-level configuration file
 func NewProjectConfig(fs afero.Fs, os types.Os) *ProjectConfig {
 	projectConfig := &ProjectConfig{
@@ -189,6 +198,60 @@ func SetManifestSource(ctx context.Context, fs afero.Fs, os types.Os, source Man
 	return nil
 }
 
+// GetDeployGitMetadata returns if deploys of the project note git details
+func (c *ProjectConfig) GetDeployGitMetadata(ctx context.Context) (bool, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "GetDeployGitMetadata")
+	defer span.Finish()
+
+	var projectConfig, err = ReadProjectConfigFile(ctx, c.fs, c.os)
+	if err != nil {
+		return false, err
+	}
+	if projectConfig.Deploy == nil {
+		return false, nil
+	}
+	return projectConfig.Deploy.GitMetadata, nil
+}
+
+// SetDeployGitMetadata saves if deploys of the project note git details
+func SetDeployGitMetadata(ctx context.Context, fs afero.Fs, os types.Os, enabled bool) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SetDeployGitMetadata")
+	defer span.Finish()
+	projectConfig, err := ReadProjectConfigFile(ctx, fs, os)
+	if err != nil {
+		return err
+	}
+	if projectConfig.Deploy == nil {
+		projectConfig.Deploy = &DeployConfig{}
+	}
+	projectConfig.Deploy.GitMetadata = enabled
+	_, err = WriteProjectConfigFile(ctx, fs, os, projectConfig)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetProjectExperiment toggles an experiment in the project-level config file
+func SetProjectExperiment(ctx context.Context, fs afero.Fs, os types.Os, exp experiment.Experiment, enabled bool) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SetProjectExperiment")
+	defer span.Finish()
+	projectConfig, err := ReadProjectConfigFile(ctx, fs, os)
+	if err != nil {
+		return err
+	}
+	if projectConfig.Experiments == nil {
+		projectConfig.Experiments = map[string]bool{}
+	}
+	projectConfig.Experiments[string(exp)] = enabled
+	_, err = WriteProjectConfigFile(ctx, fs, os, projectConfig)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetSurveyConfig reads the survey for the given survey ID from the project-level config file
 func (c *ProjectConfig) GetSurveyConfig(ctx context.Context, name string) (SurveyConfig, error) {
 	var span opentracing.Span
@@ -341,6 +404,29 @@ func GetProjectDirPath(fs afero.Fs, os types.Os) (string, error) {
 	return currentDir, nil
 }
 
+// ResolveProjectDirPath returns the absolute path of a project directory or an
+// error if the directory does not contain the project hooks file
+func ResolveProjectDirPath(fs afero.Fs, os types.Os, dirPath string) (string, error) {
+	if !filepath.IsAbs(dirPath) {
+		currentDir, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		dirPath = filepath.Join(currentDir, dirPath)
+	}
+	dirPath = filepath.Clean(dirPath)
+	info, err := fs.Stat(dirPath)
+	if err != nil || !info.IsDir() {
+		return "", slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --project-dir flag must be a directory: %s", dirPath)
+	}
+	if _, err := fs.Stat(GetProjectHooksJSONFilePath(dirPath)); err != nil {
+		return "", slackerror.New(slackerror.ErrInvalidAppDirectory).
+			WithMessage("The directory %s is not a Slack project", dirPath)
+	}
+	return dirPath, nil
+}
+
 // Cache loads the cached project values
 func (c *ProjectConfig) Cache() cache.Cacher {
 	path, err := GetProjectDirPath(c.fs, c.os)
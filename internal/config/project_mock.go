@@ -31,6 +31,7 @@ func NewProjectConfigMock() *ProjectConfigMock {
 
 func (m *ProjectConfigMock) AddDefaultMocks() {
 	m.On("GetManifestSource", mock.Anything).Return(ManifestSourceLocal, nil)
+	m.On("GetDeployGitMetadata", mock.Anything).Return(false, nil)
 }
 
 func (m *ProjectConfigMock) InitProjectID(ctx context.Context, overwriteExistingProjectID bool) (string, error) {
@@ -53,6 +54,11 @@ func (m *ProjectConfigMock) GetManifestSource(ctx context.Context) (ManifestSour
 	return args.Get(0).(ManifestSource), args.Error(1)
 }
 
+func (m *ProjectConfigMock) GetDeployGitMetadata(ctx context.Context) (bool, error) {
+	args := m.Called(ctx)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *ProjectConfigMock) GetSurveyConfig(ctx context.Context, id string) (SurveyConfig, error) {
 	args := m.Called(ctx, id)
 	return args.Get(0).(SurveyConfig), args.Error(1)
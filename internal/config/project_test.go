@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackdeps"
@@ -272,6 +273,21 @@ func Test_ProjectConfig_ManifestSource(t *testing.T) {
 	}
 }
 
+func Test_ProjectConfig_SetProjectExperiment(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fs := slackdeps.NewFsMock()
+	os := slackdeps.NewOsMock()
+	os.AddDefaultMocks()
+	addProjectMocks(t, fs)
+	err := SetProjectExperiment(ctx, fs, os, experiment.Placeholder, true)
+	require.NoError(t, err)
+	err = SetProjectExperiment(ctx, fs, os, experiment.Lipgloss, false)
+	require.NoError(t, err)
+	projectConfig, err := ReadProjectConfigFile(ctx, fs, os)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"lipgloss": false, "placeholder": true}, projectConfig.Experiments)
+}
+
 func Test_ProjectConfig_ReadProjectConfigFile(t *testing.T) {
 	t.Run("When not a project directory, should return an error", func(t *testing.T) {
 		ctx := slackcontext.MockContext(t.Context())
@@ -507,6 +523,69 @@ func Test_ProjectConfig_GetProjectDirPath(t *testing.T) {
 	})
 }
 
+func Test_ProjectConfig_ResolveProjectDirPath(t *testing.T) {
+	tests := map[string]struct {
+		dirPath          string
+		setup            func(t *testing.T, fs afero.Fs)
+		expectedDirPath  string
+		expectedErrorKey string
+	}{
+		"returns a relative path joined with the working directory": {
+			dirPath: filepath.Join("apps", "foo"),
+			setup: func(t *testing.T, fs afero.Fs) {
+				dirPath := filepath.Join(slackdeps.MockWorkingDirectory, "apps", "foo")
+				require.NoError(t, fs.MkdirAll(filepath.Join(dirPath, ProjectConfigDirName), 0755))
+				require.NoError(t, afero.WriteFile(fs, GetProjectHooksJSONFilePath(dirPath), []byte("{}\n"), 0644))
+			},
+			expectedDirPath: filepath.Join(slackdeps.MockWorkingDirectory, "apps", "foo"),
+		},
+		"returns an absolute path unchanged": {
+			dirPath: slackdeps.MockWorkingDirectory,
+			setup: func(t *testing.T, fs afero.Fs) {
+				addProjectMocks(t, fs)
+			},
+			expectedDirPath: slackdeps.MockWorkingDirectory,
+		},
+		"errors if the directory does not exist": {
+			dirPath:          filepath.Join("apps", "missing"),
+			expectedErrorKey: slackerror.ErrInvalidFlag,
+		},
+		"errors if the path is a file": {
+			dirPath: "README.md",
+			setup: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(slackdeps.MockWorkingDirectory, "README.md"), []byte("# App\n"), 0644))
+			},
+			expectedErrorKey: slackerror.ErrInvalidFlag,
+		},
+		"errors if the directory is missing the hooks file": {
+			dirPath: filepath.Join("apps", "bar"),
+			setup: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, fs.MkdirAll(filepath.Join(slackdeps.MockWorkingDirectory, "apps", "bar", ProjectConfigDirName), 0755))
+			},
+			expectedErrorKey: slackerror.ErrInvalidAppDirectory,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.AddDefaultMocks()
+			if tc.setup != nil {
+				tc.setup(t, fs)
+			}
+			dirPath, err := ResolveProjectDirPath(fs, os, tc.dirPath)
+			if tc.expectedErrorKey != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorKey, slackerror.ToSlackError(err).Code)
+				assert.Empty(t, dirPath)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedDirPath, dirPath)
+			}
+		})
+	}
+}
+
 func Test_ProjectConfig_Cache(t *testing.T) {
 	tests := map[string]struct {
 		mockAppID    string
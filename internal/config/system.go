@@ -20,12 +20,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
+	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -38,15 +41,44 @@ const credentialsFileName = "credentials.json"
 const configFolderName = ".slack"
 const configFileName = "config.json"
 const logsFolderName = "logs"
+const profilesFolderName = "profiles"
+
+// DefaultProfileName is the profile that uses the base configuration directory
+const DefaultProfileName = "default"
+
+// profileNamePattern matches the names of profiles that can be saved
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// DefaultAuthExpiryWarningDays is the number of days before an authorization
+// expires that a warning is shown when not otherwise configured
+const DefaultAuthExpiryWarningDays = 7
+
+// DefaultVersionCheckInterval is the time between checks for a newer version
+// when not otherwise configured
+const DefaultVersionCheckInterval = 24 * time.Hour
 
 // SystemConfigManager is the interface for interacting with the system config
 type SystemConfigManager interface {
 	SetCustomConfigDirPath(customConfigDirPath string)
+	InitCustomConfigDir(ctx context.Context) error
+	SetProfile(profile string)
+	GetProfile() string
+	ListProfiles(ctx context.Context) ([]string, error)
 	UserConfig(ctx context.Context) (*SystemConfig, error)
 	SlackConfigDir(ctx context.Context) (string, error)
 	LogsDir(ctx context.Context) (string, error)
+	GetAuthExpiryWarningDays(ctx context.Context) (int, error)
+	SetAuthExpiryWarningDays(ctx context.Context, days int) error
+	GetContextPreset(ctx context.Context, name string) (ContextPreset, error)
+	SetContextPreset(ctx context.Context, name string, preset ContextPreset) error
+	ListContextPresets(ctx context.Context) (map[string]ContextPreset, error)
+	GetCredentialStore(ctx context.Context) (CredentialStore, error)
+	SetCredentialStore(ctx context.Context, store CredentialStore) error
 	GetTrustUnknownSources(ctx context.Context) (bool, error)
 	SetTrustUnknownSources(ctx context.Context, value bool) error
+	GetVersionCheckInterval(ctx context.Context) (time.Duration, error)
+	SetVersionCheckInterval(ctx context.Context, interval time.Duration) error
+	SetExperiment(ctx context.Context, exp experiment.Experiment, enabled bool) error
 	GetLastUpdateCheckedAt(ctx context.Context) (time.Time, error)
 	SetLastUpdateCheckedAt(ctx context.Context, lastUpdateCheckedAt time.Time) (path string, err error)
 	InitSystemID(ctx context.Context) (string, error)
@@ -61,13 +93,25 @@ type SystemConfigManager interface {
 	unlock()
 }
 
+// ContextPreset is a named selection of the team and app flags
+//
+// Tokens are saved with the credentials of the team rather than in a context.
+type ContextPreset struct {
+	App  string `json:"app,omitempty"`
+	Team string `json:"team,omitempty"`
+}
+
 // SystemConfig contains the system-level config file
 type SystemConfig struct {
-	Experiments         map[string]bool         `json:"experiments,omitempty"`
-	LastUpdateCheckedAt time.Time               `json:"last_update_checked_at,omitempty"`
-	Surveys             map[string]SurveyConfig `json:"surveys,omitempty"`
-	SystemID            string                  `json:"system_id,omitempty"`
-	TrustUnknownSources bool                    `json:"trust_unknown_sources,omitempty"`
+	AuthExpiryWarningDays *int                     `json:"auth_expiry_warning_days,omitempty"`
+	Contexts              map[string]ContextPreset `json:"contexts,omitempty"`
+	CredentialStore       CredentialStore          `json:"credential_store,omitempty"`
+	Experiments           map[string]bool          `json:"experiments,omitempty"`
+	LastUpdateCheckedAt   time.Time                `json:"last_update_checked_at,omitempty"`
+	Surveys               map[string]SurveyConfig  `json:"surveys,omitempty"`
+	SystemID              string                   `json:"system_id,omitempty"`
+	TrustUnknownSources   bool                     `json:"trust_unknown_sources,omitempty"`
+	VersionCheckInterval  *string                  `json:"version_check_interval,omitempty"`
 
 	// fs is the file system module that's shared by all packages and enables testing & mock of the file system
 	fs afero.Fs
@@ -78,6 +122,9 @@ type SystemConfig struct {
 	// customConfigDirPath is an optional path for the SystemConfig directory
 	customConfigDirPath string
 
+	// profile is an optional name of the profile directory for configurations
+	profile string
+
 	// configFileLock is a file locking mutex that works across goroutines for configFileName
 	configFileLock sync.Mutex
 }
@@ -105,6 +152,82 @@ func (c *SystemConfig) SetCustomConfigDirPath(customConfigDirPath string) {
 	c.customConfigDirPath = strings.TrimSpace(customConfigDirPath)
 }
 
+// InitCustomConfigDir creates a custom system config directory if missing and
+// errors if files cannot be written to it
+func (c *SystemConfig) InitCustomConfigDir(ctx context.Context) error {
+	if c.customConfigDirPath == "" {
+		return nil
+	}
+	if _, err := c.fs.Stat(c.customConfigDirPath); os.IsNotExist(err) {
+		if err := c.fs.MkdirAll(c.customConfigDirPath, 0755); err != nil {
+			return slackerror.New(slackerror.ErrHomeDirectoryAccessFailed).
+				WithMessage("Failed to create the config directory %s", c.customConfigDirPath).
+				WithRemediation("Choose a directory that can be created with the --config-dir flag or the %s environment variable", slackCLIConfigDirEnv).
+				WithRootCause(err)
+		}
+	}
+	file, err := afero.TempFile(c.fs, c.customConfigDirPath, ".write-check-")
+	if err != nil {
+		return slackerror.New(slackerror.ErrHomeDirectoryAccessFailed).
+			WithMessage("Failed to write to the config directory %s", c.customConfigDirPath).
+			WithRemediation("Check permissions of the directory or choose a writable directory with the --config-dir flag or the %s environment variable", slackCLIConfigDirEnv).
+			WithRootCause(err)
+	}
+	_ = file.Close()
+	_ = c.fs.Remove(file.Name())
+	return nil
+}
+
+// SetProfile sets the name of the profile that configurations are saved to
+func (c *SystemConfig) SetProfile(profile string) {
+	c.profile = strings.TrimSpace(profile)
+}
+
+// GetProfile returns the name of the active profile or an empty string for the
+// default configurations
+func (c *SystemConfig) GetProfile() string {
+	return c.profile
+}
+
+// ListProfiles returns the sorted names of profiles saved to the system
+// configuration directory
+func (c *SystemConfig) ListProfiles(ctx context.Context) ([]string, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "ListProfiles")
+	defer span.Finish()
+
+	configDirPath, err := c.baseConfigDir(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := afero.ReadDir(c.fs, filepath.Join(configDirPath, profilesFolderName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	profiles := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultProfileName && ValidateProfileName(entry.Name()) == nil {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
+
+// ValidateProfileName errors if a profile name is not safe to use as a
+// directory name
+func ValidateProfileName(profile string) error {
+	if !profileNamePattern.MatchString(profile) {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The profile name \"%s\" is not valid", profile).
+			WithRemediation("Use only letters, numbers, dashes, and underscores in profile names")
+	}
+	return nil
+}
+
 // UserConfig returns the system-level config.json file contents
 func (c *SystemConfig) UserConfig(ctx context.Context) (*SystemConfig, error) {
 	var span opentracing.Span
@@ -166,6 +289,29 @@ func (c *SystemConfig) SlackConfigDir(ctx context.Context) (string, error) {
 	span, ctx = opentracing.StartSpanFromContext(ctx, "SlackConfigDir")
 	defer span.Finish()
 
+	configDirPath, err := c.baseConfigDir(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Separate the configurations of a profile into a nested directory
+	if c.profile != "" {
+		configDirPath = filepath.Join(configDirPath, profilesFolderName, c.profile)
+		if err := c.fs.MkdirAll(configDirPath, 0755); err != nil {
+			return "", slackerror.Wrap(err, slackerror.ErrHomeDirectoryAccessFailed)
+		}
+	}
+
+	// Initialize the config files
+	if err := c.initializeConfigFiles(ctx, configDirPath); err != nil {
+		return "", err
+	}
+
+	return configDirPath, nil
+}
+
+// baseConfigDir returns the system configuration directory without a profile
+func (c *SystemConfig) baseConfigDir(ctx context.Context) (string, error) {
 	var configDirPath = ""
 
 	// Try to use a custom config directory
@@ -192,11 +338,6 @@ func (c *SystemConfig) SlackConfigDir(ctx context.Context) (string, error) {
 		}
 	}
 
-	// Initialize the config files
-	if err := c.initializeConfigFiles(ctx, configDirPath); err != nil {
-		return "", err
-	}
-
 	return configDirPath, nil
 }
 
@@ -387,6 +528,164 @@ func (c *SystemConfig) SetSurveyConfig(ctx context.Context, name string, surveyC
 	return nil
 }
 
+// GetAuthExpiryWarningDays reads the auth_expiry_warning_days property from the
+// user-level config file or returns the default when unset
+func (c *SystemConfig) GetAuthExpiryWarningDays(ctx context.Context) (int, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "GetAuthExpiryWarningDays")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return DefaultAuthExpiryWarningDays, err
+	}
+	if userConfig.AuthExpiryWarningDays == nil {
+		return DefaultAuthExpiryWarningDays, nil
+	}
+	return *userConfig.AuthExpiryWarningDays, nil
+}
+
+// SetAuthExpiryWarningDays sets the auth_expiry_warning_days property to the user-level config file
+func (c *SystemConfig) SetAuthExpiryWarningDays(ctx context.Context, days int) error {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "SetAuthExpiryWarningDays")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	userConfig.AuthExpiryWarningDays = &days
+
+	b, err := json.MarshalIndent(userConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir, err := c.SlackConfigDir(ctx)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, configFileName)
+
+	return c.writeConfigFile(path, b)
+}
+
+// GetCredentialStore reads the credential_store property from the user-level
+// config file or returns the automatic store when unset
+func (c *SystemConfig) GetCredentialStore(ctx context.Context) (CredentialStore, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "GetCredentialStore")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return CredentialStoreAuto, err
+	}
+	if userConfig.CredentialStore == "" {
+		return CredentialStoreAuto, nil
+	}
+	return userConfig.CredentialStore, nil
+}
+
+// SetCredentialStore sets the credential_store property to the user-level config file
+func (c *SystemConfig) SetCredentialStore(ctx context.Context, store CredentialStore) error {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "SetCredentialStore")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	userConfig.CredentialStore = store
+
+	b, err := json.MarshalIndent(userConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir, err := c.SlackConfigDir(ctx)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, configFileName)
+
+	return c.writeConfigFile(path, b)
+}
+
+// GetContextPreset reads the named context from the user-level config file and
+// errors if the context is not saved
+func (c *SystemConfig) GetContextPreset(ctx context.Context, name string) (ContextPreset, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "GetContextPreset")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return ContextPreset{}, err
+	}
+	preset, ok := userConfig.Contexts[name]
+	if !ok {
+		return ContextPreset{}, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The context \"%s\" was not found", name).
+			WithRemediation("List the saved contexts with %s", style.Commandf("config context list", false))
+	}
+	return preset, nil
+}
+
+// SetContextPreset writes the named context to the user-level config file
+func (c *SystemConfig) SetContextPreset(ctx context.Context, name string, preset ContextPreset) error {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "SetContextPreset")
+	defer span.Finish()
+
+	if !profileNamePattern.MatchString(name) {
+		return slackerror.New(slackerror.ErrInvalidArguments).
+			WithMessage("The context name \"%s\" is not valid", name).
+			WithRemediation("Use only letters, numbers, dashes, and underscores in context names")
+	}
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if userConfig.Contexts == nil {
+		userConfig.Contexts = map[string]ContextPreset{}
+	}
+	userConfig.Contexts[name] = preset
+
+	b, err := json.MarshalIndent(userConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir, err := c.SlackConfigDir(ctx)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, configFileName)
+
+	return c.writeConfigFile(path, b)
+}
+
+// ListContextPresets reads the saved contexts from the user-level config file
+func (c *SystemConfig) ListContextPresets(ctx context.Context) (map[string]ContextPreset, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "ListContextPresets")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userConfig.Contexts == nil {
+		return map[string]ContextPreset{}, nil
+	}
+	return userConfig.Contexts, nil
+}
+
 // GetTrustUnknownSources reads the TrustUnknownSources property from the user-level config file
 func (c *SystemConfig) GetTrustUnknownSources(ctx context.Context) (bool, error) {
 	var span opentracing.Span
@@ -431,6 +730,36 @@ func (c *SystemConfig) SetTrustUnknownSources(ctx context.Context, value bool) e
 	return nil
 }
 
+// SetExperiment toggles an experiment in the user-level config file
+func (c *SystemConfig) SetExperiment(ctx context.Context, exp experiment.Experiment, enabled bool) error {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "SetExperiment")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	if userConfig.Experiments == nil {
+		userConfig.Experiments = map[string]bool{}
+	}
+	userConfig.Experiments[string(exp)] = enabled
+
+	b, err := json.MarshalIndent(userConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir, err := c.SlackConfigDir(ctx)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, configFileName)
+
+	return c.writeConfigFile(path, b)
+}
+
 // initializeConfigFolder creates the required files (credentials.json and config.json)
 // in the /.slack/ folder if they do not yet exist
 func (c *SystemConfig) initializeConfigFiles(ctx context.Context, dir string) error {
@@ -499,3 +828,74 @@ func (c *SystemConfig) writeConfigFile(configFilePath string, configFileBytes []
 	err := afero.WriteFile(c.fs, configFilePath, configFileBytes, 0600)
 	return err
 }
+
+// ParseVersionCheckInterval parses a duration like 12h between checks for a
+// newer version where "0" and "off" disable the checks
+func ParseVersionCheckInterval(value string) (time.Duration, error) {
+	if value == "off" || value == "0" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if interval < 0 {
+		return 0, fmt.Errorf("negative duration %s", value)
+	}
+	return interval, nil
+}
+
+// GetVersionCheckInterval reads the version_check_interval property from the
+// user-level config file or returns the default when unset
+func (c *SystemConfig) GetVersionCheckInterval(ctx context.Context) (time.Duration, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "GetVersionCheckInterval")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return DefaultVersionCheckInterval, err
+	}
+	if userConfig.VersionCheckInterval == nil {
+		return DefaultVersionCheckInterval, nil
+	}
+	interval, err := ParseVersionCheckInterval(*userConfig.VersionCheckInterval)
+	if err != nil {
+		return DefaultVersionCheckInterval, slackerror.New(slackerror.ErrConfigValueInvalid).
+			WithMessage("The version_check_interval value \"%s\" is not a valid duration", *userConfig.VersionCheckInterval).
+			WithRootCause(err)
+	}
+	return interval, nil
+}
+
+// SetVersionCheckInterval sets the version_check_interval property to the
+// user-level config file where 0 disables checks for a newer version
+func (c *SystemConfig) SetVersionCheckInterval(ctx context.Context, interval time.Duration) error {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "SetVersionCheckInterval")
+	defer span.Finish()
+
+	var userConfig, err = c.UserConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	value := "off"
+	if interval > 0 {
+		value = interval.String()
+	}
+	userConfig.VersionCheckInterval = &value
+
+	b, err := json.MarshalIndent(userConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir, err := c.SlackConfigDir(ctx)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, configFileName)
+
+	return c.writeConfigFile(path, b)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -29,6 +30,25 @@ func (m *SystemConfigMock) SetCustomConfigDirPath(customConfigDirPath string) {
 	m.Called(customConfigDirPath)
 }
 
+func (m *SystemConfigMock) InitCustomConfigDir(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *SystemConfigMock) SetProfile(profile string) {
+	m.Called(profile)
+}
+
+func (m *SystemConfigMock) GetProfile() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *SystemConfigMock) ListProfiles(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *SystemConfigMock) UserConfig(ctx context.Context) (*SystemConfig, error) {
 	args := m.Called(ctx)
 	return args.Get(0).(*SystemConfig), args.Error(1)
@@ -79,6 +99,51 @@ func (m *SystemConfigMock) SetSurveyConfig(ctx context.Context, id string, surve
 	return args.Error(0)
 }
 
+func (m *SystemConfigMock) GetAuthExpiryWarningDays(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *SystemConfigMock) SetAuthExpiryWarningDays(ctx context.Context, days int) error {
+	args := m.Called(ctx, days)
+	return args.Error(0)
+}
+
+func (m *SystemConfigMock) GetVersionCheckInterval(ctx context.Context) (time.Duration, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *SystemConfigMock) SetVersionCheckInterval(ctx context.Context, interval time.Duration) error {
+	args := m.Called(ctx, interval)
+	return args.Error(0)
+}
+
+func (m *SystemConfigMock) GetContextPreset(ctx context.Context, name string) (ContextPreset, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(ContextPreset), args.Error(1)
+}
+
+func (m *SystemConfigMock) SetContextPreset(ctx context.Context, name string, preset ContextPreset) error {
+	args := m.Called(ctx, name, preset)
+	return args.Error(0)
+}
+
+func (m *SystemConfigMock) ListContextPresets(ctx context.Context) (map[string]ContextPreset, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]ContextPreset), args.Error(1)
+}
+
+func (m *SystemConfigMock) GetCredentialStore(ctx context.Context) (CredentialStore, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(CredentialStore), args.Error(1)
+}
+
+func (m *SystemConfigMock) SetCredentialStore(ctx context.Context, store CredentialStore) error {
+	args := m.Called(ctx, store)
+	return args.Error(0)
+}
+
 func (m *SystemConfigMock) GetTrustUnknownSources(ctx context.Context) (bool, error) {
 	args := m.Called(ctx)
 	return args.Bool(0), args.Error(1)
@@ -89,6 +154,11 @@ func (m *SystemConfigMock) SetTrustUnknownSources(ctx context.Context, value boo
 	return args.Error(0)
 }
 
+func (m *SystemConfigMock) SetExperiment(ctx context.Context, exp experiment.Experiment, enabled bool) error {
+	args := m.Called(ctx, exp, enabled)
+	return args.Error(0)
+}
+
 func (m *SystemConfigMock) initializeConfigFiles(ctx context.Context, dir string) error {
 	args := m.Called(ctx, dir)
 	return args.Error(0)
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -52,6 +53,56 @@ func Test_SystemConfig_SetCustomConfigDirPath(t *testing.T) {
 	require.Equal(t, "", systemConfig.customConfigDirPath)
 }
 
+func Test_SystemConfig_InitCustomConfigDir(t *testing.T) {
+	tests := map[string]struct {
+		fs            afero.Fs
+		customPath    string
+		expectedError string
+	}{
+		"does nothing without a custom path": {
+			fs: afero.NewMemMapFs(),
+		},
+		"creates a missing custom directory": {
+			fs:         afero.NewMemMapFs(),
+			customPath: slackdeps.MockCustomConfigDirectory,
+		},
+		"errors if the custom directory cannot be created": {
+			fs:            afero.NewReadOnlyFs(afero.NewMemMapFs()),
+			customPath:    slackdeps.MockCustomConfigDirectory,
+			expectedError: "Failed to create the config directory",
+		},
+		"errors if the custom directory is not writable": {
+			fs: func() afero.Fs {
+				base := afero.NewMemMapFs()
+				_ = base.MkdirAll(slackdeps.MockCustomConfigDirectory, 0755)
+				return afero.NewReadOnlyFs(base)
+			}(),
+			customPath:    slackdeps.MockCustomConfigDirectory,
+			expectedError: "Failed to write to the config directory",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			systemConfig := NewSystemConfig(tc.fs, slackdeps.NewOsMock())
+			systemConfig.SetCustomConfigDirPath(tc.customPath)
+			err := systemConfig.InitCustomConfigDir(ctx)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrHomeDirectoryAccessFailed, slackerror.ToSlackError(err).Code)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.customPath != "" {
+				entries, err := afero.ReadDir(tc.fs, tc.customPath)
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+			}
+		})
+	}
+}
+
 func Test_SystemConfig_UserConfig(t *testing.T) {
 	t.Run("Error reading configuration directory", func(t *testing.T) {
 		ctx := slackcontext.MockContext(t.Context())
@@ -243,6 +294,83 @@ func Test_Config_SlackConfigDir(t *testing.T) {
 	})
 }
 
+func Test_SystemConfig_Profiles(t *testing.T) {
+	t.Run("Return a nested profile directory with configuration files", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+		os.AddDefaultMocks()
+
+		config := NewConfig(fs, os)
+		config.SystemConfig.SetProfile("work")
+		dir, err := config.SystemConfig.SlackConfigDir(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(slackdeps.MockHomeDirectory, configFolderName, profilesFolderName, "work"), dir)
+		assert.Equal(t, "work", config.SystemConfig.GetProfile())
+		_, err = fs.Stat(filepath.Join(dir, configFileName))
+		assert.NoError(t, err)
+		_, err = fs.Stat(filepath.Join(dir, credentialsFileName))
+		assert.NoError(t, err)
+	})
+
+	t.Run("List the saved profiles in order", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+		os.AddDefaultMocks()
+
+		config := NewConfig(fs, os)
+		profiles, err := config.SystemConfig.ListProfiles(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, profiles)
+
+		for _, profile := range []string{"work", "personal"} {
+			config.SystemConfig.SetProfile(profile)
+			_, err := config.SystemConfig.SlackConfigDir(ctx)
+			require.NoError(t, err)
+		}
+		err = afero.WriteFile(fs, filepath.Join(slackdeps.MockHomeDirectory, configFolderName, profilesFolderName, "notes.txt"), []byte{}, 0644)
+		require.NoError(t, err)
+		profiles, err = config.SystemConfig.ListProfiles(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"personal", "work"}, profiles)
+	})
+}
+
+func Test_ValidateProfileName(t *testing.T) {
+	tests := map[string]struct {
+		profile       string
+		expectedError bool
+	}{
+		"allows letters, numbers, dashes, and underscores": {
+			profile: "work_2-east",
+		},
+		"errors for an empty name": {
+			profile:       "",
+			expectedError: true,
+		},
+		"errors for a path separator": {
+			profile:       "../work",
+			expectedError: true,
+		},
+		"errors for spaces": {
+			profile:       "my work",
+			expectedError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateProfileName(tc.profile)
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_SystemConfig_LogsDir(t *testing.T) {
 	t.Run("Create logs folder in .slack directory", func(t *testing.T) {
 		ctx := slackcontext.MockContext(t.Context())
@@ -624,6 +752,170 @@ func Test_SystemConfig_writeConfigFile(t *testing.T) {
 	})
 }
 
+func Test_SystemConfig_GetAuthExpiryWarningDays(t *testing.T) {
+	t.Run("When no auth_expiry_warning_days is set, should return the default", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+
+		// Use default mocks to return home directory path
+		os.AddDefaultMocks()
+
+		config := NewConfig(fs, os)
+		days, err := config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, DefaultAuthExpiryWarningDays, days)
+	})
+
+	t.Run("When auth_expiry_warning_days is set to zero, should return zero", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+
+		// Use default mocks to return home directory path
+		os.AddDefaultMocks()
+
+		// Set an auth_expiry_warning_days
+		err := afero.WriteFile(fs, filepath.Join(slackdeps.MockHomeDirectory, configFolderName, configFileName), []byte(`{"auth_expiry_warning_days":0}`), 0600)
+		require.NoError(t, err)
+
+		config := NewConfig(fs, os)
+		days, err := config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 0, days)
+	})
+}
+
+func Test_SystemConfig_SetAuthExpiryWarningDays(t *testing.T) {
+	t.Run("Should update the auth_expiry_warning_days", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+
+		// Use default mocks to return home directory path
+		os.AddDefaultMocks()
+
+		config := NewConfig(fs, os)
+
+		err := config.SystemConfig.SetAuthExpiryWarningDays(ctx, 14)
+		require.NoError(t, err)
+		days, err := config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 14, days)
+	})
+}
+
+func Test_SystemConfig_CredentialStore(t *testing.T) {
+	tests := map[string]struct {
+		configFileData string
+		store          CredentialStore
+		expected       CredentialStore
+	}{
+		"returns the automatic store when unset": {
+			configFileData: `{}`,
+			expected:       CredentialStoreAuto,
+		},
+		"returns the saved credential store": {
+			configFileData: `{"credential_store":"file"}`,
+			expected:       CredentialStoreFile,
+		},
+		"updates the credential store": {
+			configFileData: `{"credential_store":"file"}`,
+			store:          CredentialStoreKeychain,
+			expected:       CredentialStoreKeychain,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.AddDefaultMocks()
+			err := afero.WriteFile(fs, filepath.Join(slackdeps.MockHomeDirectory, configFolderName, configFileName), []byte(tc.configFileData), 0600)
+			require.NoError(t, err)
+
+			config := NewConfig(fs, os)
+			if tc.store != "" {
+				err = config.SystemConfig.SetCredentialStore(ctx, tc.store)
+				require.NoError(t, err)
+			}
+			store, err := config.SystemConfig.GetCredentialStore(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, store)
+		})
+	}
+}
+
+func Test_SystemConfig_ContextPresets(t *testing.T) {
+	tests := map[string]struct {
+		configFileData  string
+		name            string
+		preset          ContextPreset
+		expectedPresets map[string]ContextPreset
+		expectedError   string
+	}{
+		"lists no contexts when unset": {
+			configFileData:  `{}`,
+			expectedPresets: map[string]ContextPreset{},
+		},
+		"lists the saved contexts": {
+			configFileData: `{"contexts":{"work":{"team":"T0123456789","app":"A0123456789"}}}`,
+			expectedPresets: map[string]ContextPreset{
+				"work": {Team: "T0123456789", App: "A0123456789"},
+			},
+		},
+		"saves a context with existing contexts": {
+			configFileData: `{"contexts":{"work":{"team":"T0123456789"}}}`,
+			name:           "dev",
+			preset:         ContextPreset{App: "local"},
+			expectedPresets: map[string]ContextPreset{
+				"dev":  {App: "local"},
+				"work": {Team: "T0123456789"},
+			},
+		},
+		"errors for a context name that is not valid": {
+			configFileData: `{}`,
+			name:           "../work",
+			preset:         ContextPreset{Team: "T0123456789"},
+			expectedError:  slackerror.ErrInvalidArguments,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.AddDefaultMocks()
+			err := afero.WriteFile(fs, filepath.Join(slackdeps.MockHomeDirectory, configFolderName, configFileName), []byte(tc.configFileData), 0600)
+			require.NoError(t, err)
+
+			config := NewConfig(fs, os)
+			if tc.name != "" {
+				err = config.SystemConfig.SetContextPreset(ctx, tc.name, tc.preset)
+				if tc.expectedError != "" {
+					require.Error(t, err)
+					assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+					return
+				}
+				require.NoError(t, err)
+			}
+			presets, err := config.SystemConfig.ListContextPresets(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPresets, presets)
+			for name, expected := range tc.expectedPresets {
+				preset, err := config.SystemConfig.GetContextPreset(ctx, name)
+				require.NoError(t, err)
+				assert.Equal(t, expected, preset)
+			}
+			_, err = config.SystemConfig.GetContextPreset(ctx, "missing")
+			require.Error(t, err)
+			assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+		})
+	}
+}
+
 func Test_SystemConfig_GetTrustUnknownSources(t *testing.T) {
 	t.Run("When no trust_unknown_sources is set, should return false", func(t *testing.T) {
 		ctx := slackcontext.MockContext(t.Context())
@@ -694,3 +986,33 @@ func Test_SystemConfig_SetTrustUnknownSources(t *testing.T) {
 		assert.True(t, trustSources)
 	})
 }
+
+func Test_SystemConfig_SetExperiment(t *testing.T) {
+	t.Run("Should toggle an experiment and keep others", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+
+		// Use default mocks to return home directory path
+		os.AddDefaultMocks()
+
+		systemConfig := &SystemConfig{Experiments: map[string]bool{"lipgloss": true}}
+		systemConfigBytes, err := json.Marshal(systemConfig)
+		require.NoError(t, err)
+		err = afero.WriteFile(fs, filepath.Join(slackdeps.MockHomeDirectory, configFolderName, configFileName), systemConfigBytes, 0600)
+		require.NoError(t, err)
+
+		config := NewConfig(fs, os)
+		err = config.SystemConfig.SetExperiment(ctx, experiment.Placeholder, true)
+		require.NoError(t, err)
+		userConfig, err := config.SystemConfig.UserConfig(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"lipgloss": true, "placeholder": true}, userConfig.Experiments)
+
+		err = config.SystemConfig.SetExperiment(ctx, experiment.Lipgloss, false)
+		require.NoError(t, err)
+		userConfig, err = config.SystemConfig.UserConfig(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"lipgloss": false, "placeholder": true}, userConfig.Experiments)
+	})
+}
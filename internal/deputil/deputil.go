@@ -15,6 +15,7 @@
 package deputil
 
 import (
+	"errors"
 	"os/exec"
 	"runtime"
 	"strings"
@@ -31,6 +32,84 @@ func GetGitVersion() (string, error) {
 	return string(version), nil
 }
 
+// GitMetadata describes the commit checked out in a git repository
+type GitMetadata struct {
+	Branch string
+	Commit string
+}
+
+// GetGitMetadata returns the commit SHA and branch checked out in the directory
+// or an error if the directory is not in a git repository. The branch is empty
+// when the HEAD is detached
+func GetGitMetadata(dirPath string) (GitMetadata, error) {
+	commit, err := gitOutput(dirPath, "rev-parse", "HEAD")
+	if err != nil {
+		return GitMetadata{}, err
+	}
+	branch, err := gitOutput(dirPath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return GitMetadata{}, err
+	}
+	if branch == "HEAD" {
+		branch = ""
+	}
+	return GitMetadata{Branch: branch, Commit: commit}, nil
+}
+
+// GetGitChanges returns the paths of files in the directory that changed since
+// the commit, including uncommitted changes and untracked files that are not
+// ignored
+func GetGitChanges(dirPath string, commit string) ([]string, error) {
+	hash, err := resolveGitCommit(dirPath, commit)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := gitOutput(dirPath, "diff", "--name-only", hash, "--", ".")
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := gitOutput(dirPath, "ls-files", "--others", "--exclude-standard", "--full-name", "--", ".")
+	if err != nil {
+		return nil, err
+	}
+	changes := []string{}
+	for _, path := range strings.Split(diff+"\n"+untracked, "\n") {
+		if path != "" {
+			changes = append(changes, path)
+		}
+	}
+	return changes, nil
+}
+
+// resolveGitCommit returns the hash of the commit so that values such as options
+// are never passed to other git commands
+func resolveGitCommit(dirPath string, commit string) (string, error) {
+	hash, err := gitOutput(dirPath, "rev-parse", "--verify", "--quiet", "--end-of-options", commit+"^{commit}")
+	if err != nil {
+		if slackerror.ToSlackError(err).Code == slackerror.ErrGitNotFound {
+			return "", err
+		}
+		return "", slackerror.New(slackerror.ErrGitRefNotFound).
+			WithMessage("The git commit \"%s\" was not found in the repository", commit).
+			WithRemediation("Check that the commit exists in the project repository")
+	}
+	return hash, nil
+}
+
+// gitOutput runs a git command in the directory and returns the trimmed output
+func gitOutput(dirPath string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dirPath
+	output, err := cmd.Output()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", slackerror.New(slackerror.ErrGitNotFound)
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
 // GetDenoVersion shells out to `deno` to determine the version of the runtime. This should only be called once in root.go!
 func GetDenoVersion() string {
 	denoVersion, err := exec.Command("deno", "--version").Output()
@@ -0,0 +1,169 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deputil
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// git runs a git command in the directory with an author for commits
+func git(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(cmd.Environ(),
+		"GIT_AUTHOR_NAME=slack", "GIT_AUTHOR_EMAIL=slack@example.com",
+		"GIT_COMMITTER_NAME=slack", "GIT_COMMITTER_EMAIL=slack@example.com",
+	)
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	return string(output)
+}
+
+func Test_GetGitMetadata(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	tests := map[string]struct {
+		setup          func(t *testing.T, dir string)
+		expectedBranch string
+		expectedError  bool
+	}{
+		"returns the commit and branch of a repository": {
+			setup: func(t *testing.T, dir string) {
+				git(t, dir, "init", "--initial-branch", "release")
+				git(t, dir, "commit", "--allow-empty", "--message", "initial")
+			},
+			expectedBranch: "release",
+		},
+		"returns an empty branch for a detached head": {
+			setup: func(t *testing.T, dir string) {
+				git(t, dir, "init", "--initial-branch", "release")
+				git(t, dir, "commit", "--allow-empty", "--message", "initial")
+				git(t, dir, "checkout", "--detach")
+			},
+			expectedBranch: "",
+		},
+		"errors outside of a repository": {
+			setup:         func(t *testing.T, dir string) {},
+			expectedError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("GIT_CEILING_DIRECTORIES", dir)
+			tc.setup(t, dir)
+			metadata, err := GetGitMetadata(dir)
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, GitMetadata{}, metadata)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, metadata.Commit, 40)
+			assert.Equal(t, tc.expectedBranch, metadata.Branch)
+		})
+	}
+}
+
+func Test_GetGitChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	tests := map[string]struct {
+		setup           func(t *testing.T, dir string)
+		expectedChanges []string
+	}{
+		"returns no changes without edits since the commit": {
+			setup:           func(t *testing.T, dir string) {},
+			expectedChanges: []string{},
+		},
+		"returns committed and uncommitted changes since the commit": {
+			setup: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "manifest.json"), []byte("{}"), 0o644))
+				git(t, dir, "commit", "--all", "--message", "update")
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "main.go"), []byte("package app"), 0o644))
+			},
+			expectedChanges: []string{"app/main.go", "app/manifest.json"},
+		},
+		"returns untracked files that are not ignored": {
+			setup: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app", ".gitignore"), []byte("*.log\n"), 0o644))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "debug.log"), []byte(""), 0o644))
+			},
+			expectedChanges: []string{"app/.gitignore"},
+		},
+		"skips changes outside of the directory": {
+			setup: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# docs"), 0o644))
+			},
+			expectedChanges: []string{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("GIT_CEILING_DIRECTORIES", dir)
+			require.NoError(t, os.Mkdir(filepath.Join(dir, "app"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "manifest.json"), []byte(""), 0o644))
+			git(t, dir, "init")
+			git(t, dir, "add", "--all")
+			git(t, dir, "commit", "--message", "initial")
+			commit := strings.TrimSpace(git(t, dir, "rev-parse", "HEAD"))
+			tc.setup(t, dir)
+			changes, err := GetGitChanges(filepath.Join(dir, "app"), commit)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expectedChanges, changes)
+		})
+	}
+}
+
+func Test_GetGitChanges_InvalidCommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	tests := map[string]struct {
+		commit string
+	}{
+		"errors for an option": {
+			commit: "--output=changes.txt",
+		},
+		"errors for a commit that does not exist": {
+			commit: "0000000000000000000000000000000000000000",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("GIT_CEILING_DIRECTORIES", dir)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(""), 0o644))
+			git(t, dir, "init")
+			git(t, dir, "add", "--all")
+			git(t, dir, "commit", "--message", "initial")
+			_, err := GetGitChanges(dir, tc.commit)
+			require.Error(t, err)
+			assert.Equal(t, slackerror.ErrGitRefNotFound, slackerror.ToSlackError(err).Code)
+			assert.NoFileExists(t, filepath.Join(dir, "changes.txt"))
+		})
+	}
+}
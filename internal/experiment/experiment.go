@@ -48,6 +48,14 @@ var AllExperiments = []Experiment{
 	SetIcon,
 }
 
+// Descriptions summarize the behavior that each experiment toggles
+// Please also add here 👇
+var Descriptions = map[Experiment]string{
+	Lipgloss:    "Shows pretty styles",
+	Placeholder: "A placeholder for testing that does nothing",
+	SetIcon:     "Enables icon upload for non-hosted apps",
+}
+
 // EnabledExperiments is a list of experiments that are permanently enabled
 // Please also add here 👇
 var EnabledExperiments = []Experiment{}
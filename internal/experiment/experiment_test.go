@@ -37,6 +37,12 @@ func Test_AllExperimentsListedAreValid(t *testing.T) {
 	}
 }
 
+func Test_AllExperimentsListedHaveDescriptions(t *testing.T) {
+	for _, exp := range AllExperiments {
+		require.NotEmpty(t, Descriptions[exp], exp)
+	}
+}
+
 func Test_EnabledExperimentsListedAreValid(t *testing.T) {
 	for _, exp := range EnabledExperiments {
 		require.Equal(t, true, isValid(string(exp)))
@@ -95,3 +95,19 @@ func JSONUnmarshal(data []byte, v interface{}) error {
 func IsEmptyJSON(data []byte) bool {
 	return len(bytes.TrimSpace(data)) == 0
 }
+
+// TrimTrailingJSON returns the first JSON value of the data when other content
+// follows it. This matches the state left behind when a shorter JSON state file
+// was written over a longer one without truncation. The data is unchanged and
+// false is returned when the first value is invalid or nothing follows it.
+func TrimTrailingJSON(data []byte) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var value json.RawMessage
+	if err := decoder.Decode(&value); err != nil {
+		return data, false
+	}
+	if len(bytes.TrimSpace(data[decoder.InputOffset():])) == 0 {
+		return data, false
+	}
+	return value, true
+}
@@ -179,6 +179,28 @@ func Test_IsEmptyJSON(t *testing.T) {
 	}
 }
 
+func Test_TrimTrailingJSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		data             string
+		expectedData     string
+		expectedRepaired bool
+	}{
+		"valid JSON is unchanged":         {data: `{"one":"1"}`, expectedData: `{"one":"1"}`, expectedRepaired: false},
+		"trailing whitespace is ignored":  {data: "{}\n\n", expectedData: "{}\n\n", expectedRepaired: false},
+		"invalid JSON is unchanged":       {data: `{"one":`, expectedData: `{"one":`, expectedRepaired: false},
+		"trailing content is removed":     {data: "{\"one\":\"1\"}\n\"}\n}", expectedData: `{"one":"1"}`, expectedRepaired: true},
+		"trailing values are removed":     {data: `{"one":"1"}{"two":"2"}`, expectedData: `{"one":"1"}`, expectedRepaired: true},
+		"empty data is not repaired":      {data: "", expectedData: "", expectedRepaired: false},
+		"leading garbage is not repaired": {data: `x{"one":"1"}`, expectedData: `x{"one":"1"}`, expectedRepaired: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, repaired := TrimTrailingJSON([]byte(tc.data))
+			assert.Equal(t, tc.expectedData, string(data))
+			assert.Equal(t, tc.expectedRepaired, repaired)
+		})
+	}
+}
+
 func Test_UnmarshalJSON(t *testing.T) {
 	type testConfig struct {
 		One string `json:"one,omitempty"`
@@ -75,62 +75,70 @@ func ExtractFirstJSONFromString(s string) string {
 	return s[start : end+1]
 }
 
+// regexListNoQuotes finds sensitive values that follow a key without quotes
+var regexListNoQuotes = []*regexp.Regexp{
+	// Escape token values as "token=xoxp-123"
+	regexp.MustCompile(`(?P<keys>(?:\w)*token(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape oauth_authorize_url for apps apis
+	regexp.MustCompile(`(?P<keys>(?:\w)*oauth_authorize_url(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape provider_key for 3p auth
+	regexp.MustCompile(`(?P<keys>(?:\w)*provider_key(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape authorizations for 3p auth
+	regexp.MustCompile(`(?P<keys>(?:\w)*authorizations(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape authorization_url for 3p auth
+	regexp.MustCompile(`(?P<keys>(?:\w)*authorization_url(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape secret for 3p auth
+	regexp.MustCompile(`(?P<keys>(?:\w)*secret(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape variables for 3p auth client_id
+	regexp.MustCompile(`(?P<keys>(?:\w)*client_id(?:\=s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape variables for 3p auth add client secrets
+	regexp.MustCompile(`(?P<keys>(?:\w)*secret(?:\ s*))(?P<values>(([\w\s.-]*)))`),
+	// Escape variables for env and its aliases for set/unset/add/remove commands
+	regexp.MustCompile(`(?P<keys>(?:\w)*(env|var|vars|variable|variables|auth) (set|unset|add|remove)(?:\ s*))(?P<values>(([\w\s.-]*)))`),
+	// Add more regex here
+}
+
+// regexListWithQuotes will find sensitive data within quotes and later we escape with "..."
+var regexListWithQuotes = []*regexp.Regexp{
+	// Escape token values based on hash keys with keyword "token" from JSON string
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*token(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape user name as `"user":"cheng chen"`
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*user(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape oauth_authorize_url
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*oauth_authorize_url(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape provider_key
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*provider_key(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape authorizations
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*authorizations(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape authorization_url
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*authorization_url(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape secret
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*secret(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Escape variables
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*variables(?:\"|\')(?:\:\s*))(?:\[\{)?(?P<values>(\[.*?\]))(?:\}])?`),
+	// Escape client_id
+	regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*client_id(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
+	// Add more regex here
+}
+
+// regexListOfWords finds tokens and email addresses anywhere in a string
+var regexListOfWords = []*regexp.Regexp{
+	// Escape Rotating Tokens (xoxe.xoxp and xoxe.xoxb)
+	regexp.MustCompile(`(?P<words>((xoxe\.xox[bp]-[\w.-]*)))`),
+	// Escape App Token (xapp)
+	regexp.MustCompile(`(?P<words>((xapp-[\w.-]*)))`),
+	// Escape Bot Token (xoxb)
+	regexp.MustCompile(`(?P<words>((xoxb-[\w.-]*)))`),
+	// Escape User Token (xoxp)
+	regexp.MustCompile(`(?P<words>((xoxp-[\w.-]*)))`),
+	// Escape Refresh Token (xoxe)
+	regexp.MustCompile(`(?P<words>((xoxe-[\w.-]*)))`),
+	// Escape email addresses
+	regexp.MustCompile(`(?P<words>([\w.+-]+@[\w-]+(\.[\w-]+)*\.[A-Za-z]{2,}))`),
+}
+
 // RedactPII will replace all occurrences in a string that match any of the expressions in a regex list
 func RedactPII(s string) string {
-	var regexListNoQuotes = []*regexp.Regexp{
-		// Escape token values as "token=xoxp-123"
-		regexp.MustCompile(`(?P<keys>(?:\w)*token(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape oauth_authorize_url for apps apis
-		regexp.MustCompile(`(?P<keys>(?:\w)*oauth_authorize_url(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape provider_key for 3p auth
-		regexp.MustCompile(`(?P<keys>(?:\w)*provider_key(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape authorizations for 3p auth
-		regexp.MustCompile(`(?P<keys>(?:\w)*authorizations(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape authorization_url for 3p auth
-		regexp.MustCompile(`(?P<keys>(?:\w)*authorization_url(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape secret for 3p auth
-		regexp.MustCompile(`(?P<keys>(?:\w)*secret(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape variables for 3p auth client_id
-		regexp.MustCompile(`(?P<keys>(?:\w)*client_id(?:\=s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape variables for 3p auth add client secrets
-		regexp.MustCompile(`(?P<keys>(?:\w)*secret(?:\ s*))(?P<values>(([\w\s.-]*)))`),
-		// Escape variables for env and its aliases for set/unset/add/remove commands
-		regexp.MustCompile(`(?P<keys>(?:\w)*(env|var|vars|variable|variables|auth) (set|unset|add|remove)(?:\ s*))(?P<values>(([\w\s.-]*)))`),
-		// Add more regex here
-	}
-	// regexListWithQuotes will find sensitive data within quotes and later we escape with "..."
-	var regexListWithQuotes = []*regexp.Regexp{
-		// Escape token values based on hash keys with keyword "token" from JSON string
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*token(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape user name as `"user":"cheng chen"`
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*user(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape oauth_authorize_url
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*oauth_authorize_url(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape provider_key
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*provider_key(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape authorizations
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*authorizations(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape authorization_url
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*authorization_url(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape secret
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*secret(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Escape variables
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*variables(?:\"|\')(?:\:\s*))(?:\[\{)?(?P<values>(\[.*?\]))(?:\}])?`),
-		// Escape client_id
-		regexp.MustCompile(`(?P<keys>(?:\"|\')(?:\w)*client_id(?:\"|\')(?:\:\s*))(?:\"|\')?(?P<values>([\w\s.-]*))(?:\"|\')?`),
-		// Add more regex here
-	}
-	var regexListOfWords = []*regexp.Regexp{
-		// Escape App Token (xapp)
-		regexp.MustCompile(`(?P<words>((xapp-[\w.-]*)))`),
-		// Escape Bot Token (xoxb)
-		regexp.MustCompile(`(?P<words>((xoxb-[\w.-]*)))`),
-		// Escape User Token (xoxp)
-		regexp.MustCompile(`(?P<words>((xoxp-[\w.-]*)))`),
-		// Escape Refresh Token (xoxe)
-		regexp.MustCompile(`(?P<words>((xoxe-[\w.-]*)))`),
-	}
-
 	for _, re := range regexListNoQuotes {
 		// Keep token name and replace value with ...
 		s = re.ReplaceAllString(s, "$1...")
@@ -139,12 +147,22 @@ func RedactPII(s string) string {
 		// Keep token name and replace value with "...""
 		s = re.ReplaceAllString(s, "$1\"...\"")
 	}
+	s = RedactSecrets(s)
+	if home, err := os.UserHomeDir(); err == nil && home != "" {
+		s = strings.ReplaceAll(s, home, "~")
+	}
+	return s
+}
+
+// RedactSecrets replaces tokens and email addresses found anywhere in a string
+//
+// Unlike RedactPII the surrounding text is kept as is which makes this safe to
+// use on messages meant for reading.
+func RedactSecrets(s string) string {
 	for _, re := range regexListOfWords {
 		// Replace matched words with "..."
 		s = re.ReplaceAllString(s, "...")
 	}
-	home, _ := os.UserHomeDir()
-	s = strings.ReplaceAll(s, home, "...")
 	return s
 }
 
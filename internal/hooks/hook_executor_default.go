@@ -17,7 +17,10 @@ package hooks
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -30,6 +33,8 @@ import (
 type HookExecutorDefaultProtocol struct {
 	IO iostreams.IOStreamer
 	Fs afero.Fs
+	// Timeout stops hooks that run longer than the duration when non-zero
+	Timeout time.Duration
 }
 
 // Execute processes the data received by the SDK.
@@ -52,6 +57,13 @@ func (e *HookExecutorDefaultProtocol) Execute(ctx context.Context, opts HookExec
 		)
 	}()
 
+	// Stream diagnostics from stderr as these happen since stdout is kept for the
+	// hook response
+	diagnostics := opts.Stderr
+	if diagnostics == nil {
+		diagnostics = e.IO.WriteDiagnostic()
+	}
+
 	buffout := bytes.Buffer{}
 	bufferr := bytes.Buffer{}
 	stdout := iostreams.BufferedWriter{
@@ -64,21 +76,24 @@ func (e *HookExecutorDefaultProtocol) Execute(ctx context.Context, opts HookExec
 	stderr := iostreams.BufferedWriter{
 		Buff: &bufferr,
 		Stream: iostreams.BufferedWriter{
-			Buff:   opts.Stderr,
+			Buff:   diagnostics,
 			Stream: e.IO.WriteDebug(ctx),
 		},
 	}
 
 	cmd := opts.Exec.Command(cmdEnvVars, stdout, stderr, opts.Stdin, cmdArgs[0], cmdArgVars...)
-	err = cmd.Run()
+	err = runCommand(cmd, hookTimeout(opts.Hook, e.Timeout))
 
 	response := strings.TrimSpace(buffout.String())
 	if err != nil {
-		// Include stderr outputs in error details if these aren't streamed
+		// Include stderr outputs in error details if these aren't streamed to the caller
 		details := slackerror.ErrorDetails{}
 		if opts.Stderr == nil {
 			details = append(details, slackerror.ErrorDetail{Message: strings.TrimSpace(bufferr.String())})
 		}
+		if errors.As(err, &hookTimeoutError{}) {
+			details = append(details, slackerror.ErrorDetail{Message: fmt.Sprintf("The '%s' hook timed out and was stopped", opts.Hook.Name)})
+		}
 		return "", slackerror.New(slackerror.ErrSDKHookInvocationFailed).
 			WithMessage("Error running '%s' command: %s", opts.Hook.Name, err).
 			WithDetails(details)
@@ -18,7 +18,9 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
@@ -126,6 +128,26 @@ func Test_Hook_Execute_Default_Protocol(t *testing.T) {
 				}),
 			expectedResponse: "",
 		},
+		"streams stderr diagnostics while the hook runs": {
+			opts: HookExecOpts{
+				Hook: HookScript{Name: "GetManifest", Command: "cat manifest.json"},
+				Exec: &MockExec{
+					mockCommand: &MockCommand{
+						MockStdout: []byte(`{"display_information":{}}`),
+						MockStderr: []byte("bundling the app\n"),
+					},
+				},
+			},
+			handler: func(t *testing.T, ctx context.Context, executor HookExecutor, opts HookExecOpts) {
+				stderr := &bytes.Buffer{}
+				executor.(*HookExecutorDefaultProtocol).IO.(*iostreams.IOStreamsMock).Stderr.SetOutput(stderr)
+				response, err := executor.Execute(ctx, opts)
+				require.NoError(t, err)
+				assert.Equal(t, `{"display_information":{}}`, response)
+				assert.Contains(t, stderr.String(), "bundling the app")
+				assert.NotContains(t, stderr.String(), "display_information")
+			},
+		},
 		"successful deploy command": {
 			opts: HookExecOpts{
 				Hook:   HookScript{Command: "echo lgtm!", Name: "Deploy"},
@@ -145,6 +167,52 @@ func Test_Hook_Execute_Default_Protocol(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		"stops a hook that runs longer than the timeout": {
+			opts: HookExecOpts{
+				Hook: HookScript{Name: "Deploy", Command: "sleep 10"},
+			},
+			handler: func(t *testing.T, ctx context.Context, executor HookExecutor, opts HookExecOpts) {
+				if runtime.GOOS == "windows" {
+					t.Skip("sleep is not available on windows")
+				}
+				executor.(*HookExecutorDefaultProtocol).Timeout = 100 * time.Millisecond
+				started := time.Now()
+				_, err := executor.Execute(ctx, opts)
+				require.Error(t, err)
+				assert.Less(t, time.Since(started), 5*time.Second)
+				slackErr := err.(*slackerror.Error)
+				assert.Equal(t, slackerror.ErrSDKHookInvocationFailed, slackErr.Code)
+				assert.Contains(t, slackErr.Message, "timed out after 100ms")
+				require.NotEmpty(t, slackErr.Details)
+				assert.Equal(t, "The 'Deploy' hook timed out and was stopped", slackErr.Details[len(slackErr.Details)-1].Message)
+			},
+		},
+		"does not stop the start hook with the timeout": {
+			opts: HookExecOpts{
+				Hook: HookScript{Name: "Start", Command: "sleep 1"},
+			},
+			handler: func(t *testing.T, ctx context.Context, executor HookExecutor, opts HookExecOpts) {
+				if runtime.GOOS == "windows" {
+					t.Skip("sleep is not available on windows")
+				}
+				executor.(*HookExecutorDefaultProtocol).Timeout = 100 * time.Millisecond
+				started := time.Now()
+				_, err := executor.Execute(ctx, opts)
+				require.NoError(t, err)
+				assert.GreaterOrEqual(t, time.Since(started), time.Second)
+			},
+		},
+		"finishes a hook that completes before the timeout": {
+			opts: HookExecOpts{
+				Hook: HookScript{Name: "Deploy", Command: "echo done"},
+			},
+			handler: func(t *testing.T, ctx context.Context, executor HookExecutor, opts HookExecOpts) {
+				executor.(*HookExecutorDefaultProtocol).Timeout = time.Minute
+				response, err := executor.Execute(ctx, opts)
+				require.NoError(t, err)
+				assert.Equal(t, "done", response)
+			},
+		},
 		"successful start command": {
 			opts: HookExecOpts{
 				Hook: HookScript{Name: "Start", Command: "deno run some/path/to/start"},
@@ -20,8 +20,11 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -34,6 +37,8 @@ import (
 type HookExecutorMessageBoundaryProtocol struct {
 	IO iostreams.IOStreamer
 	Fs afero.Fs
+	// Timeout stops hooks that run longer than the duration when non-zero
+	Timeout time.Duration
 }
 
 // generateBoundary is a function for creating boundaries that can be mocked
@@ -62,6 +67,16 @@ func (e *HookExecutorMessageBoundaryProtocol) Execute(ctx context.Context, opts
 		)
 	}()
 
+	// Stream diagnostics outside of the message boundaries as these happen
+	stdoutDiagnostics := opts.Stdout
+	if stdoutDiagnostics == nil {
+		stdoutDiagnostics = e.IO.WriteDiagnostic()
+	}
+	stderrDiagnostics := opts.Stderr
+	if stderrDiagnostics == nil {
+		stderrDiagnostics = e.IO.WriteDiagnostic()
+	}
+
 	buffout := bytes.Buffer{}
 	bufferr := bytes.Buffer{}
 	stdout := iostreams.BoundariedWriter{
@@ -70,7 +85,7 @@ func (e *HookExecutorMessageBoundaryProtocol) Execute(ctx context.Context, opts
 		Stream: iostreams.BufferedWriter{
 			Buff: iostreams.FilteredWriter{
 				Bounds: boundary,
-				Stream: opts.Stdout,
+				Stream: stdoutDiagnostics,
 			},
 			Stream: e.IO.WriteDebug(ctx),
 		},
@@ -80,19 +95,22 @@ func (e *HookExecutorMessageBoundaryProtocol) Execute(ctx context.Context, opts
 		Stream: iostreams.BufferedWriter{
 			Buff: iostreams.FilteredWriter{
 				Bounds: boundary,
-				Stream: opts.Stderr,
+				Stream: stderrDiagnostics,
 			},
 			Stream: e.IO.WriteDebug(ctx),
 		},
 	}
 
 	cmd := opts.Exec.Command(cmdEnvVars, &stdout, stderr, opts.Stdin, cmdArgs[0], cmdArgVars...)
-	if err = cmd.Run(); err != nil {
-		// Include stderr outputs in error details if these aren't streamed
+	if err = runCommand(cmd, hookTimeout(opts.Hook, e.Timeout)); err != nil {
+		// Include stderr outputs in error details if these aren't streamed to the caller
 		details := slackerror.ErrorDetails{}
 		if opts.Stderr == nil {
 			details = append(details, slackerror.ErrorDetail{Message: strings.TrimSpace(bufferr.String())})
 		}
+		if errors.As(err, &hookTimeoutError{}) {
+			details = append(details, slackerror.ErrorDetail{Message: fmt.Sprintf("The '%s' hook timed out and was stopped", opts.Hook.Name)})
+		}
 		return "", slackerror.New(slackerror.ErrSDKHookInvocationFailed).
 			WithMessage("Error running '%s' command: %s", opts.Hook.Name, err).
 			WithDetails(details)
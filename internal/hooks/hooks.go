@@ -17,6 +17,7 @@ package hooks
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/iostreams"
@@ -27,18 +28,22 @@ type HookExecutor interface {
 	Execute(ctx context.Context, opts HookExecOpts) (response string, err error)
 }
 
-func GetHookExecutor(ios iostreams.IOStreamer, fs afero.Fs, cfg SDKCLIConfig) HookExecutor {
+// GetHookExecutor returns the executor of the preferred protocol that stops hooks
+// after the timeout when the timeout is non-zero
+func GetHookExecutor(ios iostreams.IOStreamer, fs afero.Fs, cfg SDKCLIConfig, timeout time.Duration) HookExecutor {
 	protocol := cfg.Config.SupportedProtocols.Preferred()
 	switch protocol {
 	case HookProtocolV2:
 		return &HookExecutorMessageBoundaryProtocol{
-			IO: ios,
-			Fs: fs,
+			IO:      ios,
+			Fs:      fs,
+			Timeout: timeout,
 		}
 	default:
 		return &HookExecutorDefaultProtocol{
-			IO: ios,
-			Fs: fs,
+			IO:      ios,
+			Fs:      fs,
+			Timeout: timeout,
 		}
 	}
 }
@@ -16,6 +16,7 @@ package hooks
 
 import (
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/iostreams"
@@ -26,6 +27,7 @@ import (
 func Test_Hooks_GetHookExecutor(t *testing.T) {
 	tests := map[string]struct {
 		protocolVersions ProtocolVersions
+		timeout          time.Duration
 		expectedType     interface{}
 	}{
 		"Type HookProtocolV2": {
@@ -44,6 +46,11 @@ func Test_Hooks_GetHookExecutor(t *testing.T) {
 			protocolVersions: ProtocolVersions{HookProtocolDefault, HookProtocolV2},
 			expectedType:     &HookExecutorDefaultProtocol{},
 		},
+		"Type HookProtocolV2 with a timeout": {
+			protocolVersions: ProtocolVersions{HookProtocolV2},
+			timeout:          time.Minute,
+			expectedType:     &HookExecutorMessageBoundaryProtocol{},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -54,8 +61,14 @@ func Test_Hooks_GetHookExecutor(t *testing.T) {
 			io := iostreams.NewIOStreamsMock(config, fs, os)
 			sdkConfig := NewSDKConfigMock()
 			sdkConfig.Config.SupportedProtocols = tc.protocolVersions
-			hookExecutor := GetHookExecutor(io, fs, sdkConfig)
+			hookExecutor := GetHookExecutor(io, fs, sdkConfig, tc.timeout)
 			require.IsType(t, tc.expectedType, hookExecutor)
+			switch executor := hookExecutor.(type) {
+			case *HookExecutorMessageBoundaryProtocol:
+				require.Equal(t, tc.timeout, executor.Timeout)
+			case *HookExecutorDefaultProtocol:
+				require.Equal(t, tc.timeout, executor.Timeout)
+			}
 		})
 	}
 }
@@ -16,6 +16,7 @@ package hooks
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/slackapi/slack-cli/internal/slackerror"
@@ -46,6 +47,32 @@ type SDKCLIConfig struct {
 	WorkingDirectory string
 }
 
+// HookEntry pairs a hook script with the key of the hook in the hooks file
+type HookEntry struct {
+	Key    string
+	Script HookScript
+}
+
+// ListHooks returns each known hook of the SDK config in the order of the hooks
+// struct, including hooks without a command
+func (s *SDKCLIConfig) ListHooks() []HookEntry {
+	values := reflect.ValueOf(s.Hooks)
+	fields := reflect.VisibleFields(values.Type())
+	entries := make([]HookEntry, 0, len(fields))
+	for _, field := range fields {
+		script, ok := values.FieldByIndex(field.Index).Interface().(HookScript)
+		if !ok {
+			continue
+		}
+		if script.Name == "" {
+			script.Name = field.Name
+		}
+		key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		entries = append(entries, HookEntry{Key: key, Script: script})
+	}
+	return entries
+}
+
 // Exists returns true when the SDKCLIConfig was successfully loaded, otherwise false with an error
 func (s *SDKCLIConfig) Exists() (bool, error) {
 	if strings.TrimSpace(s.WorkingDirectory) == "" {
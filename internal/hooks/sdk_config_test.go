@@ -47,6 +47,18 @@ func Test_SDKCLIConfig_Exists(t *testing.T) {
 	}
 }
 
+func Test_SDKCLIConfig_ListHooks(t *testing.T) {
+	config := SDKCLIConfig{}
+	config.Hooks.GetManifest = HookScript{Name: "GetManifest", Command: "cat manifest.json"}
+	config.Hooks.Start = HookScript{Command: "npm start"}
+
+	entries := config.ListHooks()
+	require.Len(t, entries, 9)
+	assert.Equal(t, HookEntry{Key: "build", Script: HookScript{Name: "BuildProject"}}, entries[0])
+	assert.Equal(t, HookEntry{Key: "get-manifest", Script: HookScript{Name: "GetManifest", Command: "cat manifest.json"}}, entries[5])
+	assert.Equal(t, HookEntry{Key: "start", Script: HookScript{Name: "Start", Command: "npm start"}}, entries[8])
+}
+
 func Test_ProtocolResolution(t *testing.T) {
 	tests := map[string]struct {
 		config SDKCLIConfig
@@ -22,6 +22,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/iostreams"
 	"github.com/slackapi/slack-cli/internal/slackdotenv"
@@ -87,6 +88,65 @@ func (e execCommander) GetProcess() *os.Process {
 	return nil
 }
 
+// hookTimeoutError is returned when a hook runs longer than the timeout
+type hookTimeoutError struct {
+	timeout time.Duration
+}
+
+func (e hookTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s", e.timeout)
+}
+
+// hookWaitDelay is how long to wait for outputs of a stopped hook to close
+// since children of the hook might keep these open after the hook is stopped
+const hookWaitDelay = 5 * time.Second
+
+// longRunningHooks are hooks that keep running for the duration of a command and
+// are never stopped by the hook timeout
+var longRunningHooks = map[string]bool{
+	"Start": true,
+}
+
+// hookTimeout returns the timeout to use for the hook or zero for hooks that
+// are expected to keep running
+func hookTimeout(hook HookScript, timeout time.Duration) time.Duration {
+	if longRunningHooks[hook.Name] {
+		return 0
+	}
+	return timeout
+}
+
+// runCommand runs the command and kills the process group of the command if the
+// timeout is exceeded. A timeout of zero waits for the command without limits.
+func runCommand(cmd ShellCommand, timeout time.Duration) error {
+	if timeout <= 0 {
+		return cmd.Run()
+	}
+	if e, ok := cmd.(execCommander); ok && e.Cmd != nil {
+		setProcessGroup(e.Cmd)
+		e.WaitDelay = hookWaitDelay
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		if process := cmd.GetProcess(); process != nil {
+			_ = killProcessGroup(process)
+		}
+		<-done
+		return hookTimeoutError{timeout: timeout}
+	}
+}
+
 type HookExecOpts struct {
 	Directory string
 	Hook      HookScript
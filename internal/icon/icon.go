@@ -15,15 +15,28 @@
 package icon
 
 import (
+	"io"
+	"net/http"
 	"path/filepath"
+	"slices"
+	"strings"
 
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/spf13/afero"
 )
 
+// MaxFileSize is the largest icon file in bytes that can be uploaded
+const MaxFileSize = 2 * 1024 * 1024
+
+// SupportedExtensions are the file extensions of icons in order of preference
+var SupportedExtensions = []string{".png", ".jpg", ".jpeg", ".gif"}
+
+// supportedContentTypes are the detected content types of supported icons
+var supportedContentTypes = []string{"image/png", "image/jpeg", "image/gif"}
+
 func ResolveIconPath(fs afero.Fs) string {
-	supportedExtensions := []string{".png", ".jpg", ".jpeg", ".gif"}
 	for _, dir := range []string{"assets", "."} {
-		for _, ext := range supportedExtensions {
+		for _, ext := range SupportedExtensions {
 			candidate := filepath.Join(dir, "icon"+ext)
 			if _, err := fs.Stat(candidate); err == nil {
 				return candidate
@@ -32,3 +45,40 @@ func ResolveIconPath(fs afero.Fs) string {
 	}
 	return ""
 }
+
+// Validate errors if the icon file does not exist or is not a supported image
+// of an accepted size
+func Validate(fs afero.Fs, iconPath string) error {
+	if !slices.Contains(SupportedExtensions, strings.ToLower(filepath.Ext(iconPath))) {
+		return slackerror.New(slackerror.ErrUnsupportedFileName).
+			WithMessage("The icon file \"%s\" does not have a supported extension", iconPath).
+			WithRemediation("Use an image file ending in one of: %s", strings.Join(SupportedExtensions, ", "))
+	}
+	file, err := fs.Open(iconPath)
+	if err != nil {
+		return slackerror.New(slackerror.ErrUnableToOpenFile).
+			WithMessage("The icon file \"%s\" could not be opened", iconPath).
+			WithRootCause(err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return slackerror.New(slackerror.ErrUnableToOpenFile).WithRootCause(err)
+	}
+	if info.Size() > MaxFileSize {
+		return slackerror.New(slackerror.ErrIconFileTooLarge).
+			WithMessage("The icon file \"%s\" is %d bytes and larger than %d bytes", iconPath, info.Size(), MaxFileSize)
+	}
+	header := make([]byte, 512)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return slackerror.New(slackerror.ErrUnableToOpenFile).WithRootCause(err)
+	}
+	contentType := http.DetectContentType(header[:n])
+	if !slices.Contains(supportedContentTypes, contentType) {
+		return slackerror.New(slackerror.ErrUnknownFileType).
+			WithMessage("The icon file \"%s\" has an unsupported file type: %s", iconPath, contentType).
+			WithRemediation("Use a PNG, JPEG, or GIF image file")
+	}
+	return nil
+}
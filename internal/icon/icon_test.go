@@ -15,8 +15,10 @@
 package icon
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,3 +81,59 @@ func Test_ResolveIconPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_Validate(t *testing.T) {
+	pngHeader := []byte("\x89PNG\r\n\x1a\n")
+	tests := map[string]struct {
+		iconPath      string
+		content       []byte
+		expectedError string
+	}{
+		"png file is valid": {
+			iconPath: "logo.png",
+			content:  pngHeader,
+		},
+		"jpeg file with uppercase extension is valid": {
+			iconPath: "logo.JPG",
+			content:  []byte("\xFF\xD8\xFF\xE0"),
+		},
+		"gif file is valid": {
+			iconPath: "assets/logo.gif",
+			content:  []byte("GIF89a"),
+		},
+		"unsupported extension errors": {
+			iconPath:      "logo.svg",
+			content:       []byte("<svg></svg>"),
+			expectedError: slackerror.ErrUnsupportedFileName,
+		},
+		"missing file errors": {
+			iconPath:      "missing.png",
+			expectedError: slackerror.ErrUnableToOpenFile,
+		},
+		"text content errors": {
+			iconPath:      "logo.png",
+			content:       []byte("not an image"),
+			expectedError: slackerror.ErrUnknownFileType,
+		},
+		"large file errors": {
+			iconPath:      "logo.png",
+			content:       append(pngHeader, bytes.Repeat([]byte{0}, MaxFileSize)...),
+			expectedError: slackerror.ErrIconFileTooLarge,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.content != nil {
+				require.NoError(t, afero.WriteFile(fs, tc.iconPath, tc.content, 0o644))
+			}
+			err := Validate(fs, tc.iconPath)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
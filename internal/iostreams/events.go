@@ -0,0 +1,71 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iostreams
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// EventType is the kind of lifecycle event written with the --events-fd flag
+type EventType string
+
+const (
+	// EventCommandStart is written before a command runs
+	EventCommandStart EventType = "command_start"
+	// EventTrace is written for each trace of a command
+	EventTrace EventType = "trace"
+	// EventCommandEnd is written after a command completes with the exit code
+	EventCommandEnd EventType = "command_end"
+)
+
+// Event is a lifecycle event of a command that is written as a line of JSON
+//
+// The schema of events is stable for tooling that wraps the CLI.
+type Event struct {
+	Type      EventType `json:"type"`
+	Timestamp string    `json:"timestamp"`
+	Command   string    `json:"command,omitempty"`
+	TraceID   string    `json:"trace_id,omitempty"`
+	Values    []string  `json:"values,omitempty"`
+	ExitCode  *ExitCode `json:"exit_code,omitempty"`
+	ErrorCode string    `json:"error_code,omitempty"`
+}
+
+// SetEventsWriter sets the writer of lifecycle events
+func (io *IOStreams) SetEventsWriter(w io.Writer) {
+	io.eventsMu.Lock()
+	defer io.eventsMu.Unlock()
+	io.events = w
+}
+
+// WriteEvent writes the event as a line of JSON if an events writer is set
+func (io *IOStreams) WriteEvent(ctx context.Context, event Event) {
+	io.eventsMu.Lock()
+	defer io.eventsMu.Unlock()
+	if io.events == nil {
+		return
+	}
+	if event.Timestamp == "" {
+		event.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	_, _ = io.events.Write(append(line, '\n'))
+}
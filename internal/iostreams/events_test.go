@@ -0,0 +1,106 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iostreams
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackdeps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IOStreams_WriteEvent(t *testing.T) {
+	exitCode := ExitError
+	tests := map[string]struct {
+		events         []Event
+		expectedEvents []Event
+	}{
+		"writes each event as a line of JSON": {
+			events: []Event{
+				{Type: EventCommandStart, Command: "deploy"},
+				{Type: EventTrace, TraceID: "SLACK_TRACE_APP_INSTALL_STEP", Values: []string{"1", "Updating app settings"}},
+				{Type: EventCommandEnd, Command: "deploy", ExitCode: &exitCode, ErrorCode: "invalid_manifest"},
+			},
+			expectedEvents: []Event{
+				{Type: EventCommandStart, Command: "deploy"},
+				{Type: EventTrace, TraceID: "SLACK_TRACE_APP_INSTALL_STEP", Values: []string{"1", "Updating app settings"}},
+				{Type: EventCommandEnd, Command: "deploy", ExitCode: &exitCode, ErrorCode: "invalid_manifest"},
+			},
+		},
+		"keeps an existing timestamp": {
+			events: []Event{
+				{Type: EventCommandStart, Timestamp: "2026-01-02T03:04:05Z", Command: "run"},
+			},
+			expectedEvents: []Event{
+				{Type: EventCommandStart, Timestamp: "2026-01-02T03:04:05Z", Command: "run"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fsMock := slackdeps.NewFsMock()
+			osMock := slackdeps.NewOsMock()
+			io := NewIOStreams(config.NewConfig(fsMock, osMock), fsMock, osMock)
+			events := &bytes.Buffer{}
+			io.SetEventsWriter(events)
+			for _, event := range tc.events {
+				io.WriteEvent(ctx, event)
+			}
+			lines := strings.Split(strings.TrimSuffix(events.String(), "\n"), "\n")
+			require.Len(t, lines, len(tc.expectedEvents))
+			for i, line := range lines {
+				var actual Event
+				require.NoError(t, json.Unmarshal([]byte(line), &actual))
+				assert.NotEmpty(t, actual.Timestamp)
+				if tc.expectedEvents[i].Timestamp == "" {
+					actual.Timestamp = ""
+				}
+				assert.Equal(t, tc.expectedEvents[i], actual)
+			}
+		})
+	}
+}
+
+func Test_IOStreams_WriteEvent_NoWriter(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	io := NewIOStreams(config.NewConfig(fsMock, osMock), fsMock, osMock)
+	assert.NotPanics(t, func() {
+		io.WriteEvent(ctx, Event{Type: EventCommandStart, Command: "version"})
+	})
+}
+
+func Test_IOStreams_PrintTrace_Event(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	io := NewIOStreams(config.NewConfig(fsMock, osMock), fsMock, osMock)
+	events := &bytes.Buffer{}
+	io.SetEventsWriter(events)
+	io.PrintTrace(ctx, "SLACK_TRACE_ID", "VALUE_1", "VALUE_2")
+	var actual Event
+	require.NoError(t, json.Unmarshal(events.Bytes(), &actual))
+	assert.Equal(t, EventTrace, actual.Type)
+	assert.Equal(t, "SLACK_TRACE_ID", actual.TraceID)
+	assert.Equal(t, []string{"VALUE_1", "VALUE_2"}, actual.Values)
+}
@@ -19,6 +19,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"sync"
 	"sync/atomic"
 
 	"github.com/slackapi/slack-cli/internal/config"
@@ -65,7 +66,13 @@ type IOStreams struct {
 	Stdout *log.Logger
 	Stderr *log.Logger
 
+	// NonInteractive skips prompts as if outputs were not a terminal
+	NonInteractive bool
+
 	exitCode ExitCode
+
+	events   io.Writer
+	eventsMu sync.Mutex
 }
 
 type IOStreamer interface {
@@ -87,6 +94,11 @@ type IOStreamer interface {
 	// SetExitCode sets the desired process exit code in a thread safe way
 	SetExitCode(code ExitCode)
 
+	// SetEventsWriter sets the writer of lifecycle events
+	SetEventsWriter(w io.Writer)
+	// WriteEvent writes the event as a line of JSON if an events writer is set
+	WriteEvent(ctx context.Context, event Event)
+
 	// ConfirmPrompt prompts the user for a "yes" or "no" (true or false) value
 	// for the message
 	ConfirmPrompt(ctx context.Context, message string, defaultValue bool) (bool, error)
@@ -122,6 +134,9 @@ func (io *IOStreams) SetCmdIO(cmd *cobra.Command) {
 //
 // Reference: https://rderik.com/blog/identify-if-output-goes-to-the-terminal-or-is-being-redirected-in-golang/
 func (io *IOStreams) IsTTY() bool {
+	if io.NonInteractive {
+		return false
+	}
 	if o, err := io.os.Stdout().Stat(); o == nil || err != nil {
 		return false
 	} else {
@@ -17,6 +17,7 @@ package iostreams
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
@@ -41,6 +42,8 @@ type IOStreamsMock struct {
 	Stderr *log.Logger
 
 	exitCode ExitCode
+
+	events bytes.Buffer
 }
 
 // NewIOStreamsMock creates a new IOStream with buffers that can be read for tests.
@@ -118,3 +121,18 @@ func (m *IOStreamsMock) InitLogFile(ctx context.Context) error {
 func (m *IOStreamsMock) FinishLogFile(ctx context.Context) {}
 
 func (m *IOStreamsMock) FlushToLogFile(ctx context.Context, prefix, errStr string) error { return nil }
+
+// SetEventsWriter mocks setting the writer of lifecycle events
+func (m *IOStreamsMock) SetEventsWriter(w io.Writer) {}
+
+// WriteEvent mocks writing lifecycle events to a buffer that can be read for
+// tests
+func (m *IOStreamsMock) WriteEvent(ctx context.Context, event Event) {
+	line, _ := json.Marshal(event)
+	m.events.Write(append(line, '\n'))
+}
+
+// GetEvents returns the lifecycle events that were written
+func (m *IOStreamsMock) GetEvents() string {
+	return m.events.String()
+}
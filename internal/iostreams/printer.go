@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/goutils"
 	"github.com/slackapi/slack-cli/internal/style"
 )
 
@@ -38,6 +40,7 @@ type Printer interface {
 	// PrintInfo print a formatted message to stdout, sometimes tracing context
 	PrintInfo(ctx context.Context, shouldTrace bool, format string, a ...any)
 	// PrintTrace prints traceID and values to stdout if SLACK_TEST_TRACE=true
+	// and writes the trace as an event if an events writer is set
 	//
 	// Trace value definitions are listed in internal/slacktrace/slacktrace.go
 	PrintTrace(ctx context.Context, traceID string, traceValues ...string)
@@ -46,6 +49,10 @@ type Printer interface {
 // PrintDebug prints a debug message to stdout if debug is enabled
 func (io *IOStreams) PrintDebug(ctx context.Context, format string, a ...any) {
 	message := strings.TrimSpace(style.RemoveANSI(sprintF(format, a...)))
+	// Debug log files are redacted when written so only shown output needs this
+	if io.config.DebugEnabled && !io.config.NoRedactFlag {
+		message = goutils.RedactPII(message)
+	}
 	span, _ := opentracing.StartSpanFromContext(ctx, "printDebug", opentracing.Tag{Key: "debug_log", Value: message})
 	defer span.Finish()
 	lines := strings.Split(message, "\n")
@@ -62,6 +69,9 @@ func (io *IOStreams) PrintDebug(ctx context.Context, format string, a ...any) {
 // PrintError logs and prints an error message to stderr
 func (io *IOStreams) PrintError(ctx context.Context, format string, a ...any) {
 	message := sprintF(format, a...)
+	if !io.config.NoRedactFlag {
+		message = goutils.RedactSecrets(message)
+	}
 	span, _ := opentracing.StartSpanFromContext(ctx, "printError", opentracing.Tag{Key: "error_log", Value: message})
 	defer span.Finish()
 	_ = io.FlushToLogFile(ctx, "error", message)
@@ -79,6 +89,9 @@ func (io *IOStreams) PrintWarning(ctx context.Context, format string, a ...any)
 	span, _ := opentracing.StartSpanFromContext(ctx, "printWarning", opentracing.Tag{Key: "warning_log", Value: message})
 	defer span.Finish()
 	_ = io.FlushToLogFile(ctx, "warning", message)
+	if !io.config.LogLevel.Includes(config.LogLevelWarn) {
+		return
+	}
 	io.Stderr.Println("\n" + style.Emoji("warning") + message)
 }
 
@@ -89,6 +102,9 @@ func (io *IOStreams) PrintInfo(ctx context.Context, shouldTrace bool, format str
 		span, _ := opentracing.StartSpanFromContext(ctx, "printInfo", opentracing.Tag{Key: "printInfo", Value: message})
 		defer span.Finish()
 	}
+	if !io.config.LogLevel.Includes(config.LogLevelInfo) {
+		return
+	}
 	if style.IsLipglossEnabled() {
 		io.Stdout.Println(message)
 	} else {
@@ -97,7 +113,9 @@ func (io *IOStreams) PrintInfo(ctx context.Context, shouldTrace bool, format str
 }
 
 // PrintTrace prints traceID and values to stdout if SLACK_TEST_TRACE=true
+// and writes the trace as an event if an events writer is set
 func (io *IOStreams) PrintTrace(ctx context.Context, traceID string, traceValues ...string) {
+	io.WriteEvent(ctx, Event{Type: EventTrace, TraceID: traceID, Values: traceValues})
 	if !io.config.SlackTestTraceFlag {
 		return
 	}
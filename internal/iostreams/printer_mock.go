@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/style"
 )
 
@@ -45,6 +46,9 @@ func (m *IOStreamsMock) PrintWarning(ctx context.Context, format string, a ...in
 
 // PrintInfo print a formatted message to stdout, sometimes tracing context
 func (m *IOStreamsMock) PrintInfo(ctx context.Context, shouldTrace bool, format string, a ...interface{}) {
+	if !m.config.LogLevel.Includes(config.LogLevelInfo) {
+		return
+	}
 	msg := fmt.Sprintf(format, a...)
 	m.Stdout.Println(msg)
 }
@@ -52,6 +56,7 @@ func (m *IOStreamsMock) PrintInfo(ctx context.Context, shouldTrace bool, format
 // PrintTrace mocks how traces are generated and printed, matching the actual implementation
 func (m *IOStreamsMock) PrintTrace(ctx context.Context, traceID string, traceValues ...string) {
 	m.Called(ctx, traceID, traceValues)
+	m.WriteEvent(ctx, Event{Type: EventTrace, TraceID: traceID, Values: traceValues})
 	if !m.config.SlackTestTraceFlag {
 		return
 	}
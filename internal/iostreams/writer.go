@@ -35,6 +35,10 @@ type Writer interface {
 	// WriteDebug writes the debug message using the io.Writer implementation
 	WriteDebug(context.Context) WriteDebugger
 
+	// WriteDiagnostic returns the writer associated with diagnostic outputs of
+	// subprocesses such as hooks
+	WriteDiagnostic() io.Writer
+
 	// WriteIndent writes an indented message to the writer
 	WriteIndent(io.Writer) WriteIndenter
 
@@ -140,6 +144,21 @@ func (io *IOStreams) WriteErr() io.Writer {
 	return io.Stderr.Writer()
 }
 
+// WriteDiagnostic returns the writer associated with diagnostic outputs of
+// subprocesses such as hooks
+func (io *IOStreams) WriteDiagnostic() io.Writer {
+	return diagnosticWriter(io.config.DebugEnabled, io.WriteErr())
+}
+
+// diagnosticWriter streams outputs to stderr with secondary highlights or drops
+// outputs when debug logs are enabled since those logs include the outputs
+func diagnosticWriter(debug bool, stderr io.Writer) io.Writer {
+	if debug {
+		return io.Discard
+	}
+	return WriteIndenter{Writer: WriteSecondarier{Writer: stderr}}
+}
+
 // WriteDebugger contains information needed to write debug logs
 type WriteDebugger struct {
 	ctx context.Context
@@ -29,6 +29,11 @@ func (m *IOStreamsMock) WriteErr() io.Writer {
 	return m.Stderr.Writer()
 }
 
+// WriteDiagnostic returns the mocked writer associated with diagnostic outputs
+func (m *IOStreamsMock) WriteDiagnostic() io.Writer {
+	return diagnosticWriter(m.config.DebugEnabled, m.WriteErr())
+}
+
 // WriteDebug implements the actual WriteDebug method with a mock call
 func (m *IOStreamsMock) WriteDebug(ctx context.Context) WriteDebugger {
 	m.Called(ctx)
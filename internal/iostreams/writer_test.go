@@ -291,3 +291,31 @@ func Test_WriteSecondary(t *testing.T) {
 		})
 	}
 }
+
+func Test_diagnosticWriter(t *testing.T) {
+	tests := map[string]struct {
+		debug    bool
+		input    string
+		expected string
+	}{
+		"streams indented outputs to stderr": {
+			input:    "bundling\nbundled\n",
+			expected: "   bundling\n   bundled\n",
+		},
+		"drops outputs when debug logs include these": {
+			debug:    true,
+			input:    "bundling\n",
+			expected: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			w := diagnosticWriter(tc.debug, buff)
+			n, err := w.Write([]byte(tc.input))
+			require.NoError(t, err)
+			require.Equal(t, len(tc.input), n)
+			assert.Equal(t, tc.expected, buff.String())
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/icon"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+)
+
+// LintRule is a local check of best practices for an app manifest
+type LintRule struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	check       func(fs afero.Fs, slackManifest types.SlackYaml) slackerror.Warnings
+}
+
+// LintRules are the checks made by Lint in the order of outputs
+var LintRules = []LintRule{
+	{
+		Code:        "missing_bot_display_name",
+		Description: "The bot user of an app with bot scopes has a display name",
+		check:       lintBotDisplayName,
+	},
+	{
+		Code:        "broad_scope",
+		Description: "Scopes that grant admin access or read all message history are avoided",
+		check:       lintBroadScopes,
+	},
+	{
+		Code:        "missing_outgoing_domain",
+		Description: "External URLs of an app hosted on Slack are listed in outgoing domains",
+		check:       lintOutgoingDomains,
+	},
+	{
+		Code:        "missing_icon",
+		Description: "The app has an icon file in the project",
+		check:       lintIcon,
+	},
+}
+
+// broadScopePrefixes start scopes that grant access to more than most apps need
+var broadScopePrefixes = []string{"admin"}
+
+// broadScopeSuffixes end scopes that grant access to more than most apps need
+var broadScopeSuffixes = []string{":history"}
+
+// Lint checks the app manifest and project files against LintRules without
+// requests to Slack
+func Lint(fs afero.Fs, slackManifest types.SlackYaml) slackerror.Warnings {
+	warnings := slackerror.Warnings{}
+	for _, rule := range LintRules {
+		warnings = append(warnings, rule.check(fs, slackManifest)...)
+	}
+	return warnings
+}
+
+// lintBotDisplayName warns if bot scopes are requested without a bot display name
+func lintBotDisplayName(fs afero.Fs, slackManifest types.SlackYaml) slackerror.Warnings {
+	manifest := slackManifest.AppManifest
+	if manifest.OAuthConfig == nil || manifest.OAuthConfig.Scopes == nil || len(manifest.OAuthConfig.Scopes.Bot) == 0 {
+		return nil
+	}
+	if manifest.Features != nil && strings.TrimSpace(manifest.Features.BotUser.DisplayName) != "" {
+		return nil
+	}
+	return slackerror.Warnings{{
+		Code:        "missing_bot_display_name",
+		Message:     "The bot user does not have a display name",
+		Remediation: "Add a display name to the bot user of the app",
+		Pointer:     "/features/bot_user/display_name",
+	}}
+}
+
+// lintBroadScopes warns of each bot or user scope that grants broad access
+func lintBroadScopes(fs afero.Fs, slackManifest types.SlackYaml) slackerror.Warnings {
+	manifest := slackManifest.AppManifest
+	if manifest.OAuthConfig == nil || manifest.OAuthConfig.Scopes == nil {
+		return nil
+	}
+	warnings := slackerror.Warnings{}
+	scopes := map[string][]string{
+		"bot":           manifest.OAuthConfig.Scopes.Bot,
+		"bot_optional":  manifest.OAuthConfig.Scopes.BotOptional,
+		"user":          manifest.OAuthConfig.Scopes.User,
+		"user_optional": manifest.OAuthConfig.Scopes.UserOptional,
+	}
+	for _, kind := range slices.Sorted(maps.Keys(scopes)) {
+		for i, scope := range scopes[kind] {
+			if !isBroadScope(scope) {
+				continue
+			}
+			warnings = append(warnings, slackerror.Warning{
+				Code:        "broad_scope",
+				Message:     fmt.Sprintf("The %s scope grants broad access", scope),
+				Remediation: "Request a narrower scope if the app does not need this access",
+				Pointer:     fmt.Sprintf("/oauth_config/scopes/%s/%d", kind, i),
+			})
+		}
+	}
+	return warnings
+}
+
+// isBroadScope returns if the scope grants more access than most apps need
+func isBroadScope(scope string) bool {
+	for _, prefix := range broadScopePrefixes {
+		if scope == prefix || strings.HasPrefix(scope, prefix+".") || strings.HasPrefix(scope, prefix+":") {
+			return true
+		}
+	}
+	for _, suffix := range broadScopeSuffixes {
+		if strings.HasSuffix(scope, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintOutgoingDomains warns of each external URL of an app hosted on Slack
+// with a domain that is not listed in the outgoing domains
+func lintOutgoingDomains(fs afero.Fs, slackManifest types.SlackYaml) slackerror.Warnings {
+	manifest := slackManifest.AppManifest
+	if !manifest.IsFunctionRuntimeSlackHosted() {
+		return nil
+	}
+	outgoingDomains := []string{}
+	if manifest.OutgoingDomains != nil {
+		outgoingDomains = *manifest.OutgoingDomains
+	}
+	warnings := slackerror.Warnings{}
+	for _, external := range externalURLs(manifest) {
+		parsed, err := url.Parse(external.url)
+		if err != nil || parsed.Hostname() == "" || slices.Contains(outgoingDomains, parsed.Hostname()) {
+			continue
+		}
+		warnings = append(warnings, slackerror.Warning{
+			Code:        "missing_outgoing_domain",
+			Message:     fmt.Sprintf("The domain %s is not listed in the outgoing domains", parsed.Hostname()),
+			Remediation: "Add the domain to the outgoing domains of the app manifest",
+			Pointer:     external.pointer,
+		})
+	}
+	return warnings
+}
+
+// externalURL is a URL of the app manifest that functions request
+type externalURL struct {
+	pointer string
+	url     string
+}
+
+// externalURLs returns the URLs of MCP servers and OAuth2 providers of the app
+// manifest in a stable order
+func externalURLs(manifest types.AppManifest) []externalURL {
+	urls := []externalURL{}
+	for _, name := range slices.Sorted(maps.Keys(manifest.MCPServers)) {
+		urls = append(urls, externalURL{
+			pointer: fmt.Sprintf("/mcp_servers/%s/url", name),
+			url:     manifest.MCPServers[name].URL,
+		})
+	}
+	if manifest.ExternalAuthProviders == nil {
+		return urls
+	}
+	for _, name := range slices.Sorted(maps.Keys(manifest.ExternalAuthProviders.OAuth2)) {
+		provider := manifest.ExternalAuthProviders.OAuth2[name]
+		if provider == nil || provider.JSONData == nil {
+			continue
+		}
+		var values struct {
+			Options map[string]any `json:"options"`
+		}
+		if err := json.Unmarshal(*provider.JSONData, &values); err != nil {
+			continue
+		}
+		for _, key := range []string{"authorization_url", "token_url"} {
+			if value, ok := values.Options[key].(string); ok {
+				urls = append(urls, externalURL{
+					pointer: fmt.Sprintf("/external_auth_providers/oauth2/%s/options/%s", name, key),
+					url:     value,
+				})
+			}
+		}
+	}
+	return urls
+}
+
+// lintIcon warns if the app icon is not set or cannot be found
+func lintIcon(fs afero.Fs, slackManifest types.SlackYaml) slackerror.Warnings {
+	if slackManifest.Icon != "" {
+		if _, err := fs.Stat(slackManifest.Icon); err == nil {
+			return nil
+		}
+		return slackerror.Warnings{{
+			Code:        "missing_icon",
+			Message:     fmt.Sprintf("The icon file %s was not found", slackManifest.Icon),
+			Remediation: "Update the icon path of the app manifest to an image in the project",
+		}}
+	}
+	if icon.ResolveIconPath(fs) != "" {
+		return nil
+	}
+	return slackerror.Warnings{{
+		Code:        "missing_icon",
+		Message:     "The app does not have an icon",
+		Remediation: fmt.Sprintf("Add an icon file like assets/icon%s to the project", icon.SupportedExtensions[0]),
+	}}
+}
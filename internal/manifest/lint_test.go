@@ -0,0 +1,165 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lint(t *testing.T) {
+	outgoingDomains := []string{"mcp.example.com"}
+	tests := map[string]struct {
+		manifest         types.SlackYaml
+		iconFile         string
+		expectedWarnings slackerror.Warnings
+	}{
+		"returns no warnings for a manifest of best practices": {
+			manifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					Features: &types.AppFeatures{BotUser: types.BotUser{DisplayName: "tasks"}},
+					OAuthConfig: &types.OAuthConfig{
+						Scopes: &types.ManifestScopes{Bot: []string{"chat:write", "commands"}},
+					},
+					Settings:        &types.AppSettings{FunctionRuntime: types.SlackHosted},
+					MCPServers:      map[string]types.MCPServer{"search": {URL: "https://mcp.example.com/sse"}},
+					OutgoingDomains: &outgoingDomains,
+				},
+			},
+			iconFile:         "assets/icon.png",
+			expectedWarnings: slackerror.Warnings{},
+		},
+		"warns of bot scopes without a bot display name": {
+			manifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					OAuthConfig: &types.OAuthConfig{
+						Scopes: &types.ManifestScopes{Bot: []string{"chat:write"}},
+					},
+				},
+			},
+			iconFile: "icon.png",
+			expectedWarnings: slackerror.Warnings{
+				{
+					Code:        "missing_bot_display_name",
+					Message:     "The bot user does not have a display name",
+					Remediation: "Add a display name to the bot user of the app",
+					Pointer:     "/features/bot_user/display_name",
+				},
+			},
+		},
+		"warns of broad bot and user scopes": {
+			manifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					Features: &types.AppFeatures{BotUser: types.BotUser{DisplayName: "tasks"}},
+					OAuthConfig: &types.OAuthConfig{
+						Scopes: &types.ManifestScopes{
+							Bot:  []string{"chat:write", "channels:history"},
+							User: []string{"admin.users:write"},
+						},
+					},
+				},
+			},
+			iconFile: "assets/icon.png",
+			expectedWarnings: slackerror.Warnings{
+				{
+					Code:        "broad_scope",
+					Message:     "The channels:history scope grants broad access",
+					Remediation: "Request a narrower scope if the app does not need this access",
+					Pointer:     "/oauth_config/scopes/bot/1",
+				},
+				{
+					Code:        "broad_scope",
+					Message:     "The admin.users:write scope grants broad access",
+					Remediation: "Request a narrower scope if the app does not need this access",
+					Pointer:     "/oauth_config/scopes/user/0",
+				},
+			},
+		},
+		"warns of external urls of hosted apps missing from outgoing domains": {
+			manifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					Settings:   &types.AppSettings{FunctionRuntime: types.SlackHosted},
+					MCPServers: map[string]types.MCPServer{"search": {URL: "https://search.example.com/mcp"}},
+					ExternalAuthProviders: &types.ManifestAuthProviders{
+						OAuth2: map[string]*types.RawJSON{
+							"github": types.ToRawJSON(`{"options":{"authorization_url":"https://github.com/login/oauth/authorize","token_url":"https://mcp.example.com/token"}}`),
+						},
+					},
+					OutgoingDomains: &outgoingDomains,
+				},
+			},
+			iconFile: "assets/icon.png",
+			expectedWarnings: slackerror.Warnings{
+				{
+					Code:        "missing_outgoing_domain",
+					Message:     "The domain search.example.com is not listed in the outgoing domains",
+					Remediation: "Add the domain to the outgoing domains of the app manifest",
+					Pointer:     "/mcp_servers/search/url",
+				},
+				{
+					Code:        "missing_outgoing_domain",
+					Message:     "The domain github.com is not listed in the outgoing domains",
+					Remediation: "Add the domain to the outgoing domains of the app manifest",
+					Pointer:     "/external_auth_providers/oauth2/github/options/authorization_url",
+				},
+			},
+		},
+		"ignores external urls of apps not hosted on slack": {
+			manifest: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					MCPServers: map[string]types.MCPServer{"search": {URL: "https://search.example.com/mcp"}},
+				},
+			},
+			iconFile:         "assets/icon.png",
+			expectedWarnings: slackerror.Warnings{},
+		},
+		"warns of a missing icon": {
+			manifest: types.SlackYaml{},
+			expectedWarnings: slackerror.Warnings{
+				{
+					Code:        "missing_icon",
+					Message:     "The app does not have an icon",
+					Remediation: "Add an icon file like assets/icon.png to the project",
+				},
+			},
+		},
+		"warns of an icon path that is not found": {
+			manifest: types.SlackYaml{Icon: "images/logo.png"},
+			iconFile: "assets/icon.png",
+			expectedWarnings: slackerror.Warnings{
+				{
+					Code:        "missing_icon",
+					Message:     "The icon file images/logo.png was not found",
+					Remediation: "Update the icon path of the app manifest to an image in the project",
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.iconFile != "" {
+				require.NoError(t, afero.WriteFile(fs, tc.iconFile, []byte("png"), 0o600))
+			}
+			warnings := Lint(fs, tc.manifest)
+			assert.Equal(t, tc.expectedWarnings, warnings)
+		})
+	}
+}
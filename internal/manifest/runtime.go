@@ -0,0 +1,119 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+// Runtimes that a manifest can be validated as
+const (
+	RuntimeBolt = "bolt"
+	RuntimeDeno = "deno"
+)
+
+// Runtimes are the values accepted as a runtime override
+var Runtimes = []string{RuntimeBolt, RuntimeDeno}
+
+// ValidateRuntime errors if the runtime is not a known runtime override
+func ValidateRuntime(runtime string) error {
+	switch runtime {
+	case "", RuntimeBolt, RuntimeDeno:
+		return nil
+	}
+	return slackerror.New(slackerror.ErrInvalidFlag).
+		WithMessage("The runtime \"%s\" is not supported", runtime).
+		WithRemediation("Use one of: %s", strings.Join(Runtimes, ", "))
+}
+
+// ConfigureRuntime returns a copy of the manifest with the values expected of
+// the runtime, leaving the original manifest unchanged
+//
+// The "bolt" runtime uses a remote function runtime and the "deno" runtime uses
+// the hosted function runtime. An empty runtime returns the manifest as is.
+func ConfigureRuntime(ctx context.Context, clients *shared.ClientFactory, manifest types.AppManifest, runtime string) (types.AppManifest, error) {
+	if err := ValidateRuntime(runtime); err != nil {
+		return manifest, err
+	}
+	if runtime == "" {
+		return manifest, nil
+	}
+	manifest.Settings = copySettings(manifest.Settings)
+	switch runtime {
+	case RuntimeBolt:
+		manifest.Settings.FunctionRuntime = types.Remote
+	case RuntimeDeno:
+		ConfigureHostedManifest(ctx, clients, &manifest)
+	}
+	return manifest, nil
+}
+
+// ConfigureHostedManifest sets the expected manifest values for hosted runtimes
+//
+// Run On Slack apps have certain runtime requirements so certain values are set
+// here before installing the application. This includes interactivity and event
+// subscriptions as specified through Run On Slack hosting requirements.
+//
+// The CLI determines the API host from selected credentials during installation.
+//
+// Apps without a specified or a "remote" function runtime should ignore this.
+func ConfigureHostedManifest(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	manifest *types.AppManifest,
+) {
+	if manifest == nil {
+		return
+	}
+	clients.IO.PrintDebug(ctx, "updating app manifest with required properties for a run-on-slack function runtime")
+	if manifest.Settings == nil {
+		manifest.Settings = &types.AppSettings{}
+	}
+	manifest.Settings.FunctionRuntime = types.SlackHosted
+	if manifest.Settings.Interactivity == nil {
+		manifest.Settings.Interactivity = &types.ManifestInteractivity{}
+	}
+	host := clients.API().Host()
+	manifest.Settings.Interactivity.IsEnabled = true
+	manifest.Settings.Interactivity.MessageMenuOptionsURL = host
+	manifest.Settings.Interactivity.RequestURL = host
+	if manifest.Settings.EventSubscriptions == nil {
+		manifest.Settings.EventSubscriptions = &types.ManifestEventSubscriptions{}
+	}
+	manifest.Settings.EventSubscriptions.RequestURL = host
+}
+
+// copySettings returns a copy of the settings that changes to the runtime values
+// can be made on without changing the original
+func copySettings(settings *types.AppSettings) *types.AppSettings {
+	if settings == nil {
+		return &types.AppSettings{}
+	}
+	copied := *settings
+	if settings.Interactivity != nil {
+		interactivity := *settings.Interactivity
+		copied.Interactivity = &interactivity
+	}
+	if settings.EventSubscriptions != nil {
+		eventSubscriptions := *settings.EventSubscriptions
+		copied.EventSubscriptions = &eventSubscriptions
+	}
+	return &copied
+}
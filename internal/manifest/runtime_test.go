@@ -0,0 +1,118 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConfigureRuntime(t *testing.T) {
+	tests := map[string]struct {
+		manifest         types.AppManifest
+		runtime          string
+		expectedManifest types.AppManifest
+		expectedError    error
+	}{
+		"returns the manifest without a runtime": {
+			manifest: types.AppManifest{
+				Settings: &types.AppSettings{FunctionRuntime: types.SlackHosted},
+			},
+			runtime: "",
+			expectedManifest: types.AppManifest{
+				Settings: &types.AppSettings{FunctionRuntime: types.SlackHosted},
+			},
+		},
+		"sets a remote function runtime for bolt": {
+			manifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					FunctionRuntime: types.SlackHosted,
+					Interactivity:   &types.ManifestInteractivity{IsEnabled: true, RequestURL: "https://example.com"},
+				},
+			},
+			runtime: "bolt",
+			expectedManifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					FunctionRuntime: types.Remote,
+					Interactivity:   &types.ManifestInteractivity{IsEnabled: true, RequestURL: "https://example.com"},
+				},
+			},
+		},
+		"sets the hosted function runtime and urls for deno": {
+			manifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					FunctionRuntime: types.Remote,
+					Interactivity:   &types.ManifestInteractivity{RequestURL: "https://example.com"},
+				},
+			},
+			runtime: "deno",
+			expectedManifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					FunctionRuntime: types.SlackHosted,
+					Interactivity: &types.ManifestInteractivity{
+						IsEnabled:             true,
+						MessageMenuOptionsURL: "https://slack.com",
+						RequestURL:            "https://slack.com",
+					},
+					EventSubscriptions: &types.ManifestEventSubscriptions{RequestURL: "https://slack.com"},
+				},
+			},
+		},
+		"sets the hosted function runtime without settings for deno": {
+			manifest: types.AppManifest{},
+			runtime:  "deno",
+			expectedManifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					FunctionRuntime: types.SlackHosted,
+					Interactivity: &types.ManifestInteractivity{
+						IsEnabled:             true,
+						MessageMenuOptionsURL: "https://slack.com",
+						RequestURL:            "https://slack.com",
+					},
+					EventSubscriptions: &types.ManifestEventSubscriptions{RequestURL: "https://slack.com"},
+				},
+			},
+		},
+		"errors for an unknown runtime": {
+			manifest: types.AppManifest{},
+			runtime:  "java",
+			expectedError: slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The runtime \"java\" is not supported").
+				WithRemediation("Use one of: bolt, deno"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, clients, _, _, _ := setupCommonMocks(t)
+			original, err := json.Marshal(tc.manifest)
+			require.NoError(t, err)
+			manifest, err := ConfigureRuntime(ctx, clients, tc.manifest, tc.runtime)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedManifest, manifest)
+			unchanged, err := json.Marshal(tc.manifest)
+			require.NoError(t, err)
+			assert.Equal(t, string(original), string(unchanged))
+		})
+	}
+}
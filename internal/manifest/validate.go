@@ -31,7 +31,16 @@ import (
 )
 
 // ManifestValidate validates the manifest from the project "get-manifest" hook
-func ManifestValidate(ctx context.Context, clients *shared.ClientFactory, app types.App, token string) (bool, slackerror.Warnings, error) {
+//
+// Requests to approve connectors are not prompted for if noPrompt is set and
+// are returned as errors instead.
+//
+// The manifest is validated with the values expected of the runtime when one
+// is set, without changes to the project.
+//
+// A manifest already gathered from the project can be provided to avoid running
+// the hook again. The hook is run if the manifest is nil.
+func ManifestValidate(ctx context.Context, clients *shared.ClientFactory, app types.App, token string, slackManifest *types.SlackYaml, noPrompt bool, runtime string) (bool, slackerror.Warnings, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.ManifestValidate")
 	defer span.Finish()
 
@@ -44,20 +53,29 @@ func ManifestValidate(ctx context.Context, clients *shared.ClientFactory, app ty
 		return false, nil, slackerror.New(slackerror.ErrAuthToken).WithRootCause(err)
 	}
 
-	slackManifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	if slackManifest == nil {
+		localManifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+		if err != nil {
+			return false, nil, slackerror.Wrap(err, slackerror.ErrAppManifestGenerate)
+		}
+		slackManifest = &localManifest
+	}
+	appManifest, err := ConfigureRuntime(ctx, clients, slackManifest.AppManifest, runtime)
 	if err != nil {
-		return false, nil, slackerror.Wrap(err, slackerror.ErrAppManifestGenerate)
+		return false, nil, err
 	}
 
 	// validate the manifest
-	validationResult, err := clients.API().ValidateAppManifest(ctx, token, slackManifest.AppManifest, app.AppID)
+	validationResult, err := clients.API().ValidateAppManifest(ctx, token, appManifest, app.AppID)
 
 	if retryValidate := HandleConnectorNotInstalled(ctx, clients, token, err); retryValidate {
-		validationResult, err = clients.API().ValidateAppManifest(ctx, token, slackManifest.AppManifest, app.AppID)
+		validationResult, err = clients.API().ValidateAppManifest(ctx, token, appManifest, app.AppID)
 	}
 
-	if err := HandleConnectorApprovalRequired(ctx, clients, token, err); err != nil {
-		return false, nil, err
+	if !noPrompt {
+		if err := HandleConnectorApprovalRequired(ctx, clients, token, err); err != nil {
+			return false, nil, err
+		}
 	}
 
 	if err != nil || len(validationResult.Warnings) > 0 {
@@ -38,7 +38,7 @@ func Test_ManifestValidate_GetManifestLocal_Error(t *testing.T) {
 	clients.AppClient().Manifest = manifestMock
 
 	// Test
-	isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token)
+	isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, false, "")
 
 	assert.Error(t, err)
 	assert.False(t, isValid)
@@ -53,13 +53,31 @@ func Test_ManifestValidate_Success(t *testing.T) {
 		clientsMock.API.On("ValidateAppManifest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ValidateAppManifestResult{}, nil)
 
 		// Test
-		isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token)
+		isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, false, "")
 
 		assert.NoError(t, err)
 		assert.True(t, isValid)
 	})
 }
 
+func Test_ManifestValidate_Runtime(t *testing.T) {
+	t.Run("should validate the manifest with the values of the runtime", func(t *testing.T) {
+		ctx, clients, clientsMock, appMock, authMock := setupCommonMocks(t)
+
+		// Mock manifest validation api result with no error
+		clientsMock.API.On("ValidateAppManifest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ValidateAppManifestResult{}, nil)
+
+		// Test
+		isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, false, RuntimeDeno)
+
+		assert.NoError(t, err)
+		assert.True(t, isValid)
+		clientsMock.API.AssertCalled(t, "ValidateAppManifest", mock.Anything, authMock.Token, mock.MatchedBy(func(manifest types.AppManifest) bool {
+			return manifest.IsFunctionRuntimeSlackHosted()
+		}), appMock.AppID)
+	})
+}
+
 func Test_ManifestValidate_Warnings(t *testing.T) {
 	t.Run("should return warnings", func(t *testing.T) {
 
@@ -75,7 +93,7 @@ func Test_ManifestValidate_Warnings(t *testing.T) {
 		}, nil)
 
 		// Test
-		_, warnings, err := ManifestValidate(ctx, clients, appMock, authMock.Token)
+		_, warnings, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, false, "")
 
 		assert.NoError(t, err)
 		assert.NoError(t, err)
@@ -99,7 +117,7 @@ func Test_ManifestValidate_Error(t *testing.T) {
 			}))
 
 		// Test
-		isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token)
+		isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, false, "")
 
 		assert.False(t, isValid)
 		assert.Error(t, err)
@@ -132,7 +150,7 @@ func Test_ManifestValidate_Error_ErrConnectorNotInstalled(t *testing.T) {
 		clientsMock.API.On("CertifiedAppInstall", mock.Anything, authMock.Token, mock.Anything).Return(api.CertifiedInstallResult{}, nil)
 
 		// Test
-		_, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token)
+		_, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, false, "")
 
 		// Since we've mocked the ValidateAppManifest call to return an error, we still expect this method to return an error
 		// despite a successful CertifiedAppInstall call. That is realistic given that a manifest can error for other reasons
@@ -146,6 +164,30 @@ func Test_ManifestValidate_Error_ErrConnectorNotInstalled(t *testing.T) {
 	})
 }
 
+func Test_ManifestValidate_NoPrompt(t *testing.T) {
+	t.Run("should not prompt to approve connectors", func(t *testing.T) {
+		ctx, clients, clientsMock, appMock, authMock := setupCommonMocks(t)
+
+		// Mock manifest validation api result with an approval required error
+		clientsMock.API.On("ValidateAppManifest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+			api.ValidateAppManifestResult{},
+			slackerror.New("a dummy error").WithDetails(slackerror.ErrorDetails{
+				slackerror.ErrorDetail{
+					Code:             slackerror.ErrConnectorApprovalRequired,
+					RelatedComponent: "A12345",
+				},
+			}))
+
+		// Test
+		isValid, _, err := ManifestValidate(ctx, clients, appMock, authMock.Token, nil, true, "")
+
+		assert.False(t, isValid)
+		assert.Error(t, err)
+		clientsMock.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+		clientsMock.API.AssertNotCalled(t, "RequestAppApproval", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func Test_HandleConnectorApprovalRequired(t *testing.T) {
 
 	testReason := "GIVE IT TO ME!"
@@ -0,0 +1,199 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+var rawJSONType = reflect.TypeOf(types.RawJSON{})
+
+// ParseVars parses "path=value" overrides of app manifest values where the
+// path is a dotted path of manifest keys like "display_information.name"
+//
+// Paths that are not part of the app manifest error before any override is set.
+func ParseVars(pairs []string) (map[string]string, error) {
+	vars := map[string]string{}
+	for _, pair := range pairs {
+		path, value, ok := strings.Cut(pair, "=")
+		if !ok || path == "" {
+			return nil, slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("Invalid manifest variable: %s", pair).
+				WithRemediation("Use the format path=value like display_information.name=Tasks")
+		}
+		if _, err := varValue(path, value); err != nil {
+			return nil, err
+		}
+		vars[path] = value
+	}
+	return vars, nil
+}
+
+// SetVars overrides values of the app manifest at the dotted paths of vars
+//
+// Shorter paths are set before longer paths so nested overrides are kept.
+func SetVars(appManifest *types.AppManifest, vars map[string]string) error {
+	data, err := json.Marshal(appManifest)
+	if err != nil {
+		return slackerror.New(slackerror.ErrInvalidManifest).WithRootCause(err)
+	}
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return slackerror.New(slackerror.ErrInvalidManifest).WithRootCause(err)
+	}
+	for _, path := range slices.Sorted(maps.Keys(vars)) {
+		value, err := varValue(path, vars[path])
+		if err != nil {
+			return err
+		}
+		if _, err := setVarValue(values, strings.Split(path, "."), value); err != nil {
+			return slackerror.New(slackerror.ErrInvalidManifest).
+				WithMessage("Failed to set the manifest variable %s", path).
+				WithRootCause(err)
+		}
+	}
+	data, err = json.Marshal(values)
+	if err != nil {
+		return slackerror.New(slackerror.ErrInvalidManifest).WithRootCause(err)
+	}
+	var updated types.AppManifest
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return slackerror.New(slackerror.ErrInvalidManifest).
+			WithMessage("Failed to set the manifest variables").
+			WithRootCause(err)
+	}
+	*appManifest = updated
+	return nil
+}
+
+// varValue returns the value to set at the path with the type of the manifest
+// value at that path
+func varValue(path string, value string) (any, error) {
+	kind, err := varPathKind(path)
+	if err != nil {
+		return nil, err
+	}
+	if kind == reflect.String {
+		return value, nil
+	}
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		if kind == reflect.Interface {
+			return value, nil
+		}
+		return nil, slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The manifest variable %s must have a %s value", path, kind).
+			WithRootCause(err)
+	}
+	return decoded, nil
+}
+
+// varPathKind returns the kind of value at a dotted path of the app manifest
+// or errors if the path is not part of the app manifest
+//
+// Paths into values without a known structure have the interface kind.
+func varPathKind(path string) (reflect.Kind, error) {
+	errInvalidPath := slackerror.New(slackerror.ErrInvalidFlag).
+		WithMessage("The manifest variable path %s is not part of the app manifest", path)
+	t := reflect.TypeOf(types.AppManifest{})
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			return reflect.Invalid, errInvalidPath
+		}
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t == rawJSONType || t.Kind() == reflect.Interface {
+			return reflect.Interface, nil
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := jsonField(t, segment)
+			if !ok {
+				return reflect.Invalid, errInvalidPath
+			}
+			t = field.Type
+		case reflect.Map:
+			t = t.Elem()
+		case reflect.Slice:
+			if _, err := strconv.Atoi(segment); err != nil {
+				return reflect.Invalid, errInvalidPath
+			}
+			t = t.Elem()
+		default:
+			return reflect.Invalid, errInvalidPath
+		}
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == rawJSONType {
+		return reflect.Interface, nil
+	}
+	return t.Kind(), nil
+}
+
+// jsonField returns the struct field with the JSON name
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// setVarValue sets the value at the path of keys in the decoded JSON node and
+// creates missing objects along the way
+func setVarValue(node any, keys []string, value any) (any, error) {
+	if len(keys) == 0 {
+		return value, nil
+	}
+	switch n := node.(type) {
+	case nil:
+		return setVarValue(map[string]any{}, keys, value)
+	case map[string]any:
+		child, err := setVarValue(n[keys[0]], keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		n[keys[0]] = child
+		return n, nil
+	case []any:
+		index, err := strconv.Atoi(keys[0])
+		if err != nil || index < 0 || index >= len(n) {
+			return nil, slackerror.New(slackerror.ErrInvalidManifest).
+				WithMessage("The index %s is out of range for a list of %d values", keys[0], len(n))
+		}
+		n[index], err = setVarValue(n[index], keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	default:
+		return nil, slackerror.New(slackerror.ErrInvalidManifest).
+			WithMessage("The value at %s is not an object", keys[0])
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseVars(t *testing.T) {
+	tests := map[string]struct {
+		pairs         []string
+		expectedVars  map[string]string
+		expectedError string
+	}{
+		"parses simple and nested paths": {
+			pairs: []string{
+				"display_information.name=Tasks",
+				"settings.event_subscriptions.request_url=https://example.com/events?a=b",
+			},
+			expectedVars: map[string]string{
+				"display_information.name":                 "Tasks",
+				"settings.event_subscriptions.request_url": "https://example.com/events?a=b",
+			},
+		},
+		"parses paths with map keys and list indexes": {
+			pairs: []string{
+				"functions.greet.title=Greet",
+				"settings.event_subscriptions.bot_events.0=app_mention",
+				"settings.socket_mode_enabled=true",
+			},
+			expectedVars: map[string]string{
+				"functions.greet.title":                     "Greet",
+				"settings.event_subscriptions.bot_events.0": "app_mention",
+				"settings.socket_mode_enabled":              "true",
+			},
+		},
+		"errors without a value separator": {
+			pairs:         []string{"display_information.name"},
+			expectedError: "Invalid manifest variable: display_information.name",
+		},
+		"errors for a path that is not part of the app manifest": {
+			pairs:         []string{"settings.unknown=true"},
+			expectedError: "The manifest variable path settings.unknown is not part of the app manifest",
+		},
+		"errors for a path past a value": {
+			pairs:         []string{"display_information.name.first=Tasks"},
+			expectedError: "The manifest variable path display_information.name.first is not part of the app manifest",
+		},
+		"errors for a value of the wrong type": {
+			pairs:         []string{"settings.socket_mode_enabled=yes"},
+			expectedError: "The manifest variable settings.socket_mode_enabled must have a bool value",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vars, err := ParseVars(tc.pairs)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+				assert.Contains(t, slackerror.ToSlackError(err).Message, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVars, vars)
+		})
+	}
+}
+
+func Test_SetVars(t *testing.T) {
+	socketMode := true
+	tests := map[string]struct {
+		manifest         types.AppManifest
+		vars             map[string]string
+		expectedManifest types.AppManifest
+		expectedError    string
+	}{
+		"overrides a simple path": {
+			manifest: types.AppManifest{
+				DisplayInformation: types.DisplayInformation{Name: "Tasks (dev)", Description: "Tracks tasks"},
+			},
+			vars: map[string]string{"display_information.name": "Tasks"},
+			expectedManifest: types.AppManifest{
+				DisplayInformation: types.DisplayInformation{Name: "Tasks", Description: "Tracks tasks"},
+			},
+		},
+		"overrides a nested path and creates missing objects": {
+			manifest: types.AppManifest{
+				DisplayInformation: types.DisplayInformation{Name: "Tasks"},
+			},
+			vars: map[string]string{
+				"settings.event_subscriptions.request_url": "https://example.com/events",
+				"settings.socket_mode_enabled":             "true",
+			},
+			expectedManifest: types.AppManifest{
+				DisplayInformation: types.DisplayInformation{Name: "Tasks"},
+				Settings: &types.AppSettings{
+					SocketModeEnabled:  &socketMode,
+					EventSubscriptions: &types.ManifestEventSubscriptions{RequestURL: "https://example.com/events"},
+				},
+			},
+		},
+		"overrides a list value at an index": {
+			manifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					EventSubscriptions: &types.ManifestEventSubscriptions{BotEvents: []string{"message.im", "reaction_added"}},
+				},
+			},
+			vars: map[string]string{"settings.event_subscriptions.bot_events.1": "app_mention"},
+			expectedManifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					EventSubscriptions: &types.ManifestEventSubscriptions{BotEvents: []string{"message.im", "app_mention"}},
+				},
+			},
+		},
+		"sets nested paths after shorter paths": {
+			manifest: types.AppManifest{},
+			vars: map[string]string{
+				"settings.event_subscriptions":             `{"bot_events":["app_mention"]}`,
+				"settings.event_subscriptions.request_url": "https://example.com/events",
+			},
+			expectedManifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					EventSubscriptions: &types.ManifestEventSubscriptions{
+						RequestURL: "https://example.com/events",
+						BotEvents:  []string{"app_mention"},
+					},
+				},
+			},
+		},
+		"errors for a list index out of range": {
+			manifest: types.AppManifest{
+				Settings: &types.AppSettings{
+					EventSubscriptions: &types.ManifestEventSubscriptions{BotEvents: []string{"message.im"}},
+				},
+			},
+			vars:          map[string]string{"settings.event_subscriptions.bot_events.3": "app_mention"},
+			expectedError: "Failed to set the manifest variable settings.event_subscriptions.bot_events.3",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := SetVars(&tc.manifest, tc.vars)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrInvalidManifest, slackerror.ToSlackError(err).Code)
+				assert.Contains(t, slackerror.ToSlackError(err).Message, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedManifest, tc.manifest)
+		})
+	}
+}
@@ -16,6 +16,7 @@ package apps
 
 import (
 	"context"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/internal/api"
@@ -27,6 +28,19 @@ import (
 
 // Delete will delete the app for this teamDomain both remotely (API) and locally (project)
 func Delete(ctx context.Context, clients *shared.ClientFactory, teamDomain string, app types.App, auth types.SlackAuth) (types.App, string, error) {
+	app, teamName, err := DeleteRemote(ctx, clients, teamDomain, app, auth)
+	if err != nil {
+		return app, teamName, err
+	}
+	if err := RemoveSaved(ctx, clients, app); err != nil {
+		return types.App{}, teamName, err
+	}
+	return app, teamName, nil
+}
+
+// DeleteRemote will delete the app for this teamDomain remotely (API) and keep
+// the app saved in project files
+func DeleteRemote(ctx context.Context, clients *shared.ClientFactory, teamDomain string, app types.App, auth types.SlackAuth) (types.App, string, error) {
 	span, _ := opentracing.StartSpanFromContext(ctx, "pkg.apps.delete")
 	defer span.Finish()
 
@@ -54,11 +68,15 @@ func Delete(ctx context.Context, clients *shared.ClientFactory, teamDomain strin
 	if err != nil {
 		return app, teamName, err
 	}
+	return app, teamName, nil
+}
 
-	// Remove the saved app from project files
+// RemoveSaved removes the saved app from project files without deleting the
+// app remotely
+func RemoveSaved(ctx context.Context, clients *shared.ClientFactory, app types.App) error {
 	removedApp, err := clients.AppClient().Remove(ctx, app)
 	if err != nil {
-		return types.App{}, teamName, err
+		return err
 	}
 	if removedApp.IsNew() {
 		clients.IO.PrintDebug(
@@ -68,7 +86,17 @@ func Delete(ctx context.Context, clients *shared.ClientFactory, teamDomain strin
 			app.TeamID,
 		)
 	}
-	return app, teamName, nil
+	return nil
+}
+
+// MarkSavedDeleted keeps the app in project files and marks it as deleted so it
+// is no longer selected
+func MarkSavedDeleted(ctx context.Context, clients *shared.ClientFactory, app types.App) error {
+	app.DeletedAt = time.Now().Unix()
+	if app.IsDev {
+		return clients.AppClient().SaveLocal(ctx, app)
+	}
+	return clients.AppClient().SaveDeployed(ctx, app)
 }
 
 // getAuthSession return the api.AuthSession for the current auth
@@ -17,6 +17,7 @@ package apps
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/slackapi/slack-cli/internal/style"
 )
 
@@ -40,6 +42,12 @@ const (
 
 const additionalManifestInfoNotice = "App manifest contains some components that may require additional information"
 
+// configureHostedManifest sets the expected manifest values for hosted runtimes
+var configureHostedManifest = manifest.ConfigureHostedManifest
+
+// setManifestVars overrides manifest values with the --manifest-var flags
+var setManifestVars = manifest.SetVars
+
 // Install installs the app to a team
 func Install(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, onlyCreateUpdateAppManifest bool, app types.App, orgGrantWorkspaceID string) (types.App, types.InstallState, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.apps.install")
@@ -101,15 +109,27 @@ func Install(ctx context.Context, clients *shared.ClientFactory, auth types.Slac
 	if slackManifest.IsFunctionRuntimeSlackHosted() {
 		configureHostedManifest(ctx, clients, &manifest)
 	}
+	if len(clients.Config.ManifestVars) > 0 {
+		if err := setManifestVars(&manifest, clients.Config.ManifestVars); err != nil {
+			return app, "", err
+		}
+	}
 
-	err = validateManifestForInstall(ctx, clients, token, app, manifest)
-	if err != nil {
-		return app, "", err
+	progress := newInstallProgress(clients)
+	if isSkipValidationFlagSet(clients) {
+		clients.IO.PrintInfo(ctx, false, "%s", style.SectionSecondaryf("App manifest validation skipped with the --skip-validation flag"))
+	} else {
+		progress.next(ctx, "Validating the app manifest")
+		err = validateManifestForInstall(ctx, clients, token, app, manifest)
+		if err != nil {
+			return app, "", err
+		}
 	}
 
 	start := time.Now()
 	switch {
 	case manifestUpdates:
+		progress.next(ctx, "Updating the app manifest")
 		_, _ = clients.IO.WriteOut().Write([]byte("\n" + style.Sectionf(style.TextSection{
 			Emoji: "books",
 			Text:  "App Manifest",
@@ -118,11 +138,13 @@ func Install(ctx context.Context, clients *shared.ClientFactory, auth types.Slac
 			},
 		})))
 		clients.IO.PrintDebug(ctx, "updating app %s", app.AppID)
+		saveManifestSnapshot(ctx, clients, token, app.AppID)
 		_, err := apiInterface.UpdateApp(ctx, token, app.AppID, manifest, clients.Config.ForceFlag, true)
 		if err != nil {
 			return app, "", err
 		}
 	case manifestCreates:
+		progress.next(ctx, "Creating the app manifest")
 		_, _ = clients.IO.WriteOut().Write([]byte(style.Sectionf(style.TextSection{
 			Emoji: "books",
 			Text:  "App Manifest",
@@ -201,6 +223,7 @@ func Install(ctx context.Context, clients *shared.ClientFactory, auth types.Slac
 	// Note - we use DeveloperAppInstall endpoint for both local (dev) runs
 	// and hosted installs https://github.com/slackapi/slack-cli/pull/456#discussion_r830272175
 
+	progress.next(ctx, "Installing the app")
 	result, installState, err := apiInterface.DeveloperAppInstall(ctx, clients.IO, token, app, botScopes, outgoingDomains, orgGrantWorkspaceID, clients.Config.AutoRequestAAAFlag)
 	if err != nil {
 		err = slackerror.Wrap(err, slackerror.ErrAppInstall)
@@ -220,6 +243,7 @@ func Install(ctx context.Context, clients *shared.ClientFactory, auth types.Slac
 
 	iconPath := resolveIconPath(ctx, clients, slackManifest.Icon)
 	if iconPath != "" {
+		progress.next(ctx, "Uploading the app icon")
 		err = updateIcon(ctx, clients, iconPath, app.AppID, token, manifest.IsFunctionRuntimeSlackHosted())
 		if err != nil {
 			clients.IO.PrintDebug(ctx, "icon error: %s", err)
@@ -244,7 +268,73 @@ func Install(ctx context.Context, clients *shared.ClientFactory, auth types.Slac
 	return app, types.InstallSuccess, nil
 }
 
+// installProgress prints the phases of an install so slow steps are not mistaken
+// for a hung command
+type installProgress struct {
+	clients *shared.ClientFactory
+	hidden  bool
+	phase   int
+	start   time.Time
+}
+
+// newInstallProgress starts the install progress that is hidden with the
+// --no-prompt flag
+func newInstallProgress(clients *shared.ClientFactory) *installProgress {
+	return &installProgress{
+		clients: clients,
+		hidden:  isNoPromptFlagSet(clients),
+		start:   time.Now(),
+	}
+}
+
+// next prints the phase that the install is starting with the elapsed time and
+// traces the phase for lifecycle events
+func (p *installProgress) next(ctx context.Context, text string) {
+	p.phase++
+	p.clients.IO.PrintTrace(ctx, slacktrace.AppInstallStep, strconv.Itoa(p.phase), text)
+	if p.hidden {
+		return
+	}
+	_, _ = p.clients.IO.WriteErr().Write([]byte(style.SectionSecondaryf(
+		"%s... (step %d, %.1fs elapsed)",
+		text,
+		p.phase,
+		time.Since(p.start).Seconds(),
+	)))
+}
+
+// isFailOnWarningFlagSet returns true if the command has a --fail-on-warning flag set
+func isFailOnWarningFlagSet(clients *shared.ClientFactory) bool {
+	if clients.Config.Flags == nil {
+		return false
+	}
+	flag := clients.Config.Flags.Lookup("fail-on-warning")
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// isSkipValidationFlagSet returns true if the command has a --skip-validation flag set
+func isSkipValidationFlagSet(clients *shared.ClientFactory) bool {
+	if clients.Config.Flags == nil {
+		return false
+	}
+	flag := clients.Config.Flags.Lookup("skip-validation")
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// isNoPromptFlagSet returns true if the command has a --no-prompt flag set
+func isNoPromptFlagSet(clients *shared.ClientFactory) bool {
+	if clients.Config.Flags == nil {
+		return false
+	}
+	flag := clients.Config.Flags.Lookup("no-prompt")
+	return flag != nil && flag.Value.String() == "true"
+}
+
 func printNonSuccessInstallState(ctx context.Context, clients *shared.ClientFactory, installState types.InstallState) {
+	// Checks on a pending request repeat the install while waiting for approval
+	if installState == types.InstallRequestPending && config.GetContextInstallApprovalWait(ctx) {
+		return
+	}
 	var (
 		primary   string
 		secondary string
@@ -324,6 +414,13 @@ func validateManifestForInstall(ctx context.Context, clients *shared.ClientFacto
 	warnings := validationResult.Warnings
 	continueWithBreakingChanges := clients.Config.ForceFlag
 	if len(warnings) > 0 {
+		// Warnings fail the install without prompts and even with the --force flag
+		if isFailOnWarningFlagSet(clients) {
+			clients.IO.PrintWarning(ctx, "%s", warnings.WarningBySection(clients.Config.DebugEnabled, "App manifest contains warnings"))
+			return slackerror.New(slackerror.ErrAppManifestValidate).
+				WithMessage("The app manifest has warnings and the --fail-on-warning flag is set").
+				WithRemediation("Resolve the warnings or try again without the --fail-on-warning flag")
+		}
 		if !clients.Config.ForceFlag {
 			continueWithBreakingChanges, err = continueDespiteWarning(ctx, clients, warnings)
 			if err != nil {
@@ -559,41 +656,6 @@ func InstallLocalApp(ctx context.Context, clients *shared.ClientFactory, orgGran
 // 	return hex.EncodeToString(hash.Sum(nil)), nil
 // }
 
-// configureHostedManifest sets the expected manifest values for hosted runtimes
-//
-// Run On Slack apps have certain runtime requirements so certain values are set
-// here before installing the application. This includes interactivity and event
-// subscriptions as specified through Run On Slack hosting requirements.
-//
-// The CLI determines the API host from selected credentials during installation.
-//
-// Apps without a specified or a "remote" function runtime should ignore this.
-func configureHostedManifest(
-	ctx context.Context,
-	clients *shared.ClientFactory,
-	manifest *types.AppManifest,
-) {
-	if manifest == nil {
-		return
-	}
-	clients.IO.PrintDebug(ctx, "updating app manifest with required properties for a run-on-slack function runtime")
-	if manifest.Settings == nil {
-		manifest.Settings = &types.AppSettings{}
-	}
-	manifest.Settings.FunctionRuntime = types.SlackHosted
-	if manifest.Settings.Interactivity == nil {
-		manifest.Settings.Interactivity = &types.ManifestInteractivity{}
-	}
-	host := clients.API().Host()
-	manifest.Settings.Interactivity.IsEnabled = true
-	manifest.Settings.Interactivity.MessageMenuOptionsURL = host
-	manifest.Settings.Interactivity.RequestURL = host
-	if manifest.Settings.EventSubscriptions == nil {
-		manifest.Settings.EventSubscriptions = &types.ManifestEventSubscriptions{}
-	}
-	manifest.Settings.EventSubscriptions.RequestURL = host
-}
-
 // configureLocalManifest sets the default manifest values for local runtimes
 //
 // The "local" runtime applies to just Run On Slack apps and this configuration
@@ -660,6 +722,22 @@ func resolveIconPath(ctx context.Context, clients *shared.ClientFactory, manifes
 	return icon.ResolveIconPath(clients.Fs)
 }
 
+// SetIcon validates and uploads the icon file to the app without an install
+func SetIcon(ctx context.Context, clients *shared.ClientFactory, appID string, token string, iconPath string, isHosted bool) error {
+	if err := icon.Validate(clients.Fs, iconPath); err != nil {
+		return err
+	}
+	if !isHosted && !clients.Config.WithExperimentOn(experiment.SetIcon) {
+		return slackerror.New(slackerror.ErrMissingExperiment).
+			WithMessage("Setting the icon of an app not hosted on Slack requires the %s experiment", experiment.SetIcon).
+			WithRemediation("Run the command again with %s", style.Highlight("--experiment "+string(experiment.SetIcon)))
+	}
+	if err := updateIcon(ctx, clients, iconPath, appID, token, isHosted); err != nil {
+		return slackerror.New(slackerror.ErrAppIconUpdate).WithRootCause(err)
+	}
+	return nil
+}
+
 // updateIcon will upload the new icon to the Slack API
 func updateIcon(ctx context.Context, clients *shared.ClientFactory, iconPath, appID string, token string, isHosted bool) error {
 	var span opentracing.Span
@@ -826,7 +904,7 @@ func continueDespiteWarning(ctx context.Context, clients *shared.ClientFactory,
 	}
 
 	if foundBreakingChange {
-		clients.IO.PrintWarning(ctx, "%s", warn.Warning(clients.Config.DebugEnabled, "App manifest contains possible breaking changes"))
+		clients.IO.PrintWarning(ctx, "%s", warn.WarningBySection(clients.Config.DebugEnabled, "App manifest contains possible breaking changes"))
 		saveManifestDespiteWarning, err := clients.IO.ConfirmPrompt(ctx, "Confirm changes?", false)
 		if err != nil {
 			return false, err
@@ -846,7 +924,7 @@ func continueDespiteWarning(ctx context.Context, clients *shared.ClientFactory,
 		return false, nil
 	}
 
-	clients.IO.PrintWarning(ctx, "%s", warn.Warning(clients.Config.DebugEnabled, additionalManifestInfoNotice))
+	clients.IO.PrintWarning(ctx, "%s", warn.WarningBySection(clients.Config.DebugEnabled, additionalManifestInfoNotice))
 
 	return true, nil
 }
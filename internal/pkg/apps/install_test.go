@@ -29,7 +29,9 @@ import (
 	"github.com/slackapi/slack-cli/internal/shared/types"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/slacktrace"
 	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -55,6 +57,7 @@ func TestInstall(t *testing.T) {
 		mockAuth                types.SlackAuth
 		mockAuthSession         api.AuthSession
 		mockConfirmPrompt       bool
+		mockFlags               []string
 		mockIsTTY               bool
 		mockManifestAppLocal    types.SlackYaml
 		mockManifestAppRemote   types.SlackYaml
@@ -67,6 +70,7 @@ func TestInstall(t *testing.T) {
 		expectedError           error
 		expectedInstallState    types.InstallState
 		expectedManifest        types.AppManifest
+		expectedSkipValidation  bool
 		expectedUpdate          bool
 	}{
 		"create a hosted app manifest with expected rosi values": {
@@ -502,6 +506,53 @@ func TestInstall(t *testing.T) {
 			},
 			expectedUpdate: true,
 		},
+		"skips validation of the app manifest with the --skip-validation flag": {
+			mockApp: types.App{
+				AppID:  "A007",
+				TeamID: mockTeamID,
+			},
+			mockAPICreateError: slackerror.New(slackerror.ErrAppCreate),
+			mockAPIInstall: api.DeveloperAppInstallResult{
+				AppID: "A007",
+			},
+			mockAPIInstallState: types.InstallSuccess,
+			mockAPIUpdate: api.UpdateAppResult{
+				AppID: "A007",
+			},
+			mockAuth: types.SlackAuth{
+				TeamID:     mockTeamID,
+				TeamDomain: mockTeamDomain,
+				Token:      mockToken,
+				UserID:     mockUserID,
+			},
+			mockAuthSession: api.AuthSession{
+				TeamID:   &mockTeamID,
+				TeamName: &mockTeamDomain,
+				UserID:   &mockUserID,
+			},
+			mockFlags: []string{"--skip-validation"},
+			mockManifestAppRemote: types.SlackYaml{
+				AppManifest: types.AppManifest{
+					DisplayInformation: types.DisplayInformation{
+						Name: "example-7",
+					},
+				},
+			},
+			mockManifestHashInitial: cache.Hash("abc"),
+			mockManifestHashUpdated: cache.Hash("abc"),
+			expectedApp: types.App{
+				AppID:  "A007",
+				TeamID: mockTeamID,
+			},
+			expectedInstallState: types.InstallSuccess,
+			expectedManifest: types.AppManifest{
+				DisplayInformation: types.DisplayInformation{
+					Name: "example-7",
+				},
+			},
+			expectedSkipValidation: true,
+			expectedUpdate:         true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -614,8 +665,16 @@ func TestInstall(t *testing.T) {
 				mock.Anything,
 				mock.Anything,
 			).Return(nil)
+			mockProjectCache.On("GetManifestSnapshots", mock.Anything, mock.Anything).Return([]cache.ManifestSnapshot{}, nil)
+			mockProjectCache.On("AddManifestSnapshot", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			mockProjectConfig.On("Cache").Return(mockProjectCache)
 			clientsMock.Config.ProjectConfig = mockProjectConfig
+			if tc.mockFlags != nil {
+				flags := pflag.NewFlagSet("deploy", pflag.ContinueOnError)
+				flags.Bool("skip-validation", false, "")
+				require.NoError(t, flags.Parse(tc.mockFlags))
+				clientsMock.Config.Flags = flags
+			}
 
 			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
 			app, state, err := Install(
@@ -639,6 +698,7 @@ func TestInstall(t *testing.T) {
 			assert.Equal(t, tc.expectedInstallState, state)
 			assert.Equal(t, tc.expectedApp, app)
 			if tc.expectedUpdate {
+				mockProjectCache.AssertCalled(t, "AddManifestSnapshot", mock.Anything, tc.mockApp.AppID, mock.Anything)
 				clientsMock.API.AssertCalled(
 					t,
 					"UpdateApp",
@@ -673,6 +733,10 @@ func TestInstall(t *testing.T) {
 					assert.Equal(t, tc.expectedManifest, args.Get(3))
 				}
 			}
+			if tc.expectedSkipValidation {
+				clientsMock.API.AssertNotCalled(t, "ValidateAppManifest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				assert.Contains(t, clientsMock.GetCombinedOutput(), "App manifest validation skipped")
+			}
 		})
 	}
 }
@@ -1492,12 +1556,14 @@ func TestInstallLocalApp(t *testing.T) {
 
 func TestValidateManifestForInstall(t *testing.T) {
 	tests := map[string]struct {
-		app      types.App
-		manifest types.AppManifest
-		result   api.ValidateAppManifestResult
-		err      error
-		setup    func(cm *shared.ClientsMock)
-		check    func(cm *shared.ClientsMock)
+		app         types.App
+		manifest    types.AppManifest
+		result      api.ValidateAppManifestResult
+		err         error
+		flags       []string
+		setup       func(cm *shared.ClientsMock)
+		expectedErr error
+		check       func(cm *shared.ClientsMock)
 	}{
 		"no errors or warnings for a nil response": {
 			app:      types.App{AppID: "A123"},
@@ -1546,6 +1612,59 @@ func TestValidateManifestForInstall(t *testing.T) {
 				assert.Contains(t, cm.GetCombinedOutput(), additionalManifestInfoNotice)
 			},
 		},
+		"fail on manifest warnings with the --fail-on-warning flag": {
+			app:      types.App{AppID: "A123"},
+			manifest: types.AppManifest{},
+			result: api.ValidateAppManifestResult{
+				Warnings: slackerror.Warnings{
+					slackerror.Warning{
+						Code:    "invalid_manifest_field",
+						Message: "Something isn't right with the manifest",
+					},
+				}},
+			flags: []string{"--fail-on-warning"},
+			setup: func(cm *shared.ClientsMock) {
+				cm.AddDefaultMocks()
+			},
+			expectedErr: slackerror.New(slackerror.ErrAppManifestValidate),
+			check: func(cm *shared.ClientsMock) {
+				assert.Contains(t, cm.GetCombinedOutput(), "Something isn't right with the manifest")
+				cm.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"fail on breaking changes with the --fail-on-warning and --force flags": {
+			app:      types.App{AppID: "A123"},
+			manifest: types.AppManifest{},
+			result: api.ValidateAppManifestResult{
+				Warnings: slackerror.Warnings{
+					slackerror.Warning{
+						Code:    "breaking_change",
+						Message: "You're going to break existing workflows",
+					},
+				}},
+			flags: []string{"--fail-on-warning", "--force"},
+			setup: func(cm *shared.ClientsMock) {
+				cm.AddDefaultMocks()
+			},
+			expectedErr: slackerror.New(slackerror.ErrAppManifestValidate),
+			check: func(cm *shared.ClientsMock) {
+				cm.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"continue without warnings and the --fail-on-warning flag": {
+			app:      types.App{AppID: "A123"},
+			manifest: types.AppManifest{},
+			result: api.ValidateAppManifestResult{
+				Warnings: slackerror.Warnings{},
+			},
+			flags: []string{"--fail-on-warning"},
+			setup: func(cm *shared.ClientsMock) {
+				cm.AddDefaultMocks()
+			},
+			check: func(cm *shared.ClientsMock) {
+				assert.NotContains(t, cm.GetCombinedOutput(), additionalManifestInfoNotice)
+			},
+		},
 		"don't include manifest warnings the --force flag is set": {
 			app:      types.App{AppID: "A123"},
 			manifest: types.AppManifest{},
@@ -1574,10 +1693,23 @@ func TestValidateManifestForInstall(t *testing.T) {
 			tc.setup(clientsMock)
 			clientsMock.API.On("ValidateAppManifest", mock.Anything, mock.Anything, mock.Anything, tc.app.AppID).
 				Return(tc.result, tc.err)
+			if tc.flags != nil {
+				flags := pflag.NewFlagSet("deploy", pflag.ContinueOnError)
+				flags.Bool("fail-on-warning", false, "")
+				flags.Bool("force", false, "")
+				require.NoError(t, flags.Parse(tc.flags))
+				clientsMock.Config.Flags = flags
+				clientsMock.Config.ForceFlag = flags.Lookup("force").Value.String() == "true"
+			}
 			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
 
 			err := validateManifestForInstall(ctx, clients, "xoxe.xoxp-1-token", tc.app, tc.manifest)
-			assert.NoError(t, err)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ToSlackError(tc.expectedErr).Code, slackerror.ToSlackError(err).Code)
+			} else {
+				assert.NoError(t, err)
+			}
 
 			tc.check(clientsMock)
 		})
@@ -1729,6 +1861,60 @@ func TestContinueDespiteWarning(t *testing.T) {
 	}
 }
 
+func Test_installProgress(t *testing.T) {
+	tests := map[string]struct {
+		flags            []string
+		expectedOutputs  []string
+		expectedHidden   bool
+		expectedPhaseEnd int
+	}{
+		"prints each phase of the install with the step": {
+			expectedOutputs: []string{
+				"Validating the app manifest... (step 1,",
+				"Installing the app... (step 2,",
+			},
+			expectedPhaseEnd: 2,
+		},
+		"prints phases when the no prompt flag is not set": {
+			flags: []string{"--no-prompt=false"},
+			expectedOutputs: []string{
+				"Validating the app manifest... (step 1,",
+			},
+			expectedPhaseEnd: 2,
+		},
+		"hides phases with the no prompt flag": {
+			flags:            []string{"--no-prompt"},
+			expectedHidden:   true,
+			expectedPhaseEnd: 2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			flags := pflag.NewFlagSet("install", pflag.ContinueOnError)
+			flags.Bool("no-prompt", false, "")
+			require.NoError(t, flags.Parse(tc.flags))
+			clientsMock.Config.Flags = flags
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			ctx := slackcontext.MockContext(t.Context())
+			progress := newInstallProgress(clients)
+			progress.next(ctx, "Validating the app manifest")
+			progress.next(ctx, "Installing the app")
+
+			assert.Equal(t, tc.expectedPhaseEnd, progress.phase)
+			clientsMock.IO.AssertCalled(t, "PrintTrace", mock.Anything, slacktrace.AppInstallStep, []string{"2", "Installing the app"})
+			if tc.expectedHidden {
+				assert.Empty(t, clientsMock.GetStderrOutput())
+			}
+			for _, expected := range tc.expectedOutputs {
+				assert.Contains(t, clientsMock.GetStderrOutput(), expected)
+			}
+		})
+	}
+}
+
 func Test_resolveIconPath(t *testing.T) {
 	tests := map[string]struct {
 		envIconPath  string
@@ -1892,3 +2078,71 @@ func Test_updateIcon(t *testing.T) {
 		})
 	}
 }
+
+func TestSetIcon(t *testing.T) {
+	tests := map[string]struct {
+		iconContent   []byte
+		isHosted      bool
+		experimentOn  bool
+		mockError     error
+		expectedError string
+		expectUpload  bool
+	}{
+		"uploads a valid icon to a hosted app": {
+			iconContent:  []byte("\x89PNG\r\n\x1a\n"),
+			isHosted:     true,
+			expectUpload: true,
+		},
+		"uploads a valid icon to a non-hosted app with the experiment": {
+			iconContent:  []byte("\x89PNG\r\n\x1a\n"),
+			experimentOn: true,
+			expectUpload: true,
+		},
+		"errors for a non-hosted app without the experiment": {
+			iconContent:   []byte("\x89PNG\r\n\x1a\n"),
+			expectedError: slackerror.ErrMissingExperiment,
+		},
+		"errors for an invalid icon file before uploading": {
+			iconContent:   []byte("not an image"),
+			isHosted:      true,
+			expectedError: slackerror.ErrUnknownFileType,
+		},
+		"errors when the upload fails": {
+			iconContent:   []byte("\x89PNG\r\n\x1a\n"),
+			isHosted:      true,
+			mockError:     fmt.Errorf("api error"),
+			expectedError: slackerror.ErrAppIconUpdate,
+			expectUpload:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			if tc.experimentOn {
+				clientsMock.Config.ExperimentsFlag = []string{string(experiment.SetIcon)}
+				clientsMock.Config.LoadExperiments(ctx, func(_ context.Context, _ string, _ ...interface{}) {})
+			}
+			require.NoError(t, afero.WriteFile(clientsMock.Fs, "icon.png", tc.iconContent, 0o644))
+			clientsMock.API.On("IconSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(api.IconResult{}, tc.mockError)
+			clientsMock.API.On("Icon", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(api.IconResult{}, tc.mockError)
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			err := SetIcon(ctx, clients, "A001", "xoxe-token", "icon.png", tc.isHosted)
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+			if !tc.expectUpload {
+				clientsMock.API.AssertNotCalled(t, "Icon", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				clientsMock.API.AssertNotCalled(t, "IconSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
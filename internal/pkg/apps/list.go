@@ -84,6 +84,10 @@ func FetchAppInstallStates(ctx context.Context, clients *shared.ClientFactory, a
 	appIDsByEnterpriseTeamID := map[string][]string{}
 	appsByAppID := map[string]types.App{}
 	for _, a := range apps {
+		// Deleted apps are kept in project files but no longer have a status
+		if a.IsDeleted() {
+			continue
+		}
 		// Add app by its team id
 		if appIDsByTeamID[a.TeamID] == nil {
 			appIDsByTeamID[a.TeamID] = []string{}
@@ -191,3 +195,39 @@ func FetchAppInstallStates(ctx context.Context, clients *shared.ClientFactory, a
 
 	return updatedApps, nil
 }
+
+// ListStale returns the saved apps that are not found for the team they were
+// saved with. Apps without an authorization for their team are not checked and
+// only an app_not_found error marks an app as stale
+func ListStale(ctx context.Context, clients *shared.ClientFactory, apps []types.App) ([]types.App, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "pkg.apps.listStale")
+	defer span.Finish()
+
+	stale := []types.App{}
+	for _, app := range apps {
+		if app.AppID == "" {
+			continue
+		}
+		auth, err := clients.Auth().AuthWithTeamID(ctx, app.TeamID)
+		if err != nil && app.EnterpriseID != "" {
+			auth, err = clients.Auth().AuthWithTeamID(ctx, app.EnterpriseID)
+		}
+		if err != nil {
+			clients.IO.PrintDebug(ctx, "skipping the stale check for app %s without an authorization: %s", app.AppID, err.Error())
+			continue
+		}
+		apiHost := ""
+		if auth.APIHost != nil {
+			apiHost = *auth.APIHost
+		}
+		_, err = clients.APIWithHost(apiHost).GetAppStatus(ctx, auth.Token, []string{app.AppID}, auth.TeamID)
+		if err != nil {
+			if slackerror.ToSlackError(err).Code == slackerror.ErrAppNotFound {
+				stale = append(stale, app)
+				continue
+			}
+			return []types.App{}, err
+		}
+	}
+	return stale, nil
+}
@@ -190,3 +190,64 @@ func TestAppsList_FetchInstallStates_InvalidTokenFlag(t *testing.T) {
 	}
 	assert.Equal(t, []types.App{}, apps)
 }
+
+func TestAppsList_ListStale(t *testing.T) {
+	tests := map[string]struct {
+		apps          []types.App
+		setup         func(cm *shared.ClientsMock)
+		expectedStale []types.App
+		expectedError error
+	}{
+		"returns apps that are not found": {
+			apps: []types.App{team1DeployedApp, team2LocalApp},
+			setup: func(cm *shared.ClientsMock) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, team1TeamID).Return(authTeam1, nil)
+				cm.Auth.On("AuthWithTeamID", mock.Anything, team2TeamID).Return(authTeam2, nil)
+				cm.API.On("GetAppStatus", mock.Anything, team1Token, []string{team1AppID}, team1TeamID).
+					Return(api.GetAppStatusResult{}, slackerror.New(slackerror.ErrAppNotFound))
+				cm.API.On("GetAppStatus", mock.Anything, team2Token, []string{team2AppID}, team2TeamID).
+					Return(api.GetAppStatusResult{Apps: []api.AppStatusResultAppInfo{{AppID: team2AppID}}}, nil)
+			},
+			expectedStale: []types.App{team1DeployedApp},
+		},
+		"keeps apps missing from the status response": {
+			apps: []types.App{team2LocalApp},
+			setup: func(cm *shared.ClientsMock) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, team2TeamID).Return(authTeam2, nil)
+				cm.API.On("GetAppStatus", mock.Anything, team2Token, []string{team2AppID}, team2TeamID).
+					Return(api.GetAppStatusResult{}, nil)
+			},
+			expectedStale: []types.App{},
+		},
+		"skips apps without an authorization": {
+			apps: []types.App{team2LocalApp, {TeamID: team1TeamID}},
+			setup: func(cm *shared.ClientsMock) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, team2TeamID).
+					Return(types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound))
+			},
+			expectedStale: []types.App{},
+		},
+		"errors if the status cannot be fetched": {
+			apps: []types.App{team2LocalApp},
+			setup: func(cm *shared.ClientsMock) {
+				cm.Auth.On("AuthWithTeamID", mock.Anything, team2TeamID).Return(authTeam2, nil)
+				cm.API.On("GetAppStatus", mock.Anything, team2Token, []string{team2AppID}, team2TeamID).
+					Return(api.GetAppStatusResult{}, slackerror.New(slackerror.ErrHTTPRequestFailed))
+			},
+			expectedStale: []types.App{},
+			expectedError: slackerror.New(slackerror.ErrHTTPRequestFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			tc.setup(clientsMock)
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			stale, err := ListStale(ctx, clients, tc.apps)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedStale, stale)
+		})
+	}
+}
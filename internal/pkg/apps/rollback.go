@@ -0,0 +1,99 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+// RollbackManifest updates the app manifest on app settings to the newest saved
+// snapshot that differs from the current app manifest and returns the snapshot
+func RollbackManifest(ctx context.Context, clients *shared.ClientFactory, auth types.SlackAuth, app types.App) (cache.ManifestSnapshot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.apps.rollbackManifest")
+	defer span.Finish()
+
+	snapshots, err := clients.Config.ProjectConfig.Cache().GetManifestSnapshots(ctx, app.AppID)
+	if err != nil {
+		return cache.ManifestSnapshot{}, err
+	}
+	current, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	if err != nil {
+		return cache.ManifestSnapshot{}, err
+	}
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if equalManifests(snapshots[i].Manifest, current.AppManifest) {
+			continue
+		}
+		clients.IO.PrintDebug(ctx, "rolling back app %s to the snapshot from %d", app.AppID, snapshots[i].CreatedAt)
+		_, err := clients.API().UpdateApp(ctx, auth.Token, app.AppID, snapshots[i].Manifest, clients.Config.ForceFlag, true)
+		if err != nil {
+			return cache.ManifestSnapshot{}, err
+		}
+		return snapshots[i], nil
+	}
+	return cache.ManifestSnapshot{}, slackerror.New(slackerror.ErrManifestSnapshotNotFound).
+		WithMessage("No saved app manifest of %s differs from the current app manifest", app.AppID)
+}
+
+// saveManifestSnapshot saves the app manifest from app settings before it is
+// updated so that the deploy can be rolled back. Errors are only noted in debug
+// outputs since an update does not need the snapshot
+func saveManifestSnapshot(ctx context.Context, clients *shared.ClientFactory, token string, appID string) {
+	if clients.Config.SkipLocalFs() {
+		return
+	}
+	current, err := clients.AppClient().Manifest.GetManifestRemote(ctx, token, appID)
+	if err != nil {
+		clients.IO.PrintDebug(ctx, "failed to get the app manifest to snapshot: %s", err)
+		return
+	}
+	projectCache := clients.Config.ProjectConfig.Cache()
+	snapshots, err := projectCache.GetManifestSnapshots(ctx, appID)
+	if err != nil {
+		clients.IO.PrintDebug(ctx, "failed to read the app manifest snapshots: %s", err)
+		return
+	}
+	if len(snapshots) > 0 && equalManifests(snapshots[len(snapshots)-1].Manifest, current.AppManifest) {
+		return
+	}
+	snapshot := cache.ManifestSnapshot{
+		CreatedAt: time.Now().Unix(),
+		Manifest:  current.AppManifest,
+	}
+	if err := projectCache.AddManifestSnapshot(ctx, appID, snapshot); err != nil {
+		clients.IO.PrintDebug(ctx, "failed to save the app manifest snapshot: %s", err)
+	}
+}
+
+// equalManifests returns if the app manifests have the same JSON values
+func equalManifests(a types.AppManifest, b types.AppManifest) bool {
+	aBytes, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bBytes, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(aBytes) == string(bBytes)
+}
@@ -0,0 +1,142 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/app"
+	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackManifest(t *testing.T) {
+	first := types.AppManifest{DisplayInformation: types.DisplayInformation{Name: "first"}}
+	second := types.AppManifest{DisplayInformation: types.DisplayInformation{Name: "second"}}
+	current := types.AppManifest{DisplayInformation: types.DisplayInformation{Name: "current"}}
+	tests := map[string]struct {
+		snapshots        []cache.ManifestSnapshot
+		currentManifest  types.AppManifest
+		expectedSnapshot cache.ManifestSnapshot
+		expectedError    string
+	}{
+		"restores the newest snapshot": {
+			snapshots: []cache.ManifestSnapshot{
+				{CreatedAt: 1700000000, Manifest: first},
+				{CreatedAt: 1700000100, Manifest: second},
+			},
+			currentManifest:  current,
+			expectedSnapshot: cache.ManifestSnapshot{CreatedAt: 1700000100, Manifest: second},
+		},
+		"skips snapshots that match the current app manifest": {
+			snapshots: []cache.ManifestSnapshot{
+				{CreatedAt: 1700000000, Manifest: first},
+				{CreatedAt: 1700000100, Manifest: second},
+			},
+			currentManifest:  second,
+			expectedSnapshot: cache.ManifestSnapshot{CreatedAt: 1700000000, Manifest: first},
+		},
+		"errors without a saved snapshot": {
+			snapshots:       []cache.ManifestSnapshot{},
+			currentManifest: current,
+			expectedError:   slackerror.ErrManifestSnapshotNotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clientsMock.API.On("UpdateApp", mock.Anything, "xoxp-example", "A001", mock.Anything, mock.Anything, true).
+				Return(api.UpdateAppResult{}, nil)
+			manifestMock := &app.ManifestMockObject{}
+			manifestMock.On("GetManifestRemote", mock.Anything, "xoxp-example", "A001").
+				Return(types.SlackYaml{AppManifest: tc.currentManifest}, nil)
+			clientsMock.AppClient.Manifest = manifestMock
+			cacheMock := cache.NewCacheMock()
+			cacheMock.On("GetManifestSnapshots", mock.Anything, "A001").Return(tc.snapshots, nil)
+			projectConfigMock := config.NewProjectConfigMock()
+			projectConfigMock.On("Cache").Return(cacheMock)
+			clientsMock.Config.ProjectConfig = projectConfigMock
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			snapshot, err := RollbackManifest(ctx, clients, types.SlackAuth{Token: "xoxp-example"}, types.App{AppID: "A001"})
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedError, slackerror.ToSlackError(err).Code)
+				clientsMock.API.AssertNotCalled(t, "UpdateApp", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSnapshot, snapshot)
+			clientsMock.API.AssertCalled(t, "UpdateApp", mock.Anything, "xoxp-example", "A001", tc.expectedSnapshot.Manifest, mock.Anything, true)
+		})
+	}
+}
+
+func Test_saveManifestSnapshot(t *testing.T) {
+	saved := types.AppManifest{DisplayInformation: types.DisplayInformation{Name: "saved"}}
+	tests := map[string]struct {
+		snapshots       []cache.ManifestSnapshot
+		currentManifest types.AppManifest
+		expectedSaved   bool
+	}{
+		"saves the current app manifest": {
+			snapshots:       []cache.ManifestSnapshot{{CreatedAt: 1700000000, Manifest: saved}},
+			currentManifest: types.AppManifest{DisplayInformation: types.DisplayInformation{Name: "current"}},
+			expectedSaved:   true,
+		},
+		"skips an app manifest that matches the newest snapshot": {
+			snapshots:       []cache.ManifestSnapshot{{CreatedAt: 1700000000, Manifest: saved}},
+			currentManifest: saved,
+			expectedSaved:   false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			manifestMock := &app.ManifestMockObject{}
+			manifestMock.On("GetManifestRemote", mock.Anything, "xoxp-example", "A001").
+				Return(types.SlackYaml{AppManifest: tc.currentManifest}, nil)
+			clientsMock.AppClient.Manifest = manifestMock
+			cacheMock := cache.NewCacheMock()
+			cacheMock.On("GetManifestSnapshots", mock.Anything, "A001").Return(tc.snapshots, nil)
+			cacheMock.On("AddManifestSnapshot", mock.Anything, "A001", mock.Anything).Return(nil)
+			projectConfigMock := config.NewProjectConfigMock()
+			projectConfigMock.On("Cache").Return(cacheMock)
+			clientsMock.Config.ProjectConfig = projectConfigMock
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			saveManifestSnapshot(ctx, clients, "xoxp-example", "A001")
+			if tc.expectedSaved {
+				cacheMock.AssertCalled(t, "AddManifestSnapshot", mock.Anything, "A001", mock.MatchedBy(func(snapshot cache.ManifestSnapshot) bool {
+					return snapshot.Manifest.DisplayInformation.Name == tc.currentManifest.DisplayInformation.Name
+				}))
+			} else {
+				cacheMock.AssertNotCalled(t, "AddManifestSnapshot", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
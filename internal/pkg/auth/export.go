@@ -0,0 +1,125 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+)
+
+const (
+	// exportVersion is the format version of exported credentials
+	exportVersion = 1
+	// exportKDF names the function used to derive a key from the passphrase
+	exportKDF = "pbkdf2-sha256"
+	// exportIterations is the number of PBKDF2 iterations for a new export
+	exportIterations = 600000
+	// exportKeyLength is the length of the AES-256 key
+	exportKeyLength = 32
+	// exportSaltLength is the length of the random salt
+	exportSaltLength = 16
+)
+
+// exportFile is the encrypted format of exported authorizations
+type exportFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptAuths serializes authorizations and encrypts these with a key derived
+// from the passphrase
+func EncryptAuths(auths []types.SlackAuth, passphrase string) ([]byte, error) {
+	plaintext, err := json.Marshal(auths)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, exportSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	gcm, err := newExportCipher(passphrase, salt, exportIterations)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(exportFile{
+		Version:    exportVersion,
+		KDF:        exportKDF,
+		Iterations: exportIterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	}, "", "  ")
+}
+
+// DecryptAuths decrypts exported authorizations with the passphrase
+func DecryptAuths(data []byte, passphrase string) ([]types.SlackAuth, error) {
+	var file exportFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse the exported credentials").
+			WithRootCause(err)
+	}
+	if file.Version != exportVersion || file.KDF != exportKDF || file.Iterations <= 0 || file.Iterations > 10*exportIterations {
+		return nil, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("The exported credentials use an unknown format").
+			WithRemediation("Export the credentials again with the same version of the CLI")
+	}
+	gcm, err := newExportCipher(passphrase, file.Salt, file.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Nonce) != gcm.NonceSize() {
+		return nil, slackerror.New(slackerror.ErrInvalidPassphrase)
+	}
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, slackerror.New(slackerror.ErrInvalidPassphrase)
+	}
+	var auths []types.SlackAuth
+	if err := json.Unmarshal(plaintext, &auths); err != nil {
+		return nil, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse the decrypted credentials").
+			WithRootCause(err)
+	}
+	return auths, nil
+}
+
+// newExportCipher derives an AES-GCM cipher from the passphrase
+func newExportCipher(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, exportKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncryptAuths(t *testing.T) {
+	auths := []types.SlackAuth{
+		{Token: "xoxp-example-1", TeamDomain: "alpha", TeamID: "T0001", UserID: "U0001"},
+		{Token: "xoxp-example-2", TeamDomain: "beta", TeamID: "T0002", UserID: "U0002", RefreshToken: "xoxe-1-refresh"},
+	}
+	data, err := EncryptAuths(auths, "correct horse")
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "xoxp-example")
+	assert.NotContains(t, string(data), "xoxe-1-refresh")
+	assert.NotContains(t, string(data), "alpha")
+
+	decrypted, err := DecryptAuths(data, "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, auths, decrypted)
+}
+
+func Test_DecryptAuths(t *testing.T) {
+	encrypted, err := EncryptAuths([]types.SlackAuth{{Token: "xoxp-example", TeamID: "T0001"}}, "passphrase")
+	require.NoError(t, err)
+	var tampered exportFile
+	require.NoError(t, json.Unmarshal(encrypted, &tampered))
+	tampered.Ciphertext[0] ^= 0xff
+	tamperedData, err := json.Marshal(tampered)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		data         []byte
+		passphrase   string
+		expectedCode string
+	}{
+		"errors with the wrong passphrase": {
+			data:         encrypted,
+			passphrase:   "wrong",
+			expectedCode: slackerror.ErrInvalidPassphrase,
+		},
+		"errors if the ciphertext was changed": {
+			data:         tamperedData,
+			passphrase:   "passphrase",
+			expectedCode: slackerror.ErrInvalidPassphrase,
+		},
+		"errors if the file is not json": {
+			data:         []byte("xoxp-example"),
+			passphrase:   "passphrase",
+			expectedCode: slackerror.ErrUnableToParseJSON,
+		},
+		"errors if the format is unknown": {
+			data:         []byte(`{"version":2,"kdf":"pbkdf2-sha256","iterations":1}`),
+			passphrase:   "passphrase",
+			expectedCode: slackerror.ErrUnableToParseJSON,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecryptAuths(tc.data, tc.passphrase)
+			require.Error(t, err)
+			assert.Equal(t, tc.expectedCode, slackerror.ToSlackError(err).Code)
+		})
+	}
+}
@@ -15,8 +15,10 @@
 package auth
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -35,6 +37,7 @@ import (
 )
 
 const InvalidNoPromptFlags = "Invalid arguments, both --ticket and --challenge flag values are required"
+const InvalidNoBrowserFlags = "Login with the --no-browser flag waits for a challenge code and cannot be used with --no-prompt"
 
 // LoginWithClients ...
 func LoginWithClients(ctx context.Context, clients *shared.ClientFactory, userToken string, noRotation bool) (auth types.SlackAuth, credentialsPath string, err error) {
@@ -49,7 +52,7 @@ func Login(ctx context.Context, apiClient api.APIInterface, authClient auth.Auth
 	// An XOXP token was provided via the "--token" flag
 	if userToken != "" {
 		io.PrintDebug(ctx, "user token (xoxp-) provided with the --token flag")
-		return createNewLoginWithUserToken(ctx, apiClient, authClient, userToken, noRotation)
+		return createNewLoginWithUserToken(ctx, apiClient, authClient, userToken, noRotation, config.TeamFlag)
 	}
 
 	return createNewAuth(ctx, apiClient, authClient, config, io, noRotation)
@@ -57,27 +60,64 @@ func Login(ctx context.Context, apiClient api.APIInterface, authClient auth.Auth
 
 // createNewLoginWithUserToken function takes in an User Token (XOXP) and uses it to grab an existing auth
 // TODO (@Sarah) Remove this command
-func createNewLoginWithUserToken(ctx context.Context, apiClient api.APIInterface, authClient auth.AuthInterface, userToken string, noRotation bool) (auth types.SlackAuth, credentialsPath string, err error) {
+func createNewLoginWithUserToken(ctx context.Context, apiClient api.APIInterface, authClient auth.AuthInterface, userToken string, noRotation bool, teamFlag string) (auth types.SlackAuth, credentialsPath string, err error) {
 	var span opentracing.Span
 	span, ctx = opentracing.StartSpanFromContext(ctx, "createNewLoginWithUserToken")
 	defer span.Finish()
 
+	newAuth, authSession, err := newAuthWithToken(ctx, apiClient, authClient, userToken, teamFlag)
+	if err != nil {
+		return types.SlackAuth{}, "", err
+	}
+
+	// TODO: ignoring error?
+	// FIXME: This is an unsafe action, since team domain does not guarantee unique auth
+	// So returned auth struct may be unexpected
+	auth, _ = authClient.AuthWithTeamDomain(ctx, newAuth.TeamDomain)
+
+	if auth.RefreshToken != "" {
+		newAuth.RefreshToken = auth.RefreshToken
+	}
+
+	if auth.ExpiresAt != 0 {
+		newAuth.ExpiresAt = auth.ExpiresAt
+	}
+
+	// We don't want save new long-lived token auth into credentials file or overwrite rotatable token with long-lived token
+	if strings.HasPrefix(userToken, "xoxp-") && !strings.HasPrefix(auth.Token, "xoxp-") {
+		return types.SlackAuth{}, "", nil
+	}
+
+	// Save the new auth
+	auth, location, err := authClient.SetAuth(ctx, newAuth)
+	if err != nil {
+		return types.SlackAuth{}, "", slackerror.Wrap(err, "Error saving credentials")
+	}
+
+	span.SetTag("user", authSession.UserID)
+	span.SetTag("team", authSession.TeamID)
+	return auth, location, nil
+}
+
+// newAuthWithToken validates a token and returns the authorization of that
+// token with the team domain from the session URL
+func newAuthWithToken(ctx context.Context, apiClient api.APIInterface, authClient auth.AuthInterface, token string, teamFlag string) (types.SlackAuth, api.AuthSession, error) {
 	var authSession api.AuthSession
 
 	// validates the supplied user token
-	if userToken != "" {
+	if token != "" {
 		var err error
-		authSession, err = apiClient.ValidateSession(ctx, userToken)
+		authSession, err = apiClient.ValidateSession(ctx, token)
 
 		if err != nil {
-			return types.SlackAuth{}, "", slackerror.New(slackerror.ErrInvalidAuth).WithRootCause(err)
+			return types.SlackAuth{}, api.AuthSession{}, slackerror.New(slackerror.ErrInvalidAuth).WithRootCause(err)
 		}
 	} else {
-		return types.SlackAuth{}, "", slackerror.New("A user token was not provided")
+		return types.SlackAuth{}, api.AuthSession{}, slackerror.New("A user token was not provided")
 	}
 
 	var newAuth = types.SlackAuth{
-		Token:       userToken,
+		Token:       token,
 		TeamID:      *authSession.TeamID,
 		UserID:      *authSession.UserID,
 		LastUpdated: time.Now(),
@@ -100,30 +140,32 @@ func createNewLoginWithUserToken(ctx context.Context, apiClient api.APIInterface
 	TeamDomain = urlParts[0]
 	newAuth.TeamDomain = TeamDomain
 
-	// TODO: ignoring error?
-	// FIXME: This is an unsafe action, since team domain does not guarantee unique auth
-	// So returned auth struct may be unexpected
-	auth, _ = authClient.AuthWithTeamDomain(ctx, TeamDomain)
-
-	if auth.RefreshToken != "" {
-		newAuth.RefreshToken = auth.RefreshToken
+	enterpriseID := ""
+	if authSession.EnterpriseID != nil {
+		enterpriseID = *authSession.EnterpriseID
 	}
-
-	if auth.ExpiresAt != 0 {
-		newAuth.ExpiresAt = auth.ExpiresAt
+	if !matchesTeamFlag(teamFlag, newAuth.TeamID, newAuth.TeamDomain, enterpriseID) {
+		return types.SlackAuth{}, api.AuthSession{}, errAuthTeamMismatch(teamFlag, newAuth.TeamDomain, newAuth.TeamID)
 	}
+	return newAuth, authSession, nil
+}
 
-	// We don't want save new long-lived token auth into credentials file or overwrite rotatable token with long-lived token
-	if strings.HasPrefix(userToken, "xoxp-") && !strings.HasPrefix(auth.Token, "xoxp-") {
-		return types.SlackAuth{}, "", nil
-	}
+// LoginWithServiceToken validates a service token from "auth token" and saves
+// the authorization without a refresh token so that it never rotates
+func LoginWithServiceToken(ctx context.Context, clients *shared.ClientFactory, serviceToken string) (types.SlackAuth, string, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "LoginWithServiceToken")
+	defer span.Finish()
 
-	// Save the new auth
-	auth, location, err := authClient.SetAuth(ctx, newAuth)
+	newAuth, authSession, err := newAuthWithToken(ctx, clients.API(), clients.Auth(), serviceToken, clients.Config.TeamFlag)
+	if err != nil {
+		return types.SlackAuth{}, "", err
+	}
+	newAuth.IsServiceToken = true
+	auth, location, err := clients.Auth().SetAuth(ctx, newAuth)
 	if err != nil {
 		return types.SlackAuth{}, "", slackerror.Wrap(err, "Error saving credentials")
 	}
-
 	span.SetTag("user", authSession.UserID)
 	span.SetTag("team", authSession.TeamID)
 	return auth, location, nil
@@ -135,29 +177,64 @@ func createNewLoginWithUserToken(ctx context.Context, apiClient api.APIInterface
 //  3. Submit a request to exchange the ticket for an Auth response containing an access token
 //  4. Saves auth as a credential
 func createNewAuth(ctx context.Context, apiClient api.APIInterface, authClient auth.AuthInterface, config *config.Config, io iostreams.IOStreamer, noRotation bool) (auth types.SlackAuth, credentialsPath string, err error) {
-	authTicket, err := requestAuthTicket(ctx, apiClient, io, noRotation)
-	if err != nil {
-		return types.SlackAuth{}, "", err
-	}
+	for {
+		authTicket, err := requestAuthTicket(ctx, apiClient, io, noRotation, config.TeamFlag)
+		if err != nil {
+			return types.SlackAuth{}, "", err
+		}
 
-	challengeCode, err := io.InputPrompt(ctx, "Enter challenge code", iostreams.InputPromptConfig{
-		Required: true,
-	})
-	if err != nil {
-		return types.SlackAuth{}, "", err
+		challengeCode, err := io.InputPrompt(ctx, "Enter challenge code", iostreams.InputPromptConfig{
+			Required: true,
+		})
+		if err != nil {
+			return types.SlackAuth{}, "", err
+		}
+
+		authExchangeRes, err := apiClient.ExchangeAuthTicket(ctx, authTicket, challengeCode, version.Raw())
+		if err != nil {
+			return types.SlackAuth{}, "", err
+		}
+
+		// Offer another login if the slash command was run in a different team
+		// than the --team flag
+		if matchesTeamFlag(config.TeamFlag, authExchangeRes.TeamID, authExchangeRes.TeamDomain, authExchangeRes.EnterpriseID) {
+			return saveNewAuth(ctx, apiClient, authClient, authExchangeRes, noRotation)
+		}
+		mismatch := errAuthTeamMismatch(config.TeamFlag, authExchangeRes.TeamDomain, authExchangeRes.TeamID)
+		if !io.IsTTY() {
+			return types.SlackAuth{}, "", mismatch
+		}
+		io.PrintWarning(ctx, "%s", mismatch.Message)
+		retry, err := io.ConfirmPrompt(ctx, fmt.Sprintf("Log in to %s again?", config.TeamFlag), true)
+		if err != nil {
+			return types.SlackAuth{}, "", err
+		}
+		if !retry {
+			return types.SlackAuth{}, "", mismatch
+		}
 	}
+}
 
-	authExchangeRes, err := apiClient.ExchangeAuthTicket(ctx, authTicket, challengeCode, version.Raw())
-	if err != nil {
-		return types.SlackAuth{}, "", err
+// matchesTeamFlag returns true if the --team flag is unset or matches the team
+// ID, team domain, or enterprise ID of an authorization
+func matchesTeamFlag(teamFlag string, teamID string, teamDomain string, enterpriseID string) bool {
+	if teamFlag == "" {
+		return true
 	}
+	return teamFlag == teamID || teamFlag == teamDomain || (enterpriseID != "" && teamFlag == enterpriseID)
+}
 
-	return saveNewAuth(ctx, apiClient, authClient, authExchangeRes, noRotation)
+// errAuthTeamMismatch explains that an authorization is for a team other than
+// the team of the --team flag
+func errAuthTeamMismatch(teamFlag string, teamDomain string, teamID string) *slackerror.Error {
+	return slackerror.New(slackerror.ErrAuthTeamMismatch).
+		WithMessage("The authorization is for %s (%s) instead of the team %s", teamDomain, teamID, teamFlag).
+		WithRemediation("Run the slash command in the %s workspace or log in without the --team flag", teamFlag)
 }
 
 // requestAuthTicket requests an auth ticket from Slack. The ticket must be submitted
 // by a valid user within their Slack workspace, then permissions must be granted
-func requestAuthTicket(ctx context.Context, apiClient api.APIInterface, io iostreams.IOStreamer, noRotation bool) (string, error) {
+func requestAuthTicket(ctx context.Context, apiClient api.APIInterface, io iostreams.IOStreamer, noRotation bool, team string) (string, error) {
 	var span opentracing.Span
 	span, ctx = opentracing.StartSpanFromContext(ctx, "requestAuthTicket")
 	defer span.Finish()
@@ -168,15 +245,19 @@ func requestAuthTicket(ctx context.Context, apiClient api.APIInterface, io iostr
 	if authTicketResult, err := apiClient.GenerateAuthTicket(ctx, cliVersion, noRotation); err != nil {
 		return "", err
 	} else {
-		printAuthTicketSubmissionInstructions(ctx, io, authTicketResult.Ticket)
+		printAuthTicketSubmissionInstructions(ctx, io, authTicketResult.Ticket, team)
 		return authTicketResult.Ticket, nil
 	}
 }
 
-func printAuthTicketSubmissionInstructions(ctx context.Context, IO iostreams.IOStreamer, authTicket string) {
+func printAuthTicketSubmissionInstructions(ctx context.Context, IO iostreams.IOStreamer, authTicket string, team string) {
+	instructions := "Run the following slash command in any Slack channel or DM"
+	if team != "" {
+		instructions = fmt.Sprintf("Run the following slash command in any channel or DM of the %s team", team)
+	}
 	slashCommandDetails := style.Sectionf(style.TextSection{
 		Emoji: "clipboard",
-		Text:  "Run the following slash command in any Slack channel or DM",
+		Text:  instructions,
 		Secondary: []string{
 			"This will open a modal with user permissions for you to approve",
 			"Once approved, a challenge code will be generated in Slack",
@@ -250,6 +331,9 @@ func LoginNoPrompt(ctx context.Context, clients *shared.ClientFactory, ticketArg
 		if err != nil || !authExchangeRes.IsReady {
 			return types.SlackAuth{}, "", err
 		}
+		if !matchesTeamFlag(clients.Config.TeamFlag, authExchangeRes.TeamID, authExchangeRes.TeamDomain, authExchangeRes.EnterpriseID) {
+			return types.SlackAuth{}, "", errAuthTeamMismatch(clients.Config.TeamFlag, authExchangeRes.TeamDomain, authExchangeRes.TeamID)
+		}
 		savedAuth, credentialsPath, err := saveNewAuth(ctx, clients.API(), clients.Auth(), authExchangeRes, noRotation)
 		if err != nil {
 			return types.SlackAuth{}, "", err
@@ -259,7 +343,7 @@ func LoginNoPrompt(ctx context.Context, clients *shared.ClientFactory, ticketArg
 
 	// brand new login
 	if ticketArg == "" && challengeCodeArg == "" {
-		_, err := requestAuthTicket(ctx, clients.API(), clients.IO, noRotation)
+		_, err := requestAuthTicket(ctx, clients.API(), clients.IO, noRotation, clients.Config.TeamFlag)
 		if err != nil {
 			return types.SlackAuth{}, "", err
 		}
@@ -269,3 +353,46 @@ func LoginNoPrompt(ctx context.Context, clients *shared.ClientFactory, ticketArg
 	// If we get to here then invalid flags have been supplied
 	return types.SlackAuth{}, "", slackerror.New(slackerror.ErrMismatchedFlags).WithMessage(InvalidNoPromptFlags)
 }
+
+// LoginNoBrowser initiates a login flow that reads the challenge code from
+// standard input instead of an interactive prompt
+func LoginNoBrowser(ctx context.Context, clients *shared.ClientFactory, noRotation bool) (auth types.SlackAuth, credentialsPath string, err error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "authNoBrowser")
+	defer span.Finish()
+
+	authTicket, err := requestAuthTicket(ctx, clients.API(), clients.IO, noRotation, clients.Config.TeamFlag)
+	if err != nil {
+		return types.SlackAuth{}, "", err
+	}
+
+	clients.IO.PrintInfo(ctx, false, "Enter the challenge code from Slack:")
+	challengeCode, err := readChallengeCode(clients.IO.ReadIn())
+	if err != nil {
+		return types.SlackAuth{}, "", err
+	}
+
+	authExchangeRes, err := clients.API().ExchangeAuthTicket(ctx, authTicket, challengeCode, version.Raw())
+	if err != nil {
+		return types.SlackAuth{}, "", err
+	}
+	if !matchesTeamFlag(clients.Config.TeamFlag, authExchangeRes.TeamID, authExchangeRes.TeamDomain, authExchangeRes.EnterpriseID) {
+		return types.SlackAuth{}, "", errAuthTeamMismatch(clients.Config.TeamFlag, authExchangeRes.TeamDomain, authExchangeRes.TeamID)
+	}
+
+	return saveNewAuth(ctx, clients.API(), clients.Auth(), authExchangeRes, noRotation)
+}
+
+// readChallengeCode returns the first line of input without surrounding spaces
+func readChallengeCode(in io.Reader) (string, error) {
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", slackerror.New(slackerror.ErrMissingChallenge).WithRootCause(err)
+	}
+	challengeCode := strings.TrimSpace(line)
+	if challengeCode == "" {
+		return "", slackerror.New(slackerror.ErrMissingChallenge).
+			WithRemediation("Enter the challenge code displayed in Slack after running the slash command")
+	}
+	return challengeCode, nil
+}
@@ -16,9 +16,14 @@ package create
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -27,6 +32,8 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/cmd/doctor"
 	"github.com/slackapi/slack-cli/internal/app"
@@ -54,7 +61,10 @@ type CreateArgs struct {
 	DisplayName string
 	Template    Template
 	GitBranch   string
+	GitRef      string
 	Subdir      string
+	Checksum    string
+	NoGit       bool
 }
 
 // Create will create a new Slack app on the file system and app manifest on the Slack API.
@@ -115,6 +125,9 @@ func Create(ctx context.Context, clients *shared.ClientFactory, createArgs Creat
 	if createArgs.GitBranch != "" {
 		clients.IO.PrintDebug(ctx, "cloning project from branch '%s'", createArgs.GitBranch)
 	}
+	if createArgs.GitRef != "" {
+		clients.IO.PrintDebug(ctx, "cloning project at ref '%s'", createArgs.GitRef)
+	}
 	clients.IO.PrintDebug(ctx, "writing project to path '%s'", projectDirPath)
 	projectDetails := []string{
 		fmt.Sprintf("Cloning template %s", style.Highlight(createArgs.Template.GetTemplatePath())),
@@ -136,12 +149,20 @@ func Create(ctx context.Context, clients *shared.ClientFactory, createArgs Creat
 		return "", err
 	}
 
+	cloneOpts := gitCloneOptions{
+		branch:    createArgs.GitBranch,
+		checksum:  createArgs.Checksum,
+		noGit:     createArgs.NoGit,
+		ref:       createArgs.GitRef,
+		token:     clients.Config.GitToken,
+		tokenHost: clients.Config.GitTokenHost,
+	}
 	if subdir != "" {
-		if err := createAppFromSubdir(ctx, projectDirPath, createArgs.Template, createArgs.GitBranch, subdir, clients.Fs); err != nil {
+		if err := createAppFromSubdir(ctx, projectDirPath, createArgs.Template, cloneOpts, subdir, clients.Fs); err != nil {
 			return "", slackerror.Wrap(err, slackerror.ErrAppCreate)
 		}
 	} else {
-		if err := createApp(ctx, projectDirPath, createArgs.Template, createArgs.GitBranch, clients.Fs); err != nil {
+		if err := createApp(ctx, projectDirPath, createArgs.Template, cloneOpts, clients.Fs); err != nil {
 			return "", slackerror.Wrap(err, slackerror.ErrAppCreate)
 		}
 	}
@@ -259,94 +280,74 @@ func parentDirExists(dirPath string) (bool, error) {
 	return true, nil
 }
 
+// gitCloneOptions configures the revision and credentials used to clone a
+// template repository
+type gitCloneOptions struct {
+	branch    string // branch is the name of a branch to clone
+	checksum  string // checksum is the expected SHA-256 of the template archive
+	noGit     bool   // noGit downloads an archive of the template without git
+	ref       string // ref is a branch, tag, or commit to checkout after cloning
+	token     string // token authenticates HTTP requests for private templates
+	tokenHost string // tokenHost is the only host that is sent the token
+}
+
 // createApp will create the app directory using the default app template or a specified template URL.
-func createApp(ctx context.Context, dirPath string, template Template, gitBranch string, fs afero.Fs) error {
+func createApp(ctx context.Context, dirPath string, template Template, opts gitCloneOptions, fs afero.Fs) error {
+	if opts.checksum != "" && !template.isGit {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --checksum flag can only be used with remote templates")
+	}
 	if template.isGit {
-		doctorSection, err := doctor.CheckGit(ctx)
-		if doctorSection.HasError() || err != nil {
-			httpClient := slackhttp.NewHTTPClient(slackhttp.HTTPClientOptions{})
-			zipFileURL := generateGitZipFileURL(httpClient, template.path, gitBranch)
-			if zipFileURL == "" {
-				return slackerror.New(slackerror.ErrGitZipDownload)
-			}
-			resp, err := http.Get(zipFileURL)
-			if err != nil {
-				return slackerror.New(slackerror.ErrGitZipDownload)
-			}
-
-			defer resp.Body.Close()
-
-			zipFile := dirPath + ".zip"
-			out, err := os.Create(zipFile)
-			if err != nil {
-				return slackerror.Wrap(err, "error copying remote template")
-			}
-			defer out.Close()
-
-			_, err = io.Copy(out, resp.Body)
-			if err != nil {
-				return slackerror.Wrap(err, "error copying remote template")
+		var doctorSection doctor.Section
+		var err error
+		if !opts.noGit {
+			doctorSection, err = doctor.CheckGit(ctx)
+		}
+		if opts.noGit {
+			// Only archives are downloaded so git is not required
+			err = downloadGitZip(dirPath, template, opts, fs)
+			if errors.Is(err, errGitZipUnavailable) {
+				return slackerror.New(slackerror.ErrGitZipDownload).
+					WithMessage("An archive of the template is required with the --no-git flag").
+					WithRemediation("Remove the --no-git flag to clone the template with git")
+			} else if err != nil {
+				return err
 			}
-
-			_, err = archiveutil.Unzip(zipFile, dirPath)
-			if err != nil {
-				err = slackerror.Wrapf(err, "failed to extract the remote template archive")
-				return slackerror.Wrapf(err, slackerror.ErrGitZipDownload)
+		} else if opts.checksum != "" {
+			// Only archives can be verified so the template is never cloned
+			err = downloadGitZip(dirPath, template, opts, fs)
+			if errors.Is(err, errGitZipUnavailable) {
+				return slackerror.New(slackerror.ErrGitZipDownload).
+					WithMessage("An archive of the template is required to verify the checksum")
+			} else if err != nil {
+				return err
 			}
-
-			entries, _ := os.ReadDir(dirPath)
-			tmpFolder := filepath.Join(dirPath, entries[0].Name())
-
-			copyDirectoryOpts := goutils.CopyDirectoryOpts{
-				Src: tmpFolder,
-				Dst: dirPath,
+		} else if doctorSection.HasError() || err != nil {
+			err = downloadGitZip(dirPath, template, opts, fs)
+			if errors.Is(err, errGitZipUnavailable) {
+				// Archives of private templates cannot be downloaded without
+				// credentials so the repository is cloned instead
+				if err := cloneGitTemplate(dirPath, template, opts); err != nil {
+					return toGitCloneError(err)
+				}
+			} else if err != nil {
+				return err
 			}
-			if err := goutils.CopyDirectory(copyDirectoryOpts); err != nil {
-				return slackerror.Wrap(err, "error copying remote template")
+		} else if template.isSSH() && os.Getenv("GIT_SSH_COMMAND") != "" {
+			// Custom SSH commands are only supported by the git command
+			if err := runGitClone(template.path, dirPath, opts); err != nil {
+				return err
 			}
-			_ = fs.RemoveAll(tmpFolder)
-			err = os.Remove(zipFile)
-			if err != nil {
-				err = slackerror.Wrapf(err, "failed to remove the remote template archive")
-				return slackerror.Wrapf(err, slackerror.ErrGitZipDownload)
-			}
-
 		} else {
-			// Use go-git to clone repo
-			cloneOptions := git.CloneOptions{
-				URL:   template.path,
-				Depth: 1,
-			}
-			// Set ReferenceName to be the branch
-			if gitBranch != "" {
-				cloneOptions = git.CloneOptions{
-					URL:           template.path,
-					ReferenceName: plumbing.NewBranchReferenceName(gitBranch),
-					Depth:         1,
-				}
-			}
-			_, err := git.PlainClone(dirPath, false, &cloneOptions)
+			err := cloneGitTemplate(dirPath, template, opts)
 			if err != nil {
-				if err.Error() == "authentication required" {
-					gitArgs := createGitArgs(template.path, dirPath, gitBranch)
-					gitCloneCommand := exec.Command("git", gitArgs...)
-					if output, err := gitCloneCommand.CombinedOutput(); err != nil {
-						errMsg := fmt.Sprintf(
-							"%s\n%s\n\n%s",
-							"The following output was printed during the clone command",
-							fmt.Sprintf("%s %s", "git", strings.Join(gitArgs, " ")),
-							strings.TrimSpace(string(output)),
-						)
-						return slackerror.New(slackerror.ErrGitClone).
-							WithMessage("An error occurred while cloning the repository").
-							WithDetails(slackerror.ErrorDetails{
-								slackerror.ErrorDetail{
-									Message: errMsg,
-								},
-							})
-					}
-				} else {
-					return slackerror.New(slackerror.ErrGitClone)
+				if !isGitAuthError(err) {
+					return toGitCloneError(err)
+				}
+				// The git command supports credential helpers and SSH configurations
+				_ = os.RemoveAll(dirPath)
+				if err := runGitClone(template.path, dirPath, opts); err != nil {
+					return err
 				}
 			}
 		}
@@ -381,6 +382,260 @@ func createApp(ctx context.Context, dirPath string, template Template, gitBranch
 	return nil
 }
 
+// gitTokenUsername is the username paired with a git token for HTTP auth
+const gitTokenUsername = "x-access-token"
+
+// defaultGitTokenHost is the host that is sent the git token when another host
+// is not set with the SLACK_GIT_TOKEN_HOST environment variable
+const defaultGitTokenHost = "github.com"
+
+// gitTokenForURL returns the git token if the template is cloned over HTTPS from
+// the token host. Other templates are cloned without the token so it is never
+// sent to an unexpected server or over an unencrypted connection.
+func gitTokenForURL(templatePath string, opts gitCloneOptions) string {
+	if opts.token == "" {
+		return ""
+	}
+	templateURL, err := url.Parse(templatePath)
+	if err != nil || templateURL.Scheme != "https" {
+		return ""
+	}
+	if !strings.EqualFold(templateURL.Host, gitTokenHost(opts)) {
+		return ""
+	}
+	return opts.token
+}
+
+// gitTokenHost returns the host that is sent the git token
+func gitTokenHost(opts gitCloneOptions) string {
+	if opts.tokenHost == "" {
+		return defaultGitTokenHost
+	}
+	return opts.tokenHost
+}
+
+// errGitZipUnavailable is returned when an archive of the template cannot be
+// found, such as for private repositories
+var errGitZipUnavailable = errors.New("git zip archive unavailable")
+
+// downloadGitZip downloads and extracts an archive of the template to dirPath
+// without using git
+func downloadGitZip(dirPath string, template Template, opts gitCloneOptions, fs afero.Fs) error {
+	var zipFileURL string
+	if opts.ref != "" {
+		zipFileURL = generateGitZipRefURL(template.path, opts.ref)
+	} else {
+		httpClient := slackhttp.NewHTTPClient(slackhttp.HTTPClientOptions{})
+		zipFileURL = generateGitZipFileURL(httpClient, template.path, opts.branch)
+	}
+	if zipFileURL == "" {
+		return errGitZipUnavailable
+	}
+	resp, err := http.Get(zipFileURL)
+	if err != nil {
+		return slackerror.New(slackerror.ErrGitZipDownload)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errGitZipUnavailable
+	}
+
+	zipFile := dirPath + ".zip"
+	out, err := os.Create(zipFile)
+	if err != nil {
+		return slackerror.Wrap(err, "error copying remote template")
+	}
+	defer out.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(out, hash), resp.Body)
+	if err != nil {
+		return slackerror.Wrap(err, "error copying remote template")
+	}
+	if err := verifyChecksum(hex.EncodeToString(hash.Sum(nil)), opts.checksum); err != nil {
+		_ = os.Remove(zipFile)
+		return err
+	}
+
+	_, err = archiveutil.Unzip(zipFile, dirPath)
+	if err != nil {
+		err = slackerror.Wrapf(err, "failed to extract the remote template archive")
+		return slackerror.Wrapf(err, slackerror.ErrGitZipDownload)
+	}
+
+	entries, _ := os.ReadDir(dirPath)
+	tmpFolder := filepath.Join(dirPath, entries[0].Name())
+
+	copyDirectoryOpts := goutils.CopyDirectoryOpts{
+		Src: tmpFolder,
+		Dst: dirPath,
+	}
+	if err := goutils.CopyDirectory(copyDirectoryOpts); err != nil {
+		return slackerror.Wrap(err, "error copying remote template")
+	}
+	_ = fs.RemoveAll(tmpFolder)
+	err = os.Remove(zipFile)
+	if err != nil {
+		err = slackerror.Wrapf(err, "failed to remove the remote template archive")
+		return slackerror.Wrapf(err, slackerror.ErrGitZipDownload)
+	}
+	return nil
+}
+
+// verifyChecksum errors if the SHA-256 of a downloaded archive does not match
+// the expected checksum. No checksum is expected when expected is empty.
+func verifyChecksum(actual string, expected string) error {
+	if expected == "" || strings.EqualFold(actual, expected) {
+		return nil
+	}
+	return slackerror.New(slackerror.ErrTemplateChecksumMismatch).
+		WithDetails(slackerror.ErrorDetails{
+			{Message: fmt.Sprintf("Expected checksum: %s", strings.ToLower(expected))},
+			{Message: fmt.Sprintf("Actual checksum: %s", actual)},
+		})
+}
+
+// cloneGitTemplate clones the template to dirPath using go-git
+//
+// Templates using HTTPS are cloned with the git token if one is provided for the
+// host while SSH templates use the SSH agent for authentication. The complete history is
+// cloned when a ref is provided so that any branch, tag, or commit can be used.
+func cloneGitTemplate(dirPath string, template Template, opts gitCloneOptions) error {
+	cloneOptions := git.CloneOptions{
+		URL:   template.path,
+		Depth: 1,
+	}
+	// Set ReferenceName to be the branch
+	if opts.branch != "" {
+		cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(opts.branch)
+	}
+	if opts.ref != "" {
+		cloneOptions.Depth = 0
+	}
+	if token := gitTokenForURL(template.path, opts); token != "" {
+		cloneOptions.Auth = &githttp.BasicAuth{
+			Username: gitTokenUsername,
+			Password: token,
+		}
+	}
+	repo, err := git.PlainClone(dirPath, false, &cloneOptions)
+	if err != nil {
+		return err
+	}
+	if opts.ref == "" {
+		return nil
+	}
+	hash, err := resolveGitRef(repo, opts.ref)
+	if err != nil {
+		return err
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	return worktree.Checkout(&git.CheckoutOptions{
+		Hash:  hash,
+		Force: true,
+	})
+}
+
+// resolveGitRef returns the commit hash of a branch, tag, or commit in the
+// cloned repository
+func resolveGitRef(repo *git.Repository, ref string) (plumbing.Hash, error) {
+	revisions := []string{
+		ref,
+		fmt.Sprintf("refs/remotes/origin/%s", ref),
+	}
+	for _, revision := range revisions {
+		hash, err := repo.ResolveRevision(plumbing.Revision(revision))
+		if err == nil {
+			return *hash, nil
+		}
+	}
+	return plumbing.ZeroHash, slackerror.New(slackerror.ErrGitRefNotFound).
+		WithMessage("The git reference %q was not found in the template", ref)
+}
+
+// toGitCloneError returns clone errors as an ErrGitClone unless the error has
+// more details
+func toGitCloneError(err error) error {
+	var slackErr *slackerror.Error
+	if errors.As(err, &slackErr) {
+		return err
+	}
+	return slackerror.New(slackerror.ErrGitClone).WithRootCause(err)
+}
+
+// isGitAuthError returns if the clone failed from missing or invalid credentials
+func isGitAuthError(err error) bool {
+	return errors.Is(err, transport.ErrAuthenticationRequired) ||
+		errors.Is(err, transport.ErrAuthorizationFailed)
+}
+
+// runGitClone clones the template to dirPath using the git command
+//
+// The git token is passed as an HTTP header through environment variables to
+// avoid writing it to the command arguments. The header is scoped to the token
+// host so redirects and submodules on other hosts are not sent the token.
+func runGitClone(templatePath string, dirPath string, opts gitCloneOptions) error {
+	var env []string
+	if token := gitTokenForURL(templatePath, opts); token != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(gitTokenUsername + ":" + token))
+		env = append(os.Environ(),
+			"GIT_CONFIG_COUNT=1",
+			fmt.Sprintf("GIT_CONFIG_KEY_0=http.https://%s/.extraHeader", gitTokenHost(opts)),
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials,
+		)
+	}
+	gitArgs := createGitArgs(templatePath, dirPath, opts.branch)
+	if opts.ref != "" {
+		gitArgs = []string{"clone", templatePath, dirPath}
+	}
+	if output, err := runGitCommand(gitArgs, env); err != nil {
+		return slackerror.New(slackerror.ErrGitClone).
+			WithMessage("An error occurred while cloning the repository").
+			WithDetails(slackerror.ErrorDetails{
+				slackerror.ErrorDetail{
+					Message: output,
+				},
+			})
+	}
+	if opts.ref != "" {
+		checkoutArgs := []string{"-C", dirPath, "checkout", "--quiet", opts.ref}
+		if output, err := runGitCommand(checkoutArgs, env); err != nil {
+			return slackerror.New(slackerror.ErrGitRefNotFound).
+				WithMessage("The git reference %q was not found in the template", opts.ref).
+				WithDetails(slackerror.ErrorDetails{
+					slackerror.ErrorDetail{
+						Message: output,
+					},
+				})
+		}
+	}
+	return nil
+}
+
+// runGitCommand runs git with the arguments and returns a summary of the
+// command output when an error occurs
+func runGitCommand(gitArgs []string, env []string) (string, error) {
+	gitCommand := exec.Command("git", gitArgs...)
+	if len(env) > 0 {
+		gitCommand.Env = env
+	}
+	output, err := gitCommand.CombinedOutput()
+	if err != nil {
+		return fmt.Sprintf(
+			"%s\n%s\n\n%s",
+			"The following output was printed during the git command",
+			fmt.Sprintf("%s %s", "git", strings.Join(gitArgs, " ")),
+			strings.TrimSpace(string(output)),
+		), err
+	}
+	return "", nil
+}
+
 // normalizeSubdir cleans the subdir path and returns "" if it resolves to root.
 func normalizeSubdir(subdir string) (string, error) {
 	if subdir == "" {
@@ -399,7 +654,7 @@ func normalizeSubdir(subdir string) (string, error) {
 
 // createAppFromSubdir clones the full template into a temp directory, then copies
 // only the specified subdirectory to the final project path.
-func createAppFromSubdir(ctx context.Context, dirPath string, template Template, gitBranch string, subdir string, fs afero.Fs) error {
+func createAppFromSubdir(ctx context.Context, dirPath string, template Template, opts gitCloneOptions, subdir string, fs afero.Fs) error {
 	tmpDirRoot := afero.GetTempDir(fs, "")
 	tmpDir, err := afero.TempDir(fs, tmpDirRoot, "slack-create-")
 	if err != nil {
@@ -408,7 +663,7 @@ func createAppFromSubdir(ctx context.Context, dirPath string, template Template,
 	defer func() { _ = fs.RemoveAll(tmpDir) }()
 
 	cloneDir := filepath.Join(tmpDir, "repo")
-	if err := createApp(ctx, cloneDir, template, gitBranch, fs); err != nil {
+	if err := createApp(ctx, cloneDir, template, opts, fs); err != nil {
 		return err
 	}
 
@@ -615,6 +870,11 @@ func generateGitZipFileURL(httpClient slackhttp.HTTPClient, templateURL string,
 	return zipURL
 }
 
+// generateGitZipRefURL returns the archive URL of a branch, tag, or commit
+func generateGitZipRefURL(templateURL string, gitRef string) string {
+	return strings.TrimSuffix(templateURL, ".git") + "/archive/" + gitRef + ".zip"
+}
+
 func createGitArgs(templatePath string, dirPath string, gitBranch string) []string {
 	gitArgs := []string{"clone", "--depth=1", templatePath, dirPath}
 	gitBranch = strings.Trim(gitBranch, " ")
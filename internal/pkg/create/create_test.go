@@ -18,12 +18,15 @@ import (
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/experiment"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackerror"
 	"github.com/slackapi/slack-cli/internal/slackhttp"
 	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/afero"
@@ -298,6 +301,31 @@ func Test_generateGitZipFileURL(t *testing.T) {
 	}
 }
 
+func Test_generateGitZipRefURL(t *testing.T) {
+	tests := map[string]struct {
+		templateURL string
+		gitRef      string
+		expectedURL string
+	}{
+		"tag reference": {
+			templateURL: "https://github.com/slack-samples/deno-hello-world",
+			gitRef:      "v1.0.0",
+			expectedURL: "https://github.com/slack-samples/deno-hello-world/archive/v1.0.0.zip",
+		},
+		"commit reference with git suffix": {
+			templateURL: "https://github.com/slack-samples/deno-hello-world.git",
+			gitRef:      "a1b2c3d",
+			expectedURL: "https://github.com/slack-samples/deno-hello-world/archive/a1b2c3d.zip",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			url := generateGitZipRefURL(tc.templateURL, tc.gitRef)
+			assert.Equal(t, tc.expectedURL, url)
+		})
+	}
+}
+
 func TestCreateGitArgs(t *testing.T) {
 	var testGitArgs, expectedArgs []string
 
@@ -319,6 +347,85 @@ func TestCreateGitArgs(t *testing.T) {
 	assert.Equal(t, expectedArgs, testGitArgs)
 }
 
+func TestIsGitAuthError(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"missing credentials require authentication": {
+			err:      transport.ErrAuthenticationRequired,
+			expected: true,
+		},
+		"invalid credentials fail authorization": {
+			err:      fmt.Errorf("clone: %w", transport.ErrAuthorizationFailed),
+			expected: true,
+		},
+		"other errors are not auth errors": {
+			err:      transport.ErrEmptyRemoteRepository,
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isGitAuthError(tc.err))
+		})
+	}
+}
+
+func Test_gitTokenForURL(t *testing.T) {
+	tests := map[string]struct {
+		templatePath string
+		opts         gitCloneOptions
+		expected     string
+	}{
+		"returns the token for https templates on github.com": {
+			templatePath: "https://github.com/slack-samples/private-template",
+			opts:         gitCloneOptions{token: "ghp_example"},
+			expected:     "ghp_example",
+		},
+		"returns the token for https templates on the token host": {
+			templatePath: "https://github.example.com/team/private-template",
+			opts:         gitCloneOptions{token: "ghp_example", tokenHost: "github.example.com"},
+			expected:     "ghp_example",
+		},
+		"returns no token for templates on another host": {
+			templatePath: "https://example.com/slack-samples/private-template",
+			opts:         gitCloneOptions{token: "ghp_example"},
+			expected:     "",
+		},
+		"returns no token for github.com when another token host is set": {
+			templatePath: "https://github.com/slack-samples/private-template",
+			opts:         gitCloneOptions{token: "ghp_example", tokenHost: "github.example.com"},
+			expected:     "",
+		},
+		"returns no token for http templates": {
+			templatePath: "http://github.com/slack-samples/private-template",
+			opts:         gitCloneOptions{token: "ghp_example"},
+			expected:     "",
+		},
+		"returns no token for ssh templates": {
+			templatePath: "git@github.com:slack-samples/private-template.git",
+			opts:         gitCloneOptions{token: "ghp_example"},
+			expected:     "",
+		},
+		"returns no token for hosts that contain the token host": {
+			templatePath: "https://github.com.example.com/slack-samples/private-template",
+			opts:         gitCloneOptions{token: "ghp_example"},
+			expected:     "",
+		},
+		"returns no token when none is set": {
+			templatePath: "https://github.com/slack-samples/private-template",
+			opts:         gitCloneOptions{},
+			expected:     "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, gitTokenForURL(tc.templatePath, tc.opts))
+		})
+	}
+}
+
 func TestNormalizeSubdir(t *testing.T) {
 	tests := map[string]struct {
 		input       string
@@ -371,10 +478,49 @@ func TestNormalizeSubdir(t *testing.T) {
 	}
 }
 
+func Test_verifyChecksum(t *testing.T) {
+	checksum := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	tests := map[string]struct {
+		actual            string
+		expected          string
+		expectedErrorCode string
+	}{
+		"skips verification without an expected checksum": {
+			actual: checksum,
+		},
+		"accepts a matching checksum": {
+			actual:   checksum,
+			expected: checksum,
+		},
+		"accepts a matching checksum in uppercase": {
+			actual:   checksum,
+			expected: strings.ToUpper(checksum),
+		},
+		"errors for a mismatched checksum": {
+			actual:            checksum,
+			expected:          strings.Repeat("0", 64),
+			expectedErrorCode: slackerror.ErrTemplateChecksumMismatch,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := verifyChecksum(tc.actual, tc.expected)
+			if tc.expectedErrorCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrorCode, slackerror.ToSlackError(err).Code)
+				assert.Contains(t, err.Error(), "Actual checksum: "+checksum)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCreateAppFromSubdir(t *testing.T) {
 	tests := map[string]struct {
 		setupTemplate func(t *testing.T, fs afero.Fs) string
 		subdir        string
+		checksum      string
 		expectError   bool
 		errorContains string
 		expectFiles   []string
@@ -411,6 +557,15 @@ func TestCreateAppFromSubdir(t *testing.T) {
 			expectError:   true,
 			errorContains: "is not a directory",
 		},
+		"returns error for a checksum with a local template": {
+			setupTemplate: func(t *testing.T, fs afero.Fs) string {
+				return t.TempDir()
+			},
+			subdir:        "apps/my-app",
+			checksum:      strings.Repeat("0", 64),
+			expectError:   true,
+			errorContains: "The --checksum flag can only be used with remote templates",
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -422,7 +577,7 @@ func TestCreateAppFromSubdir(t *testing.T) {
 
 			template := Template{path: templateDir, isLocal: true}
 
-			err := createAppFromSubdir(t.Context(), outputDir, template, "", tc.subdir, fs)
+			err := createAppFromSubdir(t.Context(), outputDir, template, gitCloneOptions{checksum: tc.checksum}, tc.subdir, fs)
 
 			if tc.expectError {
 				assert.Error(t, err)
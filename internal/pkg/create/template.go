@@ -105,6 +105,11 @@ func (t Template) GetTemplatePath() string {
 	}
 }
 
+// isSSH returns if the template is cloned using the SSH protocol
+func (t Template) isSSH() bool {
+	return t.isGit && (strings.HasPrefix(t.path, "ssh://") || strings.HasPrefix(t.path, "git@"))
+}
+
 // IsSample returns if the complete URL points to a sample template
 func (t Template) IsSample() bool {
 	if t.isLocal || !t.isGit || strings.HasPrefix(t.path, "ssh://") {
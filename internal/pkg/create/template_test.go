@@ -256,6 +256,47 @@ func TestTemplate_GetTemplatePath(t *testing.T) {
 	}
 }
 
+func TestTemplate_isSSH(t *testing.T) {
+	tests := map[string]struct {
+		template    Template
+		expectedSSH bool
+	}{
+		"templates cloned via ssh:// use ssh": {
+			template: Template{
+				isGit: true,
+				path:  "ssh://git@github.com/example/private-template.git",
+			},
+			expectedSSH: true,
+		},
+		"templates cloned via git@ use ssh": {
+			template: Template{
+				isGit: true,
+				path:  "git@github.com:example/private-template.git",
+			},
+			expectedSSH: true,
+		},
+		"templates cloned via https do not use ssh": {
+			template: Template{
+				isGit: true,
+				path:  "https://github.com/example/private-template.git",
+			},
+			expectedSSH: false,
+		},
+		"local paths do not use ssh": {
+			template: Template{
+				isLocal: true,
+				path:    "git@example/path",
+			},
+			expectedSSH: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedSSH, tc.template.isSSH())
+		})
+	}
+}
+
 func TestTemplate_IsSample(t *testing.T) {
 	tests := map[string]struct {
 		template       Template
@@ -0,0 +1,84 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
+)
+
+// AttachArgs are the arguments passed into the Attach function
+type AttachArgs struct {
+	ActivityLevel string
+	App           types.App
+	Auth          types.SlackAuth
+}
+
+// activityFunc streams activity logs and can be replaced for testing
+var activityFunc = Activity
+
+// Attach streams activity logs of an app served by a separate "run" command
+//
+// Nothing is installed or served from here so that the running session keeps
+// ownership of the socket connection.
+func Attach(ctx context.Context, clients *shared.ClientFactory, attachArgs AttachArgs) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "cmd.attach")
+	defer span.Finish()
+
+	session, err := clients.Config.ProjectConfig.Cache().GetRunSession(ctx, attachArgs.App.AppID)
+	if err != nil {
+		return err
+	}
+	if !session.Exists() {
+		return slackerror.New(slackerror.ErrRunSessionNotFound).
+			WithMessage("No running development session was found for the app %s", attachArgs.App.AppID)
+	}
+
+	minLevel := attachArgs.ActivityLevel
+	if strings.TrimSpace(minLevel) == "" {
+		minLevel = ActivityMinLevelDefault
+	}
+
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "electric_plug",
+		Text:  fmt.Sprintf("Attached to the development session of %s", attachArgs.App.AppID),
+		Secondary: []string{
+			fmt.Sprintf("Session started %s by process %d", time.Unix(session.StartedAt, 0).Format(time.RFC1123), session.PID),
+			"Activity is streamed read-only, press Ctrl+C to detach",
+		},
+	}))
+
+	ctx = config.SetContextToken(ctx, attachArgs.Auth.Token)
+	activityArgs := types.ActivityArgs{
+		TeamID:            attachArgs.Auth.TeamID,
+		AppID:             attachArgs.App.AppID,
+		TailArg:           true,
+		PollingIntervalMS: ActivityPollingIntervalDefault * 1000,
+		MinDateCreated:    time.Now().UnixMicro(),
+		MinLevel:          minLevel,
+		Limit:             ActivityLimitDefault,
+		IdleTimeoutM:      60 * 24,
+	}
+	return activityFunc(ctx, clients, activityArgs)
+}
@@ -0,0 +1,110 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/cache"
+	"github.com/slackapi/slack-cli/internal/config"
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Attach(t *testing.T) {
+	tests := map[string]struct {
+		mockSession          cache.RunSession
+		activityLevel        string
+		expectedActivityArgs types.ActivityArgs
+		expectedOutputs      []string
+		expectedError        error
+	}{
+		"streams activity of a running session": {
+			mockSession: cache.RunSession{PID: 4242, TeamID: "T123", StartedAt: 1700000000},
+			expectedActivityArgs: types.ActivityArgs{
+				TeamID:   "T123",
+				AppID:    "A123",
+				TailArg:  true,
+				MinLevel: ActivityMinLevelDefault,
+			},
+			expectedOutputs: []string{"Attached to the development session of A123", "by process 4242"},
+		},
+		"streams activity at the provided level": {
+			mockSession:   cache.RunSession{PID: 4242, TeamID: "T123", StartedAt: 1700000000},
+			activityLevel: "error",
+			expectedActivityArgs: types.ActivityArgs{
+				TeamID:   "T123",
+				AppID:    "A123",
+				TailArg:  true,
+				MinLevel: "error",
+			},
+		},
+		"errors if no session is running": {
+			mockSession:   cache.RunSession{},
+			expectedError: slackerror.New(slackerror.ErrRunSessionNotFound),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			mockProjectCache := cache.NewCacheMock()
+			mockProjectCache.On("GetRunSession", mock.Anything, "A123").Return(tc.mockSession, nil)
+			mockProjectConfig := config.NewProjectConfigMock()
+			mockProjectConfig.On("Cache").Return(mockProjectCache)
+			clientsMock.Config.ProjectConfig = mockProjectConfig
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			var activityArgs types.ActivityArgs
+			var activityToken string
+			activityFunc = func(ctx context.Context, clients *shared.ClientFactory, args types.ActivityArgs) error {
+				activityArgs = args
+				activityToken = config.GetContextToken(ctx)
+				return nil
+			}
+			defer func() {
+				activityFunc = Activity
+			}()
+
+			err := Attach(ctx, clients, AttachArgs{
+				ActivityLevel: tc.activityLevel,
+				App:           types.App{AppID: "A123", IsDev: true},
+				Auth:          types.SlackAuth{TeamID: "T123", Token: "xoxp-example"},
+			})
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ToSlackError(tc.expectedError).Code, slackerror.ToSlackError(err).Code)
+				assert.Empty(t, activityArgs.AppID)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "xoxp-example", activityToken)
+			assert.Equal(t, tc.expectedActivityArgs.TeamID, activityArgs.TeamID)
+			assert.Equal(t, tc.expectedActivityArgs.AppID, activityArgs.AppID)
+			assert.Equal(t, tc.expectedActivityArgs.TailArg, activityArgs.TailArg)
+			assert.Equal(t, tc.expectedActivityArgs.MinLevel, activityArgs.MinLevel)
+			for _, expected := range tc.expectedOutputs {
+				assert.Contains(t, clientsMock.GetCombinedOutput(), expected)
+			}
+		})
+	}
+}
@@ -36,7 +36,7 @@ import (
 )
 
 // Deploy will package and upload an app to the Slack Platform
-func Deploy(ctx context.Context, clients *shared.ClientFactory, showTriggers bool, app types.App) error {
+func Deploy(ctx context.Context, clients *shared.ClientFactory, args types.DeployArgs, app types.App) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "cmd.deploy")
 	defer span.Finish()
 
@@ -79,7 +79,7 @@ func Deploy(ctx context.Context, clients *shared.ClientFactory, showTriggers boo
 		return slackerror.Wrap(err, slackerror.ErrAppManifestAccess)
 	}
 
-	if showTriggers {
+	if args.ShowTriggers {
 		// Generate an optional trigger when none exist
 		_, err = triggers.TriggerGenerate(ctx, clients, app)
 		if err != nil {
@@ -88,7 +88,7 @@ func Deploy(ctx context.Context, clients *shared.ClientFactory, showTriggers boo
 	}
 
 	// deploy the app
-	if err := deployApp(ctx, clients, app, manifest, authSession); err != nil {
+	if err := deployApp(ctx, clients, app, manifest, authSession, args.MaxUploadRetries); err != nil {
 		return slackerror.Wrap(err, slackerror.ErrAppDeploy)
 	}
 
@@ -102,7 +102,7 @@ func Deploy(ctx context.Context, clients *shared.ClientFactory, showTriggers boo
 	return nil
 }
 
-func deployApp(ctx context.Context, clients *shared.ClientFactory, app types.App, manifest types.SlackYaml, authSession api.AuthSession) error {
+func deployApp(ctx context.Context, clients *shared.ClientFactory, app types.App, manifest types.SlackYaml, authSession api.AuthSession, maxUploadRetries int) error {
 	var span opentracing.Span
 	span, ctx = opentracing.StartSpanFromContext(ctx, "deployApp")
 	defer span.Finish()
@@ -164,23 +164,11 @@ func deployApp(ctx context.Context, clients *shared.ClientFactory, app types.App
 	deploySpinnerText := "Deploying to Slack Platform" + style.Secondary(" (this will take a moment)")
 	deploySpinner.Update(deploySpinnerText, "").Start()
 
-	//upload zip to s3
+	// upload the zip to s3 and then to the app
 	var startDeploy = time.Now()
-	s3Params, err := clients.API().GetPresignedS3PostParams(ctx, token, app.AppID)
+	err = uploadPackage(ctx, clients, deploySpinner, app.AppID, result.Filename, maxUploadRetries)
 	if err != nil {
-		return slackerror.Wrapf(err, "failed generating s3 upload params %s", app.AppID)
-	}
-
-	fileName, err := clients.API().UploadPackageToS3(ctx, clients.Fs, app.AppID, s3Params, result.Filename)
-	if err != nil {
-		return slackerror.Wrapf(err, "failed uploading the zip file to s3 %s", app.AppID)
-	}
-
-	// upload
-	runtime := strings.ToLower(clients.Runtime.Name())
-	err = clients.API().UploadApp(ctx, token, runtime, app.AppID, fileName)
-	if err != nil {
-		return fmt.Errorf("error uploading app: %s", err)
+		return err
 	}
 	var elapsedDeploy = time.Since(startDeploy)
 	var deployTime = fmt.Sprintf("%.1fs", elapsedDeploy.Seconds())
@@ -200,13 +188,62 @@ func deployApp(ctx context.Context, clients *shared.ClientFactory, app types.App
 	return nil
 }
 
+// uploadRetryDelay returns the pause before the next upload attempt and doubles
+// with each failed attempt
+var uploadRetryDelay = func(attempt int) time.Duration {
+	return time.Duration(1<<attempt) * time.Second
+}
+
+// uploadPackage uploads the packaged archive to s3 and then attaches it to the
+// app, retrying transient failures with fresh upload params each attempt
+func uploadPackage(ctx context.Context, clients *shared.ClientFactory, spinner *style.Spinner, appID string, archivePath string, maxRetries int) error {
+	var token = config.GetContextToken(ctx)
+	var runtime = strings.ToLower(clients.Runtime.Name())
+	for attempt := 0; ; attempt++ {
+		retryable, err := uploadPackageOnce(ctx, clients, token, runtime, appID, archivePath)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= maxRetries {
+			return err
+		}
+		delay := uploadRetryDelay(attempt)
+		clients.IO.PrintDebug(ctx, "upload attempt %d failed: %s. Retrying upload in %s...", attempt+1, err, delay)
+		spinner.Update(fmt.Sprintf("Retrying the upload to Slack Platform (attempt %d of %d)", attempt+2, maxRetries+1), "")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// uploadPackageOnce makes a single upload attempt and reports if a failed
+// attempt might succeed if retried
+func uploadPackageOnce(ctx context.Context, clients *shared.ClientFactory, token string, runtime string, appID string, archivePath string) (bool, error) {
+	s3Params, err := clients.API().GetPresignedS3PostParams(ctx, token, appID)
+	if err != nil {
+		return false, slackerror.Wrapf(err, "failed generating s3 upload params %s", appID)
+	}
+	fileName, err := clients.API().UploadPackageToS3(ctx, clients.Fs, appID, s3Params, archivePath)
+	if err != nil {
+		return true, slackerror.Wrapf(err, "failed uploading the zip file to s3 %s", appID)
+	}
+	err = clients.API().UploadApp(ctx, token, runtime, appID, fileName)
+	if err != nil {
+		// The uploaded file might not be available yet
+		return slackerror.ToSlackError(err).Code == slackerror.ErrNoFile, fmt.Errorf("error uploading app: %s", err)
+	}
+	return false, nil
+}
+
 // deploySuccessText formats the success message and app information for a deployed app
 func deploySuccessText(clients *shared.ClientFactory, app types.App, manifest types.SlackYaml, authSession api.AuthSession, deployTime string) string {
 	parsedAppInfo := map[string]string{}
 
 	host := clients.API().Host()
 	if app.AppID != "" && host != "" {
-		parsedAppInfo["Dashboard"] = fmt.Sprintf("%s/apps/%s", host, app.AppID)
+		parsedAppInfo["Dashboard"] = api.AppDirectoryURL(host, app.AppID)
 	}
 
 	if authSession.UserName != nil && authSession.UserID != nil {
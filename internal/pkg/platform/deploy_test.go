@@ -16,11 +16,18 @@ package platform
 
 import (
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/api"
+	"github.com/slackapi/slack-cli/internal/runtime"
 	"github.com/slackapi/slack-cli/internal/shared"
 	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDeploySuccessText(t *testing.T) {
@@ -97,3 +104,92 @@ func TestDeploySuccessText(t *testing.T) {
 		})
 	}
 }
+
+func Test_uploadPackage(t *testing.T) {
+	tests := map[string]struct {
+		maxRetries           int
+		s3Errors             []error
+		uploadAppErrors      []error
+		expectedError        string
+		expectedS3Calls      int
+		expectedUploadCalls  int
+		expectedPresignCalls int
+	}{
+		"uploads the package on the first attempt": {
+			maxRetries:           3,
+			s3Errors:             []error{nil},
+			uploadAppErrors:      []error{nil},
+			expectedS3Calls:      1,
+			expectedUploadCalls:  1,
+			expectedPresignCalls: 1,
+		},
+		"retries a transient s3 failure then succeeds": {
+			maxRetries:           3,
+			s3Errors:             []error{slackerror.New("Failed uploads to s3"), nil},
+			uploadAppErrors:      []error{nil},
+			expectedS3Calls:      2,
+			expectedUploadCalls:  1,
+			expectedPresignCalls: 2,
+		},
+		"retries a missing uploaded file then succeeds": {
+			maxRetries:           3,
+			s3Errors:             []error{nil, nil},
+			uploadAppErrors:      []error{slackerror.New(slackerror.ErrNoFile), nil},
+			expectedS3Calls:      2,
+			expectedUploadCalls:  2,
+			expectedPresignCalls: 2,
+		},
+		"stops after the maximum retries": {
+			maxRetries:           1,
+			s3Errors:             []error{slackerror.New("Failed uploads to s3"), slackerror.New("Failed uploads to s3")},
+			expectedError:        "Failed uploads to s3",
+			expectedS3Calls:      2,
+			expectedPresignCalls: 2,
+		},
+		"does not retry other upload errors": {
+			maxRetries:           3,
+			s3Errors:             []error{nil},
+			uploadAppErrors:      []error{slackerror.New(slackerror.ErrAppNotFound)},
+			expectedError:        slackerror.ErrAppNotFound,
+			expectedS3Calls:      1,
+			expectedUploadCalls:  1,
+			expectedPresignCalls: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			retryDelay := uploadRetryDelay
+			uploadRetryDelay = func(attempt int) time.Duration { return 0 }
+			defer func() { uploadRetryDelay = retryDelay }()
+			clientsMock := shared.NewClientsMock()
+			clientsMock.API.On("GetPresignedS3PostParams", mock.Anything, mock.Anything, "A001").
+				Return(api.GenerateS3PresignedPostResult{FileName: "bundle.zip"}, nil)
+			for _, err := range tc.s3Errors {
+				clientsMock.API.On("UploadPackageToS3", mock.Anything, mock.Anything, "A001", mock.Anything, "archive.zip").
+					Return("bundle.zip", err).Once()
+			}
+			for _, err := range tc.uploadAppErrors {
+				clientsMock.API.On("UploadApp", mock.Anything, mock.Anything, "deno", "A001", "bundle.zip").
+					Return(err).Once()
+			}
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			var err error
+			clients.Runtime, err = runtime.New("deno")
+			require.NoError(t, err)
+
+			spinner := style.NewSpinner(clientsMock.IO.WriteErr())
+			err = uploadPackage(ctx, clients, spinner, "A001", "archive.zip", tc.maxRetries)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			clientsMock.API.AssertNumberOfCalls(t, "GetPresignedS3PostParams", tc.expectedPresignCalls)
+			clientsMock.API.AssertNumberOfCalls(t, "UploadPackageToS3", tc.expectedS3Calls)
+			clientsMock.API.AssertNumberOfCalls(t, "UploadApp", tc.expectedUploadCalls)
+		})
+	}
+}
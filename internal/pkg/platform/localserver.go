@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +41,9 @@ import (
 	"github.com/slackapi/slack-cli/internal/style"
 )
 
+// reconnectBackoffMax is the longest wait between socket reconnect attempts
+const reconnectBackoffMax = time.Minute
+
 // for lazy testing
 var websocketDialerDial = func(d *websocket.Dialer, urlStr string,
 	requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
@@ -82,29 +86,41 @@ type LocalServer struct {
 	localHostedContext LocalHostedContext
 	cliConfig          hooks.SDKCLIConfig
 	appFilePath        string
+	inspectPort        int
+	noSocket           bool
+	reconnectAttempts  int
+	reconnectBackoff   time.Duration
 	Connection         WebSocketConnection
 	delegateCmd        hooks.ShellCommand // track running delegated process
 	delegateCmdMutex   sync.Mutex         // protect concurrent access
 }
 
 // Start establishes a socket connection to Slack, which will receive app-relevant events. It does so in a loop to support for re-establishing the socket connection.
+//
+// Dropped socket connections are retried up to the reconnect attempts with a
+// wait that doubles after each attempt. Attempts reset once a connection opens.
 func (r *LocalServer) Start(ctx context.Context) error {
+	attempt := 0
 	for {
+		reconnect := false
 		// Wrapping in an error function so that we can `defer` closing the TCP connection within the loop in the case of a restart
 		err := func() error {
 			// Get a socket connection address
 			r.clients.IO.PrintDebug(ctx, "Retrieving and establishing connection to WebSocket URL...")
 			result, err := r.clients.API().ConnectionsOpen(ctx, r.token)
 			if err != nil {
+				reconnect = true
 				return slackerror.Wrap(err, slackerror.ErrSocketConnection).WithMessage("Error fetching socket connection URL")
 			}
 
 			// Open the websocket connection
 			c, _, err := websocketDialerDial(websocket.DefaultDialer, result.URL, nil)
 			if err != nil {
+				reconnect = true
 				return slackerror.Wrap(err, slackerror.ErrSocketConnection).WithMessage("Error establishing socket connection")
 			}
 			r.Connection = c
+			attempt = 0
 			// Signal to CLI that this command will need to do additional cleanup of I/O (closing socket connection cleanly); matching Done() in defer function below
 			r.clients.CleanupWaitGroup.Add(1)
 			// Two channels to communicate with Listen(): errChan for errors, and done for signaling restarting the connection
@@ -129,15 +145,30 @@ func (r *LocalServer) Start(ctx context.Context) error {
 				}
 				r.clients.IO.PrintDebug(ctx, "LocalServer.Listen errored: %s", err.Error())
 				sendWebSocketCloseControlMessage(ctx, r.clients, r.Connection)
+				reconnect = slackerror.ToSlackError(err).Code == slackerror.ErrSocketConnection
 				return slackerror.Wrap(err, slackerror.ErrLocalAppRun)
 			case <-done:
 				r.clients.IO.PrintDebug(ctx, "LocalServer.Listen signalled for restart")
 				return nil
 			}
 		}()
-		if err != nil {
+		if err == nil {
+			continue
+		}
+		if !reconnect || attempt >= r.reconnectAttempts {
 			return err
 		}
+		attempt++
+		backoff := r.reconnectBackoff << (attempt - 1)
+		if backoff <= 0 || backoff > reconnectBackoffMax {
+			backoff = reconnectBackoffMax
+		}
+		r.clients.IO.PrintDebug(ctx, "Reconnecting to the socket in %s (attempt %d of %d) after error: %s", backoff, attempt, r.reconnectAttempts, err.Error())
+		select {
+		case <-ctx.Done():
+			return slackerror.New(slackerror.ErrLocalAppRunCleanExit)
+		case <-time.After(backoff):
+		}
 	}
 }
 
@@ -212,6 +243,7 @@ func (r *LocalServer) Listen(ctx context.Context, errChan chan<- error, done cha
 				}
 				// Mimic the hosted app by executing the SDKs run command with the message as a param
 				var startHookOpts = hooks.HookExecOpts{
+					Env:    r.inspectEnv(),
 					Hook:   r.clients.SDKConfig.Hooks.Start,
 					Stdin:  bytes.NewBuffer(body),
 					Stdout: r.clients.IO.WriteSecondary(r.clients.IO.WriteOut()),
@@ -276,14 +308,23 @@ func (r *LocalServer) stopDelegateProcess(ctx context.Context) {
 	}
 }
 
+// inspectEnv returns the hook environment that asks the SDK to enable the
+// runtime debugger on the selected port
+func (r *LocalServer) inspectEnv() map[string]string {
+	env := map[string]string{}
+	if r.inspectPort > 0 {
+		env["SLACK_CLI_INSPECT_PORT"] = strconv.Itoa(r.inspectPort)
+	}
+	return env
+}
+
 // StartDelegate passes along required opts to SDK, delegating
 // connection for running app locally to script hook start
 func (r *LocalServer) StartDelegate(ctx context.Context) error {
 	// Set up hook execution options
-	env := map[string]string{
-		"SLACK_CLI_XAPP": r.token,
-		"SLACK_CLI_XOXB": r.localHostedContext.BotAccessToken,
-	}
+	env := r.inspectEnv()
+	env["SLACK_CLI_XAPP"] = r.token
+	env["SLACK_CLI_XOXB"] = r.localHostedContext.BotAccessToken
 	if r.appFilePath != "" {
 		env["SLACK_APP_PATH"] = r.appFilePath
 		env["SLACK_CLI_CUSTOM_FILE_PATH"] = r.appFilePath
@@ -427,11 +468,11 @@ func (r *LocalServer) WatchManifest(ctx context.Context, auth types.SlackAuth, a
 	return w.Start(time.Millisecond * 100)
 }
 
-// WatchApp starts the delegated server and watches for app/code file changes to trigger restarts (SDK-managed connections only)
+// WatchApp starts the delegated server and watches for app/code file changes to trigger restarts (SDK-managed connections or no socket only)
 func (r *LocalServer) WatchApp(ctx context.Context) error {
-	// Only run for SDK-managed connections
-	if !r.cliConfig.Config.SDKManagedConnection {
-		r.clients.IO.PrintDebug(ctx, "App watching is only enabled for SDK-managed connections")
+	// Only run for SDK-managed connections or apps without a socket
+	if !r.cliConfig.Config.SDKManagedConnection && !r.noSocket {
+		r.clients.IO.PrintDebug(ctx, "App watching is only enabled for SDK-managed connections or without a socket")
 		return nil
 	}
 
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/slackapi/slack-cli/internal/api"
@@ -74,6 +75,35 @@ func Test_LocalServer_Start(t *testing.T) {
 				require.ErrorContains(t, server.Start(ctx), "oh no")
 			},
 		},
+		"should reconnect after a dropped socket with reconnect attempts": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				conn.On("ReadMessage").Return(0, []byte{}, slackerror.New("oh no")).Once()
+				conn.On("ReadMessage").Return(websocket.CloseMessage, []byte{}, &websocket.CloseError{Code: websocket.CloseNormalClosure, Text: "byebye"}).Once()
+			},
+			fakeDialer: func(conn *WebSocketConnMock) func(d *websocket.Dialer, urlStr string, requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
+				return func(d *websocket.Dialer, urlStr string, requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
+					return conn, nil, nil
+				}
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server *LocalServer, conn *WebSocketConnMock) {
+				server.reconnectAttempts = 1
+				server.reconnectBackoff = time.Millisecond
+				require.ErrorContains(t, server.Start(ctx), slackerror.ErrLocalAppRunCleanExit)
+				conn.AssertNumberOfCalls(t, "Close", 2)
+				cm.API.AssertNumberOfCalls(t, "ConnectionsOpen", 2)
+			},
+		},
+		"should return an error after the reconnect attempts are used": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				cm.API.On("ConnectionsOpen", mock.Anything, mock.Anything).Return(api.AppsConnectionsOpenResult{}, slackerror.New("no can do, pipes are clogged"))
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server *LocalServer, conn *WebSocketConnMock) {
+				server.reconnectAttempts = 2
+				server.reconnectBackoff = time.Millisecond
+				require.ErrorContains(t, server.Start(ctx), "pipes are clogged")
+				cm.API.AssertNumberOfCalls(t, "ConnectionsOpen", 3)
+			},
+		},
 		"should re-establish connection if disconnect message received": {
 			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
 				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
@@ -286,6 +316,30 @@ func Test_LocalServer_Listen(t *testing.T) {
 				}
 			},
 		},
+		"should set the inspect port in the start hook environment if the debugger is enabled": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				// TODO: should probably create a hookscript mock instead of doing this.
+				clients.SDKConfig.Hooks.Start = hooks.HookScript{Command: "echo '{}'", Name: "start"}
+				// Simulate receiving an event, then a disconnect message
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"event\",\"payload\":{}}"), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
+				cm.HookExecutor.On("Execute", mock.Anything, mock.Anything).Return("{}", nil)
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server *LocalServer, conn *WebSocketConnMock) {
+				server.inspectPort = 9229
+				errChan := make(chan error)
+				done := make(chan bool)
+				go server.Listen(ctx, errChan, done)
+				select {
+				case err := <-errChan:
+					assert.Fail(t, "unexpected err channel signalled", err)
+				case <-done:
+					cm.HookExecutor.AssertCalled(t, "Execute", mock.Anything, mock.MatchedBy(func(opts hooks.HookExecOpts) bool {
+						return opts.Env["SLACK_CLI_INSPECT_PORT"] == "9229"
+					}))
+				}
+			},
+		},
 		"should not send a websocket response message if socket event message received led to start hook error": {
 			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
 				// TODO: should probably create a hookscript mock instead of doing this.
@@ -17,11 +17,14 @@ package platform
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/slackapi/slack-cli/cmd/feedback"
 	"github.com/slackapi/slack-cli/cmd/triggers"
+	"github.com/slackapi/slack-cli/internal/cache"
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/pkg/apps"
 	"github.com/slackapi/slack-cli/internal/shared"
@@ -32,6 +35,9 @@ import (
 	"github.com/slackapi/slack-cli/internal/style"
 )
 
+// InspectPortDefault is the runtime debugger port used when one is not chosen
+const InspectPortDefault = 9229
+
 // RunArgs are the arguments passed into the Run function
 type RunArgs struct {
 	Activity            bool
@@ -40,6 +46,10 @@ type RunArgs struct {
 	AppFilePath         string
 	Auth                types.SlackAuth
 	Cleanup             bool
+	InspectPort         int
+	NoSocket            bool
+	ReconnectAttempts   int
+	ReconnectBackoff    time.Duration
 	ShowTriggers        bool
 	OrgGrantWorkspaceID string
 }
@@ -132,6 +142,10 @@ func Run(ctx context.Context, clients *shared.ClientFactory, runArgs RunArgs) (t
 		localHostedContext: localHostedContext,
 		cliConfig:          cliConfig,
 		appFilePath:        runArgs.AppFilePath,
+		inspectPort:        runArgs.InspectPort,
+		noSocket:           runArgs.NoSocket,
+		reconnectAttempts:  runArgs.ReconnectAttempts,
+		reconnectBackoff:   runArgs.ReconnectBackoff,
 		Connection:         nil,
 	}
 
@@ -148,6 +162,7 @@ func Run(ctx context.Context, clients *shared.ClientFactory, runArgs RunArgs) (t
 		if cleanup {
 			deleteAppOnTerminate(ctx, clients, runArgs.Auth, installedApp, teamName)
 		}
+		deleteRunSession(ctx, clients, installedApp.AppID)
 		feedback.ShowFeedbackMessageOnTerminate(ctx, clients)
 		// Notify Slack backend we are closing connection; this should trigger an echoing close message from Slack
 		// in Listen() below (as per WS spec), which we can detect and gracefully return from.
@@ -169,6 +184,7 @@ func Run(ctx context.Context, clients *shared.ClientFactory, runArgs RunArgs) (t
 	// be canceled, then cleanup performed, with the erroring error returned.
 	errChan := make(chan error)
 	clients.IO.PrintTrace(ctx, slacktrace.PlatformRunStart)
+	saveRunSession(ctx, clients, installedApp.AppID, *authSession.TeamID)
 
 	// Start watching for Slack Platform log activity
 	if runArgs.Activity {
@@ -183,10 +199,15 @@ func Run(ctx context.Context, clients *shared.ClientFactory, runArgs RunArgs) (t
 		errChan <- server.WatchManifest(ctx, runArgs.Auth, installedApp)
 	}()
 
-	// Check to see whether the SDK managed connection flag is enabled
-	// If so start app watcher (which handles initial start + restarts), otherwise start connection
-	if cliConfig.Config.SDKManagedConnection {
-		clients.IO.PrintDebug(ctx, "Delegating connection to SDK managed script hook")
+	// Check to see whether the SDK managed connection flag is enabled or the
+	// app serves HTTP requests without a socket. If so start app watcher (which
+	// handles initial start + restarts), otherwise start connection
+	if cliConfig.Config.SDKManagedConnection || runArgs.NoSocket {
+		if runArgs.NoSocket {
+			clients.IO.PrintDebug(ctx, "Running the start hook without a CLI managed socket connection")
+		} else {
+			clients.IO.PrintDebug(ctx, "Delegating connection to SDK managed script hook")
+		}
 		// Start app watcher which handles initial server start and restarts on file changes
 		go func() {
 			errChan <- server.WatchApp(ctx)
@@ -220,3 +241,22 @@ func deleteAppOnTerminate(ctx context.Context, clients *shared.ClientFactory, au
 		clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(fmt.Sprintf(`Cleaned up local app install for "%s".`, teamName)))
 	}
 }
+
+// saveRunSession records the running app so "run --attach" can find it
+func saveRunSession(ctx context.Context, clients *shared.ClientFactory, appID string, teamID string) {
+	session := cache.RunSession{
+		PID:       os.Getpid(),
+		TeamID:    teamID,
+		StartedAt: time.Now().Unix(),
+	}
+	if err := clients.Config.ProjectConfig.Cache().SetRunSession(ctx, appID, session); err != nil {
+		clients.IO.PrintDebug(ctx, "Failed to save the run session: %s", err)
+	}
+}
+
+// deleteRunSession removes the record of the running app once it has stopped
+func deleteRunSession(ctx context.Context, clients *shared.ClientFactory, appID string) {
+	if err := clients.Config.ProjectConfig.Cache().DeleteRunSession(ctx, appID); err != nil {
+		clients.IO.PrintDebug(ctx, "Failed to remove the run session: %s", err)
+	}
+}
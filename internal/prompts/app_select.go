@@ -20,6 +20,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/slackapi/slack-cli/internal/api"
@@ -152,7 +153,7 @@ func getApps(ctx context.Context, clients *shared.ClientFactory) (map[string]Sel
 		}
 	}
 	for _, saved := range deployedApps {
-		if appIDs[saved.AppID].App.AppID != "" {
+		if appIDs[saved.AppID].App.AppID != "" || saved.IsDeleted() {
 			continue
 		}
 		resolvedAuth, err := clients.Auth().AuthWithTeamID(ctx, saved.TeamID)
@@ -175,7 +176,7 @@ func getApps(ctx context.Context, clients *shared.ClientFactory) (map[string]Sel
 		appIDs[selection.App.AppID] = selection
 	}
 	for _, saved := range localApps {
-		if appIDs[saved.AppID].App.AppID != "" {
+		if appIDs[saved.AppID].App.AppID != "" || saved.IsDeleted() {
 			continue
 		}
 		resolvedAuth, err := clients.Auth().AuthWithTeamID(ctx, saved.TeamID)
@@ -205,36 +206,15 @@ func getApps(ctx context.Context, clients *shared.ClientFactory) (map[string]Sel
 			teamIDToAppIDs[app.App.TeamID] = []SelectedApp{app}
 		}
 	}
-	for _, apps := range teamIDToAppIDs {
-		if len(apps) <= 0 {
-			continue
-		}
-		auth := apps[0].Auth
-		apiHost := ""
-		if auth.APIHost != nil {
-			apiHost = *auth.APIHost
-		}
-		ids := []string{}
-		for _, app := range apps {
-			ids = append(ids, app.App.AppID)
-		}
-		statuses, err := getInstallationStatuses(ctx, clients, auth.Token, ids, auth.TeamID, apiHost)
-		if err != nil {
-			clients.IO.PrintDebug(
-				ctx,
-				"error fetching installation status for the following %s in team %s %v: %s",
-				style.Pluralize("app", "apps", len(appIDs)),
-				auth.TeamDomain,
-				appIDs,
-				err.Error(),
-			)
-		}
-		for _, status := range statuses {
-			app := appIDs[status.AppID]
-			app.App.EnterpriseGrants = status.EnterpriseGrants
-			app.App.InstallStatus = status.InstallationState
-			appIDs[status.AppID] = app
-		}
+	statuses, err := getInstallationStatusesByTeam(ctx, clients, teamIDToAppIDs, installationStatusConcurrency)
+	if err != nil {
+		return map[string]SelectedApp{}, err
+	}
+	for _, status := range statuses {
+		app := appIDs[status.AppID]
+		app.App.EnterpriseGrants = status.EnterpriseGrants
+		app.App.InstallStatus = status.InstallationState
+		appIDs[status.AppID] = app
 	}
 	return appIDs, nil
 }
@@ -331,15 +311,84 @@ type AppStatus struct {
 	EnterpriseGrants  []types.EnterpriseGrant
 }
 
+// installationStatusConcurrency is the most teams with installation states
+// fetched at once
+const installationStatusConcurrency = 4
+
+// getInstallationStatusesByTeam fetches installation states for the apps of
+// each team with at most concurrency requests in flight. Requests in flight are
+// stopped and an interrupt error is returned once the context is canceled.
+func getInstallationStatusesByTeam(ctx context.Context, clients *shared.ClientFactory, teamIDToAppIDs map[string][]SelectedApp, concurrency int) ([]AppStatus, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	statuses := []AppStatus{}
+	semaphore := make(chan struct{}, max(concurrency, 1))
+	var collecting sync.Mutex
+	var wg sync.WaitGroup
+	for _, apps := range teamIDToAppIDs {
+		if len(apps) <= 0 {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-semaphore }()
+
+			auth := apps[0].Auth
+			apiHost := ""
+			if auth.APIHost != nil {
+				apiHost = *auth.APIHost
+			}
+			ids := []string{}
+			for _, app := range apps {
+				ids = append(ids, app.App.AppID)
+			}
+			teamStatuses, err := getInstallationStatuses(ctx, clients, auth.Token, ids, auth.TeamID, apiHost)
+			if err != nil {
+				clients.IO.PrintDebug(
+					ctx,
+					"error fetching installation status for the following %s in team %s %v: %s",
+					style.Pluralize("app", "apps", len(ids)),
+					auth.TeamDomain,
+					ids,
+					err.Error(),
+				)
+				return
+			}
+			collecting.Lock()
+			defer collecting.Unlock()
+			statuses = append(statuses, teamStatuses...)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	if ctx.Err() != nil {
+		return nil, slackerror.New(slackerror.ErrProcessInterrupted).WithRootCause(ctx.Err())
+	}
+	return statuses, nil
+}
+
 // getInstallationStatuses fetches installation states for the apps.
 func getInstallationStatuses(ctx context.Context, clients *shared.ClientFactory, token string, appIDs []string, teamID string, apiHost string) ([]AppStatus, error) {
 	startTimer := time.Now()
 
-	// Ensure that the client's host in this case is set to any apiHost provided
-	apiClient := clients.API()
-	if apiHost != "" {
-		apiClient.SetHost(apiHost)
-	}
+	// Use a client for the apiHost since statuses of teams with different hosts
+	// are fetched at the same time
+	apiClient := clients.APIWithHost(apiHost)
 
 	// Get the app status of appIDs that are sorted for stable mocking in tests
 	slices.Sort(appIDs)
@@ -901,9 +950,10 @@ func SortAlphaNumeric(teamDomains []string, labels []string, teamIDs []string) e
 	return nil
 }
 
-// appExists checks if the app exists based on the presence of an app ID
+// appExists checks if the app exists based on the presence of an app ID and
+// that the app was not deleted
 func appExists(app types.App) bool {
-	return app.AppID != ""
+	return app.AppID != "" && !app.IsDeleted()
 }
 
 // validateAuth checks if the auth for the selected app is valid and if not,
@@ -918,7 +968,7 @@ func validateAuth(ctx context.Context, clients *shared.ClientFactory, auth *type
 	}
 	_, err := apiClient.ValidateSession(ctx, auth.Token)
 	if err == nil {
-		return nil
+		return warnAuthExpiry(ctx, clients, auth)
 	}
 	_, unfilteredError := clients.Auth().FilterKnownAuthErrors(ctx, err)
 	if unfilteredError != nil || !clients.IO.IsTTY() {
@@ -932,3 +982,44 @@ func validateAuth(ctx context.Context, clients *shared.ClientFactory, auth *type
 	*auth = reauth
 	return nil
 }
+
+// warnAuthExpiry warns if the auth expires within the configured number of days
+// and prompts to re-authenticate before the auth expires
+//
+// The prompt is skipped without an interactive terminal or with the
+// --no-prompt flag.
+func warnAuthExpiry(ctx context.Context, clients *shared.ClientFactory, auth *types.SlackAuth) error {
+	days, err := clients.Config.SystemConfig.GetAuthExpiryWarningDays(ctx)
+	if err != nil {
+		clients.IO.PrintDebug(ctx, "failed to read the auth expiry warning days: %s", err)
+		return nil
+	}
+	if days <= 0 || !auth.ExpiresWithin(time.Duration(days)*24*time.Hour) {
+		return nil
+	}
+	expiresAt := time.Unix(int64(auth.ExpiresAt), 0)
+	clients.IO.PrintWarning(ctx, "The authorization for %s expires on %s", auth.TeamDomain, expiresAt.Format(time.DateOnly))
+	if !clients.IO.IsTTY() || isNoPromptFlagSet(clients) {
+		clients.IO.PrintInfo(ctx, false, "%s", style.SectionSecondaryf("Re-authenticate with %s to avoid interruptions", style.Commandf("login", false)))
+		return nil
+	}
+	reauthenticate, err := clients.IO.ConfirmPrompt(ctx, "Re-authenticate now?", false)
+	if err != nil || !reauthenticate {
+		return err
+	}
+	reauth, _, err := authpkg.Login(ctx, clients.API(), clients.Auth(), clients.Config, clients.IO, "", false)
+	if err != nil {
+		return err
+	}
+	*auth = reauth
+	return nil
+}
+
+// isNoPromptFlagSet returns true if the command has a --no-prompt flag set
+func isNoPromptFlagSet(clients *shared.ClientFactory) bool {
+	if clients.Config.Flags == nil {
+		return false
+	}
+	flag := clients.Config.Flags.Lookup("no-prompt")
+	return flag != nil && flag.Value.String() == "true"
+}
@@ -15,7 +15,9 @@
 package prompts
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -473,6 +475,32 @@ func TestPrompt_AppSelectPrompt_GetApps(t *testing.T) {
 				},
 			},
 		},
+		"excludes deployed apps that are marked as deleted": {
+			mockAuths: fakeAuthsByTeamDomainSlice,
+			mockAppsSavedDeployed: []types.App{
+				{
+					AppID:      deployedTeam1InstalledAppID,
+					DeletedAt:  1700000000,
+					TeamDomain: team1TeamDomain,
+					TeamID:     team1TeamID,
+				},
+				deployedTeam2UninstalledApp,
+			},
+			mockTeam2StatusAppIDs: []string{
+				deployedTeam2UninstalledAppID,
+			},
+			mockTeam2Status: api.GetAppStatusResult{
+				Apps: []api.AppStatusResultAppInfo{
+					deployedTeam2UninstalledAppStatus,
+				},
+			},
+			expectedApps: map[string]SelectedApp{
+				deployedTeam2UninstalledAppID: {
+					App:  deployedTeam2UninstalledApp,
+					Auth: fakeAuthsByTeamDomain[team2TeamDomain],
+				},
+			},
+		},
 		"returns enterprise workspace apps with matching auths": {
 			mockAuths: []types.SlackAuth{
 				{
@@ -645,6 +673,108 @@ func TestPrompt_AppSelectPrompt_GetApps(t *testing.T) {
 	}
 }
 
+func TestPrompt_getInstallationStatusesByTeam(t *testing.T) {
+	teamIDToAppIDs := map[string][]SelectedApp{}
+	for i := range 6 {
+		teamID := fmt.Sprintf("T%d", i)
+		teamIDToAppIDs[teamID] = []SelectedApp{{
+			Auth: types.SlackAuth{TeamID: teamID, Token: fmt.Sprintf("xoxp-%d", i)},
+			App:  types.App{AppID: fmt.Sprintf("A%d", i), TeamID: teamID},
+		}}
+	}
+
+	t.Run("fetches statuses with at most the concurrency in flight", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		clientsMock := shared.NewClientsMock()
+		var counting sync.Mutex
+		inFlight, maxInFlight := 0, 0
+		clientsMock.API.On(GetAppStatus, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				counting.Lock()
+				inFlight++
+				maxInFlight = max(maxInFlight, inFlight)
+				counting.Unlock()
+				time.Sleep(10 * time.Millisecond)
+				counting.Lock()
+				inFlight--
+				counting.Unlock()
+			}).
+			Return(api.GetAppStatusResult{Apps: []api.AppStatusResultAppInfo{{Installed: true}}}, nil)
+		clientsMock.AddDefaultMocks()
+		clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+		statuses, err := getInstallationStatusesByTeam(ctx, clients, teamIDToAppIDs, 2)
+		require.NoError(t, err)
+		assert.Len(t, statuses, 6)
+		assert.LessOrEqual(t, maxInFlight, 2)
+		clientsMock.API.AssertNumberOfCalls(t, GetAppStatus, 6)
+	})
+
+	t.Run("fetches statuses of teams with different hosts using separate clients", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		clientsMock := shared.NewClientsMock()
+		clientsMock.AddDefaultMocks()
+		clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+		hosts := []string{"https://slack.com", "https://dev.slack.com", "https://dev1234.slack.com"}
+		apiMocks := map[string]*api.APIMock{}
+		mixedTeams := map[string][]SelectedApp{}
+		for i := range 6 {
+			host := hosts[i%len(hosts)]
+			if _, ok := apiMocks[host]; !ok {
+				apiMocks[host] = &api.APIMock{}
+			}
+			teamID := fmt.Sprintf("T%d", i)
+			token := fmt.Sprintf("xoxp-%d", i)
+			appID := fmt.Sprintf("A%d", i)
+			apiMocks[host].On(GetAppStatus, mock.Anything, token, []string{appID}, teamID).
+				Return(api.GetAppStatusResult{Apps: []api.AppStatusResultAppInfo{{AppID: appID, Installed: true}}}, nil)
+			mixedTeams[teamID] = []SelectedApp{{
+				Auth: types.SlackAuth{TeamID: teamID, Token: token, APIHost: &host},
+				App:  types.App{AppID: appID, TeamID: teamID},
+			}}
+		}
+		clients.APIWithHost = func(host string) api.APIInterface {
+			return apiMocks[host]
+		}
+
+		statuses, err := getInstallationStatusesByTeam(ctx, clients, mixedTeams, 3)
+		require.NoError(t, err)
+		assert.Len(t, statuses, 6)
+		for _, apiMock := range apiMocks {
+			apiMock.AssertNumberOfCalls(t, GetAppStatus, 2)
+			apiMock.AssertNotCalled(t, "SetHost", mock.Anything)
+		}
+		clientsMock.API.AssertNotCalled(t, GetAppStatus, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns early when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(slackcontext.MockContext(t.Context()))
+		clientsMock := shared.NewClientsMock()
+		started := make(chan struct{}, len(teamIDToAppIDs))
+		release := make(chan struct{})
+		defer close(release)
+		clientsMock.API.On(GetAppStatus, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				started <- struct{}{}
+				<-release
+			}).
+			Return(api.GetAppStatusResult{}, nil)
+		clientsMock.AddDefaultMocks()
+		clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+		go func() {
+			<-started
+			cancel()
+		}()
+		start := time.Now()
+		statuses, err := getInstallationStatusesByTeam(ctx, clients, teamIDToAppIDs, 2)
+		assert.Less(t, time.Since(start), time.Second)
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrProcessInterrupted, slackerror.ToSlackError(err).Code)
+		assert.Nil(t, statuses)
+	})
+}
+
 func TestPrompt_AppSelectPrompt(t *testing.T) {
 	tests := map[string]struct {
 		mockAuths                  []types.SlackAuth
@@ -1811,6 +1941,93 @@ func Test_ValidateAuth(t *testing.T) {
 	}
 }
 
+func Test_warnAuthExpiry(t *testing.T) {
+	expiresSoon := int(time.Now().Add(24 * time.Hour).Unix())
+	expiresLater := int(time.Now().Add(30 * 24 * time.Hour).Unix())
+	tests := map[string]struct {
+		authProvided          types.SlackAuth
+		authExpected          types.SlackAuth
+		expiryWarningDays     *int
+		ioIsTTYResponse       bool
+		ioConfirmPromptResult bool
+		expectedWarning       bool
+		expectedPrompt        bool
+	}{
+		"does not warn for an auth without an expiration": {
+			authProvided: types.SlackAuth{Token: "xoxp-original"},
+			authExpected: types.SlackAuth{Token: "xoxp-original"},
+		},
+		"does not warn for an auth that expires after the threshold": {
+			authProvided: types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresLater},
+			authExpected: types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresLater},
+		},
+		"does not warn when the warning is disabled": {
+			authProvided:      types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			authExpected:      types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			expiryWarningDays: new(int),
+		},
+		"warns without a prompt outside of an interactive terminal": {
+			authProvided:    types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			authExpected:    types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			expectedWarning: true,
+		},
+		"warns and keeps the auth if re-authentication is declined": {
+			authProvided:    types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			authExpected:    types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			ioIsTTYResponse: true,
+			expectedWarning: true,
+			expectedPrompt:  true,
+		},
+		"warns and replaces the auth if re-authentication is confirmed": {
+			authProvided:          types.SlackAuth{Token: "xoxp-original", ExpiresAt: expiresSoon},
+			authExpected:          types.SlackAuth{Token: "xoxp-renewed", TeamID: team1TeamID, TeamDomain: team1TeamDomain},
+			ioIsTTYResponse:       true,
+			ioConfirmPromptResult: true,
+			expectedWarning:       true,
+			expectedPrompt:        true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.API.On("GenerateAuthTicket", mock.Anything, mock.Anything, mock.Anything).Return(api.GenerateAuthTicketResult{}, nil)
+			clientsMock.API.On("ExchangeAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(api.ExchangeAuthTicketResult{
+				Token:      "xoxp-renewed",
+				TeamID:     team1TeamID,
+				TeamDomain: team1TeamDomain,
+			}, nil)
+			clientsMock.Auth.On("IsAPIHostSlackProd", mock.Anything).Return(true)
+			clientsMock.Auth.On(SetAuth, mock.Anything, mock.Anything).Return(types.SlackAuth{}, "", nil)
+			clientsMock.IO.On("InputPrompt", mock.Anything, "Enter challenge code", iostreams.InputPromptConfig{Required: true}).Return("challengeCode", nil)
+			clientsMock.IO.On("ConfirmPrompt", mock.Anything, "Re-authenticate now?", false).Return(tc.ioConfirmPromptResult, nil)
+			clientsMock.IO.On("IsTTY").Return(tc.ioIsTTYResponse)
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			if tc.expiryWarningDays != nil {
+				err := clients.Config.SystemConfig.SetAuthExpiryWarningDays(ctx, *tc.expiryWarningDays)
+				require.NoError(t, err)
+			}
+
+			err := warnAuthExpiry(ctx, clients, &tc.authProvided)
+			require.NoError(t, err)
+
+			tc.authProvided.LastUpdated = time.Time{} // ignore time for this tc
+			assert.Equal(t, tc.authExpected, tc.authProvided)
+			if tc.expectedWarning {
+				clientsMock.IO.AssertCalled(t, "PrintWarning", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				clientsMock.IO.AssertNotCalled(t, "PrintWarning", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tc.expectedPrompt {
+				clientsMock.IO.AssertCalled(t, "ConfirmPrompt", mock.Anything, "Re-authenticate now?", false)
+			} else {
+				clientsMock.IO.AssertNotCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
 // Test_getAuths_NoWorkspacesConnected tests the login prompt behavior when no
 // workspaces are connected
 func Test_getAuths_NoWorkspacesConnected(t *testing.T) {
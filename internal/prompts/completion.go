@@ -0,0 +1,81 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prompts
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/spf13/cobra"
+)
+
+// AppFlagCompletion suggests app environments and the app IDs saved to the
+// project for the app flag
+//
+// Completions are gathered from saved files only since network requests would
+// make completions slow.
+func AppFlagCompletion(clients *shared.ClientFactory) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		ctx := cmd.Context()
+		completions := []cobra.Completion{
+			cobra.CompletionWithDesc("local", "the app run locally"),
+			cobra.CompletionWithDesc("deploy", "the app deployed to Slack"),
+		}
+		apps := []types.App{}
+		if deployedApps, _, err := clients.AppClient().GetDeployedAll(ctx); err == nil {
+			apps = append(apps, deployedApps...)
+		}
+		if localApps, err := clients.AppClient().GetLocalAll(ctx); err == nil {
+			apps = append(apps, localApps...)
+		}
+		sort.Slice(apps, func(i, j int) bool {
+			return apps[i].AppID < apps[j].AppID
+		})
+		for _, app := range apps {
+			if !appExists(app) {
+				continue
+			}
+			environment := "deployed"
+			if app.IsDev {
+				environment = "local"
+			}
+			completions = append(completions, cobra.CompletionWithDesc(
+				app.AppID,
+				fmt.Sprintf("%s %s", app.TeamDomain, environment),
+			))
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// TeamFlagCompletion suggests the team domains and IDs of saved authorizations
+// for the team flag
+func TeamFlagCompletion(clients *shared.ClientFactory) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		ctx := cmd.Context()
+		auths, err := clients.Auth().Auths(ctx)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions := []cobra.Completion{}
+		for _, auth := range auths {
+			completions = append(completions, cobra.CompletionWithDesc(auth.TeamID, auth.TeamDomain))
+		}
+		sort.Strings(completions)
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prompts
+
+import (
+	"testing"
+
+	"github.com/slackapi/slack-cli/internal/shared"
+	"github.com/slackapi/slack-cli/internal/shared/types"
+	"github.com/slackapi/slack-cli/internal/slackcontext"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppFlagCompletion(t *testing.T) {
+	tests := map[string]struct {
+		mockAppsSavedDeployed []types.App
+		mockAppsSavedLocal    []types.App
+		expectedCompletions   []string
+	}{
+		"suggests environments without saved apps": {
+			expectedCompletions: []string{
+				"local\tthe app run locally",
+				"deploy\tthe app deployed to Slack",
+			},
+		},
+		"suggests saved app ids with the team domain": {
+			mockAppsSavedDeployed: []types.App{
+				{AppID: "A0002", TeamID: "T0001", TeamDomain: "sandbox"},
+			},
+			mockAppsSavedLocal: []types.App{
+				{AppID: "A0001", TeamID: "T0001", TeamDomain: "sandbox", IsDev: true},
+			},
+			expectedCompletions: []string{
+				"local\tthe app run locally",
+				"deploy\tthe app deployed to Slack",
+				"A0001\tsandbox local",
+				"A0002\tsandbox deployed",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			for _, app := range tc.mockAppsSavedDeployed {
+				err := clients.AppClient().SaveDeployed(ctx, app)
+				require.NoError(t, err)
+			}
+			for _, app := range tc.mockAppsSavedLocal {
+				err := clients.AppClient().SaveLocal(ctx, app)
+				require.NoError(t, err)
+			}
+			cmd := &cobra.Command{}
+			cmd.SetContext(ctx)
+			completions, directive := AppFlagCompletion(clients)(cmd, nil, "")
+			assert.Equal(t, tc.expectedCompletions, completions)
+			assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+		})
+	}
+}
+
+func TestTeamFlagCompletion(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.Auth.On(Auths, mock.Anything).Return([]types.SlackAuth{
+		{TeamID: "T0002", TeamDomain: "production"},
+		{TeamID: "T0001", TeamDomain: "sandbox"},
+	}, nil)
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	completions, directive := TeamFlagCompletion(clients)(cmd, nil, "")
+	assert.Equal(t, []string{"T0001\tsandbox", "T0002\tproduction"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
@@ -43,6 +43,7 @@ import (
 // ClientFactory are shared clients and configurations for use across the CLI commands (cmd) and handlers (pkg).
 type ClientFactory struct {
 	API          func() api.APIInterface
+	APIWithHost  func(host string) api.APIInterface
 	AppClient    func() *app.Client
 	Auth         func() auth.AuthInterface
 	Config       *config.Config
@@ -63,6 +64,9 @@ type ClientFactory struct {
 
 	// CleanupWaitGroup is a group of wait groups shared by all packages and allow functions to cleanup before the process terminates
 	CleanupWaitGroup sync.WaitGroup
+
+	// CredentialStores are the credential stores of auth clients resolved once for the process
+	CredentialStores *auth.CredentialStores
 }
 
 const sdkSlackDevDomainFlag = "sdk-slack-dev-domain"
@@ -87,7 +91,9 @@ func NewClientFactory(options ...func(*ClientFactory)) *ClientFactory {
 		Fs: clients.Fs,
 	}
 	clients.EventTracker = tracking.NewEventTracker()
+	clients.CredentialStores = auth.NewCredentialStores()
 	clients.API = clients.defaultAPIFunc
+	clients.APIWithHost = clients.defaultAPIWithHostFunc
 	clients.AppClient = clients.defaultAppClientFunc
 	clients.Auth = clients.defaultAuthFunc
 	clients.Browser = clients.defaultBrowserFunc
@@ -111,14 +117,26 @@ func (c *ClientFactory) defaultAPIFunc() api.APIInterface {
 	return c.defaultAPIClientFunc()
 }
 
+// defaultAPIWithHostFunc return a new API Client using the host or the
+// ConfigAPIHost if the host is empty
+//
+// Each client has its own host so requests to different hosts can be made at
+// the same time without changing the host of another client.
+func (c *ClientFactory) defaultAPIWithHostFunc(host string) api.APIInterface {
+	if host == "" {
+		return c.defaultAPIFunc()
+	}
+	return api.NewClient(nil, host, c.IO)
+}
+
 // defaultAppClientFunc return a new App Client
 func (c *ClientFactory) defaultAppClientFunc() *app.Client {
-	return app.NewClient(c.API(), c.Config, c.Fs, c.Os)
+	return app.NewClient(c.API(), c.Config, c.IO, c.Fs, c.Os)
 }
 
 // defaultAuthClientFunc return a new Auth Client
 func (c *ClientFactory) defaultAuthClientFunc() *auth.Client {
-	return auth.NewClient(c.API(), c.AppClient(), c.Config, c.IO, c.Fs)
+	return auth.NewClient(c.API(), c.AppClient(), c.Config, c.IO, c.Fs).WithCredentialStores(c.CredentialStores)
 }
 
 // defaultAuthFunc return a new Auth Interface
@@ -131,6 +149,13 @@ func (c *ClientFactory) defaultBrowserFunc() slackdeps.Browser {
 	return slackdeps.NewBrowser(c.IO.WriteOut())
 }
 
+// IsStyleEnabled returns true if styles and colors should be shown in outputs,
+// which requires an interactive terminal and that neither the --no-color flag
+// nor the NO_COLOR environment variable is set
+func (c *ClientFactory) IsStyleEnabled() bool {
+	return c.IO.IsTTY() && !c.Config.NoColor
+}
+
 // InitRuntime initializes a new Runtime instance from the runtime flag or the
 // SDK config or the directory structure
 func (c *ClientFactory) InitRuntime(ctx context.Context, dirPath string) error {
@@ -238,7 +263,7 @@ func (c *ClientFactory) InitSDKConfig(ctx context.Context, dirPath string) error
 	// TODO: this is a side-effect-y way of signaling to the rest of the codebase "we are in an app project directory now"
 	c.SDKConfig.WorkingDirectory = dirPath
 
-	c.HookExecutor = hooks.GetHookExecutor(c.IO, c.Fs, c.SDKConfig)
+	c.HookExecutor = hooks.GetHookExecutor(c.IO, c.Fs, c.SDKConfig, c.Config.HookTimeoutFlag)
 
 	return err
 }
@@ -277,8 +302,9 @@ func (c *ClientFactory) InitSDKConfigFromJSON(ctx context.Context, configFileByt
 			Hook: getHooksConfig.Hooks.GetHooks,
 		}
 		defaultExecutor := hooks.HookExecutorDefaultProtocol{
-			IO: c.IO,
-			Fs: c.Fs,
+			IO:      c.IO,
+			Fs:      c.Fs,
+			Timeout: c.Config.HookTimeoutFlag,
 		}
 		if SDKHooksResponse, err = defaultExecutor.Execute(ctx, hookExecOpts); err != nil {
 			return err
@@ -62,7 +62,7 @@ func NewClientsMock() *ClientsMock {
 	clientsMock.Config = config.NewConfig(clientsMock.Fs, clientsMock.Os)
 	clientsMock.IO = iostreams.NewIOStreamsMock(clientsMock.Config, clientsMock.Fs, clientsMock.Os)
 
-	clientsMock.AppClient = &app.Client{Manifest: &app.ManifestMockObject{}, AppClientInterface: app.NewAppClient(clientsMock.Config, clientsMock.Fs, clientsMock.Os)}
+	clientsMock.AppClient = &app.Client{Manifest: &app.ManifestMockObject{}, AppClientInterface: app.NewAppClient(clientsMock.Config, clientsMock.IO, clientsMock.Fs, clientsMock.Os)}
 
 	return clientsMock
 }
@@ -88,6 +88,7 @@ func (m *ClientsMock) MockClientFactory() func(c *ClientFactory) {
 		clients.IO = m.IO
 		clients.Fs = m.Fs
 		clients.API = func() api.APIInterface { return m.API }
+		clients.APIWithHost = func(host string) api.APIInterface { return m.API }
 		clients.Auth = func() auth.AuthInterface { return m.Auth }
 		clients.AppClient = func() *app.Client { return m.AppClient }
 		clients.HookExecutor = &m.HookExecutor
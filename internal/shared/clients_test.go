@@ -23,6 +23,7 @@ import (
 	"github.com/slackapi/slack-cli/internal/slackcontext"
 	"github.com/slackapi/slack-cli/internal/slackdeps"
 	"github.com/slackapi/slack-cli/internal/slackerror"
+	"github.com/slackapi/slack-cli/internal/style"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,6 +46,49 @@ func Test_ClientFactory_FunctionalOptions(t *testing.T) {
 	require.True(t, clients.Config.SlackDevFlag, "default should be true")
 }
 
+func Test_ClientFactory_IsStyleEnabled(t *testing.T) {
+	tests := map[string]struct {
+		isTTY    bool
+		noColor  bool
+		expected bool
+	}{
+		"shows styles in an interactive terminal": {
+			isTTY:    true,
+			expected: true,
+		},
+		"removes styles when color is disabled": {
+			isTTY:    true,
+			noColor:  true,
+			expected: false,
+		},
+		"removes styles when outputs are piped": {
+			isTTY:    false,
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clientsMock := NewClientsMock()
+			clientsMock.IO.On("IsTTY").Return(tc.isTTY)
+			clientsMock.AddDefaultMocks()
+			clients := NewClientFactory(clientsMock.MockClientFactory())
+			clients.Config.NoColor = tc.noColor
+			defer style.ToggleStyles(false)
+
+			enabled := clients.IsStyleEnabled()
+			assert.Equal(t, tc.expected, enabled)
+			style.ToggleStyles(enabled)
+			output := style.Highlight("example") + style.Secondary("example") + style.Emoji("gear")
+			if tc.expected {
+				assert.NotEqual(t, style.RemoveANSI(output), output)
+			} else {
+				assert.NotContains(t, output, "\x1b[")
+				assert.Equal(t, "exampleexample", output)
+			}
+		})
+	}
+}
+
 const getHooksScript = `#!/bin/sh
 	echo "{\"hooks\": {\"start\": \"echo 'start' $@\"}}"
 `
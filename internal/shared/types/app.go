@@ -232,6 +232,7 @@ type EnterpriseGrant struct {
 // App models app metadata such as team domain, AppID, TeamID and UserID
 type App struct {
 	AppID            string            `json:"app_id,omitempty"`
+	DeletedAt        int64             `json:"deleted_at,omitempty"` // Unix time the app was deleted from Slack
 	EnterpriseID     string            `json:"enterprise_id,omitempty"`
 	EnterpriseGrants []EnterpriseGrant `json:"-"`
 	LegacyName       string            `json:"name,omitempty"` // Legacy "name". Do not use this field.
@@ -248,6 +249,9 @@ func (app *App) Equals(otherApp App) bool {
 	if app.AppID != otherApp.AppID {
 		return false
 	}
+	if app.DeletedAt != otherApp.DeletedAt {
+		return false
+	}
 	if app.EnterpriseID != otherApp.EnterpriseID {
 		return false
 	}
@@ -295,6 +299,11 @@ func NewApp() App {
 	return App{new: true}
 }
 
+// IsDeleted returns true if the app was deleted from Slack but kept in project files
+func (app *App) IsDeleted() bool {
+	return app.DeletedAt != 0
+}
+
 // IsUninstalled returns true if the app's installation status indicates that it is uninstalled
 func (app *App) IsUninstalled() bool {
 	return app.InstallStatus == AppStatusUninstalled
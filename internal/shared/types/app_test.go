@@ -836,6 +836,21 @@ func Test_App_NewApp(t *testing.T) {
 	require.True(t, app.IsNew())
 }
 
+func Test_App_IsDeleted(t *testing.T) {
+	// Should be false when an app is not marked as deleted
+	app := App{
+		AppID: "A123",
+	}
+	require.False(t, app.IsDeleted())
+
+	// Should be true when an app is marked as deleted
+	app = App{
+		AppID:     "A123",
+		DeletedAt: 1700000000,
+	}
+	require.True(t, app.IsDeleted())
+}
+
 func Test_App_IsUninstalled(t *testing.T) {
 	// Should be false when an app is installed
 	app := App{
@@ -39,6 +39,7 @@ type SlackAuth struct {
 	RefreshToken        string    `json:"refresh_token,omitempty"`
 	ExpiresAt           int       `json:"exp,omitempty"`
 	IsEnterpriseInstall bool      `json:"is_enterprise_install,omitempty"`
+	IsServiceToken      bool      `json:"is_service_token,omitempty"`
 }
 
 // AuthLevel returns the authorization level of a specific SlackAuth, e.g. organization or workspace level
@@ -107,3 +108,16 @@ func (a *SlackAuth) TokenIsExpired() bool {
 	now := int(time.Now().Unix())
 	return now > a.ExpiresAt
 }
+
+// ExpiresWithin returns true if an auth credential that cannot be rotated
+// expires before the duration has passed
+func (a *SlackAuth) ExpiresWithin(duration time.Duration) bool {
+
+	// tokens without an expiration or with a refresh token are not at risk of expiring
+	if a == nil || a.ExpiresAt == 0 || a.RefreshToken != "" {
+		return false
+	}
+
+	expiresAt := time.Unix(int64(a.ExpiresAt), 0)
+	return time.Until(expiresAt) <= duration
+}
@@ -114,3 +114,56 @@ func Test_SlackAuth_TokenIsExpired(t *testing.T) {
 		})
 	}
 }
+
+func Test_SlackAuth_ExpiresWithin(t *testing.T) {
+	var token = "fakeToken"
+	var timeNow = int(time.Now().Unix())
+	var day = 24 * 60 * 60 // in seconds
+
+	tests := map[string]struct {
+		input    *SlackAuth
+		duration time.Duration
+		expected bool
+	}{
+		"nil case": {
+			input:    nil,
+			duration: 7 * 24 * time.Hour,
+			expected: false,
+		},
+		"token but no expiration": {
+			input:    &SlackAuth{Token: token},
+			duration: 7 * 24 * time.Hour,
+			expected: false,
+		},
+		"token + expiration + refresh token - token rotates before expiring": {
+			input:    &SlackAuth{Token: token, ExpiresAt: timeNow + day, RefreshToken: "fakeRefreshToken"},
+			duration: 7 * 24 * time.Hour,
+			expected: false,
+		},
+		"token + expiration present - and token expires before the threshold": {
+			input:    &SlackAuth{Token: token, ExpiresAt: timeNow + 6*day},
+			duration: 7 * 24 * time.Hour,
+			expected: true,
+		},
+		"token + expiration present - and token expires after the threshold": {
+			input:    &SlackAuth{Token: token, ExpiresAt: timeNow + 8*day},
+			duration: 7 * 24 * time.Hour,
+			expected: false,
+		},
+		"token + expiration present - and token is already expired": {
+			input:    &SlackAuth{Token: token, ExpiresAt: timeNow - 1},
+			duration: 7 * 24 * time.Hour,
+			expected: true,
+		},
+		"token + expiration present - and the threshold is zero": {
+			input:    &SlackAuth{Token: token, ExpiresAt: timeNow + day},
+			duration: 0,
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.input.ExpiresWithin(tc.duration))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022-2026 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// DeployArgs contains options for deploying an app to the Slack Platform
+type DeployArgs struct {
+	// ShowTriggers lists triggers and prompts to create a trigger when none exist
+	ShowTriggers bool
+	// MaxUploadRetries is the number of times a failed code bundle upload is retried
+	MaxUploadRetries int
+}
@@ -44,6 +44,9 @@ type Os interface {
 	// Getwd defaults to `os.Getwd` and can be mocked to test
 	Getwd() (dir string, err error)
 
+	// Chdir defaults to `os.Chdir` and can be mocked to test
+	Chdir(dir string) error
+
 	// UserHomeDir returns the current user's home directory and can be mocked to test
 	UserHomeDir() (dir string, err error)
 
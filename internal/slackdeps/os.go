@@ -63,6 +63,11 @@ func (c *Os) Getwd() (dir string, err error) {
 	return os.Getwd()
 }
 
+// Chdir defaults to `os.Chdir` and can be mocked to test
+func (c *Os) Chdir(dir string) error {
+	return os.Chdir(dir)
+}
+
 // UserHomeDir returns the current user's home directory and can be mocked to test
 func (c *Os) UserHomeDir() (string, error) {
 	return os.UserHomeDir()
@@ -48,6 +48,7 @@ func (m *OsMock) AddDefaultMocks() {
 	m.On("Setenv", mock.Anything, mock.Anything).Return(nil)
 	m.On("Unsetenv", mock.Anything).Return(nil)
 	m.On("Getwd").Return(MockWorkingDirectory, nil)
+	m.On("Chdir", mock.Anything).Return(nil)
 	m.On("UserHomeDir").Return(MockHomeDirectory, nil)
 	m.On("GetExecutionDir").Return(MockHomeDirectory, nil)
 	m.On("SetExecutionDir", mock.Anything)
@@ -98,6 +99,12 @@ func (m *OsMock) Getwd() (_dir string, _err error) {
 	return args.String(0), args.Error(1)
 }
 
+// Chdir mocks changing the working directory.
+func (m *OsMock) Chdir(dir string) error {
+	args := m.Called(dir)
+	return args.Error(0)
+}
+
 // UserHomeDir mocks returning the home directory.
 func (m *OsMock) UserHomeDir() (_dir string, _err error) {
 	args := m.Called()
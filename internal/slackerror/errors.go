@@ -42,6 +42,7 @@ const (
 	ErrAppFlagRequired                               = "app_flag_required"
 	ErrAppFound                                      = "app_found"
 	ErrAppHosted                                     = "app_hosted"
+	ErrAppIconUpdate                                 = "app_icon_update_error"
 	ErrAppInstall                                    = "app_install_error"
 	ErrAppManifestAccess                             = "app_manifest_access_error"
 	ErrAppManifestCreate                             = "app_manifest_create_error"
@@ -57,6 +58,7 @@ const (
 	ErrAppsList                                      = "apps_list_error"
 	ErrAtActiveSandboxLimit                          = "at_active_sandbox_limit" // Slack API error code
 	ErrAuthProdTokenNotFound                         = "auth_prod_token_not_found"
+	ErrAuthTeamMismatch                              = "auth_team_mismatch"
 	ErrAuthTimeout                                   = "auth_timeout_error"
 	ErrAuthToken                                     = "auth_token_error"
 	ErrAuthVerification                              = "auth_verification_error"
@@ -77,6 +79,8 @@ const (
 	ErrCannotRevokeOrgBotToken                       = "cannot_revoke_org_bot_token"
 	ErrChannelNotFound                               = "channel_not_found"
 	ErrCommentRequired                               = "comment_required"
+	ErrConfigKeyUnknown                              = "config_key_unknown"
+	ErrConfigValueInvalid                            = "config_value_invalid"
 	ErrConnectedOrgDenied                            = "connected_org_denied"
 	ErrConnectedTeamDenied                           = "connected_team_denied"
 	ErrConnectorApprovalPending                      = "connector_approval_pending"
@@ -84,6 +88,7 @@ const (
 	ErrConnectorDenied                               = "connector_denied"
 	ErrConnectorNotInstalled                         = "connector_not_installed"
 	ErrContextValueNotFound                          = "context_value_not_found"
+	ErrCredentialStoreUnavailable                    = "credential_store_unavailable"
 	ErrCredentialsNotFound                           = "credentials_not_found"
 	ErrCustomizableInputMissingMatchingWorkflowInput = "customizable_input_missing_matching_workflow_input"
 	ErrCustomizableInputUnsupportedType              = "customizable_input_unsupported_type"
@@ -121,19 +126,23 @@ const (
 	ErrFunctionNotFound                              = "function_not_found"
 	ErrGitClone                                      = "git_clone_error"
 	ErrGitNotFound                                   = "git_not_found"
+	ErrGitRefNotFound                                = "git_ref_not_found"
 	ErrGitZipDownload                                = "git_zip_download_error"
 	ErrHTTPRequestFailed                             = "http_request_failed"
 	ErrHTTPResponseInvalid                           = "http_response_invalid"
 	ErrHomeDirectoryAccessFailed                     = "home_directory_access_failed"
 	ErrHooksJSONLocation                             = "hooks_json_location_error"
 	ErrHostAppsDisallowUserScopes                    = "hosted_apps_disallow_user_scopes"
+	ErrIconFileTooLarge                              = "icon_file_too_large"
 	ErrInsecureRequest                               = "insecure_request"
 	ErrInstallationDenied                            = "installation_denied"
 	ErrInstallationFailed                            = "installation_failed"
 	ErrInstallationRequired                          = "installation_required"
 	ErrInternal                                      = "internal_error"
+	ErrInvalidAPIHost                                = "invalid_api_host"
 	ErrInvalidApp                                    = "invalid_app"
 	ErrInvalidAppDirectory                           = "invalid_app_directory"
+	ErrInvalidAppFile                                = "invalid_app_file"
 	ErrInvalidAppFlag                                = "invalid_app_flag"
 	ErrInvalidAppID                                  = "invalid_app_id"
 	ErrInvalidArgs                                   = "invalid_args"
@@ -142,6 +151,7 @@ const (
 	ErrInvalidAuth                                   = "invalid_auth"
 	ErrInvalidChallenge                              = "invalid_challenge"
 	ErrInvalidChannelID                              = "invalid_channel_id"
+	ErrInvalidConfigFile                             = "invalid_config_file"
 	ErrInvalidCursor                                 = "invalid_cursor"
 	ErrInvalidDatastore                              = "invalid_datastore"
 	ErrInvalidDatastoreExpression                    = "invalid_datastore_expression"
@@ -151,6 +161,7 @@ const (
 	ErrInvalidManifest                               = "invalid_manifest"
 	ErrInvalidManifestSource                         = "invalid_manifest_source"
 	ErrInvalidParameters                             = "invalid_parameters"
+	ErrInvalidPassphrase                             = "invalid_passphrase"
 	ErrInvalidPermissionType                         = "invalid_permission_type"
 	ErrInvalidRefreshToken                           = "invalid_refresh_token"
 	ErrInvalidRequestID                              = "invalid_request_id"
@@ -181,6 +192,8 @@ const (
 	ErrLocalAppRemoval                               = "local_app_removal_error"
 	ErrLocalAppRun                                   = "local_app_run_error"
 	ErrLocalAppRunCleanExit                          = "local_app_run_clean_exit"
+	ErrManifestLintWarnings                          = "manifest_lint_warnings"
+	ErrManifestSnapshotNotFound                      = "manifest_snapshot_not_found"
 	ErrMethodNotSupported                            = "method_not_supported"
 	ErrMismatchedFlags                               = "mismatched_flags"
 	ErrMissingAppID                                  = "missing_app_id"
@@ -222,6 +235,7 @@ const (
 	ErrRatelimited                                   = "ratelimited"
 	ErrRequestIDOrAppIDIsRequired                    = "request_id_or_app_id_is_required"
 	ErrRestrictedPlanLevel                           = "restricted_plan_level"
+	ErrRunSessionNotFound                            = "run_session_not_found"
 	ErrRuntimeNotFound                               = "runtime_not_found"
 	ErrRuntimeNotSupported                           = "runtime_not_supported"
 	ErrSDKConfigLoad                                 = "sdk_config_load_error"
@@ -249,6 +263,7 @@ const (
 	ErrTeamNotFound                                  = "team_not_found"
 	ErrTeamNotOnEnterprise                           = "team_not_on_enterprise"
 	ErrTeamQuotaExceeded                             = "team_quota_exceeded"
+	ErrTemplateChecksumMismatch                      = "template_checksum_mismatch"
 	ErrTemplatePathNotFound                          = "template_path_not_found"
 	ErrTokenExpired                                  = "token_expired"
 	ErrTokenRevoked                                  = "token_revoked"
@@ -397,6 +412,11 @@ Otherwise start your app for local development with: %s`,
 		Message: "App is configured for Run on Slack infrastructure",
 	},
 
+	ErrAppIconUpdate: {
+		Code:    ErrAppIconUpdate,
+		Message: "Couldn't update the app icon",
+	},
+
 	ErrAppInstall: {
 		Code:    ErrAppInstall,
 		Message: "Couldn't install your app to a workspace",
@@ -481,6 +501,12 @@ Otherwise start your app for local development with: %s`,
 		),
 	},
 
+	ErrAuthTeamMismatch: {
+		Code:        ErrAuthTeamMismatch,
+		Message:     "The authorization is for a team other than the --team flag",
+		Remediation: "Run the slash command in the team of the --team flag or log in without the --team flag",
+	},
+
 	ErrAuthTimeout: {
 		Code:        ErrAuthTimeout,
 		Message:     "Couldn't receive authorization in the time allowed",
@@ -588,8 +614,20 @@ Otherwise start your app for local development with: %s`,
 	},
 
 	ErrCommentRequired: {
-		Code:    ErrCommentRequired,
-		Message: "Your admin is requesting a reason to approve installation of this app",
+		Code:        ErrCommentRequired,
+		Message:     "Your admin is requesting a reason to approve installation of this app",
+		Remediation: "Provide a reason with the `--request-reason` flag of the install command",
+	},
+
+	ErrConfigKeyUnknown: {
+		Code:        ErrConfigKeyUnknown,
+		Message:     "The configuration key is not known",
+		Remediation: fmt.Sprintf("Find the available keys with the %s command", style.Commandf("config --help", false)),
+	},
+
+	ErrConfigValueInvalid: {
+		Code:    ErrConfigValueInvalid,
+		Message: "The configuration value is not valid",
 	},
 
 	ErrConnectedOrgDenied: {
@@ -631,6 +669,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "The context value could not be found",
 	},
 
+	ErrCredentialStoreUnavailable: {
+		Code:        ErrCredentialStoreUnavailable,
+		Message:     "The credential store is not available",
+		Remediation: fmt.Sprintf("Save authorizations to the credentials file with %s", style.Commandf("config set credential_store file --system", false)),
+	},
+
 	ErrCredentialsNotFound: {
 		Code:        ErrCredentialsNotFound,
 		Message:     "No authentication found for this team",
@@ -826,6 +870,12 @@ Otherwise start your app for local development with: %s`,
 		Remediation: "To install Git, visit https://github.com/git-guides/install-git.",
 	},
 
+	ErrGitRefNotFound: {
+		Code:        ErrGitRefNotFound,
+		Message:     "The git reference was not found in the repository",
+		Remediation: "Check that the branch, tag, or commit exists in the template repository",
+	},
+
 	ErrGitZipDownload: {
 		Code:    ErrGitZipDownload,
 		Message: "Cannot download Git repository as a .zip archive",
@@ -858,6 +908,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "Hosted apps do not support user scopes",
 	},
 
+	ErrIconFileTooLarge: {
+		Code:        ErrIconFileTooLarge,
+		Message:     "The icon file is too large to upload",
+		Remediation: "Use an image file that is 2 MB or smaller",
+	},
+
 	ErrInsecureRequest: {
 		Code:    ErrInsecureRequest,
 		Message: "The method was not called via a `POST` request",
@@ -886,6 +942,12 @@ Otherwise start your app for local development with: %s`,
 		Remediation: "Please reach out to feedback@slack.com if the problem persists.",
 	},
 
+	ErrInvalidAPIHost: {
+		Code:        ErrInvalidAPIHost,
+		Message:     "The API host is not a valid URL",
+		Remediation: "Provide the API host as a URL such as `--apihost https://dev.slack.com`",
+	},
+
 	ErrInvalidApp: {
 		Code:    ErrInvalidApp,
 		Message: "Either the app does not exist or an app created from the provided manifest would not be valid",
@@ -901,6 +963,12 @@ Otherwise start your app for local development with: %s`,
 		}, "\n"),
 	},
 
+	ErrInvalidAppFile: {
+		Code:        ErrInvalidAppFile,
+		Message:     "The file of apps to deploy is not valid",
+		Remediation: "Check that the --app-file is YAML or JSON with a list of apps that each have an app_id",
+	},
+
 	ErrInvalidAppFlag: {
 		Code:        ErrInvalidAppFlag,
 		Message:     "The provided --app flag value is not valid",
@@ -949,6 +1017,12 @@ Otherwise start your app for local development with: %s`,
 		Remediation: "Channel ID appears to be formatted correctly. Check if this channel exists on the current team and that you have permissions to access it.",
 	},
 
+	ErrInvalidConfigFile: {
+		Code:        ErrInvalidConfigFile,
+		Message:     "The config file of default flag values is not valid",
+		Remediation: "Check that the --config file is YAML or JSON with flag names as keys",
+	},
+
 	ErrInvalidCursor: {
 		Code:    ErrInvalidCursor,
 		Message: "Value passed for `cursor` was not valid or is valid no longer",
@@ -1002,6 +1076,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "slack_cli_version supplied is invalid",
 	},
 
+	ErrInvalidPassphrase: {
+		Code:        ErrInvalidPassphrase,
+		Message:     "The passphrase is incorrect or the exported credentials are damaged",
+		Remediation: "Check that the passphrase matches the one used to export the credentials",
+	},
+
 	ErrInvalidPermissionType: {
 		Code:    ErrInvalidPermissionType,
 		Message: "Permission type must be set to `named_entities` before you can manage users",
@@ -1151,6 +1231,18 @@ Otherwise start your app for local development with: %s`,
 		Message: "Couldn't run app locally",
 	},
 
+	ErrManifestLintWarnings: {
+		Code:        ErrManifestLintWarnings,
+		Message:     "The app manifest has lint warnings",
+		Remediation: "Update the app manifest to resolve the warnings or run the command again without --strict",
+	},
+
+	ErrManifestSnapshotNotFound: {
+		Code:        ErrManifestSnapshotNotFound,
+		Message:     "No saved app manifest is available to roll back to",
+		Remediation: "App manifests are saved to the project before each deploy updates the app manifest",
+	},
+
 	ErrMethodNotSupported: {
 		Code:    ErrMethodNotSupported,
 		Message: "This API method is not supported",
@@ -1368,6 +1460,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "Your Slack plan does not have access to the requested feature",
 	},
 
+	ErrRunSessionNotFound: {
+		Code:        ErrRunSessionNotFound,
+		Message:     "No running development session was found for the app",
+		Remediation: fmt.Sprintf("Start a development session in another terminal with %s", style.Commandf("run", false)),
+	},
+
 	ErrRuntimeNotFound: {
 		Code:        ErrRuntimeNotFound,
 		Message:     "The hook runtime executable was not found",
@@ -1528,6 +1626,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "Total number of requests exceeded team quota",
 	},
 
+	ErrTemplateChecksumMismatch: {
+		Code:        ErrTemplateChecksumMismatch,
+		Message:     "The downloaded template does not match the expected checksum",
+		Remediation: "Confirm the SHA-256 checksum of the template archive and that the template source is trusted",
+	},
+
 	ErrTemplatePathNotFound: {
 		Code:    "template_path_not_found",
 		Message: "No template app was found at the provided path",
@@ -16,6 +16,7 @@ package slackerror
 
 import (
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 
@@ -41,6 +42,53 @@ func (warn Warnings) Warning(verbose bool, message string) string {
 	if len(warn) == 0 {
 		return message
 	}
+	return fmt.Sprintf("%s\n\n%s\n", message, strings.TrimSpace(strings.Join(warn.format(verbose), "\n")))
+}
+
+// WarningBySection formats the custom Warnings array with a message and groups
+// the warnings by the top level manifest key of each pointer
+//
+// Warnings without a pointer are grouped together after the other sections.
+func (warn Warnings) WarningBySection(verbose bool, message string) string {
+	if warn == nil {
+		return ""
+	}
+	if len(warn) == 0 {
+		return message
+	}
+	sections := map[string]Warnings{}
+	for _, w := range warn {
+		sections[w.Section()] = append(sections[w.Section()], w)
+	}
+	names := slices.Sorted(maps.Keys(sections))
+	if names[0] == "" {
+		names = append(names[1:], "")
+	}
+	var sectionsF []string
+	for _, name := range names {
+		heading := name
+		if heading == "" {
+			heading = "other"
+		}
+		sectionsF = append(sectionsF, fmt.Sprintf(
+			"%s\n%s",
+			style.Bold(heading),
+			strings.TrimSpace(strings.Join(sections[name].format(verbose), "\n")),
+		))
+	}
+	return fmt.Sprintf("%s\n\n%s\n", message, strings.Join(sectionsF, "\n\n"))
+}
+
+// Section returns the top level manifest key of the warning pointer such as
+// "functions" for "/functions/greet" or an empty string without a pointer
+func (w Warning) Section() string {
+	pointer := strings.TrimPrefix(strings.TrimSpace(w.Pointer), "/")
+	section, _, _ := strings.Cut(pointer, "/")
+	return section
+}
+
+// format returns a section for each unique warning with the sources grouped
+func (warn Warnings) format(verbose bool) []string {
 	type warningKey struct {
 		Code        string
 		Message     string
@@ -116,5 +164,5 @@ func (warn Warnings) Warning(verbose bool, message string) string {
 			},
 		}))
 	}
-	return fmt.Sprintf("%s\n\n%s\n", message, strings.TrimSpace(strings.Join(warningsF, "\n")))
+	return warningsF
 }
@@ -15,6 +15,7 @@
 package slackerror
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/slackapi/slack-cli/internal/style"
@@ -260,3 +261,107 @@ func Test_Warning(t *testing.T) {
 		})
 	}
 }
+
+func Test_WarningBySection(t *testing.T) {
+	tests := map[string]struct {
+		message  string
+		warnings Warnings
+		expected string
+	}{
+		"formats nothing if nothing is provided": {
+			warnings: nil,
+		},
+		"formats the message if only a message without details": {
+			message:  "something strange happened",
+			warnings: []Warning{},
+			expected: "something strange happened",
+		},
+		"groups warnings by the top level key of the pointer": {
+			message: "something strange happened",
+			warnings: []Warning{
+				{
+					Code:    "warn_workflow",
+					Message: "a workflow step is unused",
+					Pointer: "/workflows/post_random_gif/steps/0",
+				},
+				{
+					Code:    "warn_manifest",
+					Message: "the manifest is large",
+				},
+				{
+					Code:    "warn_scope",
+					Message: "a scope is unused",
+					Pointer: "/oauth_config/scopes/bot",
+				},
+				{
+					Code:    "warn_function",
+					Message: "a function is unused",
+					Pointer: "/functions/greet",
+				},
+				{
+					Code:    "warn_function",
+					Message: "a function is unused",
+					Pointer: "/functions/goodbye",
+				},
+			},
+			expected: "something strange happened\n\n" +
+				style.Bold("functions") + "\n" +
+				strings.TrimSpace(style.Sectionf(style.TextSection{
+					Emoji: "memo",
+					Text:  "a function is unused (warn_function)",
+					Secondary: []string{
+						"Source: /functions/goodbye",
+						"Source: /functions/greet",
+					},
+				})) + "\n\n" +
+				style.Bold("oauth_config") + "\n" +
+				strings.TrimSpace(style.Sectionf(style.TextSection{
+					Emoji:     "memo",
+					Text:      "a scope is unused (warn_scope)",
+					Secondary: []string{"Source: /oauth_config/scopes/bot"},
+				})) + "\n\n" +
+				style.Bold("workflows") + "\n" +
+				strings.TrimSpace(style.Sectionf(style.TextSection{
+					Emoji:     "memo",
+					Text:      "a workflow step is unused (warn_workflow)",
+					Secondary: []string{"Source: /workflows/post_random_gif/steps/0"},
+				})) + "\n\n" +
+				style.Bold("other") + "\n" +
+				strings.TrimSpace(style.Sectionf(style.TextSection{
+					Emoji: "memo",
+					Text:  "the manifest is large (warn_manifest)",
+				})) + "\n",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.warnings.WarningBySection(false, tc.message)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_Warning_Section(t *testing.T) {
+	tests := map[string]struct {
+		pointer  string
+		expected string
+	}{
+		"returns the top level key of a nested pointer": {
+			pointer:  "/oauth_config/scopes/bot",
+			expected: "oauth_config",
+		},
+		"returns the key of a top level pointer": {
+			pointer:  "/settings",
+			expected: "settings",
+		},
+		"returns nothing without a pointer": {
+			pointer:  " ",
+			expected: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Warning{Pointer: tc.pointer}.Section())
+		})
+	}
+}
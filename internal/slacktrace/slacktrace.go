@@ -42,6 +42,7 @@ const (
 	AdminAppApprovalRequestSendError       = "SLACK_TRACE_ADMIN_APPROVAL_REQUEST_SEND_ERROR"
 	AdminAppApprovalRequestShouldSend      = "SLACK_TRACE_ADMIN_APPROVAL_REQUEST_SHOULD_SEND"
 	AdminAppApprovalRequestRequired        = "SLACK_TRACE_ADMIN_APPROVAL_REQUIRED"
+	AppInstallStep                         = "SLACK_TRACE_APP_INSTALL_STEP"
 	AppLinkStart                           = "SLACK_TRACE_APP_LINK_START"
 	AppLinkSuccess                         = "SLACK_TRACE_APP_LINK_SUCCESS"
 	AppSettingsStart                       = "SLACK_TRACE_APP_SETTINGS_START"
@@ -57,6 +58,7 @@ const (
 	AuthLogoutSuccess                      = "SLACK_TRACE_AUTH_LOGOUT_SUCCESS"
 	AuthRevokeStart                        = "SLACK_TRACE_AUTH_REVOKE_START"
 	AuthRevokeSuccess                      = "SLACK_TRACE_AUTH_REVOKE_SUCCESS"
+	AuthWhoamiSuccess                      = "SLACK_TRACE_AUTH_WHOAMI_SUCCESS"
 	CollaboratorAddCollaborator            = "SLACK_TRACE_COLLABORATOR_ADD_COLLABORATOR"
 	CollaboratorAddSuccess                 = "SLACK_TRACE_COLLABORATOR_ADD_SUCCESS"
 	CollaboratorListCollaborator           = "SLACK_TRACE_COLLABORATOR_LIST_COLLABORATOR"
@@ -76,6 +76,12 @@ type AppEventData struct {
 	EnterpriseID string `json:"enterprise_id,omitempty"`
 	TeamID       string `json:"team_id,omitempty"`
 	UserID       string `json:"user_id,omitempty"`
+	// DeployMessage holds the note attached to a deploy with the `deploy --message` flag
+	DeployMessage string `json:"deploy_message,omitempty"`
+	// GitBranch holds the git branch of a deploy with the `deploy --git-metadata` flag
+	GitBranch string `json:"git_branch,omitempty"`
+	// GitCommit holds the git commit SHA of a deploy with the `deploy --git-metadata` flag
+	GitCommit string `json:"git_commit,omitempty"`
 	// Template holds information for the sample app template used as part of the `create` command
 	Template string `json:"template,omitempty"`
 }
@@ -50,6 +50,8 @@ type TrackingManager interface {
 	SetAppTeamID(id string)
 	SetAppUserID(id string)
 	SetAppTemplate(template string)
+	SetAppDeployMessage(message string)
+	SetAppGitMetadata(commit string, branch string)
 }
 
 type EventTracker struct {
@@ -146,6 +148,21 @@ func (e *EventTracker) SetAppTemplate(template string) {
 	e.setSessionData(data)
 }
 
+// SetAppDeployMessage sets the note attached to a deploy in this CLI execution for metrics
+func (e *EventTracker) SetAppDeployMessage(message string) {
+	data := e.getSessionData()
+	data.App.DeployMessage = message
+	e.setSessionData(data)
+}
+
+// SetAppGitMetadata sets the git commit and branch of a deploy in this CLI execution for metrics
+func (e *EventTracker) SetAppGitMetadata(commit string, branch string) {
+	data := e.getSessionData()
+	data.App.GitCommit = commit
+	data.App.GitBranch = branch
+	e.setSessionData(data)
+}
+
 // cleanSessionData ensures every string value the provided object has PII redacted
 func (e *EventTracker) cleanSessionData(data EventData) EventData {
 	if len(data.ErrorMessage) > 0 {
@@ -154,6 +171,12 @@ func (e *EventTracker) cleanSessionData(data EventData) EventData {
 	if len(data.App.Template) > 0 {
 		data.App.Template = goutils.RedactPII(data.App.Template)
 	}
+	if len(data.App.DeployMessage) > 0 {
+		data.App.DeployMessage = goutils.RedactPII(style.RemoveANSI(data.App.DeployMessage))
+	}
+	if len(data.App.GitBranch) > 0 {
+		data.App.GitBranch = goutils.RedactPII(data.App.GitBranch)
+	}
 
 	return data
 }
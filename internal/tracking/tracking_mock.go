@@ -30,6 +30,8 @@ func (m *EventTrackerMock) AddDefaultMocks() {
 	m.On("SetAppEnterpriseID", mock.Anything)
 	m.On("SetAppTeamID", mock.Anything)
 	m.On("SetAppTemplate", mock.Anything)
+	m.On("SetAppDeployMessage", mock.Anything)
+	m.On("SetAppGitMetadata", mock.Anything, mock.Anything)
 	m.On("SetAppUserID", mock.Anything)
 	m.On("SetAuthEnterpriseID", mock.Anything)
 	m.On("SetAuthTeamID", mock.Anything)
@@ -88,3 +90,11 @@ func (m *EventTrackerMock) SetAppUserID(id string) {
 func (m *EventTrackerMock) SetAppTemplate(template string) {
 	m.Called(template)
 }
+
+func (m *EventTrackerMock) SetAppDeployMessage(message string) {
+	m.Called(message)
+}
+
+func (m *EventTrackerMock) SetAppGitMetadata(commit string, branch string) {
+	m.Called(commit, branch)
+}
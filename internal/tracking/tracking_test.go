@@ -64,6 +64,18 @@ func Test_Tracking_cleanSessionData(t *testing.T) {
 				},
 			},
 		},
+		"should redact tokens from deploy messages": {
+			input: EventData{
+				App: AppEventData{
+					DeployMessage: "release 1.4.2 with token=xoxb-1234-5678",
+				},
+			},
+			expectedOutput: EventData{
+				App: AppEventData{
+					DeployMessage: "release 1.4.2 with token=...",
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -272,6 +284,33 @@ func Test_Tracking_SetSessionData(t *testing.T) {
 			},
 			value: "slack-samples/deno-hello-world",
 		},
+		"app deploy message can be retrieved": {
+			setterFunc: func(e *EventTracker, value string) {
+				e.SetAppDeployMessage(value)
+			},
+			getterFunc: func(e *EventTracker) string {
+				return e.getSessionData().App.DeployMessage
+			},
+			value: "release 1.4.2",
+		},
+		"app git commit can be retrieved": {
+			setterFunc: func(e *EventTracker, value string) {
+				e.SetAppGitMetadata(value, "main")
+			},
+			getterFunc: func(e *EventTracker) string {
+				return e.getSessionData().App.GitCommit
+			},
+			value: "0123456789abcdef0123456789abcdef01234567",
+		},
+		"app git branch can be retrieved": {
+			setterFunc: func(e *EventTracker, value string) {
+				e.SetAppGitMetadata("0123456789abcdef0123456789abcdef01234567", value)
+			},
+			getterFunc: func(e *EventTracker) string {
+				return e.getSessionData().App.GitBranch
+			},
+			value: "release/1.4",
+		},
 	}
 
 	for name, tc := range tests {
@@ -105,6 +105,16 @@ func (u *UpdateNotification) SetHours(h float64) {
 	u.hoursToWait = h
 }
 
+// SetInterval sets the time to wait between checking for updates where 0
+// disables checking for updates.
+func (u *UpdateNotification) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		u.enabled = false
+		return
+	}
+	u.hoursToWait = interval.Hours()
+}
+
 // Hours returns the number of hours to wait between checking for updates.
 func (u *UpdateNotification) Hours() float64 {
 	return u.hoursToWait
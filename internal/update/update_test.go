@@ -19,6 +19,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/slackapi/slack-cli/internal/config"
 	"github.com/slackapi/slack-cli/internal/hooks"
@@ -145,6 +146,16 @@ func Test_UpdateNotification_SetHours(t *testing.T) {
 	require.Equal(t, 0.0, u.Hours())
 }
 
+func Test_UpdateNotification_SetInterval(t *testing.T) {
+	u := &UpdateNotification{enabled: true}
+	u.SetInterval(12 * time.Hour)
+	require.Equal(t, 12.0, u.Hours())
+	require.True(t, u.Enabled())
+	u.SetInterval(0)
+	require.Equal(t, 12.0, u.Hours())
+	require.False(t, u.Enabled())
+}
+
 func Test_UpdateNotification_isIgnoredCommand(t *testing.T) {
 	for name, tc := range map[string]struct {
 		command  string